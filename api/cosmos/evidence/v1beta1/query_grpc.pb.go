@@ -19,8 +19,9 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Evidence_FullMethodName    = "/cosmos.evidence.v1beta1.Query/Evidence"
-	Query_AllEvidence_FullMethodName = "/cosmos.evidence.v1beta1.Query/AllEvidence"
+	Query_Evidence_FullMethodName           = "/cosmos.evidence.v1beta1.Query/Evidence"
+	Query_AllEvidence_FullMethodName        = "/cosmos.evidence.v1beta1.Query/AllEvidence"
+	Query_UntombstoneRecords_FullMethodName = "/cosmos.evidence.v1beta1.Query/UntombstoneRecords"
 )
 
 // QueryClient is the client API for Query service.
@@ -31,6 +32,9 @@ type QueryClient interface {
 	Evidence(ctx context.Context, in *QueryEvidenceRequest, opts ...grpc.CallOption) (*QueryEvidenceResponse, error)
 	// AllEvidence queries all evidence.
 	AllEvidence(ctx context.Context, in *QueryAllEvidenceRequest, opts ...grpc.CallOption) (*QueryAllEvidenceResponse, error)
+	// UntombstoneRecords queries the recorded untombstone decisions, optionally
+	// filtered by validator consensus address.
+	UntombstoneRecords(ctx context.Context, in *QueryUntombstoneRecordsRequest, opts ...grpc.CallOption) (*QueryUntombstoneRecordsResponse, error)
 }
 
 type queryClient struct {
@@ -59,6 +63,15 @@ func (c *queryClient) AllEvidence(ctx context.Context, in *QueryAllEvidenceReque
 	return out, nil
 }
 
+func (c *queryClient) UntombstoneRecords(ctx context.Context, in *QueryUntombstoneRecordsRequest, opts ...grpc.CallOption) (*QueryUntombstoneRecordsResponse, error) {
+	out := new(QueryUntombstoneRecordsResponse)
+	err := c.cc.Invoke(ctx, Query_UntombstoneRecords_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -67,6 +80,9 @@ type QueryServer interface {
 	Evidence(context.Context, *QueryEvidenceRequest) (*QueryEvidenceResponse, error)
 	// AllEvidence queries all evidence.
 	AllEvidence(context.Context, *QueryAllEvidenceRequest) (*QueryAllEvidenceResponse, error)
+	// UntombstoneRecords queries the recorded untombstone decisions, optionally
+	// filtered by validator consensus address.
+	UntombstoneRecords(context.Context, *QueryUntombstoneRecordsRequest) (*QueryUntombstoneRecordsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -80,6 +96,9 @@ func (UnimplementedQueryServer) Evidence(context.Context, *QueryEvidenceRequest)
 func (UnimplementedQueryServer) AllEvidence(context.Context, *QueryAllEvidenceRequest) (*QueryAllEvidenceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllEvidence not implemented")
 }
+func (UnimplementedQueryServer) UntombstoneRecords(context.Context, *QueryUntombstoneRecordsRequest) (*QueryUntombstoneRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UntombstoneRecords not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -129,6 +148,24 @@ func _Query_AllEvidence_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_UntombstoneRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUntombstoneRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UntombstoneRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_UntombstoneRecords_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UntombstoneRecords(ctx, req.(*QueryUntombstoneRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -144,6 +181,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AllEvidence",
 			Handler:    _Query_AllEvidence_Handler,
 		},
+		{
+			MethodName: "UntombstoneRecords",
+			Handler:    _Query_UntombstoneRecords_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/evidence/v1beta1/query.proto",
@@ -19,7 +19,8 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_SubmitEvidence_FullMethodName = "/cosmos.evidence.v1beta1.Msg/SubmitEvidence"
+	Msg_SubmitEvidence_FullMethodName       = "/cosmos.evidence.v1beta1.Msg/SubmitEvidence"
+	Msg_UntombstoneValidator_FullMethodName = "/cosmos.evidence.v1beta1.Msg/UntombstoneValidator"
 )
 
 // MsgClient is the client API for Msg service.
@@ -29,6 +30,10 @@ type MsgClient interface {
 	// SubmitEvidence submits an arbitrary Evidence of misbehavior such as equivocation or
 	// counterfactual signing.
 	SubmitEvidence(ctx context.Context, in *MsgSubmitEvidence, opts ...grpc.CallOption) (*MsgSubmitEvidenceResponse, error)
+	// UntombstoneValidator defines a governance operation for lifting the tombstone
+	// of a validator, optionally refunding it from the community pool. The authority
+	// defaults to the x/gov module account.
+	UntombstoneValidator(ctx context.Context, in *MsgUntombstoneValidator, opts ...grpc.CallOption) (*MsgUntombstoneValidatorResponse, error)
 }
 
 type msgClient struct {
@@ -48,6 +53,15 @@ func (c *msgClient) SubmitEvidence(ctx context.Context, in *MsgSubmitEvidence, o
 	return out, nil
 }
 
+func (c *msgClient) UntombstoneValidator(ctx context.Context, in *MsgUntombstoneValidator, opts ...grpc.CallOption) (*MsgUntombstoneValidatorResponse, error) {
+	out := new(MsgUntombstoneValidatorResponse)
+	err := c.cc.Invoke(ctx, Msg_UntombstoneValidator_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -55,6 +69,10 @@ type MsgServer interface {
 	// SubmitEvidence submits an arbitrary Evidence of misbehavior such as equivocation or
 	// counterfactual signing.
 	SubmitEvidence(context.Context, *MsgSubmitEvidence) (*MsgSubmitEvidenceResponse, error)
+	// UntombstoneValidator defines a governance operation for lifting the tombstone
+	// of a validator, optionally refunding it from the community pool. The authority
+	// defaults to the x/gov module account.
+	UntombstoneValidator(context.Context, *MsgUntombstoneValidator) (*MsgUntombstoneValidatorResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -65,6 +83,9 @@ type UnimplementedMsgServer struct {
 func (UnimplementedMsgServer) SubmitEvidence(context.Context, *MsgSubmitEvidence) (*MsgSubmitEvidenceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitEvidence not implemented")
 }
+func (UnimplementedMsgServer) UntombstoneValidator(context.Context, *MsgUntombstoneValidator) (*MsgUntombstoneValidatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UntombstoneValidator not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -96,6 +117,24 @@ func _Msg_SubmitEvidence_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UntombstoneValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUntombstoneValidator)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UntombstoneValidator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UntombstoneValidator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UntombstoneValidator(ctx, req.(*MsgUntombstoneValidator))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -107,6 +146,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SubmitEvidence",
 			Handler:    _Msg_SubmitEvidence_Handler,
 		},
+		{
+			MethodName: "UntombstoneValidator",
+			Handler:    _Msg_UntombstoneValidator_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/evidence/v1beta1/tx.proto",
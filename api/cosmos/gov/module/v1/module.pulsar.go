@@ -10,16 +10,102 @@ import (
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	io "io"
 	reflect "reflect"
+	sort "sort"
 	sync "sync"
 )
 
+var _ protoreflect.Map = (*_Module_6_map)(nil)
+
+type _Module_6_map struct {
+	m *map[string]string
+}
+
+func (x *_Module_6_map) Len() int {
+	if x.m == nil {
+		return 0
+	}
+	return len(*x.m)
+}
+
+func (x *_Module_6_map) Range(f func(protoreflect.MapKey, protoreflect.Value) bool) {
+	if x.m == nil {
+		return
+	}
+	for k, v := range *x.m {
+		mapKey := (protoreflect.MapKey)(protoreflect.ValueOfString(k))
+		mapValue := protoreflect.ValueOfString(v)
+		if !f(mapKey, mapValue) {
+			break
+		}
+	}
+}
+
+func (x *_Module_6_map) Has(key protoreflect.MapKey) bool {
+	if x.m == nil {
+		return false
+	}
+	keyUnwrapped := key.String()
+	concreteValue := keyUnwrapped
+	_, ok := (*x.m)[concreteValue]
+	return ok
+}
+
+func (x *_Module_6_map) Clear(key protoreflect.MapKey) {
+	if x.m == nil {
+		return
+	}
+	keyUnwrapped := key.String()
+	concreteKey := keyUnwrapped
+	delete(*x.m, concreteKey)
+}
+
+func (x *_Module_6_map) Get(key protoreflect.MapKey) protoreflect.Value {
+	if x.m == nil {
+		return protoreflect.Value{}
+	}
+	keyUnwrapped := key.String()
+	concreteKey := keyUnwrapped
+	v, ok := (*x.m)[concreteKey]
+	if !ok {
+		return protoreflect.Value{}
+	}
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Module_6_map) Set(key protoreflect.MapKey, value protoreflect.Value) {
+	if !key.IsValid() || !value.IsValid() {
+		panic("invalid key or value provided")
+	}
+	keyUnwrapped := key.String()
+	concreteKey := keyUnwrapped
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.m)[concreteKey] = concreteValue
+}
+
+func (x *_Module_6_map) Mutable(key protoreflect.MapKey) protoreflect.Value {
+	panic("should not call Mutable on protoreflect.Map whose value is not of type protoreflect.Message")
+}
+
+func (x *_Module_6_map) NewValue() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Module_6_map) IsValid() bool {
+	return x.m != nil
+}
+
 var (
-	md_Module                      protoreflect.MessageDescriptor
-	fd_Module_max_metadata_len     protoreflect.FieldDescriptor
-	fd_Module_authority            protoreflect.FieldDescriptor
-	fd_Module_max_title_len        protoreflect.FieldDescriptor
-	fd_Module_max_summary_len      protoreflect.FieldDescriptor
-	fd_Module_max_vote_options_len protoreflect.FieldDescriptor
+	md_Module                                protoreflect.MessageDescriptor
+	fd_Module_max_metadata_len               protoreflect.FieldDescriptor
+	fd_Module_authority                      protoreflect.FieldDescriptor
+	fd_Module_max_title_len                  protoreflect.FieldDescriptor
+	fd_Module_max_summary_len                protoreflect.FieldDescriptor
+	fd_Module_max_vote_options_len           protoreflect.FieldDescriptor
+	fd_Module_proposal_type_tally_strategies protoreflect.FieldDescriptor
+	fd_Module_max_vote_delegation_depth      protoreflect.FieldDescriptor
+	fd_Module_max_simulate_proposal_gas      protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -30,6 +116,9 @@ func init() {
 	fd_Module_max_title_len = md_Module.Fields().ByName("max_title_len")
 	fd_Module_max_summary_len = md_Module.Fields().ByName("max_summary_len")
 	fd_Module_max_vote_options_len = md_Module.Fields().ByName("max_vote_options_len")
+	fd_Module_proposal_type_tally_strategies = md_Module.Fields().ByName("proposal_type_tally_strategies")
+	fd_Module_max_vote_delegation_depth = md_Module.Fields().ByName("max_vote_delegation_depth")
+	fd_Module_max_simulate_proposal_gas = md_Module.Fields().ByName("max_simulate_proposal_gas")
 }
 
 var _ protoreflect.Message = (*fastReflection_Module)(nil)
@@ -127,6 +216,24 @@ func (x *fastReflection_Module) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if len(x.ProposalTypeTallyStrategies) != 0 {
+		value := protoreflect.ValueOfMap(&_Module_6_map{m: &x.ProposalTypeTallyStrategies})
+		if !f(fd_Module_proposal_type_tally_strategies, value) {
+			return
+		}
+	}
+	if x.MaxVoteDelegationDepth != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxVoteDelegationDepth)
+		if !f(fd_Module_max_vote_delegation_depth, value) {
+			return
+		}
+	}
+	if x.MaxSimulateProposalGas != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxSimulateProposalGas)
+		if !f(fd_Module_max_simulate_proposal_gas, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -152,6 +259,12 @@ func (x *fastReflection_Module) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.MaxSummaryLen != uint64(0)
 	case "cosmos.gov.module.v1.Module.max_vote_options_len":
 		return x.MaxVoteOptionsLen != uint64(0)
+	case "cosmos.gov.module.v1.Module.proposal_type_tally_strategies":
+		return len(x.ProposalTypeTallyStrategies) != 0
+	case "cosmos.gov.module.v1.Module.max_vote_delegation_depth":
+		return x.MaxVoteDelegationDepth != uint64(0)
+	case "cosmos.gov.module.v1.Module.max_simulate_proposal_gas":
+		return x.MaxSimulateProposalGas != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.module.v1.Module"))
@@ -178,6 +291,12 @@ func (x *fastReflection_Module) Clear(fd protoreflect.FieldDescriptor) {
 		x.MaxSummaryLen = uint64(0)
 	case "cosmos.gov.module.v1.Module.max_vote_options_len":
 		x.MaxVoteOptionsLen = uint64(0)
+	case "cosmos.gov.module.v1.Module.proposal_type_tally_strategies":
+		x.ProposalTypeTallyStrategies = nil
+	case "cosmos.gov.module.v1.Module.max_vote_delegation_depth":
+		x.MaxVoteDelegationDepth = uint64(0)
+	case "cosmos.gov.module.v1.Module.max_simulate_proposal_gas":
+		x.MaxSimulateProposalGas = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.module.v1.Module"))
@@ -209,6 +328,18 @@ func (x *fastReflection_Module) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "cosmos.gov.module.v1.Module.max_vote_options_len":
 		value := x.MaxVoteOptionsLen
 		return protoreflect.ValueOfUint64(value)
+	case "cosmos.gov.module.v1.Module.proposal_type_tally_strategies":
+		if len(x.ProposalTypeTallyStrategies) == 0 {
+			return protoreflect.ValueOfMap(&_Module_6_map{})
+		}
+		mapValue := &_Module_6_map{m: &x.ProposalTypeTallyStrategies}
+		return protoreflect.ValueOfMap(mapValue)
+	case "cosmos.gov.module.v1.Module.max_vote_delegation_depth":
+		value := x.MaxVoteDelegationDepth
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.gov.module.v1.Module.max_simulate_proposal_gas":
+		value := x.MaxSimulateProposalGas
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.module.v1.Module"))
@@ -239,6 +370,14 @@ func (x *fastReflection_Module) Set(fd protoreflect.FieldDescriptor, value proto
 		x.MaxSummaryLen = value.Uint()
 	case "cosmos.gov.module.v1.Module.max_vote_options_len":
 		x.MaxVoteOptionsLen = value.Uint()
+	case "cosmos.gov.module.v1.Module.proposal_type_tally_strategies":
+		mv := value.Map()
+		cmv := mv.(*_Module_6_map)
+		x.ProposalTypeTallyStrategies = *cmv.m
+	case "cosmos.gov.module.v1.Module.max_vote_delegation_depth":
+		x.MaxVoteDelegationDepth = value.Uint()
+	case "cosmos.gov.module.v1.Module.max_simulate_proposal_gas":
+		x.MaxSimulateProposalGas = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.module.v1.Module"))
@@ -259,6 +398,12 @@ func (x *fastReflection_Module) Set(fd protoreflect.FieldDescriptor, value proto
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Module) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "cosmos.gov.module.v1.Module.proposal_type_tally_strategies":
+		if x.ProposalTypeTallyStrategies == nil {
+			x.ProposalTypeTallyStrategies = make(map[string]string)
+		}
+		value := &_Module_6_map{m: &x.ProposalTypeTallyStrategies}
+		return protoreflect.ValueOfMap(value)
 	case "cosmos.gov.module.v1.Module.max_metadata_len":
 		panic(fmt.Errorf("field max_metadata_len of message cosmos.gov.module.v1.Module is not mutable"))
 	case "cosmos.gov.module.v1.Module.authority":
@@ -269,6 +414,10 @@ func (x *fastReflection_Module) Mutable(fd protoreflect.FieldDescriptor) protore
 		panic(fmt.Errorf("field max_summary_len of message cosmos.gov.module.v1.Module is not mutable"))
 	case "cosmos.gov.module.v1.Module.max_vote_options_len":
 		panic(fmt.Errorf("field max_vote_options_len of message cosmos.gov.module.v1.Module is not mutable"))
+	case "cosmos.gov.module.v1.Module.max_vote_delegation_depth":
+		panic(fmt.Errorf("field max_vote_delegation_depth of message cosmos.gov.module.v1.Module is not mutable"))
+	case "cosmos.gov.module.v1.Module.max_simulate_proposal_gas":
+		panic(fmt.Errorf("field max_simulate_proposal_gas of message cosmos.gov.module.v1.Module is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.module.v1.Module"))
@@ -292,6 +441,13 @@ func (x *fastReflection_Module) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfUint64(uint64(0))
 	case "cosmos.gov.module.v1.Module.max_vote_options_len":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.gov.module.v1.Module.proposal_type_tally_strategies":
+		m := make(map[string]string)
+		return protoreflect.ValueOfMap(&_Module_6_map{m: &m})
+	case "cosmos.gov.module.v1.Module.max_vote_delegation_depth":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.gov.module.v1.Module.max_simulate_proposal_gas":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.module.v1.Module"))
@@ -377,6 +533,33 @@ func (x *fastReflection_Module) ProtoMethods() *protoiface.Methods {
 		if x.MaxVoteOptionsLen != 0 {
 			n += 1 + runtime.Sov(uint64(x.MaxVoteOptionsLen))
 		}
+		if len(x.ProposalTypeTallyStrategies) > 0 {
+			SiZeMaP := func(k string, v string) {
+				mapEntrySize := 1 + len(k) + runtime.Sov(uint64(len(k))) + 1 + len(v) + runtime.Sov(uint64(len(v)))
+				n += mapEntrySize + 1 + runtime.Sov(uint64(mapEntrySize))
+			}
+			if options.Deterministic {
+				sortme := make([]string, 0, len(x.ProposalTypeTallyStrategies))
+				for k := range x.ProposalTypeTallyStrategies {
+					sortme = append(sortme, k)
+				}
+				sort.Strings(sortme)
+				for _, k := range sortme {
+					v := x.ProposalTypeTallyStrategies[k]
+					SiZeMaP(k, v)
+				}
+			} else {
+				for k, v := range x.ProposalTypeTallyStrategies {
+					SiZeMaP(k, v)
+				}
+			}
+		}
+		if x.MaxVoteDelegationDepth != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxVoteDelegationDepth))
+		}
+		if x.MaxSimulateProposalGas != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxSimulateProposalGas))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -406,6 +589,59 @@ func (x *fastReflection_Module) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.MaxSimulateProposalGas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxSimulateProposalGas))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.MaxVoteDelegationDepth != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxVoteDelegationDepth))
+			i--
+			dAtA[i] = 0x38
+		}
+		if len(x.ProposalTypeTallyStrategies) > 0 {
+			MaRsHaLmAp := func(k string, v string) (protoiface.MarshalOutput, error) {
+				baseI := i
+				i -= len(v)
+				copy(dAtA[i:], v)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(v)))
+				i--
+				dAtA[i] = 0x12
+				i -= len(k)
+				copy(dAtA[i:], k)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(k)))
+				i--
+				dAtA[i] = 0xa
+				i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
+				i--
+				dAtA[i] = 0x32
+				return protoiface.MarshalOutput{}, nil
+			}
+			if options.Deterministic {
+				keysForProposalTypeTallyStrategies := make([]string, 0, len(x.ProposalTypeTallyStrategies))
+				for k := range x.ProposalTypeTallyStrategies {
+					keysForProposalTypeTallyStrategies = append(keysForProposalTypeTallyStrategies, string(k))
+				}
+				sort.Slice(keysForProposalTypeTallyStrategies, func(i, j int) bool {
+					return keysForProposalTypeTallyStrategies[i] < keysForProposalTypeTallyStrategies[j]
+				})
+				for iNdEx := len(keysForProposalTypeTallyStrategies) - 1; iNdEx >= 0; iNdEx-- {
+					v := x.ProposalTypeTallyStrategies[string(keysForProposalTypeTallyStrategies[iNdEx])]
+					out, err := MaRsHaLmAp(keysForProposalTypeTallyStrategies[iNdEx], v)
+					if err != nil {
+						return out, err
+					}
+				}
+			} else {
+				for k := range x.ProposalTypeTallyStrategies {
+					v := x.ProposalTypeTallyStrategies[k]
+					out, err := MaRsHaLmAp(k, v)
+					if err != nil {
+						return out, err
+					}
+				}
+			}
+		}
 		if x.MaxVoteOptionsLen != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxVoteOptionsLen))
 			i--
@@ -590,6 +826,171 @@ func (x *fastReflection_Module) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposalTypeTallyStrategies", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ProposalTypeTallyStrategies == nil {
+					x.ProposalTypeTallyStrategies = make(map[string]string)
+				}
+				var mapkey string
+				var mapvalue string
+				for iNdEx < postIndex {
+					entryPreIndex := iNdEx
+					var wire uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						wire |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					fieldNum := int32(wire >> 3)
+					if fieldNum == 1 {
+						var stringLenmapkey uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							stringLenmapkey |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						intStringLenmapkey := int(stringLenmapkey)
+						if intStringLenmapkey < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						postStringIndexmapkey := iNdEx + intStringLenmapkey
+						if postStringIndexmapkey < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						if postStringIndexmapkey > l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+						iNdEx = postStringIndexmapkey
+					} else if fieldNum == 2 {
+						var stringLenmapvalue uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							stringLenmapvalue |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						intStringLenmapvalue := int(stringLenmapvalue)
+						if intStringLenmapvalue < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+						if postStringIndexmapvalue < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						if postStringIndexmapvalue > l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+						iNdEx = postStringIndexmapvalue
+					} else {
+						iNdEx = entryPreIndex
+						skippy, err := runtime.Skip(dAtA[iNdEx:])
+						if err != nil {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+						}
+						if (skippy < 0) || (iNdEx+skippy) < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						if (iNdEx + skippy) > postIndex {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						iNdEx += skippy
+					}
+				}
+				x.ProposalTypeTallyStrategies[mapkey] = mapvalue
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxVoteDelegationDepth", wireType)
+				}
+				x.MaxVoteDelegationDepth = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxVoteDelegationDepth |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxSimulateProposalGas", wireType)
+				}
+				x.MaxSimulateProposalGas = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxSimulateProposalGas |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -658,6 +1059,16 @@ type Module struct {
 	// max_vote_options_len defines the maximum number of vote options a proposal can have.
 	// Defaults to 0 if not explicitly set.
 	MaxVoteOptionsLen uint64 `protobuf:"varint,5,opt,name=max_vote_options_len,json=maxVoteOptionsLen,proto3" json:"max_vote_options_len,omitempty"`
+	// proposal_type_tally_strategies defines the tally strategy used per proposal type, keyed by the
+	// proposal type name (e.g. PROPOSAL_TYPE_STANDARD). The tally strategy of the message based params of
+	// a proposal takes precedence. The strategies must be registered in the application.
+	ProposalTypeTallyStrategies map[string]string `protobuf:"bytes,6,rep,name=proposal_type_tally_strategies,json=proposalTypeTallyStrategies,proto3" json:"proposal_type_tally_strategies,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// max_vote_delegation_depth defines the maximum length of the vote delegation chains followed when tallying.
+	// Defaults to 3 if not explicitly set.
+	MaxVoteDelegationDepth uint64 `protobuf:"varint,7,opt,name=max_vote_delegation_depth,json=maxVoteDelegationDepth,proto3" json:"max_vote_delegation_depth,omitempty"`
+	// max_simulate_proposal_gas defines the maximum gas limit of the execution of each message of a proposal
+	// simulated by the SimulateProposal query. Defaults to 10000000 if not explicitly set.
+	MaxSimulateProposalGas uint64 `protobuf:"varint,8,opt,name=max_simulate_proposal_gas,json=maxSimulateProposalGas,proto3" json:"max_simulate_proposal_gas,omitempty"`
 }
 
 func (x *Module) Reset() {
@@ -715,6 +1126,27 @@ func (x *Module) GetMaxVoteOptionsLen() uint64 {
 	return 0
 }
 
+func (x *Module) GetProposalTypeTallyStrategies() map[string]string {
+	if x != nil {
+		return x.ProposalTypeTallyStrategies
+	}
+	return nil
+}
+
+func (x *Module) GetMaxVoteDelegationDepth() uint64 {
+	if x != nil {
+		return x.MaxVoteDelegationDepth
+	}
+	return 0
+}
+
+func (x *Module) GetMaxSimulateProposalGas() uint64 {
+	if x != nil {
+		return x.MaxSimulateProposalGas
+	}
+	return 0
+}
+
 var File_cosmos_gov_module_v1_module_proto protoreflect.FileDescriptor
 
 var file_cosmos_gov_module_v1_module_proto_rawDesc = []byte{
@@ -723,7 +1155,7 @@ var file_cosmos_gov_module_v1_module_proto_rawDesc = []byte{
 	0x6f, 0x74, 0x6f, 0x12, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e,
 	0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x1a, 0x20, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
 	0x73, 0x2f, 0x61, 0x70, 0x70, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x6d,
-	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe9, 0x01, 0x0a, 0x06,
+	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb4, 0x04, 0x0a, 0x06,
 	0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x61, 0x78, 0x5f, 0x6d, 0x65,
 	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x6c, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
 	0x52, 0x0e, 0x6d, 0x61, 0x78, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x4c, 0x65, 0x6e,
@@ -736,22 +1168,43 @@ var file_cosmos_gov_module_v1_module_proto_rawDesc = []byte{
 	0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x4c, 0x65, 0x6e, 0x12, 0x2f, 0x0a, 0x14, 0x6d, 0x61,
 	0x78, 0x5f, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x6c,
 	0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6d, 0x61, 0x78, 0x56, 0x6f, 0x74,
-	0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4c, 0x65, 0x6e, 0x3a, 0x1a, 0xba, 0xc0, 0x96,
-	0xda, 0x01, 0x14, 0x0a, 0x12, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
-	0x6f, 0x2f, 0x78, 0x2f, 0x67, 0x6f, 0x76, 0x42, 0xca, 0x01, 0x0a, 0x18, 0x63, 0x6f, 0x6d, 0x2e,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c,
-	0x65, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x50, 0x01, 0x5a, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
-	0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x67, 0x6f, 0x76,
-	0x2f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x6d, 0x6f, 0x64, 0x75, 0x6c,
-	0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x47, 0x4d, 0xaa, 0x02, 0x14, 0x43, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x47, 0x6f, 0x76, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x56, 0x31,
-	0xca, 0x02, 0x14, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x47, 0x6f, 0x76, 0x5c, 0x4d, 0x6f,
-	0x64, 0x75, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x20, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x5c, 0x47, 0x6f, 0x76, 0x5c, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47,
-	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x17, 0x43, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x47, 0x6f, 0x76, 0x3a, 0x3a, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
-	0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4c, 0x65, 0x6e, 0x12, 0x82, 0x01, 0x0a, 0x1e,
+	0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x74, 0x61,
+	0x6c, 0x6c, 0x79, 0x5f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x69, 0x65, 0x73, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f,
+	0x76, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x64, 0x75,
+	0x6c, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x54,
+	0x61, 0x6c, 0x6c, 0x79, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x69, 0x65, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x1b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x54, 0x79, 0x70,
+	0x65, 0x54, 0x61, 0x6c, 0x6c, 0x79, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x69, 0x65, 0x73,
+	0x12, 0x39, 0x0a, 0x19, 0x6d, 0x61, 0x78, 0x5f, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x64, 0x65, 0x6c,
+	0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x16, 0x6d, 0x61, 0x78, 0x56, 0x6f, 0x74, 0x65, 0x44, 0x65, 0x6c, 0x65,
+	0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x70, 0x74, 0x68, 0x12, 0x39, 0x0a, 0x19, 0x6d,
+	0x61, 0x78, 0x5f, 0x73, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16,
+	0x6d, 0x61, 0x78, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x61, 0x6c, 0x47, 0x61, 0x73, 0x1a, 0x4e, 0x0a, 0x20, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x61, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x54, 0x61, 0x6c, 0x6c, 0x79, 0x53, 0x74, 0x72, 0x61, 0x74,
+	0x65, 0x67, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x3a, 0x1a, 0xba, 0xc0, 0x96, 0xda, 0x01, 0x14, 0x0a, 0x12,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x78, 0x2f, 0x67,
+	0x6f, 0x76, 0x42, 0xca, 0x01, 0x0a, 0x18, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x42,
+	0x0b, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x67, 0x6f, 0x76, 0x2f, 0x6d, 0x6f, 0x64, 0x75,
+	0x6c, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x76, 0x31, 0xa2, 0x02,
+	0x03, 0x43, 0x47, 0x4d, 0xaa, 0x02, 0x14, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x47, 0x6f,
+	0x76, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x14, 0x43, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x47, 0x6f, 0x76, 0x5c, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5c,
+	0x56, 0x31, 0xe2, 0x02, 0x20, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x47, 0x6f, 0x76, 0x5c,
+	0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x17, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a,
+	0x47, 0x6f, 0x76, 0x3a, 0x3a, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -766,16 +1219,18 @@ func file_cosmos_gov_module_v1_module_proto_rawDescGZIP() []byte {
 	return file_cosmos_gov_module_v1_module_proto_rawDescData
 }
 
-var file_cosmos_gov_module_v1_module_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_cosmos_gov_module_v1_module_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_cosmos_gov_module_v1_module_proto_goTypes = []interface{}{
 	(*Module)(nil), // 0: cosmos.gov.module.v1.Module
+	nil,            // 1: cosmos.gov.module.v1.Module.ProposalTypeTallyStrategiesEntry
 }
 var file_cosmos_gov_module_v1_module_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	1, // 0: cosmos.gov.module.v1.Module.proposal_type_tally_strategies:type_name -> cosmos.gov.module.v1.Module.ProposalTypeTallyStrategiesEntry
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_cosmos_gov_module_v1_module_proto_init() }
@@ -803,7 +1258,7 @@ func file_cosmos_gov_module_v1_module_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cosmos_gov_module_v1_module_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
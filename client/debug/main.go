@@ -3,6 +3,7 @@ package debug
 import (
 	"encoding/base64"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"slices"
 	"strconv"
@@ -10,9 +11,11 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
@@ -354,3 +357,52 @@ func PrefixesCmd() *cobra.Command {
 		},
 	}
 }
+
+// DecodeKVCmd returns a command decoding a raw key/value pair of the store of a
+// store key with the collections schemas of the app registered in the decoder.
+func DecodeKVCmd(decoder collections.StateDecoder) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "decode-kv <store-key> <key-hex> [value-hex]",
+		Short: "Decode a raw key/value pair of the state of the app",
+		Long: `Decode a raw key/value pair of the store of a store key, e.g. printed by the streaming,
+snapshot or store tooling, with the collections schema of the module of the store key.
+The collection name, the decoded key and the decoded value are printed.`,
+		Example: fmt.Sprintf("$ %s debug decode-kv mint 00 <minter-value-hex>\n$ %s debug decode-kv bank <balance-key-hex> --output json", version.AppName, version.AppName),
+		Args:    cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			key, err := hex.DecodeString(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid key %s: %w", args[1], err)
+			}
+
+			var value []byte
+			if len(args) > 2 {
+				if value, err = hex.DecodeString(args[2]); err != nil {
+					return fmt.Errorf("invalid value %s: %w", args[2], err)
+				}
+			}
+
+			kv, err := decoder.DecodeKV(args[0], key, value)
+			if err != nil {
+				return err
+			}
+
+			output, _ := cmd.Flags().GetString(flags.FlagOutput)
+			if output == flags.OutputFormatJSON {
+				bz, err := json.Marshal(kv)
+				if err != nil {
+					return err
+				}
+				cmd.Println(string(bz))
+				return nil
+			}
+
+			cmd.Println(kv.String())
+			return nil
+		},
+	}
+
+	cmd.Flags().StringP(flags.FlagOutput, "o", flags.OutputFormatText, "Output format (text|json)")
+
+	return cmd
+}
@@ -102,6 +102,7 @@ Supported app-db-backend types include 'goleveldb', 'rocksdb', 'pebbledb'.`,
 	cmd.Flags().Uint64(server.FlagPruningInterval, 10,
 		`Height interval at which pruned heights are removed from disk (ignored if pruning is not 'custom'), 
 		this is not used by this command but kept for compatibility with the complete pruning options`)
+	cmd.Flags().StringSlice(server.FlagPruningOverrides, []string{}, "Pruning of some stores, overriding the pruning strategy, e.g. gov=nothing,staking=100000 (the values are a strategy or a number of recent heights to keep)")
 
 	return cmd
 }
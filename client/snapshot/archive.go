@@ -0,0 +1,617 @@
+package snapshot
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/gogoproto/proto"
+	iavltree "github.com/cosmos/iavl"
+	idb "github.com/cosmos/iavl/db"
+	"github.com/spf13/cast"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/snapshots"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+)
+
+const (
+	// ArchiveVersion is the version of the snapshot archive format.
+	ArchiveVersion uint32 = 1
+
+	// ManifestFileName is the name of the manifest file, the first file of an archive.
+	// Archives dumped before the manifest was introduced start with the snapshot file.
+	ManifestFileName = "manifest.json"
+
+	// StoreV1 and StoreV2 identify the snapshot manager which produced a snapshot.
+	StoreV1 = "store/v1"
+	StoreV2 = "store/v2"
+
+	// deltaFormat is the format of the store/v2 delta snapshots, which contain the
+	// changesets committed since a base snapshot. It mirrors the DeltaFormat constant
+	// of cosmossdk.io/store/v2/snapshots/types, which this module doesn't depend on,
+	// and must be kept in sync with it.
+	deltaFormat uint32 = 4
+)
+
+// Manifest describes the snapshot of an archive, so that it can be verified and
+// loaded without knowing the node it was dumped from.
+type Manifest struct {
+	// Version is the version of the archive format.
+	Version uint32 `json:"version"`
+	// SnapshotManager is the snapshot manager which produced the snapshot, either
+	// StoreV1 or StoreV2.
+	SnapshotManager string `json:"snapshot_manager"`
+	ChainID         string `json:"chain_id"`
+	Height          uint64 `json:"height"`
+	// Format is the snapshot format.
+	Format uint32 `json:"format"`
+	// BaseHeight is the height of the base snapshot of a delta snapshot.
+	BaseHeight uint64 `json:"base_height,omitempty"`
+	// AppHash is the hex-encoded app hash at the snapshot height.
+	AppHash string `json:"app_hash"`
+	// Stores is the sorted list of the module stores in the snapshot, for a delta
+	// snapshot the list of the changed stores.
+	Stores []string `json:"stores"`
+}
+
+// snapshotInfo is the information of a snapshot computed from its content.
+type snapshotInfo struct {
+	appHash []byte
+	// recomputed is true if the app hash is recomputed from the state of the
+	// snapshot, false if it is only read from the snapshot.
+	recomputed bool
+	stores     []string
+}
+
+// inspectSnapshot reads the snapshot chunks and returns the app hash and the stores of
+// the snapshot. The app hash of a full snapshot is recomputed by importing the IAVL
+// trees of the stores in a scratch database under workDir, while the one of a delta
+// snapshot is only read from its commit hash at the snapshot height, since it can
+// only be recomputed on top of the state of its base snapshot.
+func inspectSnapshot(snapshot *snapshottypes.Snapshot, chunks <-chan io.ReadCloser, workDir string) (*snapshotInfo, error) {
+	defer snapshots.DrainChunks(chunks)
+
+	streamReader, err := snapshots.NewStreamReader(chunks)
+	if err != nil {
+		return nil, err
+	}
+	defer streamReader.Close()
+
+	switch snapshot.Format {
+	case snapshottypes.CurrentFormat:
+		return inspectFullSnapshot(snapshot.Height, streamReader, workDir)
+	case deltaFormat:
+		return inspectDeltaSnapshot(snapshot.Height, streamReader)
+	default:
+		return nil, fmt.Errorf("unsupported snapshot format %d", snapshot.Format)
+	}
+}
+
+func inspectFullSnapshot(height uint64, streamReader *snapshots.StreamReader, workDir string) (*snapshotInfo, error) {
+	if height > math.MaxInt64 {
+		return nil, fmt.Errorf("snapshot height %d cannot exceed %d", height, int64(math.MaxInt64))
+	}
+
+	db, err := dbm.NewGoLevelDB("snapshot", workDir, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create scratch database: %w", err)
+	}
+	defer db.Close()
+
+	var (
+		commitInfo storetypes.CommitInfo
+		tree       *iavltree.MutableTree
+		importer   *iavltree.Importer
+	)
+	// commit imports the current store and records its root hash
+	commit := func() error {
+		if importer == nil {
+			return nil
+		}
+		defer importer.Close()
+		if err := importer.Commit(); err != nil {
+			return fmt.Errorf("IAVL commit failed: %w", err)
+		}
+		commitInfo.StoreInfos[len(commitInfo.StoreInfos)-1].CommitId.Hash = tree.Hash()
+		return nil
+	}
+
+	for {
+		var item snapshottypes.SnapshotItem
+		err := streamReader.ReadMsg(&item)
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("invalid protobuf message: %w", err)
+		}
+
+		switch item := item.Item.(type) {
+		case *snapshottypes.SnapshotItem_Store:
+			if err := commit(); err != nil {
+				return nil, err
+			}
+			prefix := []byte(fmt.Sprintf("s/%d/", len(commitInfo.StoreInfos)))
+			tree = iavltree.NewMutableTree(idb.NewWrapper(dbm.NewPrefixDB(db, prefix)), 0, true, log.NewNopLogger())
+			importer, err = tree.Import(int64(height))
+			if err != nil {
+				return nil, fmt.Errorf("failed to import store %s: %w", item.Store.Name, err)
+			}
+			commitInfo.StoreInfos = append(commitInfo.StoreInfos, storetypes.StoreInfo{
+				Name:     item.Store.Name,
+				CommitId: storetypes.CommitID{Version: int64(height)},
+			})
+
+		case *snapshottypes.SnapshotItem_IAVL:
+			if importer == nil {
+				return nil, errors.New("received IAVL node item before store item")
+			}
+			if item.IAVL.Height > math.MaxInt8 {
+				return nil, fmt.Errorf("node height %v cannot exceed %v", item.IAVL.Height, math.MaxInt8)
+			}
+			node := &iavltree.ExportNode{
+				Key:     item.IAVL.Key,
+				Value:   item.IAVL.Value,
+				Height:  int8(item.IAVL.Height),
+				Version: item.IAVL.Version,
+			}
+			// Protobuf does not differentiate between []byte{} as nil, but fortunately IAVL does
+			// not allow nil keys nor nil values for leaf nodes, so we can always set them to empty.
+			if node.Key == nil {
+				node.Key = []byte{}
+			}
+			if node.Height == 0 && node.Value == nil {
+				node.Value = []byte{}
+			}
+			if err := importer.Add(node); err != nil {
+				return nil, fmt.Errorf("IAVL node import failed: %w", err)
+			}
+
+		default:
+			// the extension items are not part of the app hash
+			if err := commit(); err != nil {
+				return nil, err
+			}
+			importer = nil
+		}
+	}
+	if err := commit(); err != nil {
+		return nil, err
+	}
+
+	info := &snapshotInfo{appHash: commitInfo.Hash(), recomputed: true}
+	for _, storeInfo := range commitInfo.StoreInfos {
+		info.stores = append(info.stores, storeInfo.Name)
+	}
+	sort.Strings(info.stores)
+
+	return info, nil
+}
+
+func inspectDeltaSnapshot(height uint64, streamReader *snapshots.StreamReader) (*snapshotInfo, error) {
+	var (
+		version *snapshottypes.SnapshotVersionItem
+		stores  = make(map[string]bool)
+	)
+	for {
+		var item snapshottypes.SnapshotItem
+		err := streamReader.ReadMsg(&item)
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("invalid protobuf message: %w", err)
+		}
+
+		switch item := item.Item.(type) {
+		case *snapshottypes.SnapshotItem_Version:
+			version = item.Version
+		case *snapshottypes.SnapshotItem_Store:
+			stores[item.Store.Name] = true
+		}
+	}
+	if version == nil || version.Version != height {
+		return nil, fmt.Errorf("the delta snapshot does not end at height %d", height)
+	}
+
+	info := &snapshotInfo{appHash: version.Hash}
+	for store := range stores {
+		info.stores = append(info.stores, store)
+	}
+	sort.Strings(info.stores)
+
+	return info, nil
+}
+
+// writeArchive writes the snapshot with its manifest as a gzipped tar archive. The
+// archive contains the manifest, the snapshot metadata and the chunks, in this order.
+func writeArchive(w io.Writer, manifest *Manifest, snapshot *snapshottypes.Snapshot, chunkPath func(chunk uint32) string) error {
+	manifestBz, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal manifest: %w", err)
+	}
+	snapshotBz, err := snapshot.Marshal()
+	if err != nil {
+		return err
+	}
+
+	// since the chunk files are already compressed, we just use fastest compression here
+	gzipWriter, err := gzip.NewWriterLevel(w, gzip.BestSpeed)
+	if err != nil {
+		return err
+	}
+	tarWriter := tar.NewWriter(gzipWriter)
+
+	for _, file := range []struct {
+		name string
+		bz   []byte
+	}{
+		{ManifestFileName, manifestBz},
+		{SnapshotFileName, snapshotBz},
+	} {
+		if err := tarWriter.WriteHeader(&tar.Header{
+			Name: file.name,
+			Mode: 0o644,
+			Size: int64(len(file.bz)),
+		}); err != nil {
+			return fmt.Errorf("failed to write %s header to tar: %w", file.name, err)
+		}
+		if _, err := tarWriter.Write(file.bz); err != nil {
+			return fmt.Errorf("failed to write %s to tar: %w", file.name, err)
+		}
+	}
+
+	for i := uint32(0); i < snapshot.Chunks; i++ {
+		if err := processChunk(tarWriter, chunkPath(i), strconv.FormatUint(uint64(i), 10)); err != nil {
+			return err
+		}
+	}
+
+	if err := tarWriter.Close(); err != nil {
+		return fmt.Errorf("failed to close tar writer: %w", err)
+	}
+
+	if err := gzipWriter.Close(); err != nil {
+		return fmt.Errorf("failed to close gzip writer: %w", err)
+	}
+
+	return nil
+}
+
+// archiveReader reads a snapshot archive.
+type archiveReader struct {
+	file       *os.File
+	gzipReader *gzip.Reader
+	tarReader  *tar.Reader
+
+	// manifest is nil for the archives dumped before the manifest was introduced.
+	manifest *Manifest
+	snapshot snapshottypes.Snapshot
+
+	nextChunk    uint32
+	snapshotHash io.Writer
+	hasher       func() []byte
+}
+
+// openArchive opens a snapshot archive and reads its manifest and snapshot metadata.
+func openArchive(path string) (*archiveReader, error) {
+	fp, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open archive file: %w", err)
+	}
+	gzipReader, err := gzip.NewReader(fp)
+	if err != nil {
+		fp.Close()
+		return nil, fmt.Errorf("failed to create gzip reader: %w", err)
+	}
+
+	hasher := sha256.New()
+	r := &archiveReader{
+		file:         fp,
+		gzipReader:   gzipReader,
+		tarReader:    tar.NewReader(gzipReader),
+		snapshotHash: hasher,
+		hasher:       func() []byte { return hasher.Sum(nil) },
+	}
+
+	hdr, err := r.tarReader.Next()
+	if err != nil {
+		r.Close()
+		return nil, fmt.Errorf("failed to read archive header: %w", err)
+	}
+	if hdr.Name == ManifestFileName {
+		bz, err := io.ReadAll(r.tarReader)
+		if err != nil {
+			r.Close()
+			return nil, fmt.Errorf("failed to read manifest file: %w", err)
+		}
+		r.manifest = &Manifest{}
+		if err := json.Unmarshal(bz, r.manifest); err != nil {
+			r.Close()
+			return nil, fmt.Errorf("failed to unmarshal manifest: %w", err)
+		}
+		if r.manifest.Version > ArchiveVersion {
+			r.Close()
+			return nil, fmt.Errorf("unsupported archive version %d", r.manifest.Version)
+		}
+
+		if hdr, err = r.tarReader.Next(); err != nil {
+			r.Close()
+			return nil, fmt.Errorf("failed to read snapshot file header: %w", err)
+		}
+	}
+
+	if hdr.Name != SnapshotFileName {
+		r.Close()
+		return nil, fmt.Errorf("invalid archive, expect file: snapshot, got: %s", hdr.Name)
+	}
+	bz, err := io.ReadAll(r.tarReader)
+	if err != nil {
+		r.Close()
+		return nil, fmt.Errorf("failed to read snapshot file: %w", err)
+	}
+	if err := r.snapshot.Unmarshal(bz); err != nil {
+		r.Close()
+		return nil, fmt.Errorf("failed to unmarshal snapshot: %w", err)
+	}
+	if uint32(len(r.snapshot.Metadata.ChunkHashes)) != r.snapshot.Chunks {
+		r.Close()
+		return nil, fmt.Errorf("invalid archive, the snapshot has %d chunk hashes, but %d chunks",
+			len(r.snapshot.Metadata.ChunkHashes), r.snapshot.Chunks)
+	}
+
+	if r.manifest != nil {
+		if r.manifest.Height != r.snapshot.Height || r.manifest.Format != r.snapshot.Format ||
+			r.manifest.BaseHeight != r.snapshot.Metadata.BaseHeight {
+			r.Close()
+			return nil, errors.New("invalid archive, the manifest does not match the snapshot")
+		}
+	}
+
+	return r, nil
+}
+
+// readChunk reads the next chunk of the archive and verifies its hash.
+func (r *archiveReader) readChunk() ([]byte, error) {
+	i := r.nextChunk
+	if i >= r.snapshot.Chunks {
+		return nil, io.EOF
+	}
+
+	hdr, err := r.tarReader.Next()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("invalid archive, missing chunk %d", i)
+		}
+		return nil, err
+	}
+	if hdr.Name != strconv.FormatInt(int64(i), 10) {
+		return nil, fmt.Errorf("invalid archive, expect file: %d, got: %s", i, hdr.Name)
+	}
+
+	bz, err := io.ReadAll(r.tarReader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read chunk file: %w", err)
+	}
+	hash := sha256.Sum256(bz)
+	if !bytes.Equal(hash[:], r.snapshot.Metadata.ChunkHashes[i]) {
+		return nil, fmt.Errorf("%w: chunk %d, expected %X, got %X",
+			snapshottypes.ErrChunkHashMismatch, i, r.snapshot.Metadata.ChunkHashes[i], hash)
+	}
+	_, _ = r.snapshotHash.Write(bz)
+	r.nextChunk++
+
+	if r.nextChunk == r.snapshot.Chunks && !bytes.Equal(r.hasher(), r.snapshot.Hash) {
+		return nil, fmt.Errorf("invalid archive, snapshot hash mismatch; expected %X, got %X", r.snapshot.Hash, r.hasher())
+	}
+
+	return bz, nil
+}
+
+// chunks streams the verified chunks of the archive. The channel is unbuffered, because
+// the tar reader can't do concurrency. The error of the stream, if any, is sent to the
+// returned error channel once the chunks channel is closed.
+func (r *archiveReader) chunks() (<-chan io.ReadCloser, <-chan error) {
+	ch := make(chan io.ReadCloser)
+	errCh := make(chan error, 1)
+	go func() {
+		defer close(errCh)
+		defer close(ch)
+		for {
+			bz, err := r.readChunk()
+			if errors.Is(err, io.EOF) {
+				return
+			} else if err != nil {
+				errCh <- err
+				return
+			}
+			ch <- io.NopCloser(bytes.NewReader(bz))
+		}
+	}()
+
+	return ch, errCh
+}
+
+// Close closes the archive file.
+func (r *archiveReader) Close() error {
+	return errors.Join(r.gzipReader.Close(), r.file.Close())
+}
+
+// snapshotStore is the snapshot store of a node, which is either the store v1 one,
+// keeping the snapshot metadata in a database, or the store/v2 one, keeping it in
+// files.
+type snapshotStore interface {
+	// SnapshotManager returns the snapshot manager the store belongs to, StoreV1 or
+	// StoreV2.
+	SnapshotManager() string
+
+	Get(height uint64, format uint32) (*snapshottypes.Snapshot, error)
+	PathChunk(height uint64, format, chunk uint32) string
+
+	// Save saves the snapshot read from the given chunks, and verifies it against the
+	// given snapshot.
+	Save(snapshot *snapshottypes.Snapshot, chunks <-chan io.ReadCloser) error
+
+	// Close releases the resources of the store, such as its metadata database.
+	Close() error
+}
+
+// getSnapshotStore returns the snapshot store of the node. The store/v2 snapshot
+// store is detected by its metadata directory.
+func getSnapshotStore(appOpts servertypes.AppOptions) (snapshotStore, error) {
+	dir := filepath.Join(cast.ToString(appOpts.Get(flags.FlagHome)), "data", "snapshots")
+	if fi, err := os.Stat(filepath.Join(dir, "metadata")); err == nil && fi.IsDir() {
+		return &v2SnapshotStore{dir: dir}, nil
+	}
+
+	// the metadata database is opened as in server.GetSnapshotStore, to be closed
+	if err := os.MkdirAll(dir, 0o744); err != nil {
+		return nil, fmt.Errorf("failed to create snapshots directory: %w", err)
+	}
+	db, err := dbm.NewDB("metadata", server.GetAppDBBackend(appOpts), dir)
+	if err != nil {
+		return nil, err
+	}
+	store, err := snapshots.NewStore(db, dir)
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return &v1SnapshotStore{Store: store, db: db}, nil
+}
+
+// v1SnapshotStore is the store v1 snapshot store.
+type v1SnapshotStore struct {
+	*snapshots.Store
+	db dbm.DB
+}
+
+func (s *v1SnapshotStore) SnapshotManager() string {
+	return StoreV1
+}
+
+func (s *v1SnapshotStore) Close() error {
+	return s.db.Close()
+}
+
+func (s *v1SnapshotStore) Save(snapshot *snapshottypes.Snapshot, chunks <-chan io.ReadCloser) error {
+	if snapshot.Format == deltaFormat || snapshot.Metadata.BaseHeight != 0 {
+		snapshots.DrainChunks(chunks)
+		return errors.New("delta snapshots are only supported by the store/v2 snapshot manager")
+	}
+
+	savedSnapshot, err := s.Store.Save(snapshot.Height, snapshot.Format, chunks)
+	if err != nil {
+		return err
+	}
+
+	if !proto.Equal(snapshot, savedSnapshot) {
+		_ = s.Delete(snapshot.Height, snapshot.Format)
+		return errors.New("invalid archive, the saved snapshot is not equal to the original one")
+	}
+
+	return nil
+}
+
+// v2SnapshotStore is the store/v2 snapshot store, whose metadata files and chunks are
+// accessed directly as the store v1 and store/v2 snapshot types are wire compatible.
+//
+// This module doesn't depend on cosmossdk.io/store/v2, so the on-disk layout of its
+// snapshots.Store is duplicated here: the metadata of a snapshot is stored in
+// metadata/<%020d height>-<%08d format> and its chunks in <height>/<format>/<chunk>
+// under the snapshot directory. Any change to that layout must be reflected here.
+type v2SnapshotStore struct {
+	dir string
+}
+
+func (s *v2SnapshotStore) SnapshotManager() string {
+	return StoreV2
+}
+
+func (s *v2SnapshotStore) Close() error {
+	return nil
+}
+
+func (s *v2SnapshotStore) pathMetadata(height uint64, format uint32) string {
+	return filepath.Join(s.dir, "metadata", fmt.Sprintf("%020d-%08d", height, format))
+}
+
+func (s *v2SnapshotStore) pathSnapshot(height uint64, format uint32) string {
+	return filepath.Join(s.dir, strconv.FormatUint(height, 10), strconv.FormatUint(uint64(format), 10))
+}
+
+func (s *v2SnapshotStore) Get(height uint64, format uint32) (*snapshottypes.Snapshot, error) {
+	bz, err := os.ReadFile(s.pathMetadata(height, format))
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to read snapshot metadata: %w", err)
+	}
+
+	snapshot := &snapshottypes.Snapshot{}
+	if err := snapshot.Unmarshal(bz); err != nil {
+		return nil, fmt.Errorf("failed to decode snapshot metadata: %w", err)
+	}
+
+	return snapshot, nil
+}
+
+func (s *v2SnapshotStore) PathChunk(height uint64, format, chunk uint32) string {
+	return filepath.Join(s.pathSnapshot(height, format), strconv.FormatUint(uint64(chunk), 10))
+}
+
+func (s *v2SnapshotStore) Save(snapshot *snapshottypes.Snapshot, chunks <-chan io.ReadCloser) error {
+	defer snapshots.DrainChunks(chunks)
+
+	if existing, err := s.Get(snapshot.Height, snapshot.Format); err != nil {
+		return err
+	} else if existing != nil {
+		return fmt.Errorf("snapshot at height %d, format %d already exists", snapshot.Height, snapshot.Format)
+	}
+
+	dir := s.pathSnapshot(snapshot.Height, snapshot.Format)
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return fmt.Errorf("failed to create snapshot directory %q: %w", dir, err)
+	}
+
+	// the chunks are verified by the archive reader
+	index := uint32(0)
+	for chunk := range chunks {
+		bz, err := io.ReadAll(chunk)
+		chunk.Close()
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(s.PathChunk(snapshot.Height, snapshot.Format, index), bz, 0o600); err != nil {
+			return fmt.Errorf("failed to write snapshot chunk %d: %w", index, err)
+		}
+		index++
+	}
+	if index != snapshot.Chunks {
+		_ = os.RemoveAll(dir)
+		return fmt.Errorf("invalid archive, expected %d chunks, got %d", snapshot.Chunks, index)
+	}
+
+	bz, err := snapshot.Marshal()
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(s.pathMetadata(snapshot.Height, snapshot.Format), bz, 0o600)
+}
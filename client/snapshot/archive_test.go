@@ -0,0 +1,510 @@
+package snapshot
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+
+	corectx "cosmossdk.io/core/context"
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	"cosmossdk.io/store/snapshots"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+)
+
+const testChainID = "test-chain"
+
+// newMultiStore returns a multistore with two IAVL stores committed at the given height.
+func newMultiStore(t *testing.T, height int) *rootmulti.Store {
+	t.Helper()
+
+	ms := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics())
+	keys := []*storetypes.KVStoreKey{storetypes.NewKVStoreKey("acc"), storetypes.NewKVStoreKey("bank")}
+	for _, key := range keys {
+		ms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	}
+	require.NoError(t, ms.LoadLatestVersion())
+
+	for h := 1; h <= height; h++ {
+		for _, key := range keys {
+			store := ms.GetKVStore(key)
+			for i := 0; i < 10; i++ {
+				store.Set([]byte(fmt.Sprintf("key-%d-%d", h, i)), []byte(fmt.Sprintf("value-%d-%d", h, i)))
+			}
+		}
+		ms.Commit()
+	}
+
+	return ms
+}
+
+// streamChunks writes the snapshot items as chunks, see snapshots.StreamWriter.
+func streamChunks(t *testing.T, write func(sw *snapshots.StreamWriter) error) [][]byte {
+	t.Helper()
+
+	ch := make(chan io.ReadCloser)
+	go func() {
+		sw := snapshots.NewStreamWriter(ch)
+		if err := write(sw); err != nil {
+			sw.CloseWithError(err)
+			return
+		}
+		if err := sw.Close(); err != nil {
+			sw.CloseWithError(err)
+		}
+	}()
+
+	var chunks [][]byte
+	for chunk := range ch {
+		bz, err := io.ReadAll(chunk)
+		require.NoError(t, err)
+		require.NoError(t, chunk.Close())
+		chunks = append(chunks, bz)
+	}
+	require.NotEmpty(t, chunks)
+
+	return chunks
+}
+
+func commitInfoHash(t *testing.T, ms *rootmulti.Store, height int64) []byte {
+	t.Helper()
+
+	commitInfo, err := ms.GetCommitInfo(height)
+	require.NoError(t, err)
+
+	return commitInfo.Hash()
+}
+
+func newSnapshot(height uint64, format uint32, baseHeight uint64, chunks [][]byte) *snapshottypes.Snapshot {
+	snapshot := &snapshottypes.Snapshot{
+		Height:   height,
+		Format:   format,
+		Chunks:   uint32(len(chunks)),
+		Metadata: snapshottypes.Metadata{BaseHeight: baseHeight},
+	}
+	hasher := sha256.New()
+	for _, chunk := range chunks {
+		hash := sha256.Sum256(chunk)
+		snapshot.Metadata.ChunkHashes = append(snapshot.Metadata.ChunkHashes, hash[:])
+		_, _ = hasher.Write(chunk)
+	}
+	snapshot.Hash = hasher.Sum(nil)
+
+	return snapshot
+}
+
+// saveV1Snapshot saves a snapshot of the multistore at the given height in the store v1
+// snapshot store of the home directory.
+func saveV1Snapshot(t *testing.T, home string, ms *rootmulti.Store, height uint64) *snapshottypes.Snapshot {
+	t.Helper()
+
+	dir := filepath.Join(home, "data", "snapshots")
+	require.NoError(t, os.MkdirAll(dir, 0o755))
+	db, err := dbm.NewDB("metadata", dbm.GoLevelDBBackend, dir)
+	require.NoError(t, err)
+	defer db.Close()
+	store, err := snapshots.NewStore(db, dir)
+	require.NoError(t, err)
+
+	chunks := streamChunks(t, func(sw *snapshots.StreamWriter) error {
+		return ms.Snapshot(height, sw)
+	})
+	ch := make(chan io.ReadCloser, len(chunks))
+	for _, chunk := range chunks {
+		ch <- io.NopCloser(bytes.NewReader(chunk))
+	}
+	close(ch)
+	snapshot, err := store.Save(height, snapshottypes.CurrentFormat, ch)
+	require.NoError(t, err)
+
+	return snapshot
+}
+
+// saveV2Snapshot saves the snapshot chunks in the store/v2 snapshot store layout of the
+// home directory, which is written by hand as the store/v2 snapshots.Store can't be
+// imported.
+func saveV2Snapshot(t *testing.T, home string, snapshot *snapshottypes.Snapshot, chunks [][]byte) {
+	t.Helper()
+
+	dir := filepath.Join(home, "data", "snapshots")
+	chunkDir := filepath.Join(dir, strconv.FormatUint(snapshot.Height, 10), strconv.FormatUint(uint64(snapshot.Format), 10))
+	require.NoError(t, os.MkdirAll(chunkDir, 0o750))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "metadata"), 0o750))
+	for i, chunk := range chunks {
+		require.NoError(t, os.WriteFile(filepath.Join(chunkDir, strconv.Itoa(i)), chunk, 0o600))
+	}
+	bz, err := snapshot.Marshal()
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "metadata", fmt.Sprintf("%020d-%08d", snapshot.Height, snapshot.Format)), bz, 0o600))
+}
+
+// newV2Home returns a home directory with an empty store/v2 snapshot store.
+func newV2Home(t *testing.T) string {
+	t.Helper()
+
+	home := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(home, "data", "snapshots", "metadata"), 0o750))
+
+	return home
+}
+
+func executeCmd(t *testing.T, cmd *cobra.Command, home string, args ...string) (string, error) {
+	t.Helper()
+
+	ctx := context.WithValue(context.Background(), corectx.ViperContextKey{}, viperWithHome(home))
+
+	out := &bytes.Buffer{}
+	cmd.SetOut(out)
+	cmd.SetErr(io.Discard)
+	cmd.SetArgs(args)
+	err := cmd.ExecuteContext(ctx)
+
+	return out.String(), err
+}
+
+func dumpArchive(t *testing.T, home string, snapshot *snapshottypes.Snapshot) string {
+	t.Helper()
+
+	output := filepath.Join(t.TempDir(), "snapshot.tar.gz")
+	_, err := executeCmd(t, DumpArchiveCmd(), home,
+		strconv.FormatUint(snapshot.Height, 10), strconv.FormatUint(uint64(snapshot.Format), 10),
+		"--output", output, "--"+flags.FlagChainID, testChainID)
+	require.NoError(t, err)
+
+	return output
+}
+
+// requireLoaded checks that the snapshot and its chunks are loaded in the snapshot
+// store of the home directory.
+func requireLoaded(t *testing.T, home string, snapshot *snapshottypes.Snapshot, chunks [][]byte) {
+	t.Helper()
+
+	store, err := getSnapshotStore(viperWithHome(home))
+	require.NoError(t, err)
+	defer store.Close()
+
+	loaded, err := store.Get(snapshot.Height, snapshot.Format)
+	require.NoError(t, err)
+	require.Equal(t, snapshot, loaded)
+	for i, chunk := range chunks {
+		bz, err := os.ReadFile(store.PathChunk(snapshot.Height, snapshot.Format, uint32(i)))
+		require.NoError(t, err)
+		require.Equal(t, chunk, bz)
+	}
+}
+
+func viperWithHome(home string) *viper.Viper {
+	v := viper.New()
+	v.Set(flags.FlagHome, home)
+	return v
+}
+
+func readChunks(t *testing.T, store snapshotStore, snapshot *snapshottypes.Snapshot) [][]byte {
+	t.Helper()
+
+	chunks := make([][]byte, snapshot.Chunks)
+	for i := range chunks {
+		bz, err := os.ReadFile(store.PathChunk(snapshot.Height, snapshot.Format, uint32(i)))
+		require.NoError(t, err)
+		chunks[i] = bz
+	}
+
+	return chunks
+}
+
+func TestArchiveRoundTripStoreV1(t *testing.T) {
+	ms := newMultiStore(t, 3)
+	home := t.TempDir()
+	snapshot := saveV1Snapshot(t, home, ms, 3)
+	appHash := ms.LastCommitID().Hash
+
+	archive := dumpArchive(t, home, snapshot)
+
+	r, err := openArchive(archive)
+	require.NoError(t, err)
+	require.Equal(t, &Manifest{
+		Version:         ArchiveVersion,
+		SnapshotManager: StoreV1,
+		ChainID:         testChainID,
+		Height:          3,
+		Format:          snapshottypes.CurrentFormat,
+		AppHash:         fmt.Sprintf("%x", appHash),
+		Stores:          []string{"acc", "bank"},
+	}, r.manifest)
+	require.NoError(t, r.Close())
+
+	out, err := executeCmd(t, VerifyArchiveCmd(), home, archive, "--app-hash", fmt.Sprintf("%X", appHash))
+	require.NoError(t, err)
+	require.Contains(t, out, fmt.Sprintf("app hash %X", appHash))
+
+	store, err := getSnapshotStore(viperWithHome(home))
+	require.NoError(t, err)
+	chunks := readChunks(t, store, snapshot)
+	require.NoError(t, store.Close())
+
+	target := t.TempDir()
+	_, err = executeCmd(t, LoadArchiveCmd(), target, archive)
+	require.NoError(t, err)
+	requireLoaded(t, target, snapshot, chunks)
+
+	// a store/v2 node loads the same archive
+	targetV2 := newV2Home(t)
+	_, err = executeCmd(t, LoadArchiveCmd(), targetV2, archive)
+	require.NoError(t, err)
+	requireLoaded(t, targetV2, snapshot, chunks)
+}
+
+func TestArchiveRoundTripStoreV2(t *testing.T) {
+	ms := newMultiStore(t, 3)
+	appHash := ms.LastCommitID().Hash
+	home := newV2Home(t)
+
+	// the full snapshots of store v1 and store/v2 are wire compatible
+	fullChunks := streamChunks(t, func(sw *snapshots.StreamWriter) error {
+		return ms.Snapshot(2, sw)
+	})
+	full := newSnapshot(2, snapshottypes.CurrentFormat, 0, fullChunks)
+	saveV2Snapshot(t, home, full, fullChunks)
+
+	deltaChunks := streamChunks(t, func(sw *snapshots.StreamWriter) error {
+		items := []*snapshottypes.SnapshotItem{
+			{Item: &snapshottypes.SnapshotItem_Store{Store: &snapshottypes.SnapshotStoreItem{Name: "bank"}}},
+			{Item: &snapshottypes.SnapshotItem_KV{KV: &snapshottypes.SnapshotKVItem{Key: []byte("key"), Value: []byte("value")}}},
+			{Item: &snapshottypes.SnapshotItem_Version{Version: &snapshottypes.SnapshotVersionItem{Version: 3, Hash: appHash}}},
+		}
+		for _, item := range items {
+			if err := sw.WriteMsg(item); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	delta := newSnapshot(3, deltaFormat, 2, deltaChunks)
+	saveV2Snapshot(t, home, delta, deltaChunks)
+
+	testCases := []struct {
+		name       string
+		snapshot   *snapshottypes.Snapshot
+		chunks     [][]byte
+		baseHeight uint64
+		appHash    []byte
+		stores     []string
+		expOut     string
+	}{
+		{"full snapshot", full, fullChunks, 0, commitInfoHash(t, ms, 2), []string{"acc", "bank"}, "verified"},
+		{"delta snapshot", delta, deltaChunks, 2, appHash, []string{"bank"}, "integrity verified only"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			archive := dumpArchive(t, home, tc.snapshot)
+
+			r, err := openArchive(archive)
+			require.NoError(t, err)
+			require.Equal(t, StoreV2, r.manifest.SnapshotManager)
+			require.Equal(t, tc.baseHeight, r.manifest.BaseHeight)
+			require.Equal(t, fmt.Sprintf("%x", tc.appHash), r.manifest.AppHash)
+			require.Equal(t, tc.stores, r.manifest.Stores)
+			require.NoError(t, r.Close())
+
+			out, err := executeCmd(t, VerifyArchiveCmd(), home, archive, "--app-hash", fmt.Sprintf("%X", tc.appHash))
+			require.NoError(t, err)
+			require.Contains(t, out, tc.expOut)
+
+			target := newV2Home(t)
+			_, err = executeCmd(t, LoadArchiveCmd(), target, archive)
+			require.NoError(t, err)
+			requireLoaded(t, target, tc.snapshot, tc.chunks)
+		})
+	}
+
+	// a store v1 node can't load a delta snapshot
+	archive := dumpArchive(t, home, delta)
+	_, err := executeCmd(t, LoadArchiveCmd(), t.TempDir(), archive)
+	require.ErrorContains(t, err, "delta snapshots are only supported by the store/v2 snapshot manager")
+}
+
+func TestVerifyInvalidArchive(t *testing.T) {
+	ms := newMultiStore(t, 3)
+	appHash := ms.LastCommitID().Hash
+	chunks := streamChunks(t, func(sw *snapshots.StreamWriter) error {
+		return ms.Snapshot(3, sw)
+	})
+
+	testCases := []struct {
+		name     string
+		malleate func(manifest *Manifest, snapshot *snapshottypes.Snapshot, chunks [][]byte)
+		expErr   string
+	}{
+		{"valid archive", func(*Manifest, *snapshottypes.Snapshot, [][]byte) {}, ""},
+		{
+			"corrupted chunk",
+			func(_ *Manifest, _ *snapshottypes.Snapshot, chunks [][]byte) {
+				chunks[0][len(chunks[0])-1] ^= 0xff
+			},
+			snapshottypes.ErrChunkHashMismatch.Error(),
+		},
+		{
+			"bad snapshot hash",
+			func(_ *Manifest, snapshot *snapshottypes.Snapshot, _ [][]byte) {
+				snapshot.Hash = []byte("invalid")
+			},
+			"snapshot hash mismatch",
+		},
+		{
+			"manifest height mismatch",
+			func(manifest *Manifest, _ *snapshottypes.Snapshot, _ [][]byte) {
+				manifest.Height = 2
+			},
+			"the manifest does not match the snapshot",
+		},
+		{
+			"manifest app hash mismatch",
+			func(manifest *Manifest, _ *snapshottypes.Snapshot, _ [][]byte) {
+				manifest.AppHash = fmt.Sprintf("%x", commitInfoHash(t, ms, 2))
+			},
+			"app hash mismatch; manifest",
+		},
+		{
+			"manifest stores mismatch",
+			func(manifest *Manifest, _ *snapshottypes.Snapshot, _ [][]byte) {
+				manifest.Stores = []string{"acc"}
+			},
+			"stores mismatch",
+		},
+		{
+			"unsupported archive version",
+			func(manifest *Manifest, _ *snapshottypes.Snapshot, _ [][]byte) {
+				manifest.Version = ArchiveVersion + 1
+			},
+			"unsupported archive version",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tcChunks := make([][]byte, len(chunks))
+			for i, chunk := range chunks {
+				tcChunks[i] = bytes.Clone(chunk)
+			}
+			snapshot := newSnapshot(3, snapshottypes.CurrentFormat, 0, chunks)
+			manifest := &Manifest{
+				Version:         ArchiveVersion,
+				SnapshotManager: StoreV1,
+				ChainID:         testChainID,
+				Height:          3,
+				Format:          snapshottypes.CurrentFormat,
+				AppHash:         fmt.Sprintf("%x", appHash),
+				Stores:          []string{"acc", "bank"},
+			}
+			tc.malleate(manifest, snapshot, tcChunks)
+			archive := writeTestArchive(t, manifest, snapshot, tcChunks)
+
+			_, err := executeCmd(t, VerifyArchiveCmd(), t.TempDir(), archive)
+			if tc.expErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expErr)
+			}
+
+			// the invalid archives are not loaded
+			target := newV2Home(t)
+			_, err = executeCmd(t, LoadArchiveCmd(), target, archive)
+			if tc.expErr == "" || tc.name == "manifest app hash mismatch" || tc.name == "manifest stores mismatch" {
+				// the app hash and the stores of the manifest are only checked by verify
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			entries, err := os.ReadDir(filepath.Join(target, "data", "snapshots", "metadata"))
+			require.NoError(t, err)
+			require.Empty(t, entries)
+		})
+	}
+}
+
+func TestLegacyArchive(t *testing.T) {
+	ms := newMultiStore(t, 3)
+	appHash := ms.LastCommitID().Hash
+	chunks := streamChunks(t, func(sw *snapshots.StreamWriter) error {
+		return ms.Snapshot(3, sw)
+	})
+	snapshot := newSnapshot(3, snapshottypes.CurrentFormat, 0, chunks)
+	archive := writeTestArchive(t, nil, snapshot, chunks)
+
+	r, err := openArchive(archive)
+	require.NoError(t, err)
+	require.Nil(t, r.manifest)
+	require.NoError(t, r.Close())
+
+	_, err = executeCmd(t, VerifyArchiveCmd(), t.TempDir(), archive)
+	require.ErrorContains(t, err, "the archive has no manifest")
+
+	_, err = executeCmd(t, VerifyArchiveCmd(), t.TempDir(), archive, "--app-hash", fmt.Sprintf("%X", commitInfoHash(t, ms, 2)))
+	require.ErrorContains(t, err, "app hash mismatch; expected")
+
+	out, err := executeCmd(t, VerifyArchiveCmd(), t.TempDir(), archive, "--app-hash", fmt.Sprintf("%X", appHash))
+	require.NoError(t, err)
+	require.Contains(t, out, fmt.Sprintf("app hash %X", appHash))
+
+	target := t.TempDir()
+	_, err = executeCmd(t, LoadArchiveCmd(), target, archive)
+	require.NoError(t, err)
+	requireLoaded(t, target, snapshot, chunks)
+}
+
+// writeTestArchive writes an archive of the snapshot and its chunks, without manifest
+// if it is nil, as the archives dumped before the manifest was introduced.
+func writeTestArchive(t *testing.T, manifest *Manifest, snapshot *snapshottypes.Snapshot, chunks [][]byte) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	for i, chunk := range chunks {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, strconv.Itoa(i)), chunk, 0o600))
+	}
+	chunkPath := func(chunk uint32) string {
+		return filepath.Join(dir, strconv.FormatUint(uint64(chunk), 10))
+	}
+
+	output := filepath.Join(dir, "snapshot.tar.gz")
+	fp, err := os.Create(output)
+	require.NoError(t, err)
+	defer fp.Close()
+
+	if manifest != nil {
+		require.NoError(t, writeArchive(fp, manifest, snapshot, chunkPath))
+		return output
+	}
+
+	gzipWriter := gzip.NewWriter(fp)
+	tarWriter := tar.NewWriter(gzipWriter)
+	bz, err := snapshot.Marshal()
+	require.NoError(t, err)
+	require.NoError(t, tarWriter.WriteHeader(&tar.Header{Name: SnapshotFileName, Mode: 0o644, Size: int64(len(bz))}))
+	_, err = tarWriter.Write(bz)
+	require.NoError(t, err)
+	for i := range chunks {
+		require.NoError(t, processChunk(tarWriter, chunkPath(uint32(i)), strconv.Itoa(i)))
+	}
+	require.NoError(t, tarWriter.Close())
+	require.NoError(t, gzipWriter.Close())
+
+	return output
+}
@@ -2,7 +2,9 @@ package snapshot
 
 import (
 	"archive/tar"
-	"compress/gzip"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"io"
@@ -11,28 +13,45 @@ import (
 
 	"github.com/spf13/cobra"
 
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+
 	"github.com/cosmos/cosmos-sdk/client"
-	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 )
 
-// DumpArchiveCmd returns a command to dump the snapshot as portable archive format
+// DumpArchiveCmd returns a command to dump the snapshot as portable archive format.
+// The archive carries a manifest with the chain ID, the height, the app hash and the
+// stores of the snapshot, so that it can be verified offline.
 func DumpArchiveCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "dump <height> <format>",
 		Short: "Dump the snapshot as portable archive format",
 		Args:  cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
 			viper := client.GetViperFromCmd(cmd)
-			snapshotStore, err := server.GetSnapshotStore(viper)
+			snapshotStore, err := getSnapshotStore(viper)
 			if err != nil {
 				return err
 			}
+			defer snapshotStore.Close()
 
 			output, err := cmd.Flags().GetString("output")
 			if err != nil {
 				return err
 			}
 
+			chainID, err := cmd.Flags().GetString(flags.FlagChainID)
+			if err != nil {
+				return err
+			}
+			if chainID == "" {
+				chainID = clientCtx.ChainID
+			}
+			if chainID == "" {
+				return errors.New("chain-id is required to dump a snapshot archive")
+			}
+
 			height, err := strconv.ParseUint(args[0], 10, 64)
 			if err != nil {
 				return err
@@ -55,48 +74,33 @@ func DumpArchiveCmd() *cobra.Command {
 				return errors.New("snapshot doesn't exist")
 			}
 
-			bz, err := snapshot.Marshal()
+			info, err := inspectLocalSnapshot(snapshotStore, snapshot)
 			if err != nil {
-				return err
+				return fmt.Errorf("failed to inspect snapshot: %w", err)
 			}
 
-			fp, err := os.Create(output)
-			if err != nil {
-				return err
+			manifest := &Manifest{
+				Version:         ArchiveVersion,
+				SnapshotManager: snapshotStore.SnapshotManager(),
+				ChainID:         chainID,
+				Height:          snapshot.Height,
+				Format:          snapshot.Format,
+				BaseHeight:      snapshot.Metadata.BaseHeight,
+				AppHash:         hex.EncodeToString(info.appHash),
+				Stores:          info.stores,
 			}
-			defer fp.Close()
 
-			// since the chunk files are already compressed, we just use fastest compression here
-			gzipWriter, err := gzip.NewWriterLevel(fp, gzip.BestSpeed)
+			fp, err := os.Create(output)
 			if err != nil {
 				return err
 			}
-			tarWriter := tar.NewWriter(gzipWriter)
-			if err := tarWriter.WriteHeader(&tar.Header{
-				Name: SnapshotFileName,
-				Mode: 0o644,
-				Size: int64(len(bz)),
-			}); err != nil {
-				return fmt.Errorf("failed to write snapshot header to tar: %w", err)
-			}
-			if _, err := tarWriter.Write(bz); err != nil {
-				return fmt.Errorf("failed to write snapshot to tar: %w", err)
-			}
-
-			for i := uint32(0); i < snapshot.Chunks; i++ {
-				path := snapshotStore.PathChunk(height, uint32(format), i)
-				tarName := strconv.FormatUint(uint64(i), 10)
-				if err := processChunk(tarWriter, path, tarName); err != nil {
-					return err
-				}
-			}
+			defer fp.Close()
 
-			if err := tarWriter.Close(); err != nil {
-				return fmt.Errorf("failed to close tar writer: %w", err)
+			pathChunk := func(chunk uint32) string {
+				return snapshotStore.PathChunk(height, uint32(format), chunk)
 			}
-
-			if err := gzipWriter.Close(); err != nil {
-				return fmt.Errorf("failed to close gzip writer: %w", err)
+			if err := writeArchive(fp, manifest, snapshot, pathChunk); err != nil {
+				return err
 			}
 
 			return fp.Close()
@@ -104,6 +108,7 @@ func DumpArchiveCmd() *cobra.Command {
 	}
 
 	cmd.Flags().StringP("output", "o", "", "output file")
+	cmd.Flags().String(flags.FlagChainID, "", "The chain ID recorded in the archive manifest, defaults to the client config one")
 
 	return cmd
 }
@@ -134,3 +139,42 @@ func processChunk(tarWriter *tar.Writer, path, tarName string) error {
 
 	return nil
 }
+
+// inspectLocalSnapshot inspects a snapshot of the snapshot store, verifying its chunks.
+func inspectLocalSnapshot(snapshotStore snapshotStore, snapshot *snapshottypes.Snapshot) (*snapshotInfo, error) {
+	if uint32(len(snapshot.Metadata.ChunkHashes)) != snapshot.Chunks {
+		return nil, fmt.Errorf("the snapshot has %d chunk hashes, but %d chunks", len(snapshot.Metadata.ChunkHashes), snapshot.Chunks)
+	}
+
+	workDir, err := os.MkdirTemp("", "snapshot-inspect")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(workDir)
+
+	chunks := make(chan io.ReadCloser)
+	errCh := make(chan error, 1)
+	go func() {
+		defer close(errCh)
+		defer close(chunks)
+		for i := uint32(0); i < snapshot.Chunks; i++ {
+			bz, err := os.ReadFile(snapshotStore.PathChunk(snapshot.Height, snapshot.Format, i))
+			if err != nil {
+				errCh <- fmt.Errorf("failed to read chunk %d: %w", i, err)
+				return
+			}
+			if hash := sha256.Sum256(bz); !bytes.Equal(hash[:], snapshot.Metadata.ChunkHashes[i]) {
+				errCh <- fmt.Errorf("%w: chunk %d", snapshottypes.ErrChunkHashMismatch, i)
+				return
+			}
+			chunks <- io.NopCloser(bytes.NewReader(bz))
+		}
+	}()
+
+	info, err := inspectSnapshot(snapshot, chunks, workDir)
+	if streamErr := <-errCh; streamErr != nil {
+		return nil, streamErr
+	}
+
+	return info, err
+}
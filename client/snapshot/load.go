@@ -1,27 +1,18 @@
 package snapshot
 
 import (
-	"archive/tar"
-	"bytes"
-	"compress/gzip"
-	"errors"
 	"fmt"
-	"io"
-	"os"
-	"reflect"
-	"strconv"
 
 	"github.com/spf13/cobra"
 
-	snapshottypes "cosmossdk.io/store/snapshots/types"
-
 	"github.com/cosmos/cosmos-sdk/client"
-	"github.com/cosmos/cosmos-sdk/server"
 )
 
 const SnapshotFileName = "_snapshot"
 
-// LoadArchiveCmd load a portable archive format snapshot into snapshot store
+// LoadArchiveCmd load a portable archive format snapshot into snapshot store. It
+// accepts the archives dumped by both the store v1 and store/v2 snapshot managers,
+// as well as the archives dumped before the manifest was introduced.
 func LoadArchiveCmd() *cobra.Command {
 	return &cobra.Command{
 		Use:   "load <archive-file>",
@@ -29,88 +20,37 @@ func LoadArchiveCmd() *cobra.Command {
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			viper := client.GetViperFromCmd(cmd)
-			snapshotStore, err := server.GetSnapshotStore(viper)
+			snapshotStore, err := getSnapshotStore(viper)
 			if err != nil {
 				return err
 			}
+			defer snapshotStore.Close()
 
-			path := args[0]
-			fp, err := os.Open(path)
-			if err != nil {
-				return fmt.Errorf("failed to open archive file: %w", err)
-			}
-			reader, err := gzip.NewReader(fp)
-			if err != nil {
-				return fmt.Errorf("failed to create gzip reader: %w", err)
-			}
-
-			var snapshot snapshottypes.Snapshot
-			tr := tar.NewReader(reader)
+			archive, err := openArchive(args[0])
 			if err != nil {
-				return fmt.Errorf("failed to create tar reader: %w", err)
+				return err
 			}
+			defer archive.Close()
 
-			hdr, err := tr.Next()
-			if err != nil {
-				return fmt.Errorf("failed to read snapshot file header: %w", err)
-			}
-			if hdr.Name != SnapshotFileName {
-				return fmt.Errorf("invalid archive, expect file: snapshot, got: %s", hdr.Name)
-			}
-			bz, err := io.ReadAll(tr)
-			if err != nil {
-				return fmt.Errorf("failed to read snapshot file: %w", err)
-			}
-			if err := snapshot.Unmarshal(bz); err != nil {
-				return fmt.Errorf("failed to unmarshal snapshot: %w", err)
+			if archive.manifest != nil {
+				cmd.Printf("loading snapshot of chain %s at height %d, dumped by the %s snapshot manager\n",
+					archive.manifest.ChainID, archive.manifest.Height, archive.manifest.SnapshotManager)
 			}
 
-			// make sure the channel is unbuffered, because the tar reader can't do concurrency
-			chunks := make(chan io.ReadCloser)
-			quitChan := make(chan *snapshottypes.Snapshot)
-			go func() {
-				defer close(quitChan)
-
-				savedSnapshot, err := snapshotStore.Save(snapshot.Height, snapshot.Format, chunks)
-				if err != nil {
-					cmd.Println("failed to save snapshot", err)
-					return
-				}
-				quitChan <- savedSnapshot
-			}()
-
-			for i := uint32(0); i < snapshot.Chunks; i++ {
-				hdr, err = tr.Next()
-				if err != nil {
-					if errors.Is(err, io.EOF) {
-						break
+			chunks, errCh := archive.chunks()
+			if err := snapshotStore.Save(&archive.snapshot, chunks); err != nil {
+				// an invalid archive truncates the chunks, report its error instead
+				select {
+				case streamErr := <-errCh:
+					if streamErr != nil {
+						return streamErr
 					}
-					return err
+				default:
 				}
-
-				if hdr.Name != strconv.FormatInt(int64(i), 10) {
-					return fmt.Errorf("invalid archive, expect file: %d, got: %s", i, hdr.Name)
-				}
-
-				bz, err := io.ReadAll(tr)
-				if err != nil {
-					return fmt.Errorf("failed to read chunk file: %w", err)
-				}
-				chunks <- io.NopCloser(bytes.NewReader(bz))
-			}
-			close(chunks)
-
-			savedSnapshot := <-quitChan
-			if savedSnapshot == nil {
-				return fmt.Errorf("failed to save snapshot")
-			}
-
-			if !reflect.DeepEqual(&snapshot, savedSnapshot) {
-				_ = snapshotStore.Delete(snapshot.Height, snapshot.Format)
-				return fmt.Errorf("invalid archive, the saved snapshot is not equal to the original one")
+				return fmt.Errorf("failed to save snapshot: %w", err)
 			}
 
-			return nil
+			return <-errCh
 		},
 	}
 }
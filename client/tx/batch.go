@@ -0,0 +1,773 @@
+package tx
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Status of a transaction of a batch in its progress file.
+const (
+	batchTxSigned    = "signed"
+	batchTxBroadcast = "broadcast"
+	batchTxFailed    = "failed"
+)
+
+// BatchOptions defines how the messages of a batch are grouped into transactions and broadcast.
+type BatchOptions struct {
+	// MaxGas is the gas budget of each transaction of the batch.
+	MaxGas uint64
+	// MaxFees is the maximum fees of each transaction of the batch, no maximum is applied when empty.
+	MaxFees sdk.Coins
+	// MaxMsgs is the maximum number of messages of each transaction of the batch, no maximum is applied when zero.
+	MaxMsgs int
+	// Retries is the number of times the broadcast of a transaction is retried when the node can't be reached
+	// or its mempool is full.
+	Retries int
+	// RetryDelay is the delay between two broadcasts of a transaction, doubled at each retry.
+	RetryDelay time.Duration
+	// ProgressFile is the file in which the progress of the batch is logged, so that it can be resumed.
+	ProgressFile string
+	// Resign signs the remaining transactions again from the sequence of the account when it moved past
+	// transactions of the batch that can't be confirmed as not committed, e.g. as the node doesn't index
+	// the transactions. It must only be set once these transactions were checked not to be committed.
+	Resign bool
+}
+
+// batchHeader is the first line of the progress file of a batch, identifying the batch.
+type batchHeader struct {
+	InputHash string `json:"input_hash"`
+	From      string `json:"from"`
+	ChainID   string `json:"chain_id"`
+}
+
+// batchTx is a transaction of a batch, grouping consecutive messages of the batch.
+// It is logged in the progress file each time its status changes.
+type batchTx struct {
+	Index     int    `json:"index"`
+	FirstMsg  int    `json:"first_msg"`
+	NumMsgs   int    `json:"num_msgs"`
+	Sequence  uint64 `json:"sequence"`
+	Gas       uint64 `json:"gas"`
+	Tx        []byte `json:"tx,omitempty"`
+	TxHash    string `json:"txhash,omitempty"`
+	Status    string `json:"status"`
+	Codespace string `json:"codespace,omitempty"`
+	Code      uint32 `json:"code,omitempty"`
+	RawLog    string `json:"raw_log,omitempty"`
+}
+
+// ReadBatchMsgs reads the messages of a batch from a JSON or CSV file, depending on its extension.
+//
+// A JSON file holds an array of messages, or an object with a "messages" array, as the body of a transaction.
+// A CSV file has a header row naming the "@type" column and the JSON name of the fields of the messages, and
+// a message per row. The values of the cells are strings, except true and false, and the JSON arrays and objects.
+func ReadBatchMsgs(cdc codec.Codec, path string) ([]sdk.Msg, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var rawMsgs []json.RawMessage
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".json":
+		rawMsgs, err = parseBatchJSON(bz)
+	case ".csv":
+		rawMsgs, err = parseBatchCSV(bz)
+	default:
+		return nil, fmt.Errorf("unsupported batch file extension %q, expected .json or .csv", ext)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rawMsgs) == 0 {
+		return nil, fmt.Errorf("no message found in %s", path)
+	}
+
+	msgs := make([]sdk.Msg, len(rawMsgs))
+	for i, rawMsg := range rawMsgs {
+		if err := cdc.UnmarshalInterfaceJSON(rawMsg, &msgs[i]); err != nil {
+			return nil, fmt.Errorf("failed to decode message %d: %w", i, err)
+		}
+	}
+
+	return msgs, nil
+}
+
+// parseBatchJSON returns the messages of a JSON batch file.
+func parseBatchJSON(bz []byte) ([]json.RawMessage, error) {
+	var rawMsgs []json.RawMessage
+	if err := json.Unmarshal(bz, &rawMsgs); err == nil {
+		return rawMsgs, nil
+	}
+
+	var body struct {
+		Messages []json.RawMessage `json:"messages"`
+	}
+	if err := json.Unmarshal(bz, &body); err != nil {
+		return nil, fmt.Errorf("expected an array of messages or an object with a messages array: %w", err)
+	}
+
+	return body.Messages, nil
+}
+
+// parseBatchCSV returns the messages of a CSV batch file as JSON.
+func parseBatchCSV(bz []byte) ([]json.RawMessage, error) {
+	records, err := csv.NewReader(strings.NewReader(string(bz))).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(records) == 0 {
+		return nil, nil
+	}
+
+	header := records[0]
+	typeColumn := -1
+	for i, column := range header {
+		header[i] = strings.TrimSpace(column)
+		if header[i] == "@type" {
+			typeColumn = i
+		}
+	}
+	if typeColumn == -1 {
+		return nil, errors.New("missing @type column in the CSV header")
+	}
+
+	rawMsgs := make([]json.RawMessage, 0, len(records)-1)
+	for _, record := range records[1:] {
+		fields := make(map[string]json.RawMessage, len(record))
+		for i, value := range record {
+			value = strings.TrimSpace(value)
+			switch {
+			case value == "" && i != typeColumn:
+				continue
+			case value == "true" || value == "false",
+				strings.HasPrefix(value, "[") || strings.HasPrefix(value, "{"):
+				fields[header[i]] = json.RawMessage(value)
+			default:
+				bz, err := json.Marshal(value)
+				if err != nil {
+					return nil, err
+				}
+				fields[header[i]] = bz
+			}
+		}
+
+		rawMsg, err := json.Marshal(fields)
+		if err != nil {
+			return nil, err
+		}
+		rawMsgs = append(rawMsgs, rawMsg)
+	}
+
+	return rawMsgs, nil
+}
+
+// BroadcastBatch groups the messages into transactions within the gas and fees budgets of the options,
+// signs them with consecutive sequences and broadcasts them in order.
+//
+// The signed transactions and their broadcast are logged in the progress file. When the progress file of
+// the same batch exists, the batch is resumed: the logged transactions are not signed again, except the ones
+// rejected by the node, and the broadcast ones are skipped. As each transaction keeps its sequence, a message
+// can't be sent twice.
+//
+// When the node rejects a transaction as its sequence is behind the sequence of the account, e.g. because the
+// signer sent another transaction meanwhile, the remaining transactions are signed again from the sequence of
+// the account. A transaction committed before an interruption is recognized by querying it. As signing it again
+// would send its messages twice, the batch stops with an error when the node can't tell whether it is committed,
+// e.g. as it doesn't index the transactions, unless the Resign option is set.
+func BroadcastBatch(clientCtx client.Context, txf Factory, msgs []sdk.Msg, opts BatchOptions) error {
+	if txf.Unordered() {
+		return errors.New("unordered transactions are not supported in a batch, as its transactions are ordered by sequence")
+	}
+
+	if opts.ProgressFile == "" {
+		return errors.New("a progress file is required to broadcast a batch")
+	}
+
+	for _, msg := range msgs {
+		if m, ok := msg.(sdk.HasValidateBasic); ok {
+			if err := m.ValidateBasic(); err != nil {
+				return err
+			}
+		}
+	}
+
+	header, err := newBatchHeader(clientCtx, txf, msgs)
+	if err != nil {
+		return err
+	}
+
+	txf, err = txf.Prepare(clientCtx)
+	if err != nil {
+		return err
+	}
+
+	progress, txs, err := openBatchProgress(opts.ProgressFile, header)
+	if err != nil {
+		return err
+	}
+	defer progress.Close()
+
+	planned := 0
+	if len(txs) > 0 {
+		last := txs[len(txs)-1]
+		planned = last.FirstMsg + last.NumMsgs
+	}
+
+	if planned < len(msgs) {
+		newTxs, err := planBatchTxs(clientCtx, txf, msgs[planned:], planned, opts)
+		if err != nil {
+			return err
+		}
+
+		next := txf.Sequence()
+		if len(txs) > 0 {
+			next = txs[len(txs)-1].Sequence + 1
+		}
+
+		for _, tx := range newTxs {
+			tx.Index = len(txs)
+			tx.Sequence = next
+			next++
+			txs = append(txs, tx)
+		}
+	}
+
+	out := batchOutput(clientCtx)
+	printBatchPlan(out, txs)
+
+	if clientCtx.Simulate {
+		return nil
+	}
+
+	if !clientCtx.SkipConfirm {
+		ok, err := input.GetConfirmation("confirm the transactions before signing and broadcasting", batchInput(clientCtx), os.Stderr)
+		if err != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "error: %v\ncanceled batch\n", err)
+			return err
+		}
+		if !ok {
+			_, _ = fmt.Fprintln(os.Stderr, "canceled batch")
+			return nil
+		}
+	}
+
+	// sign all the transactions before broadcasting any, so that an interrupted batch is resumed
+	// with the same transactions
+	for _, tx := range txs {
+		if tx.Status == batchTxSigned || tx.Status == batchTxBroadcast {
+			continue
+		}
+
+		if err := signBatchTx(clientCtx, txf, tx, msgs); err != nil {
+			return fmt.Errorf("failed to sign transaction %d: %w", tx.Index, err)
+		}
+
+		if err := progress.log(tx); err != nil {
+			return err
+		}
+	}
+
+	refreshed := -1
+	for i := 0; i < len(txs); i++ {
+		tx := txs[i]
+		if tx.Status == batchTxBroadcast {
+			continue
+		}
+
+		if err := broadcastBatchTx(clientCtx, tx, opts); err != nil {
+			return fmt.Errorf("failed to broadcast transaction %d: %w", tx.Index, err)
+		}
+
+		if err := progress.log(tx); err != nil {
+			return err
+		}
+
+		// the sequences are refreshed once per transaction, so that a node keeping on rejecting them
+		// doesn't loop forever
+		if isWrongSequence(tx) && refreshed < i {
+			refreshed = i
+			ok, err := refreshBatchSequences(clientCtx, txf, txs[i:], msgs, progress, opts.Resign)
+			if err != nil {
+				return fmt.Errorf("failed to refresh the sequence of transaction %d: %w", tx.Index, err)
+			}
+			if ok {
+				i--
+				continue
+			}
+		}
+
+		if tx.Status == batchTxFailed {
+			return fmt.Errorf("transaction %d (sequence %d) was rejected with code %d: %s", tx.Index, tx.Sequence, tx.Code, tx.RawLog)
+		}
+
+		_, _ = fmt.Fprintf(out, "transaction %d/%d broadcast: %s\n", tx.Index+1, len(txs), tx.TxHash)
+	}
+
+	return nil
+}
+
+// newBatchHeader returns the header identifying the batch of the messages in its progress file.
+func newBatchHeader(clientCtx client.Context, txf Factory, msgs []sdk.Msg) (batchHeader, error) {
+	hasher := sha256.New()
+	for _, msg := range msgs {
+		bz, err := clientCtx.Codec.MarshalInterfaceJSON(msg)
+		if err != nil {
+			return batchHeader{}, err
+		}
+		hasher.Write(bz)
+		hasher.Write([]byte{'\n'})
+	}
+
+	return batchHeader{
+		InputHash: hex.EncodeToString(hasher.Sum(nil)),
+		From:      clientCtx.FromAddress.String(),
+		ChainID:   txf.ChainID(),
+	}, nil
+}
+
+// planBatchTxs groups consecutive messages into transactions within the budgets of the options.
+// The gas of each transaction is simulated when the factory simulates the gas, and is the gas of the
+// factory per message otherwise.
+func planBatchTxs(clientCtx client.Context, txf Factory, msgs []sdk.Msg, firstMsg int, opts BatchOptions) ([]*batchTx, error) {
+	if !txf.SimulateAndExecute() {
+		msgsGas := make([]uint64, len(msgs))
+		for i := range msgsGas {
+			msgsGas[i] = txf.Gas()
+		}
+
+		return groupBatchMsgs(txf, msgsGas, firstMsg, opts)
+	}
+
+	if clientCtx.Offline {
+		return nil, errors.New("cannot estimate gas in offline mode, set the gas of each message with --gas")
+	}
+
+	return groupSimulatedBatchMsgs(txf, msgs, firstMsg, opts, func(msgs ...sdk.Msg) (uint64, error) {
+		_, adjusted, err := CalculateGas(clientCtx, txf, msgs...)
+		return adjusted, err
+	})
+}
+
+// groupSimulatedBatchMsgs groups consecutive messages into transactions within the budgets of the options,
+// simulating the gas of each transaction as a whole, so that the gas of the transaction itself, e.g. of
+// its signature verification, is only counted once.
+func groupSimulatedBatchMsgs(
+	txf Factory, msgs []sdk.Msg, firstMsg int, opts BatchOptions, simulate func(msgs ...sdk.Msg) (uint64, error),
+) ([]*batchTx, error) {
+	var (
+		txs     []*batchTx
+		current *batchTx
+	)
+	for i := range msgs {
+		if current != nil && (opts.MaxMsgs <= 0 || current.NumMsgs < opts.MaxMsgs) {
+			gas, err := simulate(msgs[current.FirstMsg-firstMsg : i+1]...)
+			if err != nil {
+				return nil, fmt.Errorf("failed to estimate the gas of messages %d to %d: %w", current.FirstMsg, firstMsg+i, err)
+			}
+
+			if batchTxFits(txf, opts, current.NumMsgs+1, gas) {
+				current.NumMsgs++
+				current.Gas = gas
+				continue
+			}
+		}
+
+		gas, err := simulate(msgs[i])
+		if err != nil {
+			return nil, fmt.Errorf("failed to estimate the gas of message %d: %w", firstMsg+i, err)
+		}
+
+		if !batchTxFits(txf, opts, 1, gas) {
+			return nil, fmt.Errorf("message %d does not fit in a transaction: gas %d, fees %s", firstMsg+i, gas, batchTxFees(txf, gas))
+		}
+
+		current = &batchTx{
+			FirstMsg: firstMsg + i,
+			NumMsgs:  1,
+			Gas:      gas,
+		}
+		txs = append(txs, current)
+	}
+
+	return txs, nil
+}
+
+// groupBatchMsgs groups consecutive messages of the given gas into transactions within the budgets of the options.
+func groupBatchMsgs(txf Factory, msgsGas []uint64, firstMsg int, opts BatchOptions) ([]*batchTx, error) {
+	var (
+		txs     []*batchTx
+		current *batchTx
+	)
+	for i, gas := range msgsGas {
+		if current != nil && batchTxFits(txf, opts, current.NumMsgs+1, current.Gas+gas) {
+			current.NumMsgs++
+			current.Gas += gas
+			continue
+		}
+
+		if !batchTxFits(txf, opts, 1, gas) {
+			return nil, fmt.Errorf("message %d does not fit in a transaction: gas %d, fees %s", firstMsg+i, gas, batchTxFees(txf, gas))
+		}
+
+		current = &batchTx{
+			FirstMsg: firstMsg + i,
+			NumMsgs:  1,
+			Gas:      gas,
+		}
+		txs = append(txs, current)
+	}
+
+	return txs, nil
+}
+
+// batchTxFits returns whether a transaction of the given number of messages and gas is within the budgets
+// of the options.
+func batchTxFits(txf Factory, opts BatchOptions, numMsgs int, gas uint64) bool {
+	if opts.MaxMsgs > 0 && numMsgs > opts.MaxMsgs {
+		return false
+	}
+	if opts.MaxGas > 0 && gas > opts.MaxGas {
+		return false
+	}
+
+	return opts.MaxFees.Empty() || batchTxFees(txf, gas).IsAllLTE(opts.MaxFees)
+}
+
+// batchTxFees returns the fees of a transaction of the given gas, as computed by Factory.BuildUnsignedTx.
+func batchTxFees(txf Factory, gas uint64) sdk.Coins {
+	if txf.GasPrices().IsZero() {
+		return txf.Fees()
+	}
+
+	gasDec := math.LegacyNewDecFromBigInt(new(big.Int).SetUint64(gas))
+	fees := make(sdk.Coins, len(txf.GasPrices()))
+	for i, gp := range txf.GasPrices() {
+		fees[i] = sdk.NewCoin(gp.Denom, gp.Amount.Mul(gasDec).Ceil().RoundInt())
+	}
+
+	return fees.Sort()
+}
+
+// signBatchTx builds and signs the transaction of the batch with its sequence and gas.
+func signBatchTx(clientCtx client.Context, txf Factory, tx *batchTx, msgs []sdk.Msg) error {
+	txf = txf.WithSequence(tx.Sequence).WithGas(tx.Gas)
+	txBuilder, err := txf.BuildUnsignedTx(msgs[tx.FirstMsg : tx.FirstMsg+tx.NumMsgs]...)
+	if err != nil {
+		return err
+	}
+
+	if err := Sign(clientCtx.CmdContext, txf, clientCtx.FromName, txBuilder, true); err != nil {
+		return err
+	}
+
+	txBytes, err := clientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+	if err != nil {
+		return err
+	}
+
+	tx.Tx = txBytes
+	tx.TxHash = fmt.Sprintf("%X", sha256.Sum256(txBytes))
+	tx.Status = batchTxSigned
+	tx.Codespace = ""
+	tx.Code = 0
+	tx.RawLog = ""
+
+	return nil
+}
+
+// broadcastBatchTx broadcasts the transaction of the batch, retrying when the node can't be reached or its
+// mempool is full, and sets its status from the response of the node.
+func broadcastBatchTx(clientCtx client.Context, tx *batchTx, opts BatchOptions) error {
+	delay := opts.RetryDelay
+	for attempt := 0; ; attempt++ {
+		res, err := clientCtx.BroadcastTx(tx.Tx)
+		retry := err != nil ||
+			(res.Codespace == sdkerrors.RootCodespace && res.Code == sdkerrors.ErrMempoolIsFull.ABCICode())
+		if retry {
+			if attempt >= opts.Retries {
+				if err == nil {
+					err = errors.New(res.RawLog)
+				}
+				return err
+			}
+
+			time.Sleep(delay)
+			delay *= 2
+			continue
+		}
+
+		if res.TxHash != "" {
+			tx.TxHash = res.TxHash
+		}
+
+		switch {
+		case res.Code == 0,
+			res.Codespace == sdkerrors.RootCodespace && res.Code == sdkerrors.ErrTxInMempoolCache.ABCICode():
+			tx.Status = batchTxBroadcast
+		case res.Codespace == sdkerrors.RootCodespace && res.Code == sdkerrors.ErrWrongSequence.ABCICode() && isTxCommitted(clientCtx, tx):
+			// the transaction was broadcast before the batch was interrupted
+			tx.Status = batchTxBroadcast
+		default:
+			tx.Status = batchTxFailed
+			tx.Codespace = res.Codespace
+			tx.Code = res.Code
+			tx.RawLog = res.RawLog
+		}
+
+		return nil
+	}
+}
+
+// isWrongSequence returns whether the transaction of the batch was rejected for its sequence.
+func isWrongSequence(tx *batchTx) bool {
+	return tx.Status == batchTxFailed && tx.Codespace == sdkerrors.RootCodespace && tx.Code == sdkerrors.ErrWrongSequence.ABCICode()
+}
+
+// refreshBatchSequences signs the transactions of the batch again from the sequence of the account, when it is
+// ahead of the sequence of the first transaction, and logs them in the progress file. It returns false when the
+// sequence of the account can't be queried, or is behind, as the previous transactions of the batch may then be
+// pending and signing them again could send their messages twice.
+//
+// The transactions whose sequence the account moved past are committed, or were replaced by other transactions
+// of the account. The committed ones are logged as broadcast, and the others are only signed again when the node
+// reports them as not committed, or when resign is set. Otherwise an error is returned.
+func refreshBatchSequences(
+	clientCtx client.Context, txf Factory, txs []*batchTx, msgs []sdk.Msg, progress *batchProgress, resign bool,
+) (bool, error) {
+	if clientCtx.Offline || txf.AccountRetriever() == nil {
+		return false, nil
+	}
+
+	_, sequence, err := txf.AccountRetriever().GetAccountNumberSequence(clientCtx, clientCtx.FromAddress)
+	if err != nil {
+		return false, err
+	}
+
+	if sequence <= txs[0].Sequence {
+		return false, nil
+	}
+
+	var pending []*batchTx
+	for _, tx := range txs {
+		if tx.Sequence < sequence && !resign {
+			committed, err := queryTxCommitted(clientCtx, tx)
+			if err != nil {
+				return false, fmt.Errorf(
+					"the account sequence %d moved past transaction %d (sequence %d, hash %s) and it can't be checked whether it is committed: %w; "+
+						"check the transactions of the account manually, and resume the batch with --resign if it is not committed",
+					sequence, tx.Index, tx.Sequence, tx.TxHash, err)
+			}
+
+			if committed {
+				tx.Status = batchTxBroadcast
+				if err := progress.log(tx); err != nil {
+					return false, err
+				}
+				continue
+			}
+		}
+
+		pending = append(pending, tx)
+	}
+
+	for i, tx := range pending {
+		tx.Sequence = sequence + uint64(i)
+		if err := signBatchTx(clientCtx, txf, tx, msgs); err != nil {
+			return false, err
+		}
+
+		if err := progress.log(tx); err != nil {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+// isTxCommitted returns whether the transaction of the batch is known to be committed.
+func isTxCommitted(clientCtx client.Context, tx *batchTx) bool {
+	committed, err := queryTxCommitted(clientCtx, tx)
+	return err == nil && committed
+}
+
+// queryTxCommitted queries whether the transaction of the batch is committed. It returns an error when the node
+// can't tell, e.g. as it doesn't index the transactions.
+func queryTxCommitted(clientCtx client.Context, tx *batchTx) (bool, error) {
+	node, err := clientCtx.GetNode()
+	if err != nil {
+		return false, err
+	}
+
+	hash, err := hex.DecodeString(tx.TxHash)
+	if err != nil {
+		return false, err
+	}
+
+	res, err := node.Tx(context.Background(), hash, false)
+	switch {
+	case err == nil && res != nil:
+		return true, nil
+	case err == nil:
+		return false, errors.New("empty transaction query response")
+	case strings.Contains(err.Error(), "not found"):
+		return false, nil
+	default:
+		return false, err
+	}
+}
+
+// printBatchPlan prints the transactions of the batch.
+func printBatchPlan(out io.Writer, txs []*batchTx) {
+	for _, tx := range txs {
+		status := tx.Status
+		if status == "" {
+			status = "new"
+		}
+
+		_, _ = fmt.Fprintf(out, "transaction %d/%d: messages %d to %d, sequence %d, gas %d (%s)\n",
+			tx.Index+1, len(txs), tx.FirstMsg, tx.FirstMsg+tx.NumMsgs-1, tx.Sequence, tx.Gas, status)
+	}
+}
+
+// batchInput returns the reader the confirmation of a batch is read from.
+func batchInput(clientCtx client.Context) *bufio.Reader {
+	if r, ok := clientCtx.Input.(*bufio.Reader); ok {
+		return r
+	}
+	if clientCtx.Input != nil {
+		return bufio.NewReader(clientCtx.Input)
+	}
+
+	return bufio.NewReader(os.Stdin)
+}
+
+// batchOutput returns the writer the progress of a batch is printed to.
+func batchOutput(clientCtx client.Context) io.Writer {
+	if clientCtx.Output != nil {
+		return clientCtx.Output
+	}
+
+	return os.Stdout
+}
+
+// batchProgress is the append-only progress file of a batch, holding its header followed by a line
+// per status change of its transactions.
+type batchProgress struct {
+	file *os.File
+}
+
+// openBatchProgress opens the progress file of the batch, creating it if it doesn't exist, and returns the
+// transactions logged in it.
+func openBatchProgress(path string, header batchHeader) (*batchProgress, []*batchTx, error) {
+	file, err := os.OpenFile(filepath.Clean(path), os.O_RDWR|os.O_CREATE|os.O_APPEND, 0o600)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	progress := &batchProgress{file: file}
+	txs, err := progress.load(header)
+	if err != nil {
+		_ = file.Close()
+		return nil, nil, fmt.Errorf("failed to load the progress file %s: %w", path, err)
+	}
+
+	return progress, txs, nil
+}
+
+// load returns the transactions logged in the progress file, the last line of a transaction being its
+// current state, or writes the header if the file is empty. A last line truncated by an interruption
+// is discarded.
+func (p *batchProgress) load(header batchHeader) ([]*batchTx, error) {
+	bz, err := io.ReadAll(p.file)
+	if err != nil {
+		return nil, err
+	}
+
+	if complete := bytes.LastIndexByte(bz, '\n') + 1; complete < len(bz) {
+		if err := p.file.Truncate(int64(complete)); err != nil {
+			return nil, err
+		}
+		bz = bz[:complete]
+	}
+
+	if len(bz) == 0 {
+		return nil, p.writeLine(header)
+	}
+
+	lines := bytes.Split(bytes.TrimSuffix(bz, []byte{'\n'}), []byte{'\n'})
+
+	var logged batchHeader
+	if err := json.Unmarshal(lines[0], &logged); err != nil {
+		return nil, err
+	}
+	if logged != header {
+		return nil, errors.New("the progress file belongs to another batch, messages, signer or chain ID differ")
+	}
+
+	var txs []*batchTx
+	for _, line := range lines[1:] {
+		tx := &batchTx{}
+		if err := json.Unmarshal(line, tx); err != nil {
+			return nil, err
+		}
+
+		switch {
+		case tx.Index < len(txs):
+			txs[tx.Index] = tx
+		case tx.Index == len(txs):
+			txs = append(txs, tx)
+		default:
+			return nil, fmt.Errorf("unexpected transaction %d", tx.Index)
+		}
+	}
+
+	return txs, nil
+}
+
+// log appends the state of the transaction to the progress file.
+func (p *batchProgress) log(tx *batchTx) error {
+	return p.writeLine(tx)
+}
+
+// writeLine appends a JSON line to the progress file and syncs it to the disk.
+func (p *batchProgress) writeLine(v any) error {
+	bz, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	if _, err := p.file.Write(append(bz, '\n')); err != nil {
+		return err
+	}
+
+	return p.file.Sync()
+}
+
+// Close closes the progress file.
+func (p *batchProgress) Close() error {
+	return p.file.Close()
+}
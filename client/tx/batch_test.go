@@ -0,0 +1,598 @@
+package tx
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/cometbft/cometbft/rpc/client/mock"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+
+	authtx "cosmossdk.io/x/auth/tx"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	countertypes "github.com/cosmos/cosmos-sdk/testutil/x/counter/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// mockBatchNode is a CometBFT client recording the broadcast transactions, and returning
+// the errors and codes given for each of them.
+type mockBatchNode struct {
+	mock.Client
+
+	errs      []error
+	codes     []uint32
+	broadcast [][]byte
+	committed map[string]bool
+	// noTxIndex is set for a node not indexing the transactions (tx_index = "null")
+	noTxIndex bool
+}
+
+func (n *mockBatchNode) BroadcastTxSync(_ context.Context, tx cmttypes.Tx) (*coretypes.ResultBroadcastTx, error) {
+	attempt := len(n.broadcast)
+	n.broadcast = append(n.broadcast, tx)
+
+	if attempt < len(n.errs) && n.errs[attempt] != nil {
+		return nil, n.errs[attempt]
+	}
+
+	var code uint32
+	if attempt < len(n.codes) {
+		code = n.codes[attempt]
+	}
+
+	return &coretypes.ResultBroadcastTx{Code: code, Codespace: sdkerrors.RootCodespace, Hash: tx.Hash()}, nil
+}
+
+func (n *mockBatchNode) Tx(_ context.Context, hash []byte, _ bool) (*coretypes.ResultTx, error) {
+	if n.noTxIndex {
+		return nil, errors.New("transaction indexing is disabled")
+	}
+	if n.committed[fmt.Sprintf("%X", hash)] {
+		return &coretypes.ResultTx{Hash: hash}, nil
+	}
+
+	return nil, errors.New("tx not found")
+}
+
+func newBatchTestCodec() (client.TxConfig, codec.Codec) {
+	encodingConfig := moduletestutil.MakeTestEncodingConfig(testutil.CodecOptions{})
+	countertypes.RegisterInterfaces(encodingConfig.InterfaceRegistry)
+	cdc := codec.NewProtoCodec(encodingConfig.InterfaceRegistry)
+	signingCtx := encodingConfig.InterfaceRegistry.SigningContext()
+	return authtx.NewTxConfig(cdc, signingCtx.AddressCodec(), signingCtx.ValidatorAddressCodec(), authtx.DefaultSignModes), cdc
+}
+
+func newBatchTest(t *testing.T, node *mockBatchNode) (client.Context, Factory, []sdk.Msg) {
+	t.Helper()
+
+	txConfig, cdc := newBatchTestCodec()
+	kb := keyring.NewInMemory(cdc)
+	record, err := kb.NewAccount("batch", "have embark stumble card pistol fun gauge obtain forget oil awesome lottery unfold corn sure original exist siren pudding spread uphold dwarf goddess card", "", hd.CreateHDPath(118, 0, 0).String(), hd.Secp256k1)
+	require.NoError(t, err)
+	addr, err := record.GetAddress()
+	require.NoError(t, err)
+
+	clientCtx := client.Context{}.
+		WithCodec(cdc).
+		WithTxConfig(txConfig).
+		WithKeyring(kb).
+		WithFromName("batch").
+		WithFromAddress(addr).
+		WithClient(node).
+		WithBroadcastMode(flags.BroadcastSync).
+		WithSkipConfirmation(true).
+		WithOutput(&bytes.Buffer{}).
+		WithOffline(true)
+
+	txf := Factory{}.
+		WithTxConfig(txConfig).
+		WithKeybase(kb).
+		WithChainID("test-chain").
+		WithAccountNumber(50).
+		WithSequence(23).
+		WithGas(100000).
+		WithGasPrices("0.01stake").
+		WithSignMode(signingtypes.SignMode_SIGN_MODE_DIRECT)
+
+	msgs := make([]sdk.Msg, 5)
+	for i := range msgs {
+		msgs[i] = &countertypes.MsgIncreaseCounter{Signer: addr.String(), Count: int64(i + 1)}
+	}
+
+	return clientCtx, txf, msgs
+}
+
+func TestBroadcastBatchResume(t *testing.T) {
+	node := &mockBatchNode{
+		// the node can't be reached when broadcasting the second transaction
+		errs: []error{nil, errors.New("connection refused"), errors.New("connection refused")},
+	}
+	clientCtx, txf, msgs := newBatchTest(t, node)
+	opts := BatchOptions{
+		MaxGas:       250000,
+		Retries:      1,
+		ProgressFile: filepath.Join(t.TempDir(), "batch.progress"),
+	}
+
+	err := BroadcastBatch(clientCtx, txf, msgs, opts)
+	require.ErrorContains(t, err, "failed to broadcast transaction 1")
+	require.Len(t, node.broadcast, 3)
+	firstRun := node.broadcast
+
+	// the batch is resumed from the second transaction, with the transactions signed by the first run
+	node.errs, node.broadcast = nil, nil
+	require.NoError(t, BroadcastBatch(clientCtx, txf, msgs, opts))
+	require.Len(t, node.broadcast, 2)
+	require.Equal(t, firstRun[1], node.broadcast[0])
+
+	decoder := clientCtx.TxConfig.TxDecoder()
+	for i, txBytes := range [][]byte{firstRun[0], node.broadcast[0], node.broadcast[1]} {
+		tx, err := decoder(txBytes)
+		require.NoError(t, err)
+
+		wantMsgs := msgs[2*i : min(2*i+2, len(msgs))]
+		require.Equal(t, wantMsgs, tx.GetMsgs())
+
+		sigTx, err := clientCtx.TxConfig.WrapTxBuilder(tx)
+		require.NoError(t, err)
+		sigs, err := sigTx.GetTx().GetSignaturesV2()
+		require.NoError(t, err)
+		require.Equal(t, uint64(23+i), sigs[0].Sequence)
+		require.Equal(t, uint64(100000*len(wantMsgs)), sigTx.GetTx().GetGas())
+	}
+
+	// the batch is complete, nothing is broadcast anymore
+	node.broadcast = nil
+	require.NoError(t, BroadcastBatch(clientCtx, txf, msgs, opts))
+	require.Empty(t, node.broadcast)
+
+	// the progress file belongs to the batch
+	err = BroadcastBatch(clientCtx, txf, msgs[1:], opts)
+	require.ErrorContains(t, err, "belongs to another batch")
+}
+
+func TestBroadcastBatchRejected(t *testing.T) {
+	node := &mockBatchNode{
+		codes: []uint32{0, sdkerrors.ErrInsufficientFee.ABCICode()},
+	}
+	clientCtx, txf, msgs := newBatchTest(t, node)
+	opts := BatchOptions{
+		MaxMsgs:      2,
+		ProgressFile: filepath.Join(t.TempDir(), "batch.progress"),
+	}
+
+	err := BroadcastBatch(clientCtx, txf, msgs, opts)
+	require.ErrorContains(t, err, "transaction 1 (sequence 24) was rejected")
+	require.Len(t, node.broadcast, 2)
+
+	// the rejected transaction is signed again with the new fees and the same sequence
+	node.codes, node.broadcast = nil, nil
+	require.NoError(t, BroadcastBatch(clientCtx, txf.WithGasPrices("0.02stake"), msgs, opts))
+	require.Len(t, node.broadcast, 2)
+
+	tx, err := clientCtx.TxConfig.TxDecoder()(node.broadcast[0])
+	require.NoError(t, err)
+	feeTx := tx.(sdk.FeeTx)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 4000)), feeTx.GetFee())
+}
+
+func TestBroadcastBatchCommitted(t *testing.T) {
+	node := &mockBatchNode{}
+	clientCtx, txf, msgs := newBatchTest(t, node)
+	opts := BatchOptions{
+		MaxGas:       500000,
+		ProgressFile: filepath.Join(t.TempDir(), "batch.progress"),
+	}
+
+	// the batch is interrupted after signing its transaction
+	header, err := newBatchHeader(clientCtx, txf, msgs)
+	require.NoError(t, err)
+	progress, _, err := openBatchProgress(opts.ProgressFile, header)
+	require.NoError(t, err)
+	tx := &batchTx{NumMsgs: len(msgs), Sequence: 23, Gas: 500000}
+	require.NoError(t, signBatchTx(clientCtx, txf, tx, msgs))
+	require.NoError(t, progress.log(tx))
+	require.NoError(t, progress.Close())
+
+	// the node rejects the transaction as it was committed before the interruption
+	node.codes = []uint32{sdkerrors.ErrWrongSequence.ABCICode()}
+	node.committed = map[string]bool{tx.TxHash: true}
+	require.NoError(t, BroadcastBatch(clientCtx, txf, msgs, opts))
+	require.Equal(t, [][]byte{tx.Tx}, node.broadcast)
+}
+
+func TestBroadcastBatchWrongSequence(t *testing.T) {
+	node := &mockBatchNode{
+		codes: []uint32{sdkerrors.ErrWrongSequence.ABCICode()},
+	}
+	clientCtx, txf, msgs := newBatchTest(t, node)
+	clientCtx = clientCtx.WithOffline(false)
+	opts := BatchOptions{
+		MaxMsgs:      2,
+		ProgressFile: filepath.Join(t.TempDir(), "batch.progress"),
+	}
+
+	// the account sequence is behind the batch, its transactions may be pending
+	accounts := map[string]client.TestAccount{
+		clientCtx.FromAddress.String(): {Address: clientCtx.FromAddress, Num: 50, Seq: 20},
+	}
+	txf = txf.WithAccountRetriever(client.TestAccountRetriever{Accounts: accounts})
+	err := BroadcastBatch(clientCtx, txf, msgs, opts)
+	require.ErrorContains(t, err, "transaction 0 (sequence 23) was rejected")
+	require.Len(t, node.broadcast, 1)
+
+	// another transaction of the account was committed, the batch is signed again from its sequence
+	accounts[clientCtx.FromAddress.String()] = client.TestAccount{Address: clientCtx.FromAddress, Num: 50, Seq: 24}
+	node.codes, node.broadcast = []uint32{sdkerrors.ErrWrongSequence.ABCICode()}, nil
+	require.NoError(t, BroadcastBatch(clientCtx, txf, msgs, opts))
+	require.Len(t, node.broadcast, 4)
+
+	for i, txBytes := range node.broadcast[1:] {
+		tx, err := clientCtx.TxConfig.TxDecoder()(txBytes)
+		require.NoError(t, err)
+
+		sigTx, err := clientCtx.TxConfig.WrapTxBuilder(tx)
+		require.NoError(t, err)
+		sigs, err := sigTx.GetTx().GetSignaturesV2()
+		require.NoError(t, err)
+		require.Equal(t, uint64(24+i), sigs[0].Sequence)
+		require.Equal(t, msgs[2*i:min(2*i+2, len(msgs))], tx.GetMsgs())
+	}
+}
+
+func TestBroadcastBatchWrongSequenceCommitted(t *testing.T) {
+	node := &mockBatchNode{
+		codes: []uint32{sdkerrors.ErrWrongSequence.ABCICode()},
+	}
+	clientCtx, txf, msgs := newBatchTest(t, node)
+	clientCtx = clientCtx.WithOffline(false)
+	opts := BatchOptions{
+		MaxMsgs:      2,
+		ProgressFile: filepath.Join(t.TempDir(), "batch.progress"),
+	}
+
+	// the batch is interrupted after signing its transactions
+	header, err := newBatchHeader(clientCtx, txf, msgs)
+	require.NoError(t, err)
+	progress, _, err := openBatchProgress(opts.ProgressFile, header)
+	require.NoError(t, err)
+	txs := []*batchTx{
+		{Index: 0, FirstMsg: 0, NumMsgs: 2, Sequence: 23, Gas: 200000},
+		{Index: 1, FirstMsg: 2, NumMsgs: 2, Sequence: 24, Gas: 200000},
+		{Index: 2, FirstMsg: 4, NumMsgs: 1, Sequence: 25, Gas: 100000},
+	}
+	for _, tx := range txs {
+		require.NoError(t, signBatchTx(clientCtx, txf, tx, msgs))
+		require.NoError(t, progress.log(tx))
+	}
+	require.NoError(t, progress.Close())
+
+	// another transaction of the account took the sequence of the first transaction, and the second one
+	// was committed before the interruption
+	accounts := map[string]client.TestAccount{
+		clientCtx.FromAddress.String(): {Address: clientCtx.FromAddress, Num: 50, Seq: 25},
+	}
+	txf = txf.WithAccountRetriever(client.TestAccountRetriever{Accounts: accounts})
+	node.committed = map[string]bool{txs[1].TxHash: true}
+	require.NoError(t, BroadcastBatch(clientCtx, txf, msgs, opts))
+
+	// the messages of the committed transaction are not sent again
+	require.Len(t, node.broadcast, 3)
+	for i, want := range []struct {
+		sequence uint64
+		msgs     []sdk.Msg
+	}{
+		{25, msgs[0:2]},
+		{26, msgs[4:5]},
+	} {
+		tx, err := clientCtx.TxConfig.TxDecoder()(node.broadcast[1+i])
+		require.NoError(t, err)
+
+		sigTx, err := clientCtx.TxConfig.WrapTxBuilder(tx)
+		require.NoError(t, err)
+		sigs, err := sigTx.GetTx().GetSignaturesV2()
+		require.NoError(t, err)
+		require.Equal(t, want.sequence, sigs[0].Sequence)
+		require.Equal(t, want.msgs, tx.GetMsgs())
+	}
+}
+
+func TestBroadcastBatchWrongSequenceNoTxIndex(t *testing.T) {
+	node := &mockBatchNode{
+		codes:     []uint32{sdkerrors.ErrWrongSequence.ABCICode()},
+		noTxIndex: true,
+	}
+	clientCtx, txf, msgs := newBatchTest(t, node)
+	clientCtx = clientCtx.WithOffline(false)
+	opts := BatchOptions{
+		MaxGas:       500000,
+		ProgressFile: filepath.Join(t.TempDir(), "batch.progress"),
+	}
+
+	// the batch is interrupted after signing its transaction, which may have been committed
+	header, err := newBatchHeader(clientCtx, txf, msgs)
+	require.NoError(t, err)
+	progress, _, err := openBatchProgress(opts.ProgressFile, header)
+	require.NoError(t, err)
+	tx := &batchTx{NumMsgs: len(msgs), Sequence: 23, Gas: 500000}
+	require.NoError(t, signBatchTx(clientCtx, txf, tx, msgs))
+	require.NoError(t, progress.log(tx))
+	require.NoError(t, progress.Close())
+
+	accounts := map[string]client.TestAccount{
+		clientCtx.FromAddress.String(): {Address: clientCtx.FromAddress, Num: 50, Seq: 24},
+	}
+	txf = txf.WithAccountRetriever(client.TestAccountRetriever{Accounts: accounts})
+
+	// the node can't tell whether the transaction is committed, it is not signed again
+	err = BroadcastBatch(clientCtx, txf, msgs, opts)
+	require.ErrorContains(t, err, "transaction indexing is disabled")
+	require.ErrorContains(t, err, "--resign")
+	require.Equal(t, [][]byte{tx.Tx}, node.broadcast)
+
+	// the transaction was checked not to be committed, it is signed again from the sequence of the account
+	opts.Resign = true
+	node.codes, node.broadcast = []uint32{sdkerrors.ErrWrongSequence.ABCICode()}, nil
+	require.NoError(t, BroadcastBatch(clientCtx, txf, msgs, opts))
+	require.Len(t, node.broadcast, 2)
+
+	resigned, err := clientCtx.TxConfig.TxDecoder()(node.broadcast[1])
+	require.NoError(t, err)
+	sigTx, err := clientCtx.TxConfig.WrapTxBuilder(resigned)
+	require.NoError(t, err)
+	sigs, err := sigTx.GetTx().GetSignaturesV2()
+	require.NoError(t, err)
+	require.Equal(t, uint64(24), sigs[0].Sequence)
+}
+
+func TestBroadcastBatchConfirmation(t *testing.T) {
+	node := &mockBatchNode{}
+	clientCtx, txf, msgs := newBatchTest(t, node)
+	clientCtx = clientCtx.WithSkipConfirmation(false)
+	opts := BatchOptions{
+		MaxGas:       500000,
+		ProgressFile: filepath.Join(t.TempDir(), "batch.progress"),
+	}
+
+	// the confirmation is read from the input of the client
+	require.NoError(t, BroadcastBatch(clientCtx.WithInput(strings.NewReader("n\n")), txf, msgs, opts))
+	require.Empty(t, node.broadcast)
+
+	require.NoError(t, BroadcastBatch(clientCtx.WithInput(strings.NewReader("y\n")), txf, msgs, opts))
+	require.Len(t, node.broadcast, 1)
+}
+
+func TestGroupSimulatedBatchMsgs(t *testing.T) {
+	txf := Factory{}.WithGasPrices("0.01stake")
+	_, _, msgs := newBatchTest(t, &mockBatchNode{})
+
+	// a transaction costs 1000 gas, and each of its messages 100 gas
+	var simulated [][]sdk.Msg
+	simulate := func(msgs ...sdk.Msg) (uint64, error) {
+		simulated = append(simulated, msgs)
+		return 1000 + 100*uint64(len(msgs)), nil
+	}
+
+	testCases := []struct {
+		name   string
+		opts   BatchOptions
+		exp    [][2]int
+		expGas []uint64
+		expErr string
+	}{
+		{
+			name:   "gas of the transaction counted once",
+			opts:   BatchOptions{MaxGas: 1300},
+			exp:    [][2]int{{0, 3}, {3, 2}},
+			expGas: []uint64{1300, 1200},
+		},
+		{
+			name:   "max messages",
+			opts:   BatchOptions{MaxGas: 10000, MaxMsgs: 4},
+			exp:    [][2]int{{0, 4}, {4, 1}},
+			expGas: []uint64{1400, 1100},
+		},
+		{
+			name:   "message over budget",
+			opts:   BatchOptions{MaxGas: 1000},
+			expErr: "message 0 does not fit in a transaction",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			txs, err := groupSimulatedBatchMsgs(txf, msgs, 0, tc.opts, simulate)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+
+			got := make([][2]int, len(txs))
+			gotGas := make([]uint64, len(txs))
+			for i, tx := range txs {
+				got[i] = [2]int{tx.FirstMsg, tx.NumMsgs}
+				gotGas[i] = tx.Gas
+			}
+			require.Equal(t, tc.exp, got)
+			require.Equal(t, tc.expGas, gotGas)
+		})
+	}
+
+	// the messages of a transaction are simulated together
+	simulated = nil
+	_, err := groupSimulatedBatchMsgs(txf, msgs[:2], 0, BatchOptions{}, simulate)
+	require.NoError(t, err)
+	require.Equal(t, [][]sdk.Msg{msgs[:1], msgs[:2]}, simulated)
+
+	// a failed simulation is reported with its messages
+	_, err = groupSimulatedBatchMsgs(txf, msgs, 3, BatchOptions{}, func(msgs ...sdk.Msg) (uint64, error) {
+		if len(msgs) > 1 {
+			return 0, errors.New("out of gas")
+		}
+		return 1000, nil
+	})
+	require.EqualError(t, err, "failed to estimate the gas of messages 3 to 4: out of gas")
+}
+
+func TestGroupBatchMsgs(t *testing.T) {
+	txf := Factory{}.WithGasPrices("0.01stake")
+
+	testCases := []struct {
+		name    string
+		msgsGas []uint64
+		opts    BatchOptions
+		exp     [][2]int
+		expErr  string
+	}{
+		{
+			name:    "gas budget",
+			msgsGas: []uint64{100, 200, 300, 100, 50},
+			opts:    BatchOptions{MaxGas: 400},
+			exp:     [][2]int{{0, 2}, {2, 2}, {4, 1}},
+		},
+		{
+			name:    "max messages",
+			msgsGas: []uint64{100, 100, 100},
+			opts:    BatchOptions{MaxGas: 1000, MaxMsgs: 2},
+			exp:     [][2]int{{0, 2}, {2, 1}},
+		},
+		{
+			name:    "fees budget",
+			msgsGas: []uint64{100, 100, 100},
+			opts:    BatchOptions{MaxFees: sdk.NewCoins(sdk.NewInt64Coin("stake", 2))},
+			exp:     [][2]int{{0, 2}, {2, 1}},
+		},
+		{
+			name:    "message over budget",
+			msgsGas: []uint64{100, 500},
+			opts:    BatchOptions{MaxGas: 400},
+			expErr:  "message 1 does not fit in a transaction",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			txs, err := groupBatchMsgs(txf, tc.msgsGas, 0, tc.opts)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+
+			got := make([][2]int, len(txs))
+			for i, tx := range txs {
+				got[i] = [2]int{tx.FirstMsg, tx.NumMsgs}
+			}
+			require.Equal(t, tc.exp, got)
+		})
+	}
+}
+
+func TestReadBatchMsgs(t *testing.T) {
+	_, cdc := newBatchTestCodec()
+	exp := []sdk.Msg{
+		&countertypes.MsgIncreaseCounter{Signer: "cosmos1y74p8wyy4enfhfn342njve6cjmj5c8dtl6emdk", Count: 1},
+		&countertypes.MsgIncreaseCounter{Signer: "cosmos1y74p8wyy4enfhfn342njve6cjmj5c8dtl6emdk", Count: 2},
+	}
+
+	testCases := []struct {
+		name    string
+		file    string
+		content string
+		expErr  string
+	}{
+		{
+			name:    "json array",
+			file:    "msgs.json",
+			content: `[{"@type":"/cosmos.counter.v1.MsgIncreaseCounter","signer":"cosmos1y74p8wyy4enfhfn342njve6cjmj5c8dtl6emdk","count":"1"},{"@type":"/cosmos.counter.v1.MsgIncreaseCounter","signer":"cosmos1y74p8wyy4enfhfn342njve6cjmj5c8dtl6emdk","count":"2"}]`,
+		},
+		{
+			name:    "json body",
+			file:    "body.json",
+			content: `{"messages":[{"@type":"/cosmos.counter.v1.MsgIncreaseCounter","signer":"cosmos1y74p8wyy4enfhfn342njve6cjmj5c8dtl6emdk","count":"1"},{"@type":"/cosmos.counter.v1.MsgIncreaseCounter","signer":"cosmos1y74p8wyy4enfhfn342njve6cjmj5c8dtl6emdk","count":"2"}]}`,
+		},
+		{
+			name: "csv",
+			file: "msgs.csv",
+			content: `@type,signer,count
+/cosmos.counter.v1.MsgIncreaseCounter,cosmos1y74p8wyy4enfhfn342njve6cjmj5c8dtl6emdk,1
+/cosmos.counter.v1.MsgIncreaseCounter,cosmos1y74p8wyy4enfhfn342njve6cjmj5c8dtl6emdk,2
+`,
+		},
+		{
+			name:    "csv without type",
+			file:    "notype.csv",
+			content: "signer,count\ncosmos1y74p8wyy4enfhfn342njve6cjmj5c8dtl6emdk,1\n",
+			expErr:  "missing @type column",
+		},
+		{
+			name:    "unsupported extension",
+			file:    "msgs.txt",
+			content: "",
+			expErr:  "unsupported batch file extension",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), tc.file)
+			require.NoError(t, os.WriteFile(path, []byte(tc.content), 0o600))
+
+			msgs, err := ReadBatchMsgs(cdc, path)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, exp, msgs)
+		})
+	}
+}
+
+func TestBatchProgressTruncatedLine(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "batch.progress")
+	header := batchHeader{InputHash: "hash", From: "from", ChainID: "chain"}
+
+	progress, txs, err := openBatchProgress(path, header)
+	require.NoError(t, err)
+	require.Empty(t, txs)
+	require.NoError(t, progress.log(&batchTx{Index: 0, NumMsgs: 1, Status: batchTxSigned}))
+	require.NoError(t, progress.log(&batchTx{Index: 0, NumMsgs: 1, Status: batchTxBroadcast}))
+	require.NoError(t, progress.Close())
+
+	// simulate an interruption while writing a line
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0o600)
+	require.NoError(t, err)
+	_, err = f.WriteString(`{"index":1,"first_msg"`)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	progress, txs, err = openBatchProgress(path, header)
+	require.NoError(t, err)
+	require.Len(t, txs, 1)
+	require.Equal(t, batchTxBroadcast, txs[0].Status)
+	require.NoError(t, progress.log(&batchTx{Index: 1, FirstMsg: 1, NumMsgs: 1, Status: batchTxSigned}))
+	require.NoError(t, progress.Close())
+
+	progress, txs, err = openBatchProgress(path, header)
+	require.NoError(t, err)
+	require.Len(t, txs, 2)
+	require.NoError(t, progress.Close())
+}
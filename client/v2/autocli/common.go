@@ -58,7 +58,14 @@ func (b *Builder) buildMethodCommandCommon(descriptor protoreflect.MethodDescrip
 		return nil, err
 	}
 
-	cmd.Args = binder.CobraArgs
+	cmd.Args = func(cmd *cobra.Command, args []string) error {
+		// the missing positional arguments are prompted in interactive mode
+		if interactive, _ := cmd.Flags().GetBool(flags.FlagInteractive); interactive && len(args) < len(options.PositionalArgs) {
+			return nil
+		}
+
+		return binder.CobraArgs(cmd, args)
+	}
 
 	cmd.RunE = func(cmd *cobra.Command, args []string) error {
 		input, err := binder.BuildMessage(args)
@@ -86,9 +93,10 @@ func (b *Builder) buildMethodCommandCommon(descriptor protoreflect.MethodDescrip
 						return err
 					}
 				}
-			} else {
+			} else if binder.SignerInfo.PositionalArgIndex < len(args) {
 				// if the signer is not a flag, it is a positional argument
 				// we need to get the correct positional arguments
+				// in interactive mode, a missing signer argument is set from the from flag
 				if err := cmd.Flags().Set(flags.FlagFrom, args[binder.SignerInfo.PositionalArgIndex]); err != nil {
 					return err
 				}
@@ -2,10 +2,15 @@ package autocli
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"os"
 
 	gogoproto "github.com/cosmos/gogoproto/proto"
+	"github.com/manifoldco/promptui"
 	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/types/dynamicpb"
@@ -13,6 +18,7 @@ import (
 	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
 	"cosmossdk.io/client/v2/autocli/flag"
 	"cosmossdk.io/client/v2/internal/flags"
+	"cosmossdk.io/client/v2/internal/prompt"
 	"cosmossdk.io/client/v2/internal/util"
 	addresscodec "cosmossdk.io/core/address"
 
@@ -129,6 +135,12 @@ func (b *Builder) BuildMsgMethodCommand(descriptor protoreflect.MethodDescriptor
 		clientCtx = clientCtx.WithCmdContext(cmd.Context())
 		clientCtx = clientCtx.WithOutput(cmd.OutOrStdout())
 
+		if interactive, _ := cmd.Flags().GetBool(flags.FlagInteractive); interactive {
+			if ok, err := b.promptMessage(cmd, clientCtx, input); err != nil || !ok {
+				return err
+			}
+		}
+
 		fd := input.Descriptor().Fields().ByName(protoreflect.Name(flag.GetSignerFieldName(input.Descriptor())))
 		addressCodec := b.Builder.AddressCodec
 
@@ -183,6 +195,8 @@ func (b *Builder) BuildMsgMethodCommand(descriptor protoreflect.MethodDescriptor
 		cmd.SilenceUsage = true
 	}
 
+	cmd.Flags().Bool(flags.FlagInteractive, false, "Prompt the fields of the message that are not set by flags or arguments")
+
 	// set gov proposal flags if command is a gov proposal
 	if options.GovProposal {
 		govcli.AddGovPropFlagsToCmd(cmd)
@@ -231,3 +245,49 @@ func (b *Builder) handleGovProposal(
 
 	return clienttx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposal)
 }
+
+// promptMessage prompts the fields of the message that are not set, then previews the message and asks for its
+// confirmation. It returns false if the message is not confirmed.
+func (b *Builder) promptMessage(cmd *cobra.Command, clientCtx client.Context, input protoreflect.Message) (bool, error) {
+	opts := prompt.Options{
+		AddressCodec:          b.AddressCodec,
+		ValidatorAddressCodec: b.ValidatorAddressCodec,
+		ConsensusAddressCodec: b.ConsensusAddressCodec,
+		TypeResolver:          b.TypeResolver,
+	}
+	if clientCtx.InterfaceRegistry != nil {
+		opts.InterfaceRegistry = clientCtx.InterfaceRegistry
+	}
+	// promptui uses the standard input by default, with its terminal handling
+	if in := cmd.InOrStdin(); in != os.Stdin {
+		opts.Stdin = io.NopCloser(in)
+	}
+	// the prompts are written to stderr, so that they are not mixed with the command output
+	opts.Stdout = nopWriteCloser{cmd.ErrOrStderr()}
+
+	if err := prompt.PromptMessage(opts, input); err != nil {
+		return false, err
+	}
+
+	preview, err := protojson.MarshalOptions{Resolver: b.TypeResolver, Multiline: true, Indent: "  "}.Marshal(input.Interface())
+	if err != nil {
+		return false, err
+	}
+	cmd.PrintErrf("%s\n\n", preview)
+
+	confirmPrompt := promptui.Prompt{Label: "Confirm message", IsConfirm: true, Stdin: opts.Stdin, Stdout: opts.Stdout}
+	if _, err := confirmPrompt.Run(); err != nil {
+		if errors.Is(err, promptui.ErrAbort) {
+			cmd.PrintErrln("canceled message")
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return true, nil
+}
+
+type nopWriteCloser struct{ io.Writer }
+
+func (nopWriteCloser) Close() error { return nil }
@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"testing"
@@ -104,6 +105,37 @@ func TestMsg(t *testing.T) {
 	assertNormalizedJSONEqual(t, out.Bytes(), goldenLoad(t, "msg-output.golden"))
 }
 
+func TestMsgInteractive(t *testing.T) {
+	fixture := initFixture(t)
+	cmd, err := buildModuleMsgCommand("test", fixture)
+	assert.NilError(t, err)
+
+	// the missing positional arguments are prompted, each input is padded
+	// to the 4096 bytes promptui reads at once
+	in := &bytes.Buffer{}
+	for _, input := range []string{"cosmos1y74p8wyy4enfhfn342njve6cjmj5c8dtl6emdk", "1foo", "y"} {
+		in.WriteString(input + "\n")
+		in.Write(bytes.Repeat([]byte{0}, 4096-len(input)-1))
+	}
+
+	out := &bytes.Buffer{}
+	cmd.SetIn(in)
+	cmd.SetOut(out)
+	cmd.SetErr(io.Discard)
+	cmd.SetArgs([]string{
+		"send", "cosmos1y74p8wyy4enfhfn342njve6cjmj5c8dtl6emdk",
+		"--interactive",
+		"--generate-only",
+		"--output", "json",
+	})
+	assert.NilError(t, cmd.Execute())
+	assertNormalizedJSONEqual(t, out.Bytes(), goldenLoad(t, "msg-output.golden"))
+
+	// without interactive mode, all the positional arguments are required
+	_, err = runCmd(fixture, buildModuleMsgCommand, "send", "cosmos1y74p8wyy4enfhfn342njve6cjmj5c8dtl6emdk", "--generate-only")
+	assert.ErrorContains(t, err, "accepts 3 arg(s), received 1")
+}
+
 func goldenLoad(t *testing.T, filename string) []byte {
 	t.Helper()
 	content, err := os.ReadFile(filepath.Join("testdata", filename))
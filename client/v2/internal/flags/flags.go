@@ -14,6 +14,9 @@ const (
 	// FlagNoPrompt is the flag to not use a prompt for commands.
 	FlagNoPrompt = "no-prompt"
 
+	// FlagInteractive is the flag to prompt the fields of a message that are not set by flags or positional arguments.
+	FlagInteractive = "interactive"
+
 	// FlagNoProposal is the flag convert a gov proposal command into a normal command.
 	// This is used to allow user of chains with custom authority to not use gov submit proposals for usual proposal commands.
 	FlagNoProposal = "no-proposal"
@@ -0,0 +1,562 @@
+package prompt
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	cosmos_proto "github.com/cosmos/cosmos-proto"
+	"github.com/manifoldco/promptui"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	"cosmossdk.io/client/v2/autocli/flag"
+	"cosmossdk.io/client/v2/internal/coins"
+	"cosmossdk.io/core/address"
+)
+
+// noneOption is the option to leave a oneof unset.
+const noneOption = "none"
+
+// Options are the options to prompt the fields of a message.
+type Options struct {
+	// Address codecs validate the address fields, based on their cosmos.scalar annotation.
+	AddressCodec          address.Codec
+	ValidatorAddressCodec address.Codec
+	ConsensusAddressCodec address.Codec
+
+	// TypeResolver resolves the message types of the google.protobuf.Any fields.
+	TypeResolver protoregistry.MessageTypeResolver
+
+	// InterfaceRegistry lists the implementations from which the type of the google.protobuf.Any fields is selected.
+	// The implementations of the interface accepted by a field (cosmos_proto.accepts_interface) are listed, or
+	// the implementations of all interfaces if the field does not specify one. When nil or when there is no
+	// implementation, the type URL is prompted.
+	InterfaceRegistry InterfaceRegistry
+
+	// Stdin and Stdout are the input and output of the prompts. They default to os.Stdin and os.Stdout.
+	Stdin  io.ReadCloser
+	Stdout io.WriteCloser
+}
+
+// InterfaceRegistry lists the implementations of interfaces, as the codectypes.InterfaceRegistry of the SDK does.
+type InterfaceRegistry interface {
+	ListAllInterfaces() []string
+	ListImplementations(ifaceTypeURL string) []string
+}
+
+// PromptMessage prompts the fields of msg that are not set, walking its descriptor field by field.
+// The signer field of msg is not prompted, as it is set from the signing key.
+// Fields left empty are not set.
+func PromptMessage(opts Options, msg protoreflect.Message) error {
+	signerField := protoreflect.Name(flag.GetSignerFieldName(msg.Descriptor()))
+	return promptMessage(opts, "", msg, signerField)
+}
+
+// promptMessage prompts the unset fields of msg, except the skipped field.
+func promptMessage(opts Options, prefix string, msg protoreflect.Message, skip protoreflect.Name) error {
+	fields := msg.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		if field.Name() == skip || msg.Has(field) {
+			continue
+		}
+
+		name := fieldLabel(prefix, field)
+		if oneof := field.ContainingOneof(); oneof != nil && !oneof.IsSynthetic() {
+			// a oneof is prompted once, from its first field
+			if msg.WhichOneof(oneof) != nil || oneof.Fields().Get(0) != field {
+				continue
+			}
+
+			selected, err := selectOneofField(opts, prefix, oneof)
+			if err != nil {
+				return err
+			}
+			if selected == nil {
+				continue
+			}
+
+			field, name = selected, fieldLabel(prefix, selected)
+		}
+
+		if err := promptField(opts, name, msg, field); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// promptField prompts the value of a field of msg and sets it.
+func promptField(opts Options, name string, msg protoreflect.Message, field protoreflect.FieldDescriptor) error {
+	switch {
+	case field.IsMap():
+		return promptMap(opts, name, msg.Mutable(field).Map(), field)
+	case field.IsList():
+		return promptList(opts, name, msg.Mutable(field).List(), field)
+	}
+
+	value, ok, err := promptValue(opts, name, field, func() protoreflect.Value { return msg.NewField(field) })
+	if err != nil || !ok {
+		return err
+	}
+
+	msg.Set(field, value)
+	return nil
+}
+
+// promptList prompts the elements of a repeated field.
+// The elements of a repeated coin field are prompted at once, as a comma separated list of coins.
+func promptList(opts Options, name string, list protoreflect.List, field protoreflect.FieldDescriptor) error {
+	if isCoin(field) {
+		result, err := run(opts, promptui.Prompt{
+			Label:    fmt.Sprintf("Enter %s (comma separated coins)", name),
+			Validate: optional(validateCoins),
+		})
+		if err != nil || result == "" {
+			return err
+		}
+
+		for _, c := range strings.Split(result, ",") {
+			coin := list.NewElement()
+			if err := setCoin(coin.Message(), c); err != nil {
+				return err
+			}
+			list.Append(coin)
+		}
+
+		return nil
+	}
+
+	for i := 0; ; i++ {
+		if ok, err := confirm(opts, fmt.Sprintf("Add an element to %s", name)); err != nil || !ok {
+			return err
+		}
+
+		value, ok, err := promptValue(opts, fmt.Sprintf("%s[%d]", name, i), field, list.NewElement)
+		if err != nil {
+			return err
+		}
+		if ok {
+			list.Append(value)
+		}
+	}
+}
+
+// promptMap prompts the entries of a map field.
+func promptMap(opts Options, name string, m protoreflect.Map, field protoreflect.FieldDescriptor) error {
+	for {
+		if ok, err := confirm(opts, fmt.Sprintf("Add an entry to %s", name)); err != nil || !ok {
+			return err
+		}
+
+		key, ok, err := promptValue(opts, name+" key", field.MapKey(), nil)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			continue
+		}
+
+		value, ok, err := promptValue(opts, fmt.Sprintf("%s[%s]", name, key.String()), field.MapValue(), m.NewValue)
+		if err != nil {
+			return err
+		}
+		if ok {
+			m.Set(key.MapKey(), value)
+		}
+	}
+}
+
+// promptValue prompts a single value of a field, or of an element of a repeated or map field.
+// newMessage returns a new message value of the field, it is only called for message fields.
+// It returns false if the value was left empty.
+func promptValue(opts Options, name string, field protoreflect.FieldDescriptor, newMessage func() protoreflect.Value) (protoreflect.Value, bool, error) {
+	if field.Kind() == protoreflect.MessageKind || field.Kind() == protoreflect.GroupKind {
+		value := newMessage()
+		ok, err := promptMessageValue(opts, name, value.Message(), field)
+		return value, ok, err
+	}
+
+	if field.Kind() == protoreflect.EnumKind {
+		return selectEnum(opts, name, field.Enum())
+	}
+
+	if field.Kind() == protoreflect.BoolKind {
+		_, result, err := runSelect(opts, promptui.Select{
+			Label: fmt.Sprintf("Select %s", name),
+			Items: []string{"false", "true"},
+		})
+		if err != nil {
+			return protoreflect.Value{}, false, err
+		}
+
+		return protoreflect.ValueOfBool(result == "true"), true, nil
+	}
+
+	result, err := run(opts, promptui.Prompt{
+		Label:    fmt.Sprintf("Enter %s", name),
+		Validate: optional(validateScalar(opts, field)),
+	})
+	if err != nil || result == "" {
+		return protoreflect.Value{}, false, err
+	}
+
+	value, err := parseScalar(field, result)
+	return value, err == nil, err
+}
+
+// promptMessageValue prompts the fields of a message value, handling the well-known and coin types.
+// It returns false if no field was set.
+func promptMessageValue(opts Options, name string, msg protoreflect.Message, field protoreflect.FieldDescriptor) (bool, error) {
+	switch msg.Descriptor().FullName() {
+	case "google.protobuf.Any":
+		return promptAny(opts, name, msg, field)
+	case "google.protobuf.Timestamp":
+		result, err := run(opts, promptui.Prompt{
+			Label:    fmt.Sprintf("Enter %s (RFC3339 time, e.g. 2006-01-02T15:04:05Z)", name),
+			Validate: optional(validateTime),
+		})
+		if err != nil || result == "" {
+			return false, err
+		}
+
+		t, _ := time.Parse(time.RFC3339, result)
+		setSecondsAndNanos(msg, t.Unix(), int32(t.Nanosecond()))
+		return true, nil
+	case "google.protobuf.Duration":
+		result, err := run(opts, promptui.Prompt{
+			Label:    fmt.Sprintf("Enter %s (duration, e.g. 1h30m)", name),
+			Validate: optional(validateDuration),
+		})
+		if err != nil || result == "" {
+			return false, err
+		}
+
+		d, _ := time.ParseDuration(result)
+		setSecondsAndNanos(msg, int64(d/time.Second), int32(d%time.Second))
+		return true, nil
+	}
+
+	if isCoin(field) {
+		result, err := run(opts, promptui.Prompt{
+			Label:    fmt.Sprintf("Enter %s (coin)", name),
+			Validate: optional(validateCoin),
+		})
+		if err != nil || result == "" {
+			return false, err
+		}
+
+		return true, setCoin(msg, result)
+	}
+
+	if err := promptMessage(opts, name, msg, ""); err != nil {
+		return false, err
+	}
+
+	set := false
+	msg.Range(func(protoreflect.FieldDescriptor, protoreflect.Value) bool {
+		set = true
+		return false
+	})
+
+	return set, nil
+}
+
+// promptAny prompts the type and the fields of an Any value.
+// The type is selected among the implementations accepted by the field, if any.
+func promptAny(opts Options, name string, msg protoreflect.Message, field protoreflect.FieldDescriptor) (bool, error) {
+	implementations := listImplementations(opts.InterfaceRegistry, field)
+
+	var (
+		typeURL string
+		err     error
+	)
+	if len(implementations) > 0 {
+		_, typeURL, err = runSelect(opts, promptui.Select{
+			Label: fmt.Sprintf("Select %s type", name),
+			Items: implementations,
+			Size:  10,
+			Searcher: func(input string, index int) bool {
+				return strings.Contains(strings.ToLower(implementations[index]), strings.ToLower(input))
+			},
+		})
+	} else {
+		typeURL, err = run(opts, promptui.Prompt{
+			Label: fmt.Sprintf("Enter %s type URL", name),
+			Validate: optional(func(input string) error {
+				_, err := opts.TypeResolver.FindMessageByURL(input)
+				return err
+			}),
+		})
+	}
+	if err != nil || typeURL == "" {
+		return false, err
+	}
+
+	msgType, err := opts.TypeResolver.FindMessageByURL(typeURL)
+	if err != nil {
+		return false, fmt.Errorf("can't resolve type %s: %w", typeURL, err)
+	}
+
+	value := msgType.New()
+	if err := promptMessage(opts, name, value, ""); err != nil {
+		return false, err
+	}
+
+	bz, err := proto.MarshalOptions{Deterministic: true}.Marshal(value.Interface())
+	if err != nil {
+		return false, err
+	}
+
+	if !strings.HasPrefix(typeURL, "/") {
+		typeURL = "/" + string(msgType.Descriptor().FullName())
+	}
+
+	fields := msg.Descriptor().Fields()
+	msg.Set(fields.ByName("type_url"), protoreflect.ValueOfString(typeURL))
+	msg.Set(fields.ByName("value"), protoreflect.ValueOfBytes(bz))
+	return true, nil
+}
+
+// listImplementations returns the sorted type URLs of the implementations accepted by an Any field.
+func listImplementations(registry InterfaceRegistry, field protoreflect.FieldDescriptor) []string {
+	if registry == nil {
+		return nil
+	}
+
+	interfaces := registry.ListAllInterfaces()
+	if interfaceName, _ := proto.GetExtension(field.Options(), cosmos_proto.E_AcceptsInterface).(string); interfaceName != "" {
+		interfaces = []string{interfaceName}
+	}
+
+	seen := map[string]bool{}
+	var implementations []string
+	for _, iface := range interfaces {
+		for _, impl := range registry.ListImplementations(iface) {
+			if !seen[impl] {
+				seen[impl] = true
+				implementations = append(implementations, impl)
+			}
+		}
+	}
+
+	sort.Strings(implementations)
+	return implementations
+}
+
+// selectOneofField selects the field of a oneof to prompt. It returns nil if the oneof is left unset.
+func selectOneofField(opts Options, prefix string, oneof protoreflect.OneofDescriptor) (protoreflect.FieldDescriptor, error) {
+	items := []string{noneOption}
+	for i := 0; i < oneof.Fields().Len(); i++ {
+		items = append(items, string(oneof.Fields().Get(i).Name()))
+	}
+
+	i, _, err := runSelect(opts, promptui.Select{
+		Label: fmt.Sprintf("Select %s", joinLabel(prefix, string(oneof.Name()))),
+		Items: items,
+	})
+	if err != nil || i == 0 {
+		return nil, err
+	}
+
+	return oneof.Fields().Get(i - 1), nil
+}
+
+// selectEnum selects a value of an enum, the zero value leaving the field unset.
+func selectEnum(opts Options, name string, enum protoreflect.EnumDescriptor) (protoreflect.Value, bool, error) {
+	values := enum.Values()
+	items := make([]string, values.Len())
+	for i := 0; i < values.Len(); i++ {
+		items[i] = string(values.Get(i).Name())
+	}
+
+	i, _, err := runSelect(opts, promptui.Select{
+		Label: fmt.Sprintf("Select %s", name),
+		Items: items,
+	})
+	if err != nil {
+		return protoreflect.Value{}, false, err
+	}
+
+	number := values.Get(i).Number()
+	return protoreflect.ValueOfEnum(number), number != 0, nil
+}
+
+// validateScalar returns the validation of the input of a scalar field.
+func validateScalar(opts Options, field protoreflect.FieldDescriptor) func(string) error {
+	if scalarType, ok := flag.GetScalarType(field); ok {
+		switch scalarType {
+		case flag.AddressStringScalarType:
+			return ValidatePromptAddress(opts.AddressCodec)
+		case flag.ValidatorAddressStringScalarType:
+			return ValidatePromptAddress(opts.ValidatorAddressCodec)
+		case flag.ConsensusAddressStringScalarType:
+			return ValidatePromptAddress(opts.ConsensusAddressCodec)
+		}
+	}
+
+	return func(input string) error {
+		_, err := parseScalar(field, input)
+		return err
+	}
+}
+
+// parseScalar parses the input of a scalar field.
+func parseScalar(field protoreflect.FieldDescriptor, input string) (protoreflect.Value, error) {
+	switch field.Kind() {
+	case protoreflect.StringKind:
+		return protoreflect.ValueOfString(input), nil
+	case protoreflect.BytesKind:
+		bz, err := base64.StdEncoding.DecodeString(input)
+		if err != nil {
+			return protoreflect.Value{}, fmt.Errorf("invalid base64 bytes: %w", err)
+		}
+		return protoreflect.ValueOfBytes(bz), nil
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		i, err := strconv.ParseInt(input, 10, 32)
+		return protoreflect.ValueOfInt32(int32(i)), err
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		i, err := strconv.ParseInt(input, 10, 64)
+		return protoreflect.ValueOfInt64(i), err
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		u, err := strconv.ParseUint(input, 10, 32)
+		return protoreflect.ValueOfUint32(uint32(u)), err
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		u, err := strconv.ParseUint(input, 10, 64)
+		return protoreflect.ValueOfUint64(u), err
+	case protoreflect.FloatKind:
+		f, err := strconv.ParseFloat(input, 32)
+		return protoreflect.ValueOfFloat32(float32(f)), err
+	case protoreflect.DoubleKind:
+		f, err := strconv.ParseFloat(input, 64)
+		return protoreflect.ValueOfFloat64(f), err
+	default:
+		return protoreflect.Value{}, fmt.Errorf("unsupported field kind %s", field.Kind())
+	}
+}
+
+// isCoin returns true if the field is a cosmos.base.v1beta1.Coin or DecCoin.
+func isCoin(field protoreflect.FieldDescriptor) bool {
+	if field.Kind() != protoreflect.MessageKind {
+		return false
+	}
+
+	name := field.Message().FullName()
+	return name == "cosmos.base.v1beta1.Coin" || name == "cosmos.base.v1beta1.DecCoin"
+}
+
+func setCoin(msg protoreflect.Message, input string) error {
+	coin, err := coins.ParseCoin(input)
+	if err != nil {
+		return err
+	}
+
+	fields := msg.Descriptor().Fields()
+	msg.Set(fields.ByName("denom"), protoreflect.ValueOfString(coin.Denom))
+	msg.Set(fields.ByName("amount"), protoreflect.ValueOfString(coin.Amount))
+	return nil
+}
+
+func setSecondsAndNanos(msg protoreflect.Message, seconds int64, nanos int32) {
+	fields := msg.Descriptor().Fields()
+	msg.Set(fields.ByName("seconds"), protoreflect.ValueOfInt64(seconds))
+	msg.Set(fields.ByName("nanos"), protoreflect.ValueOfInt32(nanos))
+}
+
+func validateCoin(input string) error {
+	if _, err := coins.ParseCoin(input); err != nil {
+		return fmt.Errorf("invalid coin: %w", err)
+	}
+
+	return nil
+}
+
+func validateCoins(input string) error {
+	for _, c := range strings.Split(input, ",") {
+		if err := validateCoin(c); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateTime(input string) error {
+	if _, err := time.Parse(time.RFC3339, input); err != nil {
+		return fmt.Errorf("invalid time: %w", err)
+	}
+
+	return nil
+}
+
+func validateDuration(input string) error {
+	d, err := time.ParseDuration(input)
+	if err != nil {
+		return fmt.Errorf("invalid duration: %w", err)
+	}
+	if d < 0 {
+		return errors.New("duration must be positive")
+	}
+
+	return nil
+}
+
+// optional wraps a validation so that an empty input, leaving the field unset, is valid.
+func optional(validate func(string) error) func(string) error {
+	return func(input string) error {
+		if input == "" {
+			return nil
+		}
+
+		return validate(input)
+	}
+}
+
+func confirm(opts Options, label string) (bool, error) {
+	_, err := run(opts, promptui.Prompt{Label: label, IsConfirm: true})
+	if errors.Is(err, promptui.ErrAbort) {
+		return false, nil
+	}
+
+	return err == nil, err
+}
+
+func run(opts Options, prompt promptui.Prompt) (string, error) {
+	prompt.Stdin, prompt.Stdout = opts.Stdin, opts.Stdout
+	result, err := prompt.Run()
+	if err != nil && !errors.Is(err, promptui.ErrAbort) {
+		return "", fmt.Errorf("failed to prompt %q: %w", prompt.Label, err)
+	}
+
+	return strings.TrimSpace(result), err
+}
+
+func runSelect(opts Options, prompt promptui.Select) (int, string, error) {
+	prompt.Stdin, prompt.Stdout = opts.Stdin, opts.Stdout
+	i, result, err := prompt.Run()
+	if err != nil {
+		return 0, "", fmt.Errorf("failed to prompt %q: %w", prompt.Label, err)
+	}
+
+	return i, result, nil
+}
+
+func fieldLabel(prefix string, field protoreflect.FieldDescriptor) string {
+	return joinLabel(prefix, string(field.Name()))
+}
+
+func joinLabel(prefix, name string) string {
+	if prefix == "" {
+		return name
+	}
+
+	return prefix + "." + name
+}
@@ -0,0 +1,171 @@
+package prompt_test
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	queryv1beta1 "cosmossdk.io/api/cosmos/base/query/v1beta1"
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	govv1 "cosmossdk.io/api/cosmos/gov/v1"
+	"cosmossdk.io/client/v2/internal/prompt"
+	"cosmossdk.io/client/v2/internal/testpb"
+
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+)
+
+const (
+	addr    = "cosmos1y74p8wyy4enfhfn342njve6cjmj5c8dtl6emdk"
+	valAddr = "cosmosvaloper1tnh2q55v8wyygtt9srz5safamzdengsn9dsd7z"
+
+	down = "\x1b[B" // selects the next item
+)
+
+type interfaceRegistry map[string][]string
+
+func (r interfaceRegistry) ListAllInterfaces() []string {
+	interfaces := make([]string, 0, len(r))
+	for iface := range r {
+		interfaces = append(interfaces, iface)
+	}
+	return interfaces
+}
+
+func (r interfaceRegistry) ListImplementations(iface string) []string {
+	return r[iface]
+}
+
+type nopWriteCloser struct{ io.Writer }
+
+func (nopWriteCloser) Close() error { return nil }
+
+// newOptions returns the prompt options reading the given inputs, one per prompt.
+func newOptions(inputs ...string) prompt.Options {
+	// promptui reads its input in chunks of 4096 bytes, discarding the remaining bytes of a chunk
+	// when a prompt completes, so each input is padded to a chunk.
+	// https://github.com/manifoldco/promptui/issues/63
+	stdin := &bytes.Buffer{}
+	for _, input := range inputs {
+		stdin.WriteString(input + "\n")
+		stdin.Write(bytes.Repeat([]byte{0}, 4096-len(input)-1))
+	}
+
+	return prompt.Options{
+		AddressCodec:          addresscodec.NewBech32Codec("cosmos"),
+		ValidatorAddressCodec: addresscodec.NewBech32Codec("cosmosvaloper"),
+		ConsensusAddressCodec: addresscodec.NewBech32Codec("cosmosvalcons"),
+		TypeResolver:          protoregistry.GlobalTypes,
+		InterfaceRegistry: interfaceRegistry{
+			"cosmos.base.v1beta1.Msg": {"/cosmos.gov.v1.MsgVote", "/cosmos.bank.v1beta1.MsgSend"},
+		},
+		Stdin:  io.NopCloser(stdin),
+		Stdout: nopWriteCloser{io.Discard},
+	}
+}
+
+func TestPromptMessage(t *testing.T) {
+	opts := newOptions(
+		"y",              // add an element to messages
+		"",               // select the first implementation, /cosmos.bank.v1beta1.MsgSend
+		addr,             // messages[0].from_address
+		addr,             // messages[0].to_address
+		"10stake",        // messages[0].amount
+		"n",              // do not add another message
+		"100stake,5atom", // initial_deposit
+		"",               // metadata left empty
+		"title",          // title
+		"summary",        // summary
+		down,             // expedited
+		down+down,        // proposal_type
+	)
+
+	// the proposer is the signer, it is not prompted
+	msg := &govv1.MsgSubmitProposal{}
+	require.NoError(t, prompt.PromptMessage(opts, msg.ProtoReflect()))
+
+	sendBz, err := proto.MarshalOptions{Deterministic: true}.Marshal(&bankv1beta1.MsgSend{
+		FromAddress: addr,
+		ToAddress:   addr,
+		Amount:      []*basev1beta1.Coin{{Denom: "stake", Amount: "10"}},
+	})
+	require.NoError(t, err)
+
+	require.True(t, proto.Equal(&govv1.MsgSubmitProposal{
+		Messages:       []*anypb.Any{{TypeUrl: "/cosmos.bank.v1beta1.MsgSend", Value: sendBz}},
+		InitialDeposit: []*basev1beta1.Coin{{Denom: "stake", Amount: "100"}, {Denom: "atom", Amount: "5"}},
+		Title:          "title",
+		Summary:        "summary",
+		Expedited:      true,
+		ProposalType:   govv1.ProposalType_PROPOSAL_TYPE_MULTIPLE_CHOICE,
+	}, msg), msg)
+}
+
+func TestPromptMessageFieldKinds(t *testing.T) {
+	opts := newOptions(
+		"7",                    // u32
+		"",                     // u64 left empty
+		"foo",                  // str
+		"aGVsbG8=",             // bz
+		"2024-01-02T15:04:05Z", // timestamp
+		"1h30m",                // duration
+		"-3",                   // i32
+		// i64 is set
+		"",        // a_bool
+		down,      // an_enum
+		"5",       // a_message.bar
+		"",        // a_message.baz left empty
+		"1foo",    // a_coin
+		addr,      // an_address
+		"",        // page.key
+		"",        // page.offset
+		"3",       // page.limit
+		"",        // page.count_total
+		"",        // page.reverse
+		"y", down, // bools[0]
+		"n",
+		"y", "1", // uints[0]
+		"y", "2", // uints[1]
+		"n",
+		"n", // strings
+		"n", // enums
+		"n", // durations
+		"n", // some_messages
+		"1", // positional1
+		"",  // positional2
+		"",  // positional3_varargs
+		"",  // deprecated_field
+		"",  // shorthand_deprecated_field
+		"",  // hidden_bool
+		valAddr,
+	)
+
+	msg := &testpb.MsgRequest{I64: 12}
+	require.NoError(t, prompt.PromptMessage(opts, msg.ProtoReflect()))
+
+	require.True(t, proto.Equal(&testpb.MsgRequest{
+		U32:               7,
+		Str:               "foo",
+		Bz:                []byte("hello"),
+		Timestamp:         &timestamppb.Timestamp{Seconds: 1704207845},
+		Duration:          durationpb.New(90 * 60 * 1e9),
+		I32:               -3,
+		I64:               12,
+		AnEnum:            testpb.Enum_ENUM_ONE,
+		AMessage:          &testpb.AMessage{Bar: "5"},
+		ACoin:             &basev1beta1.Coin{Denom: "foo", Amount: "1"},
+		AnAddress:         addr,
+		Page:              &queryv1beta1.PageRequest{Limit: 3},
+		Bools:             []bool{true},
+		Uints:             []uint32{1, 2},
+		Positional1:       1,
+		AValidatorAddress: valAddr,
+	}, msg), msg)
+}
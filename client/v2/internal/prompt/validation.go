@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"net/url"
 
+	"cosmossdk.io/core/address"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -34,3 +36,14 @@ func ValidatePromptCoins(input string) error {
 
 	return nil
 }
+
+// ValidatePromptAddress returns a validation that the input is a valid address for the given address codec.
+func ValidatePromptAddress(addressCodec address.Codec) func(string) error {
+	return func(input string) error {
+		if _, err := addressCodec.StringToBytes(input); err != nil {
+			return fmt.Errorf("invalid address: %w", err)
+		}
+
+		return nil
+	}
+}
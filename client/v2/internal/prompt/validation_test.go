@@ -6,6 +6,8 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"cosmossdk.io/client/v2/internal/prompt"
+
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
 )
 
 func TestValidatePromptNotEmpty(t *testing.T) {
@@ -28,3 +30,12 @@ func TestValidatePromptCoins(t *testing.T) {
 	require.NoError(prompt.ValidatePromptCoins("100stake"))
 	require.ErrorContains(prompt.ValidatePromptCoins("foo"), "invalid coins")
 }
+
+func TestValidatePromptAddress(t *testing.T) {
+	require := require.New(t)
+
+	validate := prompt.ValidatePromptAddress(addresscodec.NewBech32Codec("cosmos"))
+	require.NoError(validate("cosmos1y74p8wyy4enfhfn342njve6cjmj5c8dtl6emdk"))
+	require.ErrorContains(validate("cosmosvaloper1tnh2q55v8wyygtt9srz5safamzdengsn9dsd7z"), "invalid address")
+	require.ErrorContains(validate("foo"), "invalid address")
+}
@@ -12,6 +12,7 @@ import (
 	"google.golang.org/protobuf/types/known/anypb"
 
 	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	multisigv1beta1 "cosmossdk.io/api/cosmos/crypto/multisig/v1beta1"
 	apitx "cosmossdk.io/api/cosmos/tx/v1beta1"
 	txsigning "cosmossdk.io/x/tx/signing"
 
@@ -297,6 +298,38 @@ func (b *builder) signatureDataToModeInfoAndSig(data SignatureData) (*apitx.Mode
 				Single: &apitx.ModeInfo_Single{Mode: data.SignMode},
 			},
 		}, data.Signature, nil
+	case *MultiSignatureData:
+		n := len(data.Signatures)
+		modeInfos := make([]*apitx.ModeInfo, n)
+		sigs := make([][]byte, n)
+
+		for i, d := range data.Signatures {
+			var err error
+			modeInfos[i], sigs[i], err = b.signatureDataToModeInfoAndSig(d)
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+
+		multisig := cryptotypes.MultiSignature{
+			Signatures: sigs,
+		}
+		sig, err := multisig.Marshal()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return &apitx.ModeInfo{
+			Sum: &apitx.ModeInfo_Multi_{
+				Multi: &apitx.ModeInfo_Multi{
+					Bitarray: &multisigv1beta1.CompactBitArray{
+						ExtraBitsStored: data.BitArray.ExtraBitsStored,
+						Elems:           data.BitArray.Elems,
+					},
+					ModeInfos: modeInfos,
+				},
+			},
+		}, sig, nil
 	default:
 		return nil, nil, fmt.Errorf("unexpected signature data type %T", data)
 	}
@@ -311,7 +344,50 @@ func modeInfoAndSigToSignatureData(modeInfo *apitx.ModeInfo, sig []byte) (Signat
 			Signature: sig,
 		}, nil
 
+	case *apitx.ModeInfo_Multi_:
+		multi := modeInfoType.Multi
+
+		sigs, err := decodeMultisignatures(sig)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(sigs) != len(multi.ModeInfos) {
+			return nil, errors.New("mismatch between the number of mode infos and signatures of the multisig")
+		}
+
+		sigsData := make([]SignatureData, len(sigs))
+		for i, mi := range multi.ModeInfos {
+			sigsData[i], err = modeInfoAndSigToSignatureData(mi, sigs[i])
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return &MultiSignatureData{
+			BitArray: &cryptotypes.CompactBitArray{
+				ExtraBitsStored: multi.Bitarray.GetExtraBitsStored(),
+				Elems:           multi.Bitarray.GetElems(),
+			},
+			Signatures: sigsData,
+		}, nil
+
 	default:
 		return nil, fmt.Errorf("unexpected ModeInfo data type %T", modeInfo)
 	}
 }
+
+// decodeMultisignatures safely decodes the raw bytes as a MultiSignature protobuf message.
+func decodeMultisignatures(bz []byte) ([][]byte, error) {
+	multisig := cryptotypes.MultiSignature{}
+	err := multisig.Unmarshal(bz)
+	if err != nil {
+		return nil, err
+	}
+	// NOTE: multi-signatures containing unrecognized fields are rejected, as it would be a malleability
+	// of the protobuf message.
+	if len(multisig.XXX_unrecognized) > 0 {
+		return nil, errors.New("rejecting unrecognized fields found in MultiSignature")
+	}
+	return multisig.Signatures, nil
+}
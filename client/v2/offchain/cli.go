@@ -17,6 +17,7 @@ const (
 	flagIndent             = "indent"
 	flagEncoding           = "encoding"
 	flagFileFormat         = "file-format"
+	flagMultisig           = "multisig"
 )
 
 // OffChain off-chain utilities.
@@ -29,6 +30,7 @@ func OffChain() *cobra.Command {
 
 	cmd.AddCommand(
 		SignFile(),
+		MultisignFile(),
 		VerifyFile(),
 	)
 
@@ -56,8 +58,20 @@ func SignFile() *cobra.Command {
 			encoding, _ := cmd.Flags().GetString(flagEncoding)
 			outputFormat, _ := cmd.Flags().GetString(v2flags.FlagOutput)
 			outputFile, _ := cmd.Flags().GetString(flags.FlagOutputDocument)
+			multisigName, _ := cmd.Flags().GetString(flagMultisig)
+			signModeStr, _ := cmd.Flags().GetString(flags.FlagSignMode)
 
-			signedTx, err := Sign(clientCtx, bz, args[0], indent, encoding, outputFormat, !notEmitUnpopulated)
+			signMode, err := getSignMode(signModeStr)
+			if err != nil {
+				return err
+			}
+
+			var signedTx string
+			if multisigName != "" {
+				signedTx, err = SignMultisig(clientCtx, bz, args[0], multisigName, indent, encoding, outputFormat, !notEmitUnpopulated, signMode)
+			} else {
+				signedTx, err = Sign(clientCtx, bz, args[0], indent, encoding, outputFormat, !notEmitUnpopulated, signMode)
+			}
 			if err != nil {
 				return err
 			}
@@ -80,6 +94,66 @@ func SignFile() *cobra.Command {
 	cmd.Flags().Bool(flagNotEmitUnpopulated, false, "Don't show unpopulated fields in the tx")
 	cmd.Flags().String(flagEncoding, "no-encoding", "Choose an encoding method for the file content to be added as msg data (no-encoding|base64|hex)")
 	cmd.Flags().String(flags.FlagOutputDocument, "", "The document will be written to the given file instead of STDOUT")
+	cmd.Flags().String(flags.FlagSignMode, "", "Choose a sign mode (textual|amino-json|direct), defaults to textual, or amino-json when signing for a multisig")
+	cmd.Flags().String(flagMultisig, "", "Address or key name of the multisig on behalf of which the file is signed, producing a partial signature")
+	return cmd
+}
+
+// MultisignFile combines the partial signatures of a file into the signature of a multisig.
+func MultisignFile() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "multisign-file <multisigName> <fileName> <signatureFile>...",
+		Short: "Combine the partial signatures of a file into a multisig signature.",
+		Long: `Combine the partial signatures of a file, generated with sign-file --multisig by the keys of a multisig,
+into the signature of the multisig. The partial signatures are verified against the file.`,
+		Args: cobra.MinimumNArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			bz, err := os.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			signatures := make([][]byte, len(args[2:]))
+			for i, sigFile := range args[2:] {
+				signatures[i], err = os.ReadFile(sigFile)
+				if err != nil {
+					return err
+				}
+			}
+
+			notEmitUnpopulated, _ := cmd.Flags().GetBool(flagNotEmitUnpopulated)
+			indent, _ := cmd.Flags().GetString(flagIndent)
+			encoding, _ := cmd.Flags().GetString(flagEncoding)
+			fileFormat, _ := cmd.Flags().GetString(flagFileFormat)
+			outputFormat, _ := cmd.Flags().GetString(v2flags.FlagOutput)
+			outputFile, _ := cmd.Flags().GetString(flags.FlagOutputDocument)
+
+			signedTx, err := Multisign(clientCtx, bz, args[0], signatures, indent, encoding, fileFormat, outputFormat, !notEmitUnpopulated)
+			if err != nil {
+				return err
+			}
+
+			if outputFile != "" {
+				fp, err := os.OpenFile(filepath.Clean(outputFile), os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0o600)
+				if err != nil {
+					return err
+				}
+				cmd.SetOut(fp)
+			}
+
+			cmd.Println(signedTx)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagIndent, "  ", "Choose an indent for the tx")
+	cmd.Flags().String(v2flags.FlagOutput, "json", "Choose an output format for the tx (json|text")
+	cmd.Flags().Bool(flagNotEmitUnpopulated, false, "Don't show unpopulated fields in the tx")
+	cmd.Flags().String(flagEncoding, "no-encoding", "Choose the encoding method used for the file content when signing (no-encoding|base64|hex)")
+	cmd.Flags().String(flagFileFormat, "json", "Choose what's the format of the signature files (json|text)")
+	cmd.Flags().String(flags.FlagOutputDocument, "", "The document will be written to the given file instead of STDOUT")
 	return cmd
 }
 
@@ -0,0 +1,302 @@
+package offchain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apisigning "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// multisigSignMode is the SignMode of the signatures of a multisig. The sign bytes of the other
+// sign modes depend on the signer infos of the Tx, which differ between the partial signatures and
+// the aggregated one.
+const multisigSignMode = apisigning.SignMode_SIGN_MODE_LEGACY_AMINO_JSON
+
+// SignMultisig signs given bytes with a key of a multisig, on behalf of the multisig.
+// The result is a partial signature, to be combined with the ones of the other keys of the multisig using Multisign.
+// SIGN_MODE_LEGACY_AMINO_JSON is the only supported SignMode.
+func SignMultisig(ctx client.Context, rawBytes []byte, fromName, multisigName, indent, encoding, output string, emitUnpopulated bool, signMode apisigning.SignMode) (string, error) {
+	digest, err := encodeDigest(encoding, rawBytes)
+	if err != nil {
+		return "", err
+	}
+
+	txBuilder, err := signMultisig(ctx, fromName, multisigName, digest, signMode)
+	if err != nil {
+		return "", err
+	}
+
+	txMarshaller, err := getMarshaller(output, indent, emitUnpopulated)
+	if err != nil {
+		return "", err
+	}
+
+	return marshalOffChainTx(txBuilder.GetTx(), txMarshaller)
+}
+
+// signMultisig signs a digest with provided key on behalf of the given multisig.
+func signMultisig(ctx client.Context, fromName, multisigName, digest string, signMode apisigning.SignMode) (*builder, error) {
+	if signMode == apisigning.SignMode_SIGN_MODE_UNSPECIFIED {
+		signMode = multisigSignMode
+	}
+	if signMode != multisigSignMode {
+		return nil, fmt.Errorf("%s is not supported for multisig signatures, use %s", signMode, multisigSignMode)
+	}
+
+	multisigPubKey, err := getMultisigPubKey(ctx, multisigName)
+	if err != nil {
+		return nil, err
+	}
+
+	keybase, err := keyring.NewAutoCLIKeyring(ctx.Keyring)
+	if err != nil {
+		return nil, err
+	}
+
+	pubKey, err := keybase.GetPubKey(fromName)
+	if err != nil {
+		return nil, err
+	}
+
+	if getPubKeyIndex(pubKey, multisigPubKey.GetPubKeys()) == -1 {
+		return nil, fmt.Errorf("%s is not a key of the multisig %s", fromName, multisigName)
+	}
+
+	multisigAddr, err := ctx.AddressCodec.BytesToString(multisigPubKey.Address())
+	if err != nil {
+		return nil, err
+	}
+
+	addr, err := ctx.AddressCodec.BytesToString(pubKey.Address())
+	if err != nil {
+		return nil, err
+	}
+
+	txBuilder, err := newSignArbitraryDataBuilder(ctx, multisigAddr, digest)
+	if err != nil {
+		return nil, err
+	}
+
+	signerData := signerData{
+		Address:       addr,
+		ChainID:       ExpectedChainID,
+		AccountNumber: ExpectedAccountNumber,
+		Sequence:      ExpectedSequence,
+		PubKey:        pubKey,
+	}
+
+	sig, err := signWithKey(keybase, fromName, signerData, ctx.TxConfig.SignModeHandler(), txBuilder, signMode)
+	if err != nil {
+		return nil, err
+	}
+
+	err = txBuilder.SetSignatures(sig)
+	if err != nil {
+		return nil, err
+	}
+
+	return txBuilder, nil
+}
+
+// Multisign combines the partial signatures of given bytes, produced by SignMultisig, into the signature of the multisig.
+// The partial signatures are verified against the given bytes, and at least the threshold of the multisig must be given.
+func Multisign(ctx client.Context, rawBytes []byte, multisigName string, signatures [][]byte, indent, encoding, fileFormat, output string, emitUnpopulated bool) (string, error) {
+	digest, err := encodeDigest(encoding, rawBytes)
+	if err != nil {
+		return "", err
+	}
+
+	partialTxs := make([]*builder, len(signatures))
+	for i, bz := range signatures {
+		tx, err := unmarshal(bz, fileFormat)
+		if err != nil {
+			return "", err
+		}
+
+		partialTxs[i] = &builder{
+			cdc: ctx.Codec,
+			tx:  tx,
+		}
+	}
+
+	txBuilder, err := multisign(ctx, multisigName, digest, partialTxs)
+	if err != nil {
+		return "", err
+	}
+
+	txMarshaller, err := getMarshaller(output, indent, emitUnpopulated)
+	if err != nil {
+		return "", err
+	}
+
+	return marshalOffChainTx(txBuilder.GetTx(), txMarshaller)
+}
+
+// multisign verifies the signatures of the partial Txs against the digest, and combines them into the signature of the multisig.
+func multisign(ctx client.Context, multisigName, digest string, partialTxs []*builder) (*builder, error) {
+	multisigPubKey, err := getMultisigPubKey(ctx, multisigName)
+	if err != nil {
+		return nil, err
+	}
+
+	multisigAddr, err := ctx.AddressCodec.BytesToString(multisigPubKey.Address())
+	if err != nil {
+		return nil, err
+	}
+
+	txBuilder, err := newSignArbitraryDataBuilder(ctx, multisigAddr, digest)
+	if err != nil {
+		return nil, err
+	}
+
+	pubKeys := multisigPubKey.GetPubKeys()
+	multiSig := &MultiSignatureData{
+		BitArray:   cryptotypes.NewCompactBitArray(len(pubKeys)),
+		Signatures: make([]SignatureData, 0, len(pubKeys)),
+	}
+
+	for _, partialTx := range partialTxs {
+		sigs, err := partialTx.GetSignatures()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, sig := range sigs {
+			if err := verifyPartialSignature(ctx, txBuilder, sig); err != nil {
+				return nil, err
+			}
+
+			if err := addSignature(multiSig, sig, pubKeys); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if threshold := int(multisigPubKey.GetThreshold()); len(multiSig.Signatures) < threshold {
+		return nil, fmt.Errorf("not enough signatures, have %d, expected %d", len(multiSig.Signatures), threshold)
+	}
+
+	err = txBuilder.SetSignatures(OffchainSignature{
+		PubKey:   multisigPubKey,
+		Data:     multiSig,
+		Sequence: ExpectedSequence,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return txBuilder, nil
+}
+
+// verifyPartialSignature verifies the signature of a key of a multisig against the Tx of the builder,
+// the signature being set in the builder as it was when signing.
+func verifyPartialSignature(ctx client.Context, txBuilder *builder, sig OffchainSignature) error {
+	if sig.PubKey == nil {
+		return errors.New("missing public key of the partial signature")
+	}
+
+	if _, ok := sig.Data.(*SingleSignatureData); !ok {
+		return fmt.Errorf("expected a single signature, got %T", sig.Data)
+	}
+
+	addr, err := ctx.AddressCodec.BytesToString(sig.PubKey.Address())
+	if err != nil {
+		return err
+	}
+
+	err = txBuilder.SetSignatures(sig)
+	if err != nil {
+		return err
+	}
+
+	txData, err := txBuilder.GetSigningTxData()
+	if err != nil {
+		return err
+	}
+
+	txSignerData, err := toTxSignerData(signerData{
+		Address:       addr,
+		ChainID:       ExpectedChainID,
+		AccountNumber: ExpectedAccountNumber,
+		Sequence:      ExpectedSequence,
+		PubKey:        sig.PubKey,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = verifySignature(context.Background(), sig.PubKey, txSignerData, sig.Data, ctx.TxConfig.SignModeHandler(), txData)
+	if err != nil {
+		return fmt.Errorf("couldn't verify signature for address %s: %w", addr, err)
+	}
+
+	return nil
+}
+
+// getMultisigPubKey returns the public key of a multisig key of the keyring, given by name or address.
+func getMultisigPubKey(ctx client.Context, multisigName string) (multisig.PubKey, error) {
+	record, err := ctx.Keyring.Key(multisigName)
+	if err != nil {
+		addr, addrErr := ctx.AddressCodec.StringToBytes(multisigName)
+		if addrErr != nil {
+			return nil, err
+		}
+
+		record, err = ctx.Keyring.KeyByAddress(sdk.AccAddress(addr))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	pubKey, err := record.GetPubKey()
+	if err != nil {
+		return nil, err
+	}
+
+	multisigPubKey, ok := pubKey.(multisig.PubKey)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a multisig key", multisigName)
+	}
+
+	return multisigPubKey, nil
+}
+
+// addSignature adds the signature to the multisig, at the index of its public key in the keys of the multisig.
+// If the signature already exists, it is replaced.
+func addSignature(multiSig *MultiSignatureData, sig OffchainSignature, pubKeys []cryptotypes.PubKey) error {
+	index := getPubKeyIndex(sig.PubKey, pubKeys)
+	if index == -1 {
+		return fmt.Errorf("public key %X is not a key of the multisig", sig.PubKey.Bytes())
+	}
+
+	sigIndex := multiSig.BitArray.NumTrueBitsBefore(index)
+	if multiSig.BitArray.GetIndex(index) {
+		multiSig.Signatures[sigIndex] = sig.Data
+		return nil
+	}
+
+	multiSig.BitArray.SetIndex(index, true)
+	multiSig.Signatures = append(multiSig.Signatures, nil)
+	copy(multiSig.Signatures[sigIndex+1:], multiSig.Signatures[sigIndex:])
+	multiSig.Signatures[sigIndex] = sig.Data
+
+	return nil
+}
+
+// getPubKeyIndex returns the index of the public key in the keys, or -1 if not found.
+func getPubKeyIndex(pubKey cryptotypes.PubKey, pubKeys []cryptotypes.PubKey) int {
+	for i, pk := range pubKeys {
+		if pubKey.Equals(pk) {
+			return i
+		}
+	}
+
+	return -1
+}
@@ -0,0 +1,180 @@
+package offchain
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	_ "cosmossdk.io/api/cosmos/crypto/multisig"
+	apisigning "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+func newMultisigContext(t *testing.T) client.Context {
+	t.Helper()
+
+	k := keyring.NewInMemory(getCodec())
+
+	pubKeys := make([]cryptotypes.PubKey, 3)
+	for i := range pubKeys {
+		record, err := k.NewAccount(fmt.Sprintf("key%d", i), mnemonic, "", fmt.Sprintf("m/44'/118'/0'/0/%d", i), hd.Secp256k1)
+		require.NoError(t, err)
+		pubKeys[i], err = record.GetPubKey()
+		require.NoError(t, err)
+	}
+
+	_, err := k.SaveMultisig("multi", kmultisig.NewLegacyAminoPubKey(2, pubKeys))
+	require.NoError(t, err)
+
+	_, err = k.NewAccount("other", mnemonic, "", "m/44'/118'/0'/0/3", hd.Secp256k1)
+	require.NoError(t, err)
+
+	return client.Context{
+		TxConfig:     newTestConfig(t),
+		Codec:        getCodec(),
+		AddressCodec: address.NewBech32Codec("cosmos"),
+		Keyring:      k,
+	}
+}
+
+func Test_signMultisig(t *testing.T) {
+	ctx := newMultisigContext(t)
+
+	tests := []struct {
+		name     string
+		fromName string
+		signMode apisigning.SignMode
+		wantErr  string
+	}{
+		{
+			name:     "sign",
+			fromName: "key0",
+		},
+		{
+			name:     "sign amino-json",
+			fromName: "key1",
+			signMode: apisigning.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+		},
+		{
+			name:     "textual not supported",
+			fromName: "key0",
+			signMode: apisigning.SignMode_SIGN_MODE_TEXTUAL,
+			wantErr:  "not supported for multisig signatures",
+		},
+		{
+			name:     "not a key of the multisig",
+			fromName: "other",
+			wantErr:  "is not a key of the multisig",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := signMultisig(ctx, tt.fromName, "multi", "digest", tt.signMode)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			sigs, err := got.GetSignatures()
+			require.NoError(t, err)
+			require.Len(t, sigs, 1)
+			require.Equal(t, apisigning.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, sigs[0].Data.(*SingleSignatureData).SignMode)
+		})
+	}
+}
+
+func Test_MultisignVerify(t *testing.T) {
+	ctx := newMultisigContext(t)
+	file := []byte("Hello world!")
+
+	multisigRecord, err := ctx.Keyring.Key("multi")
+	require.NoError(t, err)
+	multisigAddr, err := multisigRecord.GetAddress()
+	require.NoError(t, err)
+	multisigAddrStr, err := ctx.AddressCodec.BytesToString(multisigAddr)
+	require.NoError(t, err)
+
+	partialSign := func(fromName, multisigName string, file []byte) []byte {
+		sig, err := SignMultisig(ctx, file, fromName, multisigName, "  ", "no-encoding", "json", false, apisigning.SignMode_SIGN_MODE_UNSPECIFIED)
+		require.NoError(t, err)
+		return []byte(sig)
+	}
+
+	tests := []struct {
+		name       string
+		signatures [][]byte
+		wantErr    string
+	}{
+		{
+			name:       "threshold",
+			signatures: [][]byte{partialSign("key2", "multi", file), partialSign("key0", "multi", file)},
+		},
+		{
+			name:       "all keys",
+			signatures: [][]byte{partialSign("key0", "multi", file), partialSign("key1", "multi", file), partialSign("key2", "multi", file)},
+		},
+		{
+			name:       "multisig given by address",
+			signatures: [][]byte{partialSign("key0", multisigAddrStr, file), partialSign("key1", multisigAddrStr, file)},
+		},
+		{
+			name:       "not enough signatures",
+			signatures: [][]byte{partialSign("key0", "multi", file)},
+			wantErr:    "not enough signatures",
+		},
+		{
+			name:       "signature of another file",
+			signatures: [][]byte{partialSign("key0", "multi", file), partialSign("key1", "multi", []byte("Goodbye world!"))},
+			wantErr:    "couldn't verify signature",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signedTx, err := Multisign(ctx, file, "multi", tt.signatures, "  ", "no-encoding", "json", "json", false)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			err = Verify(ctx, []byte(signedTx), "json")
+			require.NoError(t, err)
+		})
+	}
+}
+
+func Test_addSignature(t *testing.T) {
+	pubKeys := make([]cryptotypes.PubKey, 3)
+	sigs := make([]OffchainSignature, 3)
+	for i := range pubKeys {
+		record, err := keyring.NewInMemory(getCodec()).NewAccount("key", mnemonic, "", fmt.Sprintf("m/44'/118'/0'/0/%d", i), hd.Secp256k1)
+		require.NoError(t, err)
+		pubKeys[i], err = record.GetPubKey()
+		require.NoError(t, err)
+		sigs[i] = OffchainSignature{
+			PubKey: pubKeys[i],
+			Data:   &SingleSignatureData{Signature: []byte{byte(i)}},
+		}
+	}
+
+	multiSig := &MultiSignatureData{BitArray: cryptotypes.NewCompactBitArray(len(pubKeys))}
+	require.NoError(t, addSignature(multiSig, sigs[2], pubKeys))
+	require.NoError(t, addSignature(multiSig, sigs[0], pubKeys))
+	require.NoError(t, addSignature(multiSig, sigs[2], pubKeys))
+
+	require.True(t, multiSig.BitArray.GetIndex(0))
+	require.False(t, multiSig.BitArray.GetIndex(1))
+	require.True(t, multiSig.BitArray.GetIndex(2))
+	require.Equal(t, []SignatureData{sigs[0].Data, sigs[2].Data}, multiSig.Signatures)
+
+	multisigPubKey := kmultisig.NewLegacyAminoPubKey(2, pubKeys)
+	require.Error(t, addSignature(multiSig, OffchainSignature{PubKey: multisigPubKey}, pubKeys))
+}
@@ -2,15 +2,18 @@ package offchain
 
 import (
 	"context"
+	"fmt"
 
 	"google.golang.org/protobuf/types/known/anypb"
 
 	apisigning "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
 	apitx "cosmossdk.io/api/cosmos/tx/v1beta1"
+	clientkeyring "cosmossdk.io/client/v2/autocli/keyring"
 	"cosmossdk.io/client/v2/internal/offchain"
 	txsigning "cosmossdk.io/x/tx/signing"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
@@ -25,7 +28,7 @@ const (
 	// ExpectedSequence defines the sequence number an off-chain message must have
 	ExpectedSequence = 0
 
-	signMode = apisigning.SignMode_SIGN_MODE_TEXTUAL
+	defaultSignMode = apisigning.SignMode_SIGN_MODE_TEXTUAL
 )
 
 type signerData struct {
@@ -37,32 +40,46 @@ type signerData struct {
 }
 
 // Sign signs given bytes using the specified encoder and SignMode.
-func Sign(ctx client.Context, rawBytes []byte, fromName, indent, encoding, output string, emitUnpopulated bool) (string, error) {
-	encoder, err := getEncoder(encoding)
+// SIGN_MODE_TEXTUAL is used when the SignMode is unspecified.
+func Sign(ctx client.Context, rawBytes []byte, fromName, indent, encoding, output string, emitUnpopulated bool, signMode apisigning.SignMode) (string, error) {
+	digest, err := encodeDigest(encoding, rawBytes)
 	if err != nil {
 		return "", err
 	}
 
-	digest, err := encoder(rawBytes)
+	tx, err := sign(ctx, fromName, digest, signMode)
 	if err != nil {
 		return "", err
 	}
 
-	tx, err := sign(ctx, fromName, digest)
+	txMarshaller, err := getMarshaller(output, indent, emitUnpopulated)
 	if err != nil {
 		return "", err
 	}
 
-	txMarshaller, err := getMarshaller(output, indent, emitUnpopulated)
+	return marshalOffChainTx(tx, txMarshaller)
+}
+
+// encodeDigest encodes the given bytes with the specified encoder.
+func encodeDigest(encoding string, rawBytes []byte) (string, error) {
+	encoder, err := getEncoder(encoding)
 	if err != nil {
 		return "", err
 	}
 
-	return marshalOffChainTx(tx, txMarshaller)
+	return encoder(rawBytes)
 }
 
 // sign signs a digest with provided key and SignMode.
-func sign(ctx client.Context, fromName, digest string) (*apitx.Tx, error) {
+func sign(ctx client.Context, fromName, digest string, signMode apisigning.SignMode) (*apitx.Tx, error) {
+	if signMode == apisigning.SignMode_SIGN_MODE_UNSPECIFIED {
+		signMode = defaultSignMode
+	}
+
+	if err := checkLedgerSignMode(ctx.Keyring, fromName, signMode); err != nil {
+		return nil, err
+	}
+
 	keybase, err := keyring.NewAutoCLIKeyring(ctx.Keyring)
 	if err != nil {
 		return nil, err
@@ -78,14 +95,7 @@ func sign(ctx client.Context, fromName, digest string) (*apitx.Tx, error) {
 		return nil, err
 	}
 
-	msg := &offchain.MsgSignArbitraryData{
-		AppDomain: version.AppName,
-		Signer:    addr,
-		Data:      digest,
-	}
-
-	txBuilder := newBuilder(ctx.Codec)
-	err = txBuilder.setMsgs(msg)
+	txBuilder, err := newSignArbitraryDataBuilder(ctx, addr, digest)
 	if err != nil {
 		return nil, err
 	}
@@ -98,47 +108,117 @@ func sign(ctx client.Context, fromName, digest string) (*apitx.Tx, error) {
 		PubKey:        pubKey,
 	}
 
+	sig, err := signWithKey(keybase, fromName, signerData, ctx.TxConfig.SignModeHandler(), txBuilder, signMode)
+	if err != nil {
+		return nil, err
+	}
+
+	err = txBuilder.SetSignatures(sig)
+	if err != nil {
+		return nil, err
+	}
+
+	return txBuilder.GetTx(), nil
+}
+
+// newSignArbitraryDataBuilder returns a builder of a Tx holding a MsgSignArbitraryData of the given signer and digest.
+func newSignArbitraryDataBuilder(ctx client.Context, signer, digest string) (*builder, error) {
+	msg := &offchain.MsgSignArbitraryData{
+		AppDomain: version.AppName,
+		Signer:    signer,
+		Data:      digest,
+	}
+
+	txBuilder := newBuilder(ctx.Codec)
+	err := txBuilder.setMsgs(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return txBuilder, nil
+}
+
+// signWithKey signs the Tx of the builder with the given key and SignMode, and returns the signature.
+// The signature of the key is set in the builder before computing the sign bytes.
+func signWithKey(
+	keybase clientkeyring.Keyring,
+	fromName string,
+	signerData signerData,
+	handlerMap *txsigning.HandlerMap,
+	txBuilder *builder,
+	signMode apisigning.SignMode,
+) (OffchainSignature, error) {
 	sigData := &SingleSignatureData{
 		SignMode:  signMode,
 		Signature: nil,
 	}
 
 	sig := OffchainSignature{
-		PubKey:   pubKey,
+		PubKey:   signerData.PubKey,
 		Data:     sigData,
 		Sequence: ExpectedSequence,
 	}
 
-	sigs := []OffchainSignature{sig}
-	err = txBuilder.SetSignatures(sigs...)
+	err := txBuilder.SetSignatures(sig)
 	if err != nil {
-		return nil, err
+		return OffchainSignature{}, err
 	}
 
-	bytesToSign, err := getSignBytes(
-		context.Background(), ctx.TxConfig.SignModeHandler(), signerData, txBuilder)
+	bytesToSign, err := getSignBytes(context.Background(), handlerMap, signMode, signerData, txBuilder)
 	if err != nil {
-		return nil, err
+		return OffchainSignature{}, err
 	}
 
 	signedBytes, err := keybase.Sign(fromName, bytesToSign, signMode)
 	if err != nil {
-		return nil, err
+		return OffchainSignature{}, err
 	}
 
 	sigData.Signature = signedBytes
+	return sig, nil
+}
 
-	err = txBuilder.SetSignatures(sig)
+// checkLedgerSignMode returns an error if the key is stored on a Ledger device, which only supports
+// SIGN_MODE_TEXTUAL and SIGN_MODE_LEGACY_AMINO_JSON, and the SignMode is not one of them.
+func checkLedgerSignMode(kr keyring.Keyring, fromName string, signMode apisigning.SignMode) error {
+	record, err := kr.Key(fromName)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	return txBuilder.GetTx(), nil
+	if record.GetType() != keyring.TypeLedger {
+		return nil
+	}
+
+	switch signMode {
+	case apisigning.SignMode_SIGN_MODE_TEXTUAL, apisigning.SignMode_SIGN_MODE_LEGACY_AMINO_JSON:
+		return nil
+	default:
+		return fmt.Errorf("%s is not supported by Ledger devices, use %s or %s", signMode,
+			apisigning.SignMode_SIGN_MODE_TEXTUAL, apisigning.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+	}
+}
+
+// getSignMode returns the SignMode of the given sign mode flag value.
+func getSignMode(signMode string) (apisigning.SignMode, error) {
+	switch signMode {
+	case "":
+		return apisigning.SignMode_SIGN_MODE_UNSPECIFIED, nil
+	case flags.SignModeDirect:
+		return apisigning.SignMode_SIGN_MODE_DIRECT, nil
+	case flags.SignModeLegacyAminoJSON:
+		return apisigning.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, nil
+	case flags.SignModeTextual:
+		return apisigning.SignMode_SIGN_MODE_TEXTUAL, nil
+	default:
+		return apisigning.SignMode_SIGN_MODE_UNSPECIFIED, fmt.Errorf("unsupported sign mode: %s", signMode)
+	}
 }
 
 // getSignBytes gets the bytes to be signed for the given Tx and SignMode.
 func getSignBytes(ctx context.Context,
 	handlerMap *txsigning.HandlerMap,
+	signMode apisigning.SignMode,
 	signerData signerData,
 	tx *builder,
 ) ([]byte, error) {
@@ -147,12 +227,22 @@ func getSignBytes(ctx context.Context,
 		return nil, err
 	}
 
-	anyPk, err := codectypes.NewAnyWithValue(signerData.PubKey)
+	txSignerData, err := toTxSignerData(signerData)
 	if err != nil {
 		return nil, err
 	}
 
-	txSignerData := txsigning.SignerData{
+	return handlerMap.GetSignBytes(ctx, signMode, txSignerData, txData)
+}
+
+// toTxSignerData converts the signerData to the SignerData of the sign mode handlers.
+func toTxSignerData(signerData signerData) (txsigning.SignerData, error) {
+	anyPk, err := codectypes.NewAnyWithValue(signerData.PubKey)
+	if err != nil {
+		return txsigning.SignerData{}, err
+	}
+
+	return txsigning.SignerData{
 		ChainID:       signerData.ChainID,
 		AccountNumber: signerData.AccountNumber,
 		Sequence:      signerData.Sequence,
@@ -161,7 +251,5 @@ func getSignBytes(ctx context.Context,
 			TypeUrl: anyPk.TypeUrl,
 			Value:   anyPk.Value,
 		},
-	}
-
-	return handlerMap.GetSignBytes(ctx, signMode, txSignerData, txData)
+	}, nil
 }
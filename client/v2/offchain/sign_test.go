@@ -5,6 +5,8 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	apisigning "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec/address"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
@@ -24,6 +26,7 @@ func Test_sign(t *testing.T) {
 		ctx      client.Context
 		fromName string
 		digest   string
+		signMode apisigning.SignMode
 	}
 	tests := []struct {
 		name string
@@ -37,13 +40,31 @@ func Test_sign(t *testing.T) {
 				digest:   "Hello world!",
 			},
 		},
+		{
+			name: "Sign amino-json",
+			args: args{
+				ctx:      ctx,
+				fromName: "amino-json",
+				digest:   "Hello world!",
+				signMode: apisigning.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+			},
+		},
+		{
+			name: "Sign direct",
+			args: args{
+				ctx:      ctx,
+				fromName: "direct-mode",
+				digest:   "Hello world!",
+				signMode: apisigning.SignMode_SIGN_MODE_DIRECT,
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			_, err := k.NewAccount(tt.args.fromName, mnemonic, tt.name, "m/44'/118'/0'/0/0", hd.Secp256k1)
 			require.NoError(t, err)
 
-			got, err := sign(tt.args.ctx, tt.args.fromName, tt.args.digest)
+			got, err := sign(tt.args.ctx, tt.args.fromName, tt.args.digest, tt.args.signMode)
 			require.NoError(t, err)
 			require.NotNil(t, got)
 		})
@@ -12,6 +12,8 @@ type SignatureData interface {
 
 func (m *SingleSignatureData) isSignatureData() {}
 
+func (m *MultiSignatureData) isSignatureData() {}
+
 type SingleSignatureData struct {
 	// SignMode represents the SignMode of the signature
 	SignMode apitxsigning.SignMode
@@ -20,6 +22,15 @@ type SingleSignatureData struct {
 	Signature []byte
 }
 
+type MultiSignatureData struct {
+	// BitArray is a compact way of indicating which signers from the multisig key
+	// have signed
+	BitArray *cryptotypes.CompactBitArray
+
+	// Signatures is the nested SignatureData's for each signer
+	Signatures []SignatureData
+}
+
 type OffchainSignature struct {
 	// PubKey is the public key to use for verifying the signature
 	PubKey cryptotypes.PubKey
@@ -17,6 +17,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 )
 
 // Verify verifies a digest after unmarshalling it.
@@ -126,7 +127,52 @@ func verifySignature(
 			return fmt.Errorf("unable to verify single signer signature")
 		}
 		return nil
+	case *MultiSignatureData:
+		multiPubKey, ok := pubKey.(multisig.PubKey)
+		if !ok {
+			return fmt.Errorf("expected %T, got %T", (multisig.PubKey)(nil), pubKey)
+		}
+		return verifyMultisignature(ctx, multiPubKey, signerData, data, handler, txData)
 	default:
 		return fmt.Errorf("unexpected SignatureData %T", signatureData)
 	}
 }
+
+// verifyMultisignature verifies that the signatures of a multisig are set by at least the threshold of
+// its keys, and verifies each of them.
+func verifyMultisignature(
+	ctx context.Context,
+	pubKey multisig.PubKey,
+	signerData txsigning.SignerData,
+	data *MultiSignatureData,
+	handler *txsigning.HandlerMap,
+	txData txsigning.TxData,
+) error {
+	pubKeys := pubKey.GetPubKeys()
+	size := data.BitArray.Count()
+	if len(pubKeys) != size {
+		return fmt.Errorf("bit array size is incorrect, expecting: %d", len(pubKeys))
+	}
+
+	threshold := int(pubKey.GetThreshold())
+	if len(data.Signatures) != data.BitArray.NumTrueBitsBefore(size) {
+		return errors.New("mismatch between the number of signatures and the signers of the bit array")
+	}
+	if len(data.Signatures) < threshold {
+		return fmt.Errorf("not enough signatures set, have %d, expected %d", len(data.Signatures), threshold)
+	}
+
+	sigIndex := 0
+	for i := 0; i < size; i++ {
+		if !data.BitArray.GetIndex(i) {
+			continue
+		}
+
+		if err := verifySignature(ctx, pubKeys[i], signerData, data.Signatures[sigIndex], handler, txData); err != nil {
+			return fmt.Errorf("unable to verify signature at index %d: %w", i, err)
+		}
+		sigIndex++
+	}
+
+	return nil
+}
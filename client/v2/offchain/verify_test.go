@@ -6,6 +6,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	_ "cosmossdk.io/api/cosmos/crypto/secp256k1"
+	apisigning "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec/address"
@@ -78,11 +79,20 @@ func Test_SignVerify(t *testing.T) {
 		Keyring:      k,
 	}
 
-	tx, err := sign(ctx, "signVerify", "digest")
-	require.NoError(t, err)
+	for _, signMode := range []apisigning.SignMode{
+		apisigning.SignMode_SIGN_MODE_UNSPECIFIED,
+		apisigning.SignMode_SIGN_MODE_TEXTUAL,
+		apisigning.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+		apisigning.SignMode_SIGN_MODE_DIRECT,
+	} {
+		t.Run(signMode.String(), func(t *testing.T) {
+			tx, err := sign(ctx, "signVerify", "digest", signMode)
+			require.NoError(t, err)
 
-	err = verify(ctx, tx)
-	require.NoError(t, err)
+			err = verify(ctx, tx)
+			require.NoError(t, err)
+		})
+	}
 }
 
 func Test_unmarshal(t *testing.T) {
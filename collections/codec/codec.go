@@ -124,6 +124,64 @@ type UntypedValueCodec struct {
 	ValueType  func() string
 }
 
+// NewUntypedKeyCodec returns an UntypedKeyCodec for the provided KeyCodec.
+func NewUntypedKeyCodec[K any](k KeyCodec[K]) UntypedKeyCodec {
+	typeName := fmt.Sprintf("%T", *new(K))
+	checkType := func(key interface{}) (k K, err error) {
+		concrete, ok := key.(K)
+		if !ok {
+			return k, fmt.Errorf("%w: expected key of type %s, got %T", ErrEncoding, typeName, key)
+		}
+		return concrete, nil
+	}
+	return UntypedKeyCodec{
+		Decode: func(b []byte) (int, interface{}, error) {
+			n, key, err := k.Decode(b)
+			return n, key, err
+		},
+		Encode: func(key interface{}) ([]byte, error) {
+			concrete, err := checkType(key)
+			if err != nil {
+				return nil, err
+			}
+			b := make([]byte, k.Size(concrete))
+			n, err := k.Encode(b, concrete)
+			if err != nil {
+				return nil, err
+			}
+			return b[:n], nil
+		},
+		DecodeJSON: func(b []byte) (interface{}, error) {
+			return k.DecodeJSON(b)
+		},
+		EncodeJSON: func(key interface{}) ([]byte, error) {
+			concrete, err := checkType(key)
+			if err != nil {
+				return nil, err
+			}
+			return k.EncodeJSON(concrete)
+		},
+		Stringify: func(key interface{}) (string, error) {
+			concrete, err := checkType(key)
+			if err != nil {
+				return "", err
+			}
+			return k.Stringify(concrete), nil
+		},
+		KeyType: func() string { return k.KeyType() },
+	}
+}
+
+// UntypedKeyCodec wraps a KeyCodec to expose an untyped API for encoding and decoding keys.
+type UntypedKeyCodec struct {
+	Decode     func(b []byte) (int, interface{}, error)
+	Encode     func(key interface{}) ([]byte, error)
+	DecodeJSON func(b []byte) (interface{}, error)
+	EncodeJSON func(key interface{}) ([]byte, error)
+	Stringify  func(key interface{}) (string, error)
+	KeyType    func() string
+}
+
 // KeyToValueCodec converts a KeyCodec into a ValueCodec.
 func KeyToValueCodec[K any](keyCodec KeyCodec[K]) ValueCodec[K] { return keyToValueCodec[K]{keyCodec} }
 
@@ -0,0 +1,129 @@
+package collections
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"cosmossdk.io/collections/codec"
+)
+
+// DecodedKV is a raw key/value pair of a collection decoded with its codecs.
+type DecodedKV struct {
+	// Collection is the name of the collection of the key/value pair.
+	Collection string
+	// Key is the decoded key, without the prefix of the collection.
+	Key any
+	// Value is the decoded value, or nil if the raw value was nil, e.g. of a
+	// deleted key.
+	Value any
+
+	hasValue   bool
+	keyCodec   codec.UntypedKeyCodec
+	valueCodec codec.UntypedValueCodec
+}
+
+// KeyJSON returns the JSON encoding of the key.
+func (kv DecodedKV) KeyJSON() (json.RawMessage, error) {
+	return kv.keyCodec.EncodeJSON(kv.Key)
+}
+
+// ValueJSON returns the JSON encoding of the value, or null if the raw value
+// was nil.
+func (kv DecodedKV) ValueJSON() (json.RawMessage, error) {
+	if !kv.hasValue {
+		return json.RawMessage("null"), nil
+	}
+	return kv.valueCodec.EncodeJSON(kv.Value)
+}
+
+// MarshalJSON implements json.Marshaler, encoding the key and value with the
+// JSON encoding of the codecs of the collection.
+func (kv DecodedKV) MarshalJSON() ([]byte, error) {
+	key, err := kv.KeyJSON()
+	if err != nil {
+		return nil, err
+	}
+	value, err := kv.ValueJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(struct {
+		Collection string          `json:"collection"`
+		Key        json.RawMessage `json:"key"`
+		Value      json.RawMessage `json:"value"`
+	}{kv.Collection, key, value})
+}
+
+// String returns the human-readable representation of the key/value pair, as
+// collection[key] = value.
+func (kv DecodedKV) String() string {
+	key, err := kv.keyCodec.Stringify(kv.Key)
+	if err != nil {
+		key = fmt.Sprintf("%v", kv.Key)
+	}
+	if !kv.hasValue {
+		return fmt.Sprintf("%s[%s]", kv.Collection, key)
+	}
+	value, err := kv.valueCodec.Stringify(kv.Value)
+	if err != nil {
+		value = fmt.Sprintf("%v", kv.Value)
+	}
+
+	return fmt.Sprintf("%s[%s] = %s", kv.Collection, key, value)
+}
+
+// DecodeKV decodes a raw key/value pair of the storage of the schema with the
+// codecs of the collection whose prefix the key has. The value is not decoded
+// if nil. It returns an error wrapping ErrNotFound if no collection has the key.
+func (s Schema) DecodeKV(key, value []byte) (DecodedKV, error) {
+	for prefix, coll := range s.collectionsByPrefix {
+		if !bytes.HasPrefix(key, []byte(prefix)) {
+			continue
+		}
+
+		kv := DecodedKV{
+			Collection: coll.GetName(),
+			keyCodec:   coll.KeyCodec(),
+			valueCodec: coll.ValueCodec(),
+		}
+
+		n, k, err := kv.keyCodec.Decode(key[len(prefix):])
+		if err != nil {
+			return DecodedKV{}, fmt.Errorf("failed to decode key of collection %s: %w", kv.Collection, err)
+		}
+		if n != len(key)-len(prefix) {
+			return DecodedKV{}, fmt.Errorf("%w: key of collection %s has %d trailing bytes", ErrEncoding, kv.Collection, len(key)-len(prefix)-n)
+		}
+		kv.Key = k
+
+		if value != nil {
+			if kv.Value, err = kv.valueCodec.Decode(value); err != nil {
+				return DecodedKV{}, fmt.Errorf("failed to decode value of collection %s: %w", kv.Collection, err)
+			}
+			kv.hasValue = true
+		}
+
+		return kv, nil
+	}
+
+	return DecodedKV{}, fmt.Errorf("%w: no collection with the prefix of key %X", ErrNotFound, key)
+}
+
+// StateDecoder decodes the raw key/value pairs of the stores of an app, e.g.
+// read from its storage, a snapshot or a state listener, with the Schema of
+// their store key.
+type StateDecoder map[string]Schema
+
+// DecodeKV decodes a raw key/value pair of the store of the store key with its
+// Schema. It returns an error wrapping ErrNotFound if the store key has no
+// Schema, or if no collection of the Schema has the key.
+func (d StateDecoder) DecodeKV(storeKey string, key, value []byte) (DecodedKV, error) {
+	schema, ok := d[storeKey]
+	if !ok {
+		return DecodedKV{}, fmt.Errorf("%w: no schema of store key %s", ErrNotFound, storeKey)
+	}
+
+	return schema.DecodeKV(key, value)
+}
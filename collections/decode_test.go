@@ -0,0 +1,76 @@
+package collections
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeKV(t *testing.T) {
+	sk, ctx := deps()
+	sb := NewSchemaBuilder(sk)
+	balances := NewMap(sb, NewPrefix(1), "balances", PairKeyCodec(StringKey, StringKey), Uint64Value)
+	params := NewItem(sb, NewPrefix(2), "params", StringValue)
+	schema, err := sb.Build()
+	require.NoError(t, err)
+
+	require.NoError(t, balances.Set(ctx, Join("alice", "stake"), 10))
+	require.NoError(t, params.Set(ctx, "max_supply"))
+
+	// decode the raw key/value pairs of the storage
+	var decoded []DecodedKV
+	itr, err := sk.OpenKVStore(ctx).Iterator(nil, nil)
+	require.NoError(t, err)
+	for ; itr.Valid(); itr.Next() {
+		kv, err := schema.DecodeKV(itr.Key(), itr.Value())
+		require.NoError(t, err)
+		decoded = append(decoded, kv)
+	}
+	require.NoError(t, itr.Close())
+	require.Len(t, decoded, 2)
+
+	require.Equal(t, "balances", decoded[0].Collection)
+	require.Equal(t, Join("alice", "stake"), decoded[0].Key)
+	require.Equal(t, uint64(10), decoded[0].Value)
+	require.Equal(t, `balances[("alice", "stake")] = 10`, decoded[0].String())
+	bz, err := json.Marshal(decoded[0])
+	require.NoError(t, err)
+	require.JSONEq(t, `{"collection":"balances","key":["alice","stake"],"value":"10"}`, string(bz))
+
+	require.Equal(t, "params", decoded[1].Collection)
+	require.Equal(t, "max_supply", decoded[1].Value)
+
+	t.Run("nil value", func(t *testing.T) {
+		key, err := EncodeKeyWithPrefix(NewPrefix(1), PairKeyCodec(StringKey, StringKey), Join("bob", "stake"))
+		require.NoError(t, err)
+		kv, err := schema.DecodeKV(key, nil)
+		require.NoError(t, err)
+		require.Nil(t, kv.Value)
+		bz, err := kv.ValueJSON()
+		require.NoError(t, err)
+		require.Equal(t, "null", string(bz))
+	})
+
+	t.Run("unknown prefix", func(t *testing.T) {
+		_, err := schema.DecodeKV([]byte{3, 1}, nil)
+		require.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("invalid value", func(t *testing.T) {
+		key, err := EncodeKeyWithPrefix(NewPrefix(1), PairKeyCodec(StringKey, StringKey), Join("bob", "stake"))
+		require.NoError(t, err)
+		_, err = schema.DecodeKV(key, []byte{1})
+		require.ErrorIs(t, err, ErrEncoding)
+	})
+
+	t.Run("state decoder", func(t *testing.T) {
+		decoder := StateDecoder{"bank": schema}
+		kv, err := decoder.DecodeKV("bank", []byte{2}, []byte("max_supply"))
+		require.NoError(t, err)
+		require.Equal(t, "params", kv.Collection)
+
+		_, err = decoder.DecodeKV("staking", []byte{2}, nil)
+		require.ErrorIs(t, err, ErrNotFound)
+	})
+}
@@ -65,6 +65,9 @@ type App struct {
 	interfaceRegistrar registry.InterfaceRegistrar
 	amino              legacy.Amino
 	moduleManager      *MM
+
+	// query services of the modules, served over gRPC
+	grpcQueryServices []grpcQueryService
 }
 
 // Logger returns the app logger.
@@ -0,0 +1,132 @@
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/core/transaction"
+)
+
+// GRPCBlockHeightHeader is the gRPC header for block height.
+const GRPCBlockHeightHeader = "x-cosmos-block-height"
+
+// grpcQueryService is a query service of a module, served over gRPC by the app.
+type grpcQueryService struct {
+	name     string
+	metadata any
+	methods  []grpcQueryMethod
+}
+
+// grpcQueryMethod is a method of a query service, identified by the full name
+// of its request message.
+type grpcQueryMethod struct {
+	name        string
+	requestName string
+}
+
+// RegisterGRPCServer registers the query services of the modules with the gRPC
+// server. The queries are executed by the app manager, at the height of the
+// x-cosmos-block-height header if present, or at the latest height otherwise,
+// and the height of the state queried is returned in the response header.
+func (a *App) RegisterGRPCServer(server gogogrpc.Server) {
+	for _, service := range a.grpcQueryServices {
+		desc := &grpc.ServiceDesc{
+			ServiceName: service.name,
+			HandlerType: (*any)(nil),
+			Methods:     make([]grpc.MethodDesc, 0, len(service.methods)),
+			Metadata:    service.metadata,
+		}
+
+		for _, method := range service.methods {
+			requestType := gogoproto.MessageType(method.requestName)
+			if requestType == nil {
+				a.logger.Error("unable to serve query over gRPC, request type not registered", "request", method.requestName)
+				continue
+			}
+
+			desc.Methods = append(desc.Methods, grpc.MethodDesc{
+				MethodName: method.name,
+				Handler:    a.grpcQueryHandler(fmt.Sprintf("/%s/%s", service.name, method.name), requestType),
+			})
+		}
+
+		server.RegisterService(desc, nil)
+	}
+}
+
+// GetVersionAtTime returns the last version committed at or before the given
+// block time, so that the gRPC server can resolve queries by block time.
+func (a *App) GetVersionAtTime(t time.Time) (uint64, error) {
+	return a.db.GetVersionAtTime(t)
+}
+
+// grpcQueryHandler returns the gRPC handler of a query method, decoding its
+// request into a new message of the request type.
+func (a *App) grpcQueryHandler(fullMethod string, requestType reflect.Type) func(any, context.Context, func(any) error, grpc.UnaryServerInterceptor) (any, error) {
+	return func(srv any, ctx context.Context, dec func(any) error, interceptor grpc.UnaryServerInterceptor) (any, error) {
+		req, ok := reflect.New(requestType.Elem()).Interface().(transaction.Msg)
+		if !ok {
+			return nil, status.Errorf(codes.Internal, "invalid request type %s", requestType)
+		}
+		if err := dec(req); err != nil {
+			return nil, err
+		}
+
+		if interceptor == nil {
+			return a.grpcQuery(ctx, req)
+		}
+
+		info := &grpc.UnaryServerInfo{Server: srv, FullMethod: fullMethod}
+		return interceptor(ctx, req, info, func(ctx context.Context, req any) (any, error) {
+			return a.grpcQuery(ctx, req.(transaction.Msg))
+		})
+	}
+}
+
+// grpcQuery executes the query at the height of the request header, or at the
+// latest height, and sets the height queried in the response header.
+func (a *App) grpcQuery(ctx context.Context, req transaction.Msg) (transaction.Msg, error) {
+	var height uint64
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if heightHeaders := md.Get(GRPCBlockHeightHeader); len(heightHeaders) > 0 {
+			if len(heightHeaders) > 1 {
+				return nil, status.Errorf(codes.InvalidArgument, "expected a single %s header", GRPCBlockHeightHeader)
+			}
+
+			var err error
+			height, err = strconv.ParseUint(heightHeaders[0], 10, 64)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid height header %q: %v", GRPCBlockHeightHeader, err)
+			}
+		}
+	}
+
+	if height == 0 {
+		latestHeight, err := a.db.GetLatestVersion()
+		if err != nil {
+			return nil, err
+		}
+		height = latestHeight
+	}
+
+	res, err := a.Query(ctx, height, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := grpc.SetHeader(ctx, metadata.Pairs(GRPCBlockHeightHeader, strconv.FormatUint(height, 10))); err != nil {
+		a.logger.Error("failed to set gRPC header", "err", err)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,223 @@
+package runtime
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"testing"
+	"time"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	gogotypes "github.com/cosmos/gogoproto/types"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	appmodulev2 "cosmossdk.io/core/appmodule/v2"
+	coreheader "cosmossdk.io/core/header"
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/core/transaction"
+	"cosmossdk.io/log"
+	grpcserver "cosmossdk.io/server/v2/api/grpc"
+	"cosmossdk.io/server/v2/appmanager"
+	"cosmossdk.io/server/v2/stf"
+	"cosmossdk.io/server/v2/stf/branch"
+	storev2 "cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/commitment/iavl"
+	"cosmossdk.io/store/v2/db"
+	rootstore "cosmossdk.io/store/v2/root"
+)
+
+const testStoreKey = "test"
+
+// gogoCodec is the gRPC codec of the test client.
+type gogoCodec struct{}
+
+func (gogoCodec) Marshal(v any) ([]byte, error) { return gogoproto.Marshal(v.(gogoproto.Message)) }
+
+func (gogoCodec) Unmarshal(data []byte, v any) error {
+	return gogoproto.Unmarshal(data, v.(gogoproto.Message))
+}
+
+func (gogoCodec) Name() string { return "gogoproto" }
+
+type testInterfaceRegistry struct{}
+
+func (testInterfaceRegistry) Resolve(string) (gogoproto.Message, error) { panic("not implemented") }
+
+func (testInterfaceRegistry) ListImplementations(string) []string { panic("not implemented") }
+
+func (testInterfaceRegistry) ListAllInterfaces() []string { panic("not implemented") }
+
+// newGRPCTestApp returns an app with a query of the value of a key of the test store,
+// and its root store.
+func newGRPCTestApp(t *testing.T) (*App, storev2.RootStore) {
+	t.Helper()
+
+	logger := log.NewNopLogger()
+	rs, err := rootstore.CreateRootStore(&rootstore.FactoryOptions{
+		Logger:     logger,
+		RootDir:    t.TempDir(),
+		SSType:     rootstore.SSTypePebble,
+		SCType:     rootstore.SCTypeIavl,
+		StoreKeys:  []string{testStoreKey},
+		SCRawDB:    db.NewMemDB(),
+		IavlConfig: iavl.DefaultConfig(),
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, rs.Close()) })
+
+	queryRouter := stf.NewMsgRouterBuilder()
+	require.NoError(t, queryRouter.RegisterHandler(gogoproto.MessageName(&gogotypes.StringValue{}), func(ctx context.Context, msg appmodulev2.Message) (appmodulev2.Message, error) {
+		value, err := stf.NewKVStoreService([]byte(testStoreKey)).OpenKVStore(ctx).Get([]byte(msg.(*gogotypes.StringValue).Value))
+		if err != nil {
+			return nil, err
+		}
+		return &gogotypes.StringValue{Value: string(value)}, nil
+	}))
+	queryHandler, err := queryRouter.Build()
+	require.NoError(t, err)
+	msgHandler, err := stf.NewMsgRouterBuilder().Build()
+	require.NoError(t, err)
+
+	appManager, err := appmanager.Builder[transaction.Tx]{
+		STF:           stf.NewSTF[transaction.Tx](msgHandler, queryHandler, nil, nil, nil, nil, nil, nil, branch.DefaultNewWriterMap),
+		DB:            rs,
+		QueryGasLimit: 1_000_000,
+	}.Build()
+	require.NoError(t, err)
+
+	app := &App{
+		AppManager: appManager,
+		db:         rs,
+		logger:     logger,
+		grpcQueryServices: []grpcQueryService{{
+			name:    "test.Query",
+			methods: []grpcQueryMethod{{name: "Get", requestName: gogoproto.MessageName(&gogotypes.StringValue{})}},
+		}},
+	}
+
+	return app, rs
+}
+
+// startGRPCServer starts the gRPC server of server/v2 serving the app, and returns a
+// client connection to it.
+func startGRPCServer(t *testing.T, app *App) *grpc.ClientConn {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := listener.Addr().String()
+	require.NoError(t, listener.Close())
+
+	v := viper.New()
+	v.Set("grpc-server.enable", true)
+	v.Set("grpc-server.address", addr)
+	server, err := grpcserver.New(app.logger, v, testInterfaceRegistry{}, app)
+	require.NoError(t, err)
+
+	go func() { _ = server.Start(context.Background()) }()
+	t.Cleanup(func() { require.NoError(t, server.Stop(context.Background())) })
+
+	conn, err := grpc.NewClient(addr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.ForceCodec(gogoCodec{})),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, conn.Close()) })
+
+	return conn
+}
+
+func TestGRPCQueryAtHeightAndTime(t *testing.T) {
+	app, rs := newGRPCTestApp(t)
+
+	// commit a version every 5 seconds, the key holding the height
+	genesisTime := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	for height := int64(1); height <= 10; height++ {
+		rs.SetCommitHeader(&coreheader.Info{Height: height, Time: genesisTime.Add(time.Duration(height-1) * 5 * time.Second)})
+		cs := corestore.NewChangesetWithPairs(map[string]corestore.KVPairs{testStoreKey: {
+			{Key: []byte("key"), Value: []byte(strconv.FormatInt(height, 10))},
+		}})
+		_, err := rs.Commit(cs)
+		require.NoError(t, err)
+	}
+
+	conn := startGRPCServer(t, app)
+
+	query := func(t *testing.T, ctx context.Context) (string, string, error) {
+		t.Helper()
+
+		var (
+			res    gogotypes.StringValue
+			header metadata.MD
+		)
+		err := conn.Invoke(ctx, "/test.Query/Get", &gogotypes.StringValue{Value: "key"}, &res, grpc.Header(&header), grpc.WaitForReady(true))
+		if err != nil {
+			return "", "", err
+		}
+
+		heightHeaders := header.Get(GRPCBlockHeightHeader)
+		require.Len(t, heightHeaders, 1)
+		return res.Value, heightHeaders[0], nil
+	}
+
+	testCases := []struct {
+		name      string
+		headers   []string
+		expValue  string
+		expHeight string
+		expCode   codes.Code
+	}{
+		{
+			name:      "latest height",
+			expValue:  "10",
+			expHeight: "10",
+		},
+		{
+			name:      "at height",
+			headers:   []string{GRPCBlockHeightHeader, "4"},
+			expValue:  "4",
+			expHeight: "4",
+		},
+		{
+			name:      "at time",
+			headers:   []string{grpcserver.GRPCBlockTimeHeader, genesisTime.Add(22 * time.Second).Format(time.RFC3339Nano)},
+			expValue:  "5",
+			expHeight: "5",
+		},
+		{
+			name:    "before the first block",
+			headers: []string{grpcserver.GRPCBlockTimeHeader, genesisTime.Add(-time.Second).Format(time.RFC3339Nano)},
+			expCode: codes.NotFound,
+		},
+		{
+			name:    "invalid height",
+			headers: []string{GRPCBlockHeightHeader, "-1"},
+			expCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+			if len(tc.headers) > 0 {
+				ctx = metadata.AppendToOutgoingContext(ctx, tc.headers...)
+			}
+
+			value, height, err := query(t, ctx)
+			if tc.expCode != codes.OK {
+				require.Equal(t, tc.expCode, status.Code(err), err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expValue, value)
+			require.Equal(t, tc.expHeight, height)
+		})
+	}
+}
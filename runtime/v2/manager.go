@@ -556,7 +556,12 @@ func registerServices(s appmodule.HasServices, app *App, registry *protoregistry
 		registry:       registry,
 		err:            nil,
 	}
-	return s.RegisterServices(c)
+	if err := s.RegisterServices(c); err != nil {
+		return err
+	}
+
+	app.grpcQueryServices = append(app.grpcQueryServices, c.grpcQueryServices...)
+	return nil
 }
 
 var _ grpc.ServiceRegistrar = (*configurator)(nil)
@@ -566,6 +571,8 @@ type configurator struct {
 	stfMsgRouter   *stf.MsgRouterBuilder
 	registry       *protoregistry.Files
 	err            error
+
+	grpcQueryServices []grpcQueryService
 }
 
 func (c *configurator) RegisterService(sd *grpc.ServiceDesc, ss interface{}) {
@@ -590,13 +597,22 @@ func (c *configurator) RegisterService(sd *grpc.ServiceDesc, ss interface{}) {
 }
 
 func (c *configurator) registerQueryHandlers(sd *grpc.ServiceDesc, ss interface{}) error {
+	service := grpcQueryService{name: sd.ServiceName, metadata: sd.Metadata}
 	for _, md := range sd.Methods {
 		// TODO(tip): what if a query is not deterministic?
 		err := registerMethod(c.stfQueryRouter, sd, md, ss)
 		if err != nil {
 			return fmt.Errorf("unable to register query handler %s: %w", md.MethodName, err)
 		}
+
+		requestName, err := requestFullNameFromMethodDesc(sd, md)
+		if err != nil {
+			return err
+		}
+		service.methods = append(service.methods, grpcQueryMethod{name: md.MethodName, requestName: string(requestName)})
 	}
+
+	c.grpcQueryServices = append(c.grpcQueryServices, service)
 	return nil
 }
 
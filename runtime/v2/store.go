@@ -1,6 +1,8 @@
 package runtime
 
 import (
+	"time"
+
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/server/v2/stf"
 	storev2 "cosmossdk.io/store/v2"
@@ -31,6 +33,10 @@ type Store interface {
 	// Query is a key/value query directly to the underlying database. This skips the appmanager
 	Query(storeKey []byte, version uint64, key []byte, prove bool) (storev2.QueryResult, error)
 
+	// GetVersionAtTime returns the last version committed at or before the given
+	// block time.
+	GetVersionAtTime(t time.Time) (uint64, error)
+
 	// GetStateStorage returns the SS backend.
 	GetStateStorage() storev2.VersionedDatabase
 
@@ -46,6 +46,16 @@ type BaseConfig struct {
 	PruningKeepRecent string `mapstructure:"pruning-keep-recent"`
 	PruningInterval   string `mapstructure:"pruning-interval"`
 
+	// PruningKeepDuration defines the minimum duration of history to keep on disk,
+	// based on the block times, e.g. "720h" for 30 days. It applies along with the
+	// pruning strategy, unless it is "nothing".
+	PruningKeepDuration string `mapstructure:"pruning-keep-duration"`
+
+	// PruningStoreOverrides overrides the pruning of some stores in the form
+	// {storeKey}={pruning}, where the pruning is a pruning strategy or the number of
+	// recent heights to keep, e.g. "gov=nothing" to keep the full history of x/gov.
+	PruningStoreOverrides []string `mapstructure:"pruning-store-overrides"`
+
 	// HaltHeight contains a non-zero block height at which a node will gracefully
 	// halt and shutdown that can be used to assist upgrades and testing.
 	//
@@ -212,17 +222,19 @@ func (c *Config) GetMinGasPrices() sdk.DecCoins {
 func DefaultConfig() *Config {
 	return &Config{
 		BaseConfig: BaseConfig{
-			MinGasPrices:        defaultMinGasPrices,
-			QueryGasLimit:       0,
-			InterBlockCache:     true,
-			Pruning:             pruningtypes.PruningOptionDefault,
-			PruningKeepRecent:   "0",
-			PruningInterval:     "0",
-			MinRetainBlocks:     0,
-			IndexEvents:         make([]string, 0),
-			IAVLCacheSize:       781250,
-			IAVLDisableFastNode: false,
-			AppDBBackend:        "",
+			MinGasPrices:          defaultMinGasPrices,
+			QueryGasLimit:         0,
+			InterBlockCache:       true,
+			Pruning:               pruningtypes.PruningOptionDefault,
+			PruningKeepRecent:     "0",
+			PruningInterval:       "0",
+			PruningKeepDuration:   "0s",
+			MinRetainBlocks:       0,
+			IndexEvents:           make([]string, 0),
+			PruningStoreOverrides: make([]string, 0),
+			IAVLCacheSize:         781250,
+			IAVLDisableFastNode:   false,
+			AppDBBackend:          "",
 		},
 		Telemetry: telemetry.Config{
 			Enabled:      false,
@@ -5,6 +5,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
@@ -149,6 +150,24 @@ func TestIndexEventsWriteRead(t *testing.T) {
 	require.Equal(t, expected, actual, "config value")
 }
 
+func TestPruningRetentionWriteRead(t *testing.T) {
+	confFile := filepath.Join(t.TempDir(), "app.toml")
+	conf := DefaultConfig()
+	conf.PruningKeepDuration = "720h"
+	conf.PruningStoreOverrides = []string{"gov=nothing", "staking=100000"}
+	require.NoError(t, WriteConfigFile(confFile, conf))
+
+	vpr := viper.New()
+	vpr.SetConfigFile(confFile)
+	require.NoError(t, vpr.ReadInConfig(), "reading config file into viper")
+	require.Equal(t, 720*time.Hour, vpr.GetDuration("pruning-keep-duration"))
+
+	cfg, err := ParseConfig(vpr)
+	require.NoError(t, err, "parsing config")
+	require.Equal(t, "720h", cfg.PruningKeepDuration)
+	require.Equal(t, conf.PruningStoreOverrides, cfg.PruningStoreOverrides)
+}
+
 func TestGlobalLabelsEventsMarshalling(t *testing.T) {
 	expectedIn := `global-labels = [
   ["labelname1", "labelvalue1"],
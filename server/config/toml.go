@@ -35,6 +35,17 @@ pruning = "{{ .BaseConfig.Pruning }}"
 pruning-keep-recent = "{{ .BaseConfig.PruningKeepRecent }}"
 pruning-interval = "{{ .BaseConfig.PruningInterval }}"
 
+# pruning-keep-duration keeps at least the states committed within the given duration,
+# based on the block times, e.g. "720h" to keep 30 days of history. It applies along with
+# the pruning strategy, unless it is nothing. "0s" disables it.
+pruning-keep-duration = "{{ .BaseConfig.PruningKeepDuration }}"
+
+# pruning-store-overrides overrides the pruning of some stores in the form {storeKey}={pruning},
+# where the pruning is default, nothing, everything, or the number of recent states to keep.
+# The overridden stores are pruned whenever the other stores are, e.g. ["gov=nothing", "bank=nothing"]
+# keeps the full history of x/gov and x/bank.
+pruning-store-overrides = [{{ range .BaseConfig.PruningStoreOverrides }}{{ printf "%q, " . }}{{end}}]
+
 # HaltHeight contains a non-zero block height at which a node will gracefully
 # halt and shutdown that can be used to assist upgrades and testing.
 #
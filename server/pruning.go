@@ -2,6 +2,7 @@ package server
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cast"
@@ -17,12 +18,13 @@ import (
 func GetPruningOptionsFromFlags(appOpts types.AppOptions) (pruningtypes.PruningOptions, error) {
 	strategy := strings.ToLower(cast.ToString(appOpts.Get(FlagPruning)))
 
+	var opts pruningtypes.PruningOptions
 	switch strategy {
 	case pruningtypes.PruningOptionDefault, pruningtypes.PruningOptionNothing, pruningtypes.PruningOptionEverything:
-		return pruningtypes.NewPruningOptionsFromString(strategy), nil
+		opts = pruningtypes.NewPruningOptionsFromString(strategy)
 
 	case pruningtypes.PruningOptionCustom:
-		opts := pruningtypes.NewCustomPruningOptions(
+		opts = pruningtypes.NewCustomPruningOptions(
 			cast.ToUint64(appOpts.Get(FlagPruningKeepRecent)),
 			cast.ToUint64(appOpts.Get(FlagPruningInterval)),
 		)
@@ -31,9 +33,60 @@ func GetPruningOptionsFromFlags(appOpts types.AppOptions) (pruningtypes.PruningO
 			return opts, fmt.Errorf("invalid custom pruning options: %w", err)
 		}
 
-		return opts, nil
-
 	default:
 		return pruningtypes.PruningOptions{}, fmt.Errorf("unknown pruning strategy %s", strategy)
 	}
+
+	if err := setPruningRetentionFromFlags(appOpts, &opts); err != nil {
+		return opts, err
+	}
+
+	return opts, nil
+}
+
+// setPruningRetentionFromFlags sets the time-based retention and the pruning overrides
+// of the stores of the pruning options.
+func setPruningRetentionFromFlags(appOpts types.AppOptions, opts *pruningtypes.PruningOptions) error {
+	// an unset keep duration disables the time-based retention
+	if v := appOpts.Get(FlagPruningKeepDuration); v != nil {
+		keepDuration, err := cast.ToDurationE(v)
+		if err != nil {
+			return fmt.Errorf("invalid %s: %w", FlagPruningKeepDuration, err)
+		}
+		opts.KeepDuration = keepDuration
+	}
+
+	for _, override := range cast.ToStringSlice(appOpts.Get(FlagPruningOverrides)) {
+		storeKey, value, ok := strings.Cut(override, "=")
+		if !ok || storeKey == "" {
+			return fmt.Errorf("invalid %s %q, expected <store-key>=<pruning>", FlagPruningOverrides, override)
+		}
+
+		var storeOpts pruningtypes.PruningOptions
+		switch value = strings.ToLower(value); value {
+		case pruningtypes.PruningOptionDefault, pruningtypes.PruningOptionNothing, pruningtypes.PruningOptionEverything:
+			storeOpts = pruningtypes.NewPruningOptionsFromString(value)
+
+		default:
+			keepRecent, err := strconv.ParseUint(value, 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid pruning %q of store %s, expected a pruning strategy or a number of recent heights to keep", value, storeKey)
+			}
+			storeOpts = pruningtypes.NewCustomPruningOptions(keepRecent, 0)
+		}
+
+		if opts.StoreOptions == nil {
+			opts.StoreOptions = make(map[string]pruningtypes.PruningOptions)
+		}
+		opts.StoreOptions[storeKey] = storeOpts
+	}
+
+	if opts.GetPruningStrategy() == pruningtypes.PruningNothing {
+		return nil
+	}
+	if err := opts.Validate(); err != nil {
+		return fmt.Errorf("invalid pruning options: %w", err)
+	}
+
+	return nil
 }
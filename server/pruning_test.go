@@ -2,6 +2,7 @@ package server
 
 import (
 	"testing"
+	"time"
 
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
@@ -37,6 +38,51 @@ func TestGetPruningOptionsFromFlags(t *testing.T) {
 			},
 			expectedOptions: pruningtypes.NewCustomPruningOptions(1234, 10),
 		},
+		{
+			name: "time-based retention and store overrides",
+			initParams: func() *viper.Viper {
+				v := viper.New()
+				v.Set(FlagPruning, pruningtypes.PruningOptionCustom)
+				v.Set(FlagPruningKeepRecent, 1234)
+				v.Set(FlagPruningInterval, 10)
+				v.Set(FlagPruningKeepDuration, "720h")
+				v.Set(FlagPruningOverrides, []string{"gov=nothing", "staking=100000"})
+
+				return v
+			},
+			expectedOptions: pruningtypes.PruningOptions{
+				KeepRecent:   1234,
+				Interval:     10,
+				Strategy:     pruningtypes.PruningCustom,
+				KeepDuration: 720 * time.Hour,
+				StoreOptions: map[string]pruningtypes.PruningOptions{
+					"gov":     pruningtypes.NewPruningOptions(pruningtypes.PruningNothing),
+					"staking": pruningtypes.NewCustomPruningOptions(100000, 0),
+				},
+			},
+		},
+		{
+			name: "invalid store override",
+			initParams: func() *viper.Viper {
+				v := viper.New()
+				v.Set(FlagPruning, pruningtypes.PruningOptionDefault)
+				v.Set(FlagPruningOverrides, []string{"gov"})
+
+				return v
+			},
+			wantErr: true,
+		},
+		{
+			name: "store override keeping too few heights",
+			initParams: func() *viper.Viper {
+				v := viper.New()
+				v.Set(FlagPruning, pruningtypes.PruningOptionDefault)
+				v.Set(FlagPruningOverrides, []string{"gov=1"})
+
+				return v
+			},
+			wantErr: true,
+		},
 		{
 			name: pruningtypes.PruningOptionDefault,
 			initParams: func() *viper.Viper {
@@ -62,6 +108,7 @@ func TestGetPruningOptionsFromFlags(t *testing.T) {
 				return
 			}
 
+			require.NoError(t, err)
 			require.Equal(t, tt.expectedOptions, opts)
 		})
 	}
@@ -0,0 +1,61 @@
+package grpc
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// GRPCBlockHeightHeader is the gRPC header for block height.
+	GRPCBlockHeightHeader = "x-cosmos-block-height"
+	// GRPCBlockTimeHeader is the gRPC header for block time, in RFC 3339 format.
+	// It is resolved to the last block height committed at or before that time.
+	GRPCBlockTimeHeader = "x-cosmos-block-time"
+)
+
+// VersionAtTimeResolver resolves a block time to the last version committed at or
+// before it, e.g. the RootStore of store/v2.
+type VersionAtTimeResolver interface {
+	GetVersionAtTime(t time.Time) (uint64, error)
+}
+
+// blockTimeInterceptor returns a unary interceptor resolving the block time
+// header of a query to the block height header, the query handlers returning
+// the height queried in the response header.
+func blockTimeInterceptor(resolver VersionAtTimeResolver) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		md, ok := metadata.FromIncomingContext(ctx)
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		timeHeaders := md.Get(GRPCBlockTimeHeader)
+		if len(timeHeaders) == 0 {
+			return handler(ctx, req)
+		}
+		if len(timeHeaders) > 1 || len(md.Get(GRPCBlockHeightHeader)) > 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "expected a single %s header and no %s header", GRPCBlockTimeHeader, GRPCBlockHeightHeader)
+		}
+
+		t, err := time.Parse(time.RFC3339Nano, timeHeaders[0])
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid %s header %q: %v", GRPCBlockTimeHeader, timeHeaders[0], err)
+		}
+
+		version, err := resolver.GetVersionAtTime(t)
+		if err != nil {
+			return nil, status.Errorf(codes.NotFound, "failed to get block height at time %s: %v", timeHeaders[0], err)
+		}
+
+		md = md.Copy()
+		md.Set(GRPCBlockHeightHeader, strconv.FormatUint(version, 10))
+
+		return handler(metadata.NewIncomingContext(ctx, md), req)
+	}
+}
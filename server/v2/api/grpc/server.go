@@ -43,11 +43,18 @@ func New(logger log.Logger, v *viper.Viper, interfaceRegistry appmanager.Interfa
 		}
 	}
 
-	grpcSrv := grpc.NewServer(
+	opts := []grpc.ServerOption{
 		grpc.ForceServerCodec(newProtoCodec(interfaceRegistry).GRPCCodec()),
 		grpc.MaxSendMsgSize(cfg.MaxSendMsgSize),
 		grpc.MaxRecvMsgSize(cfg.MaxRecvMsgSize),
-	)
+	}
+
+	// queries by block time are supported if the app can resolve block times to heights.
+	if resolver, ok := app.(VersionAtTimeResolver); ok {
+		opts = append(opts, grpc.ChainUnaryInterceptor(blockTimeInterceptor(resolver)))
+	}
+
+	grpcSrv := grpc.NewServer(opts...)
 
 	app.RegisterGRPCServer(grpcSrv)
 
@@ -16,6 +16,8 @@ import (
 const (
 	// GRPCBlockHeightHeader is the gRPC header for block height.
 	GRPCBlockHeightHeader = "x-cosmos-block-height"
+	// GRPCBlockTimeHeader is the gRPC header for block time, in RFC 3339 format.
+	GRPCBlockTimeHeader = "x-cosmos-block-time"
 )
 
 type Server struct {
@@ -63,6 +65,9 @@ func CustomGRPCHeaderMatcher(key string) (string, bool) {
 	case GRPCBlockHeightHeader:
 		return GRPCBlockHeightHeader, true
 
+	case GRPCBlockTimeHeader:
+		return GRPCBlockTimeHeader, true
+
 	default:
 		return runtime.DefaultHeaderMatcher(key)
 	}
@@ -11,6 +11,7 @@ import (
 	coreappmgr "cosmossdk.io/core/app"
 	"cosmossdk.io/core/comet"
 	"cosmossdk.io/core/event"
+	"cosmossdk.io/core/header"
 	"cosmossdk.io/core/log"
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/core/transaction"
@@ -453,6 +454,13 @@ func (c *Consensus[T]) FinalizeBlock(
 	if err != nil {
 		return nil, err
 	}
+	c.store.SetCommitHeader(&header.Info{
+		Height:  req.Height,
+		Hash:    req.Hash,
+		Time:    req.Time,
+		AppHash: cid.Hash,
+		ChainID: c.chainID,
+	})
 	appHash, err := c.store.Commit(&store.Changeset{Changes: stateChanges})
 	if err != nil {
 		return nil, fmt.Errorf("unable to commit the changeset: %w", err)
@@ -1,6 +1,7 @@
 package types
 
 import (
+	coreheader "cosmossdk.io/core/header"
 	"cosmossdk.io/core/store"
 	storev2 "cosmossdk.io/store/v2"
 	"cosmossdk.io/store/v2/proof"
@@ -14,6 +15,10 @@ type Store interface {
 	// associated with it.
 	StateLatest() (uint64, store.ReaderMap, error)
 
+	// SetCommitHeader sets the header of the block of the next commit, to index
+	// its time for queries by block time.
+	SetCommitHeader(h *coreheader.Info)
+
 	// Commit commits the provided changeset and returns
 	// the new state root of the state.
 	Commit(*store.Changeset) (store.Hash, error)
@@ -0,0 +1,61 @@
+package store
+
+import (
+	"encoding/hex"
+	"encoding/json"
+
+	"cosmossdk.io/collections"
+)
+
+// decodedEntry is a diff.Entry decoded with the collections.StateDecoder of the
+// app. The key and values which can't be decoded are hex encoded.
+type decodedEntry struct {
+	Collection string          `json:"collection,omitempty"`
+	Key        json.RawMessage `json:"key"`
+	ValueA     json.RawMessage `json:"value_a"`
+	ValueB     json.RawMessage `json:"value_b"`
+}
+
+// decodeEntry decodes the key and values of a store key with the decoder.
+func decodeEntry(decoder collections.StateDecoder, storeKey string, key, valueA, valueB []byte) decodedEntry {
+	entry := decodedEntry{
+		Key:    hexJSON(key),
+		ValueA: hexJSON(valueA),
+		ValueB: hexJSON(valueB),
+	}
+
+	kv, err := decoder.DecodeKV(storeKey, key, nil)
+	if err != nil {
+		return entry
+	}
+	entry.Collection = kv.Collection
+	if bz, err := kv.KeyJSON(); err == nil {
+		entry.Key = bz
+	}
+
+	for _, v := range []struct {
+		raw     []byte
+		decoded *json.RawMessage
+	}{{valueA, &entry.ValueA}, {valueB, &entry.ValueB}} {
+		if v.raw == nil {
+			continue
+		}
+		if kv, err := decoder.DecodeKV(storeKey, key, v.raw); err == nil {
+			if bz, err := kv.ValueJSON(); err == nil {
+				*v.decoded = bz
+			}
+		}
+	}
+
+	return entry
+}
+
+// hexJSON returns the JSON string of the hex encoded bytes, or null if nil.
+func hexJSON(bz []byte) json.RawMessage {
+	if bz == nil {
+		return json.RawMessage("null")
+	}
+
+	out, _ := json.Marshal(hex.EncodeToString(bz))
+	return out
+}
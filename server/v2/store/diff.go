@@ -0,0 +1,186 @@
+// Package store defines the commands of the store/v2 RootStore of a node.
+package store
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/collections"
+	serverv2 "cosmossdk.io/server/v2"
+	storev2 "cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/diff"
+)
+
+const (
+	FlagHome       = "home"
+	FlagOtherHome  = "other-home"
+	FlagStoreKeys  = "store-keys"
+	FlagLimit      = "limit"
+	FlagCompareAll = "compare-all"
+	FlagOutput     = "output"
+)
+
+// ssOnlyNote is printed with the diffs, as the keys of the state commitment are
+// not compared.
+const ssOnlyNote = "note: only the keys of the state storage are compared, the state commitment only by commit hash"
+
+// RootStoreOpener opens the RootStore of the node of the given home directory.
+type RootStoreOpener func(home string) (storev2.RootStore, error)
+
+// storeDiff is the JSON output of a diff.StoreDiff.
+type storeDiff struct {
+	StoreKey string         `json:"store_key"`
+	HashA    string         `json:"hash_a"`
+	HashB    string         `json:"hash_b"`
+	Entries  []decodedEntry `json:"entries"`
+}
+
+// StateDiffCmd returns a command comparing two versions of the RootStore of a
+// node, or the RootStores of two nodes at the same version, e.g. to find the
+// state behind diverging app hashes. The keys and values of the store keys with
+// a schema are decoded with the decoder. Only the keys of the state storage are
+// compared. The app registers it with the store server module, see New, or with
+// the RootStoreOpener of its store options, see NewRootStoreOpener.
+func StateDiffCmd(openRootStore RootStoreOpener, decoder collections.StateDecoder) *cobra.Command {
+	return stateDiffCmd("", openRootStore, decoder)
+}
+
+// stateDiffCmd returns the StateDiffCmd whose --home defaults to the given home.
+func stateDiffCmd(home string, openRootStore RootStoreOpener, decoder collections.StateDecoder) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "state-diff <version-a> [version-b]",
+		Short: "Compare the state of two versions of the store, or of the stores of two nodes",
+		Long: `Compare the state of two versions of the store of the node, or the state of the
+stores of the node and of the node of --other-home at the same version, or at the
+given versions.
+
+The stores are compared store key by store key, skipping the store keys with the same
+commit hash unless --compare-all is set. The keys and values are decoded with the
+collections schema of their store key when possible, or hex encoded.
+
+Only the keys of the state storage (SS) are compared. The state commitment (SC) is
+compared by the commit hash of each store key only, its leaves are not read: a store
+key with divergent hashes and no divergent key in the state storage points to a state
+commitment diverging from the state storage of the node.
+
+By default, the first divergent key of each store is printed. With --output json, the
+full diff of each store is printed, up to --limit keys per store.`,
+		Example: `state-diff 100 101
+state-diff 100 --other-home /path/to/other/node
+state-diff 100 --other-home /path/to/other/node --store-keys bank,staking --output json`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			home, _ := cmd.Flags().GetString(FlagHome)
+			otherHome, _ := cmd.Flags().GetString(FlagOtherHome)
+			storeKeys, _ := cmd.Flags().GetStringSlice(FlagStoreKeys)
+			limit, _ := cmd.Flags().GetInt(FlagLimit)
+			compareAll, _ := cmd.Flags().GetBool(FlagCompareAll)
+			output, _ := cmd.Flags().GetString(FlagOutput)
+
+			versionA, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid version %s: %w", args[0], err)
+			}
+			versionB := versionA
+			if len(args) > 1 {
+				if versionB, err = strconv.ParseUint(args[1], 10, 64); err != nil {
+					return fmt.Errorf("invalid version %s: %w", args[1], err)
+				}
+			} else if otherHome == "" {
+				return errors.New("a second version or --other-home is required")
+			}
+
+			storeA, err := openRootStore(home)
+			if err != nil {
+				return fmt.Errorf("failed to open the store of %s: %w", home, err)
+			}
+			defer storeA.Close()
+
+			storeB := storeA
+			if otherHome != "" {
+				if storeB, err = openRootStore(otherHome); err != nil {
+					return fmt.Errorf("failed to open the store of %s: %w", otherHome, err)
+				}
+				defer storeB.Close()
+			}
+
+			opts := diff.Options{StoreKeys: storeKeys, Limit: limit, CompareAll: compareAll}
+			if output != serverv2.OutputFormatJSON {
+				opts.Limit = 1
+			}
+
+			diffs, err := diff.Compare(
+				diff.Version{Store: storeA, Version: versionA},
+				diff.Version{Store: storeB, Version: versionB},
+				opts,
+			)
+			if err != nil {
+				return err
+			}
+
+			out := make([]storeDiff, len(diffs))
+			for i, d := range diffs {
+				out[i] = storeDiff{
+					StoreKey: d.StoreKey,
+					HashA:    fmt.Sprintf("%X", d.HashA),
+					HashB:    fmt.Sprintf("%X", d.HashB),
+					Entries:  make([]decodedEntry, len(d.Entries)),
+				}
+				for j, e := range d.Entries {
+					out[i].Entries[j] = decodeEntry(decoder, d.StoreKey, e.Key, e.ValueA, e.ValueB)
+				}
+			}
+
+			if output == serverv2.OutputFormatJSON {
+				bz, err := json.MarshalIndent(out, "", "  ")
+				if err != nil {
+					return err
+				}
+				cmd.Println(string(bz))
+				cmd.PrintErrln(ssOnlyNote)
+				return nil
+			}
+
+			printFirstDivergentKeys(cmd, out)
+			cmd.Println(ssOnlyNote)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(FlagHome, home, "The home directory of the node")
+	cmd.Flags().String(FlagOtherHome, "", "The home directory of the other node to compare the store with")
+	cmd.Flags().StringSlice(FlagStoreKeys, nil, "The store keys to compare, all store keys if empty")
+	cmd.Flags().Int(FlagLimit, 0, "The maximum number of keys of the diff of each store with --output json, 0 meaning no limit")
+	cmd.Flags().Bool(FlagCompareAll, false, "Compare the state of the store keys with the same commit hash too")
+	cmd.Flags().StringP(FlagOutput, "o", "text", "Output format (text|json)")
+
+	return cmd
+}
+
+// printFirstDivergentKeys prints the first divergent key of each store diff.
+func printFirstDivergentKeys(cmd *cobra.Command, diffs []storeDiff) {
+	if len(diffs) == 0 {
+		cmd.Println("no difference")
+		return
+	}
+
+	for _, d := range diffs {
+		cmd.Printf("store %s: hash %s vs %s\n", d.StoreKey, d.HashA, d.HashB)
+		if len(d.Entries) == 0 {
+			cmd.Println("  no divergent key in the state storage")
+			continue
+		}
+
+		e := d.Entries[0]
+		if e.Collection != "" {
+			cmd.Printf("  collection: %s\n", e.Collection)
+		}
+		cmd.Printf("  key:        %s\n", e.Key)
+		cmd.Printf("  value a:    %s\n", e.ValueA)
+		cmd.Printf("  value b:    %s\n", e.ValueB)
+	}
+}
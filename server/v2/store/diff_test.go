@@ -0,0 +1,167 @@
+package store
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+	storev2 "cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/commitment"
+	"cosmossdk.io/store/v2/commitment/iavl"
+	dbm "cosmossdk.io/store/v2/db"
+	"cosmossdk.io/store/v2/pruning"
+	"cosmossdk.io/store/v2/root"
+	"cosmossdk.io/store/v2/storage"
+	"cosmossdk.io/store/v2/storage/sqlite"
+)
+
+// nopCloseStore is a RootStore which is not closed by the command, to be reused.
+type nopCloseStore struct {
+	storev2.RootStore
+}
+
+func (nopCloseStore) Close() error { return nil }
+
+func newRootStore(t *testing.T, dir string) storev2.RootStore {
+	t.Helper()
+	nopLog := log.NewNopLogger()
+
+	sqliteDB, err := sqlite.New(dir)
+	require.NoError(t, err)
+	ss := storage.NewStorageStore(sqliteDB, nopLog)
+
+	sc, err := commitment.NewCommitStore(map[string]commitment.Tree{
+		"bank": iavl.NewIavlTree(dbm.NewMemDB(), nopLog, iavl.DefaultConfig()),
+	}, dbm.NewMemDB(), nopLog)
+	require.NoError(t, err)
+
+	rs, err := root.New(nopLog, ss, sc, pruning.NewManager(sc, ss, nil, nil), nil, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, rs.Close()) })
+
+	return rs
+}
+
+func TestStateDiffCmd(t *testing.T) {
+	sb := collections.NewSchemaBuilderFromAccessor(func(context.Context) corestore.KVStore { return nil })
+	collections.NewMap(sb, collections.NewPrefix(2), "balances", collections.StringKey, collections.Uint64Value)
+	schema, err := sb.Build()
+	require.NoError(t, err)
+
+	encode := func(key string, value uint64) corestore.KVPair {
+		keyBz, err := collections.EncodeKeyWithPrefix(collections.NewPrefix(2), collections.StringKey, key)
+		require.NoError(t, err)
+		valueBz, err := collections.Uint64Value.Encode(value)
+		require.NoError(t, err)
+		return corestore.KVPair{Key: keyBz, Value: valueBz}
+	}
+
+	stores := make(map[string]storev2.RootStore)
+	openRootStore := func(home string) (storev2.RootStore, error) { return nopCloseStore{stores[home]}, nil }
+
+	stores["a"] = newRootStore(t, t.TempDir())
+	_, err = stores["a"].Commit(corestore.NewChangesetWithPairs(map[string]corestore.KVPairs{
+		"bank": {encode("alice", 10), encode("bob", 20)},
+	}))
+	require.NoError(t, err)
+
+	stores["b"] = newRootStore(t, t.TempDir())
+	_, err = stores["b"].Commit(corestore.NewChangesetWithPairs(map[string]corestore.KVPairs{
+		"bank": {encode("alice", 10), encode("bob", 30)},
+	}))
+	require.NoError(t, err)
+
+	run := func(args ...string) string {
+		cmd := StateDiffCmd(openRootStore, collections.StateDecoder{"bank": schema})
+		out := &bytes.Buffer{}
+		cmd.SetOut(out)
+		cmd.SetArgs(args)
+		require.NoError(t, cmd.Execute())
+		return out.String()
+	}
+
+	// the first divergent key of each store
+	out := run("1", "--home", "a", "--other-home", "b")
+	require.Contains(t, out, "store bank")
+	require.Contains(t, out, "collection: balances")
+	require.Contains(t, out, `key:        "bob"`)
+	require.Contains(t, out, `value a:    "20"`)
+	require.Contains(t, out, `value b:    "30"`)
+	require.Contains(t, out, ssOnlyNote)
+
+	// the full diffs in JSON
+	var diffs []storeDiff
+	require.NoError(t, json.Unmarshal([]byte(run("1", "--home", "a", "--other-home", "b", "--output", "json")), &diffs))
+	require.Len(t, diffs, 1)
+	require.Equal(t, "bank", diffs[0].StoreKey)
+	require.Equal(t, []decodedEntry{{
+		Collection: "balances",
+		Key:        json.RawMessage(`"bob"`),
+		ValueA:     json.RawMessage(`"20"`),
+		ValueB:     json.RawMessage(`"30"`),
+	}}, diffs[0].Entries)
+
+	// a version of the store against itself
+	require.Contains(t, run("1", "1", "--home", "a"), "no difference")
+}
+
+func newStoreOptions() root.FactoryOptions {
+	return root.FactoryOptions{
+		Logger:      log.NewNopLogger(),
+		SSType:      root.SSTypeSQLite,
+		SCType:      root.SCTypeIavl,
+		IavlConfig:  iavl.DefaultConfig(),
+		StoreKeys:   []string{"bank"},
+		SCRawDBType: dbm.DBTypeGoLevelDB,
+		SCRawDBName: "application",
+	}
+}
+
+// newNodeHomes returns two node homes whose stores diverge at version 1.
+func newNodeHomes(t *testing.T, openRootStore RootStoreOpener) (homeA, homeB string) {
+	t.Helper()
+
+	homeA, homeB = t.TempDir(), t.TempDir()
+	for home, value := range map[string]string{homeA: "20", homeB: "30"} {
+		rs, err := openRootStore(home)
+		require.NoError(t, err)
+		_, err = rs.Commit(corestore.NewChangesetWithPairs(map[string]corestore.KVPairs{
+			"bank": {{Key: []byte("alice"), Value: []byte("10")}, {Key: []byte("bob"), Value: []byte(value)}},
+		}))
+		require.NoError(t, err)
+		require.NoError(t, rs.Close())
+	}
+
+	return homeA, homeB
+}
+
+func TestNewRootStoreOpener(t *testing.T) {
+	// the database of the state commitment must be configured
+	opts := newStoreOptions()
+	opts.SCRawDBName = ""
+	_, err := NewRootStoreOpener(opts)(t.TempDir())
+	require.ErrorContains(t, err, "the store options define no SCRawDBName")
+
+	// the SCRawDB of the running app is ignored
+	opts = newStoreOptions()
+	opts.SCRawDB = dbm.NewMemDB()
+	openRootStore := NewRootStoreOpener(opts)
+	homeA, homeB := newNodeHomes(t, openRootStore)
+	require.DirExists(t, filepath.Join(homeA, "data", "application.db"))
+
+	// the stores are reopened by the command
+	cmd := StateDiffCmd(openRootStore, collections.StateDecoder{})
+	out := &bytes.Buffer{}
+	cmd.SetOut(out)
+	cmd.SetArgs([]string{"1", "--home", homeA, "--other-home", homeB})
+	require.NoError(t, cmd.Execute())
+	require.Contains(t, out.String(), "store bank")
+	require.Contains(t, out.String(), "key:        \"626f62\"")
+}
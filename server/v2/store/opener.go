@@ -0,0 +1,28 @@
+package store
+
+import (
+	"errors"
+
+	storev2 "cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/root"
+)
+
+// NewRootStoreOpener returns a RootStoreOpener creating the RootStore of a node
+// home with root.CreateRootStore and the store options of the app runtime/v2 is
+// configured with, e.g. its store keys and the store types of its configuration.
+// The RootDir of the options is replaced with the home, and the database of the
+// state commitment is opened in its data directory with the SCRawDBType and
+// SCRawDBName of the options, the SCRawDB of the running app being ignored.
+func NewRootStoreOpener(opts root.FactoryOptions) RootStoreOpener {
+	return func(home string) (storev2.RootStore, error) {
+		if opts.SCRawDBName == "" {
+			return nil, errors.New("the store options define no SCRawDBName to open the state commitment of a node home with")
+		}
+
+		opts := opts
+		opts.RootDir = home
+		opts.SCRawDB = nil
+
+		return root.CreateRootStore(&opts)
+	}
+}
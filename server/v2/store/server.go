@@ -0,0 +1,57 @@
+package store
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/collections"
+	serverv2 "cosmossdk.io/server/v2"
+	"cosmossdk.io/store/v2/root"
+)
+
+const serverName = "store"
+
+var (
+	_ serverv2.ServerModule   = (*Server)(nil)
+	_ serverv2.HasCLICommands = (*Server)(nil)
+)
+
+// Server is the server module of the RootStore of the node, registering the store
+// commands in the server/v2 command set. It has nothing to start, the RootStore
+// being owned by the app.
+type Server struct {
+	home          string
+	openRootStore RootStoreOpener
+	decoder       collections.StateDecoder
+}
+
+// New returns the store server module of the node home. The RootStores are opened
+// with the store options of the app, see NewRootStoreOpener, and their keys and
+// values decoded with the decoder of the app.
+func New(home string, opts root.FactoryOptions, decoder collections.StateDecoder) *Server {
+	return &Server{
+		home:          home,
+		openRootStore: NewRootStoreOpener(opts),
+		decoder:       decoder,
+	}
+}
+
+func (s *Server) Name() string {
+	return serverName
+}
+
+func (s *Server) Start(context.Context) error {
+	return nil
+}
+
+func (s *Server) Stop(context.Context) error {
+	return nil
+}
+
+// CLICommands returns the store commands, whose --home defaults to the node home.
+func (s *Server) CLICommands() serverv2.CLIConfig {
+	return serverv2.CLIConfig{
+		Commands: []*cobra.Command{stateDiffCmd(s.home, s.openRootStore, s.decoder)},
+	}
+}
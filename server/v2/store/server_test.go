@@ -0,0 +1,35 @@
+package store
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/log"
+	serverv2 "cosmossdk.io/server/v2"
+)
+
+func TestServerCLICommands(t *testing.T) {
+	opts := newStoreOptions()
+	homeA, homeB := newNodeHomes(t, NewRootStoreOpener(opts))
+
+	// the state-diff command is registered in the command set of the server
+	server := serverv2.NewServer(log.NewNopLogger(), New(homeA, opts, collections.StateDecoder{}))
+	var cmd *cobra.Command
+	for _, c := range server.CLICommands().Commands {
+		if c.Name() == "state-diff" {
+			cmd = c
+		}
+	}
+	require.NotNil(t, cmd)
+
+	// --home defaults to the node home
+	out := &bytes.Buffer{}
+	cmd.SetOut(out)
+	cmd.SetArgs([]string{"1", "--other-home", homeB})
+	require.NoError(t, cmd.Execute())
+	require.Contains(t, out.String(), "store bank")
+}
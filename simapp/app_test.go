@@ -232,6 +232,12 @@ func TestInitGenesisOnMigration(t *testing.T) {
 	app := NewSimApp(log.NewTestLogger(t), db, nil, true, simtestutil.NewAppOptionsWithFlagHome(t.TempDir()))
 	ctx := app.NewContextLegacy(true, cmtproto.Header{Height: app.LastBlockHeight()})
 
+	// Record the migration of each module.
+	results := map[string]module.MigrationResult{}
+	ctx = module.WithMigrationListener(ctx, func(result module.MigrationResult) {
+		results[result.Module] = result
+	})
+
 	// Create a mock module. This module will serve as the new module we're
 	// adding during a migration.
 	mockCtrl := gomock.NewController(t)
@@ -264,6 +270,11 @@ func TestInitGenesisOnMigration(t *testing.T) {
 		},
 	)
 	require.NoError(t, err)
+
+	require.True(t, results["mock"].InitGenesis)
+	require.NoError(t, results["mock"].Err)
+	require.False(t, results["bank"].InitGenesis)
+	require.Equal(t, bank.AppModule{}.ConsensusVersion(), results["bank"].ToVersion)
 }
 
 func TestUpgradeStateOnGenesis(t *testing.T) {
@@ -15,6 +15,8 @@ import (
 	confixcmd "cosmossdk.io/tools/confix/cmd"
 	authcmd "cosmossdk.io/x/auth/client/cli"
 	banktypes "cosmossdk.io/x/bank/types"
+	upgradecli "cosmossdk.io/x/upgrade/client/cli"
+	upgradekeeper "cosmossdk.io/x/upgrade/keeper"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/debug"
@@ -38,13 +40,17 @@ func initRootCmd(
 	cfg := sdk.GetConfig()
 	cfg.Seal()
 
+	debugCmd := debug.Cmd()
+	debugCmd.AddCommand(debug.DecodeKVCmd(moduleManager.StateDecoder()))
+
 	rootCmd.AddCommand(
 		genutilcli.InitCmd(moduleManager),
 		NewTestnetCmd(moduleManager, banktypes.GenesisBalancesIterator{}),
-		debug.Cmd(),
+		debugCmd,
 		confixcmd.ConfigCommand(),
 		pruning.Cmd(newApp),
 		snapshot.Cmd(newApp),
+		upgradecli.NewRehearseUpgradeCmd(newApp, upgradeModules),
 	)
 
 	server.AddCommands(rootCmd, newApp, server.StartCmdOptions[servertypes.Application]{})
@@ -111,6 +117,7 @@ func txCommand() *cobra.Command {
 		authcmd.GetEncodeCommand(),
 		authcmd.GetDecodeCommand(),
 		authcmd.GetSimulateCmd(),
+		authcmd.GetSendBatchCommand(),
 	)
 
 	return cmd
@@ -131,6 +138,12 @@ func newApp(
 	)
 }
 
+// upgradeModules returns the upgrade keeper and the module manager of the application, used to rehearse upgrades.
+func upgradeModules(app servertypes.Application) (*upgradekeeper.Keeper, *module.Manager) {
+	simApp := app.(*simapp.SimApp)
+	return simApp.UpgradeKeeper, simApp.ModuleManager
+}
+
 // appExport creates a new simapp (optionally at a given height) and exports state.
 func appExport(
 	logger log.Logger,
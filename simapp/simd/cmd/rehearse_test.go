@@ -0,0 +1,150 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/log"
+	"cosmossdk.io/simapp"
+	pruningtypes "cosmossdk.io/store/pruning/types"
+	upgradecli "cosmossdk.io/x/upgrade/client/cli"
+	upgradekeeper "cosmossdk.io/x/upgrade/keeper"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+func TestRehearseUpgradeCmd(t *testing.T) {
+	const chainID = "rehearsal-chain"
+	home := t.TempDir()
+	dataDir := filepath.Join(home, "data")
+
+	// commit a block in the application database of the node
+	db, err := dbm.NewGoLevelDB("application", dataDir, nil)
+	require.NoError(t, err)
+	app := simapp.NewSimApp(log.NewNopLogger(), db, nil, true, simtestutil.NewAppOptionsWithFlagHome(home), baseapp.SetChainID(chainID))
+	stateBytes, err := json.Marshal(simapp.GenesisStateWithSingleValidator(t, app))
+	require.NoError(t, err)
+	_, err = app.InitChain(&abci.InitChainRequest{
+		ChainId:         chainID,
+		ConsensusParams: simtestutil.DefaultConsensusParams,
+		AppStateBytes:   stateBytes,
+	})
+	require.NoError(t, err)
+	_, err = app.FinalizeBlock(&abci.FinalizeBlockRequest{Height: 1})
+	require.NoError(t, err)
+	_, err = app.Commit()
+	require.NoError(t, err)
+	require.NoError(t, app.Close())
+
+	applicationDB := readDir(t, filepath.Join(dataDir, "application.db"))
+
+	testCases := []struct {
+		name      string
+		upgrade   string
+		handler   upgradetypes.UpgradeHandler
+		expErr    string
+		expReport bool
+	}{
+		{
+			name:    "no upgrade handler",
+			upgrade: "unknown",
+			expErr:  "no upgrade handler registered for unknown",
+		},
+		{
+			name:    "upgrade succeeds",
+			upgrade: "rehearsal",
+			handler: func(ctx context.Context, _ upgradetypes.Plan, fromVM appmodule.VersionMap) (appmodule.VersionMap, error) {
+				return fromVM, nil
+			},
+			expReport: true,
+		},
+		{
+			name:    "upgrade handler fails",
+			upgrade: "rehearsal",
+			handler: func(context.Context, upgradetypes.Plan, appmodule.VersionMap) (appmodule.VersionMap, error) {
+				return nil, errors.New("migration failed")
+			},
+			expErr:    "rehearsal of upgrade rehearsal failed",
+			expReport: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			modules := func(app servertypes.Application) (*upgradekeeper.Keeper, *module.Manager) {
+				upgradeKeeper, moduleManager := upgradeModules(app)
+				if tc.handler != nil {
+					upgradeKeeper.SetUpgradeHandler(tc.upgrade, tc.handler)
+				}
+				return upgradeKeeper, moduleManager
+			}
+
+			v := viper.New()
+			v.Set(flags.FlagChainID, chainID)
+			v.Set(server.FlagPruning, pruningtypes.PruningOptionNothing)
+			cfg := cmtcfg.DefaultConfig()
+			cfg.SetRoot(home)
+			serverCtx := server.NewContext(v, cfg, log.NewNopLogger())
+
+			cmd := upgradecli.NewRehearseUpgradeCmd(newApp, modules)
+			require.NoError(t, server.SetCmdServerContext(cmd, serverCtx))
+			out := &bytes.Buffer{}
+			cmd.SetOut(out)
+			cmd.SetArgs([]string{tc.upgrade, "--tmp-dir", t.TempDir(), "--output", "json"})
+
+			err := cmd.Execute()
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+
+			if tc.expReport {
+				var report upgradecli.RehearsalReport
+				require.NoError(t, json.Unmarshal(out.Bytes(), &report))
+				require.Equal(t, tc.upgrade, report.Name)
+				require.Equal(t, int64(2), report.Height)
+				require.Equal(t, tc.expErr == "", report.Success())
+			}
+
+			// the data directory of the node is left untouched
+			require.Equal(t, applicationDB, readDir(t, filepath.Join(dataDir, "application.db")))
+			_, err = os.Stat(filepath.Join(dataDir, upgradetypes.UpgradeInfoFilename))
+			require.True(t, os.IsNotExist(err))
+		})
+	}
+}
+
+// readDir returns the content of the files of a directory.
+func readDir(t *testing.T, dir string) map[string][]byte {
+	t.Helper()
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+
+	files := make(map[string][]byte, len(entries))
+	for _, entry := range entries {
+		bz, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		require.NoError(t, err)
+		files[entry.Name()] = bz
+	}
+	return files
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"sort"
 	"sync"
+	"time"
 
 	dbm "github.com/cosmos/cosmos-db"
 
@@ -20,6 +21,9 @@ type Manager struct {
 	logger           log.Logger
 	opts             types.PruningOptions
 	snapshotInterval uint64
+	// heightTime returns the block time of a height, used by the KeepDuration of the
+	// pruning options.
+	heightTime func(height int64) (time.Time, error)
 	// Snapshots are taken in a separate goroutine from the regular execution
 	// and can be delivered asynchrounously via HandleSnapshotHeight.
 	// Therefore, we sync access to pruneSnapshotHeights with this mutex.
@@ -99,6 +103,13 @@ func (m *Manager) SetSnapshotInterval(snapshotInterval uint64) {
 	m.snapshotInterval = snapshotInterval
 }
 
+// SetHeightTimeFunc sets the function returning the block time of a height. It is
+// required by the KeepDuration of the pruning options, without which no height is
+// pruned.
+func (m *Manager) SetHeightTimeFunc(heightTime func(height int64) (time.Time, error)) {
+	m.heightTime = heightTime
+}
+
 // GetPruningHeight returns the height which can prune up to if it is able to prune at the given height.
 func (m *Manager) GetPruningHeight(height int64) int64 {
 	if m.opts.GetPruningStrategy() == types.PruningNothing {
@@ -112,9 +123,38 @@ func (m *Manager) GetPruningHeight(height int64) int64 {
 		return 0
 	}
 
-	// Consider the snapshot height
-	pruneHeight := height - 1 - int64(m.opts.KeepRecent) // we should keep the current height at least
+	return m.getPruningHeight(height, m.opts)
+}
+
+// GetStorePruningHeight returns the height which the store of the given store key can
+// prune up to, when the other stores prune up to pruningHeight at the given height. It
+// only differs from pruningHeight if the pruning of the store is overridden.
+func (m *Manager) GetStorePruningHeight(storeKey string, height, pruningHeight int64) int64 {
+	opts, ok := m.opts.StoreOptions[storeKey]
+	if !ok {
+		return pruningHeight
+	}
+	if pruningHeight <= 0 || opts.GetPruningStrategy() == types.PruningNothing || height <= int64(opts.KeepRecent) {
+		return 0
+	}
+
+	return m.getPruningHeight(height, opts)
+}
+
+// getPruningHeight returns the height which can prune up to at the given height with
+// the given options, keeping the heights required by the options and the snapshots.
+func (m *Manager) getPruningHeight(height int64, opts types.PruningOptions) int64 {
+	pruneHeight := height - 1 - int64(opts.KeepRecent) // we should keep the current height at least
 
+	// Consider the block times
+	if opts.KeepDuration > 0 {
+		pruneHeight = min(pruneHeight, m.getKeepDurationPruningHeight(height, opts.KeepDuration))
+		if pruneHeight <= 0 {
+			return 0
+		}
+	}
+
+	// Consider the snapshot height
 	m.pruneSnapshotHeightsMx.RLock()
 	defer m.pruneSnapshotHeightsMx.RUnlock()
 
@@ -136,6 +176,30 @@ func (m *Manager) GetPruningHeight(height int64) int64 {
 	return pruneHeight
 }
 
+// getKeepDurationPruningHeight returns the height which can prune up to at the given
+// height to keep the given duration of history, that is up to the height before the
+// last one committed at or before the block time of the given height minus the
+// duration. The heights of unknown block time are considered as old.
+func (m *Manager) getKeepDurationPruningHeight(height int64, keepDuration time.Duration) int64 {
+	if m.heightTime == nil {
+		return 0
+	}
+	blockTime, err := m.heightTime(height)
+	if err != nil || blockTime.IsZero() {
+		m.logger.Debug("failed to get block time of the height to prune at", "height", height, "err", err)
+		return 0
+	}
+
+	cutoff := blockTime.Add(-keepDuration)
+	// the heights [1, lastOld] are committed at or before the cutoff
+	lastOld := sort.Search(int(height), func(i int) bool {
+		t, err := m.heightTime(int64(i) + 1)
+		return err == nil && t.After(cutoff)
+	})
+
+	return int64(lastOld) - 1
+}
+
 // LoadSnapshotHeights loads the snapshot heights from the database as a crash recovery.
 func (m *Manager) LoadSnapshotHeights(db dbm.DB) error {
 	if m.opts.GetPruningStrategy() == types.PruningNothing {
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	db "github.com/cosmos/cosmos-db"
 	"github.com/golang/mock/gomock"
@@ -196,6 +197,76 @@ func TestPruningHeight_Inputs(t *testing.T) {
 	}
 }
 
+func TestPruningHeight_KeepDuration(t *testing.T) {
+	genesis := time.Unix(1_700_000_000, 0)
+	// the heights are committed every second, except the unknown heights below 10
+	heightTime := func(height int64) (time.Time, error) {
+		if height < 10 {
+			return time.Time{}, errors.New("no commit info found")
+		}
+		return genesis.Add(time.Duration(height) * time.Second), nil
+	}
+
+	knownHeightTime := func(height int64) (time.Time, error) {
+		return genesis.Add(time.Duration(height) * time.Second), nil
+	}
+
+	testcases := map[string]struct {
+		height         int64
+		keepRecent     uint64
+		keepDuration   time.Duration
+		heightTime     func(int64) (time.Time, error)
+		expectedResult int64
+	}{
+		"keep recent only":                {100, 10, 0, heightTime, 89},
+		"keep duration kept more heights": {100, 10, 30 * time.Second, heightTime, 69},
+		"keep recent kept more heights":   {100, 50, 30 * time.Second, heightTime, 49},
+		"unknown heights are old":         {30, 2, time.Minute, heightTime, 8},
+		"within keep duration":            {30, 2, time.Minute, knownHeightTime, 0},
+		"no block time":                   {100, 10, 30 * time.Second, nil, 0},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			manager := pruning.NewManager(db.NewMemDB(), log.NewNopLogger())
+			opts := types.NewCustomPruningOptions(tc.keepRecent, 10)
+			opts.KeepDuration = tc.keepDuration
+			manager.SetOptions(opts)
+			if tc.heightTime != nil {
+				manager.SetHeightTimeFunc(tc.heightTime)
+			}
+
+			require.Equal(t, tc.expectedResult, manager.GetPruningHeight(tc.height))
+		})
+	}
+}
+
+func TestStorePruningHeight(t *testing.T) {
+	manager := pruning.NewManager(db.NewMemDB(), log.NewNopLogger())
+	opts := types.NewCustomPruningOptions(10, 10)
+	opts.StoreOptions = map[string]types.PruningOptions{
+		"gov":     types.NewPruningOptions(types.PruningNothing),
+		"staking": types.NewCustomPruningOptions(50, 0),
+	}
+	manager.SetOptions(opts)
+	manager.SetSnapshotInterval(20)
+	manager.HandleSnapshotHeight(20)
+
+	pruningHeight := manager.GetPruningHeight(100)
+	require.Equal(t, int64(39), pruningHeight)
+	require.Equal(t, pruningHeight, manager.GetStorePruningHeight("bank", 100, pruningHeight))
+	require.Equal(t, int64(0), manager.GetStorePruningHeight("gov", 100, pruningHeight))
+	// the snapshot heights are kept in every store
+	require.Equal(t, int64(39), manager.GetStorePruningHeight("staking", 100, pruningHeight))
+	require.Equal(t, int64(0), manager.GetStorePruningHeight("staking", 50, 39))
+	// the overrides are only pruned along with the other stores
+	require.Equal(t, int64(0), manager.GetStorePruningHeight("staking", 101, 0))
+
+	manager.HandleSnapshotHeight(40)
+	manager.HandleSnapshotHeight(60)
+	require.Equal(t, int64(49), manager.GetStorePruningHeight("staking", 100, 79))
+}
+
 func TestHandleSnapshotHeight_DbErr_Panic(t *testing.T) {
 	ctrl := gomock.NewController(t)
 
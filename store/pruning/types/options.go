@@ -3,6 +3,7 @@ package types
 import (
 	"errors"
 	"fmt"
+	"time"
 )
 
 // PruningOptions defines the pruning strategy used when determining which
@@ -16,6 +17,16 @@ type PruningOptions struct {
 
 	// Strategy defines the kind of pruning strategy. See below for more information on each.
 	Strategy PruningStrategy
+
+	// KeepDuration defines the minimum duration of history to keep on disk, based on
+	// the block times of the heights. The heights are kept if either KeepRecent or
+	// KeepDuration requires it. If set to 0, only KeepRecent applies.
+	KeepDuration time.Duration
+
+	// StoreOptions overrides the pruning of the stores of the given store keys, e.g.
+	// with the "nothing" strategy to keep the full history of a module. The Interval
+	// of an override is ignored, the store being pruned whenever the other stores are.
+	StoreOptions map[string]PruningOptions
 }
 
 type PruningStrategy int
@@ -53,9 +64,10 @@ const (
 )
 
 var (
-	ErrPruningIntervalZero       = errors.New("'pruning-interval' must not be 0. If you want to disable pruning, select pruning = \"nothing\"")
-	ErrPruningIntervalTooSmall   = fmt.Errorf("'pruning-interval' must not be less than %d. For the most aggressive pruning, select pruning = \"everything\"", pruneEverythingInterval)
-	ErrPruningKeepRecentTooSmall = fmt.Errorf("'pruning-keep-recent' must not be less than %d. For the most aggressive pruning, select pruning = \"everything\"", pruneEverythingKeepRecent)
+	ErrPruningIntervalZero         = errors.New("'pruning-interval' must not be 0. If you want to disable pruning, select pruning = \"nothing\"")
+	ErrPruningIntervalTooSmall     = fmt.Errorf("'pruning-interval' must not be less than %d. For the most aggressive pruning, select pruning = \"everything\"", pruneEverythingInterval)
+	ErrPruningKeepRecentTooSmall   = fmt.Errorf("'pruning-keep-recent' must not be less than %d. For the most aggressive pruning, select pruning = \"everything\"", pruneEverythingKeepRecent)
+	ErrPruningKeepDurationNegative = errors.New("'pruning-keep-duration' must not be negative")
 )
 
 func NewPruningOptions(pruningStrategy PruningStrategy) PruningOptions {
@@ -107,12 +119,40 @@ func (po PruningOptions) Validate() error {
 	if po.Interval < pruneEverythingInterval {
 		return ErrPruningIntervalTooSmall
 	}
+	if err := po.validateRetention(); err != nil {
+		return err
+	}
+	for storeKey, opts := range po.StoreOptions {
+		if opts.Strategy == PruningNothing {
+			continue
+		}
+		if err := opts.validateRetention(); err != nil {
+			return fmt.Errorf("invalid pruning options of store %s: %w", storeKey, err)
+		}
+	}
+	return nil
+}
+
+// validateRetention validates the options defining which heights are kept.
+func (po PruningOptions) validateRetention() error {
 	if po.KeepRecent < pruneEverythingKeepRecent {
 		return ErrPruningKeepRecentTooSmall
 	}
+	if po.KeepDuration < 0 {
+		return ErrPruningKeepDurationNegative
+	}
 	return nil
 }
 
+// GetStoreOptions returns the pruning options of the store of the given store key,
+// which are the overriding ones if any.
+func (po PruningOptions) GetStoreOptions(storeKey string) PruningOptions {
+	if opts, ok := po.StoreOptions[storeKey]; ok {
+		return opts
+	}
+	return po
+}
+
 func NewPruningOptionsFromString(strategy string) PruningOptions {
 	switch strategy {
 	case PruningOptionEverything:
@@ -2,6 +2,7 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -21,6 +22,8 @@ func TestPruningOptions_Validate(t *testing.T) {
 		{NewCustomPruningOptions(2, 9), ErrPruningIntervalTooSmall},
 		{NewCustomPruningOptions(2, 0), ErrPruningIntervalZero},
 		{NewCustomPruningOptions(2, 0), ErrPruningIntervalZero},
+		{PruningOptions{KeepRecent: 2, Interval: 10, Strategy: PruningCustom, KeepDuration: time.Hour}, nil},
+		{PruningOptions{KeepRecent: 2, Interval: 10, Strategy: PruningCustom, KeepDuration: -time.Hour}, ErrPruningKeepDurationNegative},
 	}
 
 	for _, tc := range testCases {
@@ -29,6 +32,20 @@ func TestPruningOptions_Validate(t *testing.T) {
 	}
 }
 
+func TestPruningOptions_ValidateStoreOptions(t *testing.T) {
+	opts := NewPruningOptions(PruningDefault)
+	opts.StoreOptions = map[string]PruningOptions{
+		"gov":     NewPruningOptions(PruningNothing),
+		"staking": NewCustomPruningOptions(100, 0),
+	}
+	require.NoError(t, opts.Validate())
+	require.Equal(t, opts.StoreOptions["gov"], opts.GetStoreOptions("gov"))
+	require.Equal(t, opts, opts.GetStoreOptions("bank"))
+
+	opts.StoreOptions["staking"] = NewCustomPruningOptions(1, 0)
+	require.ErrorIs(t, opts.Validate(), ErrPruningKeepRecentTooSmall)
+}
+
 func TestPruningOptions_GetStrategy(t *testing.T) {
 	testCases := []struct {
 		opts             PruningOptions
@@ -9,6 +9,7 @@ import (
 	"sort"
 	"strings"
 	"sync"
+	"time"
 
 	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
 	dbm "github.com/cosmos/cosmos-db"
@@ -86,7 +87,7 @@ var (
 // a store is created, KVStores must be mounted and finally LoadLatestVersion or
 // LoadVersion must be called.
 func NewStore(db dbm.DB, logger log.Logger, metricGatherer metrics.StoreMetrics) *Store {
-	return &Store{
+	rs := &Store{
 		db:                  db,
 		logger:              logger,
 		iavlCacheSize:       iavl.DefaultIAVLCacheSize,
@@ -99,6 +100,9 @@ func NewStore(db dbm.DB, logger log.Logger, metricGatherer metrics.StoreMetrics)
 		pruningManager:      pruning.NewManager(db, logger),
 		metrics:             metricGatherer,
 	}
+	rs.pruningManager.SetHeightTimeFunc(rs.getCommitTime)
+
+	return rs
 }
 
 // GetPruning fetches the pruning strategy from the root store.
@@ -674,7 +678,8 @@ func (rs *Store) handlePruning(version int64) error {
 	return rs.PruneStores(pruneHeight)
 }
 
-// PruneStores prunes all history up to the specific height of the multi store.
+// PruneStores prunes all history up to the specific height of the multi store. The
+// stores whose pruning is overridden are pruned according to their own options.
 func (rs *Store) PruneStores(pruningHeight int64) (err error) {
 	if pruningHeight <= 0 {
 		rs.logger.Debug("pruning skipped, height is less than or equal to 0")
@@ -692,9 +697,14 @@ func (rs *Store) PruneStores(pruningHeight int64) (err error) {
 			continue
 		}
 
+		storePruningHeight := rs.pruningManager.GetStorePruningHeight(key.Name(), rs.lastCommitInfo.GetVersion(), pruningHeight)
+		if storePruningHeight <= 0 {
+			continue
+		}
+
 		store = rs.GetCommitKVStore(key)
 
-		err := store.(*iavl.Store).DeleteVersionsTo(pruningHeight)
+		err := store.(*iavl.Store).DeleteVersionsTo(storePruningHeight)
 		if err == nil {
 			continue
 		}
@@ -1125,6 +1135,21 @@ func (rs *Store) GetCommitInfo(ver int64) (*types.CommitInfo, error) {
 	return cInfo, nil
 }
 
+// getCommitTime returns the block time of the given version, which is the one of
+// the commit header of the last version as its commit info is flushed after pruning.
+func (rs *Store) getCommitTime(ver int64) (time.Time, error) {
+	if rs.lastCommitInfo != nil && ver == rs.lastCommitInfo.Version {
+		return rs.lastCommitInfo.Timestamp, nil
+	}
+
+	cInfo, err := rs.GetCommitInfo(ver)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return cInfo.Timestamp, nil
+}
+
 func (rs *Store) flushMetadata(db dbm.DB, version int64, cInfo *types.CommitInfo) {
 	rs.logger.Debug("flushing metadata", "height", version)
 	batch := db.NewBatch()
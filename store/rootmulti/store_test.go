@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/stretchr/testify/require"
 
@@ -547,6 +548,53 @@ func TestMultiStore_Pruning(t *testing.T) {
 	}
 }
 
+func TestMultiStore_PruningStoreOptions(t *testing.T) {
+	po := pruningtypes.NewPruningOptions(pruningtypes.PruningEverything)
+	po.StoreOptions = map[string]pruningtypes.PruningOptions{
+		testStoreKey1.Name(): pruningtypes.NewPruningOptions(pruningtypes.PruningNothing),
+		testStoreKey2.Name(): pruningtypes.NewCustomPruningOptions(5, 0),
+	}
+
+	ms := newMultiStoreWithMounts(dbm.NewMemDB(), po)
+	require.NoError(t, ms.LoadLatestVersion())
+	for i := int64(0); i < 20; i++ {
+		ms.Commit()
+	}
+
+	// store1 is not pruned, store2 keeps 5 recent heights and store3 2 of them
+	versionExists := func(key types.StoreKey, version int64) bool {
+		return ms.GetCommitKVStore(key).(*iavl.Store).VersionExists(version)
+	}
+	for v := int64(1); v <= 20; v++ {
+		require.True(t, versionExists(testStoreKey1, v), "height %d", v)
+		require.Equal(t, v > 14, versionExists(testStoreKey2, v), "height %d", v)
+		require.Equal(t, v > 17, versionExists(testStoreKey3, v), "height %d", v)
+	}
+}
+
+func TestMultiStore_PruningKeepDuration(t *testing.T) {
+	po := pruningtypes.NewPruningOptions(pruningtypes.PruningEverything)
+	po.KeepDuration = 5 * time.Second
+
+	ms := newMultiStoreWithMounts(dbm.NewMemDB(), po)
+	require.NoError(t, ms.LoadLatestVersion())
+	genesis := time.Unix(1_700_000_000, 0)
+	for i := int64(1); i <= 20; i++ {
+		ms.SetCommitHeader(cmtproto.Header{Height: i, Time: genesis.Add(time.Duration(i) * time.Second)})
+		ms.Commit()
+	}
+
+	// the last height committed 5 seconds before the latest one is kept
+	for v := int64(1); v <= 20; v++ {
+		_, err := ms.CacheMultiStoreWithVersion(v)
+		if v < 15 {
+			require.Error(t, err, "expected error when loading height: %d", v)
+		} else {
+			require.NoError(t, err, "expected no error when loading height: %d", v)
+		}
+	}
+}
+
 func TestMultiStore_Pruning_SameHeightsTwice(t *testing.T) {
 	const (
 		numVersions int64  = 10
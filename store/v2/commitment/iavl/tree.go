@@ -1,6 +1,8 @@
 package iavl
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
 
 	"github.com/cosmos/iavl"
@@ -14,8 +16,9 @@ import (
 )
 
 var (
-	_ commitment.Tree      = (*IavlTree)(nil)
-	_ store.PausablePruner = (*IavlTree)(nil)
+	_ commitment.Tree               = (*IavlTree)(nil)
+	_ commitment.ChangesetTraverser = (*IavlTree)(nil)
+	_ store.PausablePruner          = (*IavlTree)(nil)
 )
 
 // IavlTree is a wrapper around iavl.MutableTree.
@@ -77,6 +80,83 @@ func (t *IavlTree) GetProof(version uint64, key []byte) (*ics23.CommitmentProof,
 	return immutableTree.GetProof(key)
 }
 
+// GetProofs returns a batch proof of the existence or absence of the given keys
+// at the given version.
+func (t *IavlTree) GetProofs(version uint64, keys [][]byte) (*ics23.CommitmentProof, error) {
+	if len(keys) == 0 {
+		return nil, errors.New("no keys to prove")
+	}
+
+	immutableTree, err := t.tree.GetImmutable(int64(version))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get immutable tree at version %d: %w", version, err)
+	}
+
+	proofs := make([]*ics23.CommitmentProof, len(keys))
+	for i, key := range keys {
+		proofs[i], err = immutableTree.GetProof(key)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get proof for key %X: %w", key, err)
+		}
+	}
+
+	return ics23.CombineProofs(proofs)
+}
+
+// GetRangeProof returns a batch proof of the key/value pairs in the range [start, end)
+// at the given version, along with the pairs right before and right after the range.
+func (t *IavlTree) GetRangeProof(version uint64, start, end []byte) (*ics23.CommitmentProof, error) {
+	if start != nil && end != nil && bytes.Compare(start, end) >= 0 {
+		return nil, fmt.Errorf("invalid range [%X, %X)", start, end)
+	}
+
+	immutableTree, err := t.tree.GetImmutable(int64(version))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get immutable tree at version %d: %w", version, err)
+	}
+
+	size := immutableTree.Size()
+	if size == 0 {
+		return nil, fmt.Errorf("cannot generate the proof of an empty tree at version %d", version)
+	}
+
+	// first and last are the indexes of the first keys not lower than start and
+	// end, they are extended to the neighbors of the range, if any
+	first, last := int64(0), size
+	if start != nil {
+		if first, _, err = immutableTree.GetWithIndex(start); err != nil {
+			return nil, err
+		}
+	}
+	if end != nil {
+		if last, _, err = immutableTree.GetWithIndex(end); err != nil {
+			return nil, err
+		}
+	}
+	if first > 0 {
+		first--
+	}
+	if last < size {
+		last++
+	}
+
+	proofs := make([]*ics23.CommitmentProof, 0, last-first)
+	for i := first; i < last; i++ {
+		key, _, err := immutableTree.GetByIndex(i)
+		if err != nil {
+			return nil, err
+		}
+
+		proof, err := immutableTree.GetMembershipProof(key)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get proof for key %X: %w", key, err)
+		}
+		proofs = append(proofs, proof)
+	}
+
+	return ics23.CombineProofs(proofs)
+}
+
 func (t *IavlTree) Get(version uint64, key []byte) ([]byte, error) {
 	immutableTree, err := t.tree.GetImmutable(int64(version))
 	if err != nil {
@@ -111,6 +191,29 @@ func (t *IavlTree) PausePruning(pause bool) {
 	}
 }
 
+// TraverseStateChanges implements commitment.ChangesetTraverser.
+func (t *IavlTree) TraverseStateChanges(startVersion, endVersion uint64, fn func(version uint64, changes []corestore.KVPair) error) error {
+	if startVersion == 0 || startVersion > endVersion {
+		return fmt.Errorf("invalid version range [%d, %d]", startVersion, endVersion)
+	}
+	// iavl silently starts the traversal at the first available version, so check
+	// that the versions are not pruned
+	if startVersion > 1 && !t.tree.VersionExists(int64(startVersion-1)) {
+		return fmt.Errorf("version %d does not exist or is pruned", startVersion-1)
+	}
+	if !t.tree.VersionExists(int64(endVersion)) {
+		return fmt.Errorf("version %d does not exist", endVersion)
+	}
+
+	return t.tree.TraverseStateChanges(int64(startVersion), int64(endVersion), func(version int64, cs *iavl.ChangeSet) error {
+		changes := make([]corestore.KVPair, len(cs.Pairs))
+		for i, pair := range cs.Pairs {
+			changes[i] = corestore.KVPair{Key: pair.Key, Value: pair.Value, Remove: pair.Delete}
+		}
+		return fn(uint64(version), changes)
+	})
+}
+
 // Export exports the tree exporter at the given version.
 func (t *IavlTree) Export(version uint64) (commitment.Exporter, error) {
 	tree, err := t.tree.GetImmutable(int64(version))
@@ -46,6 +46,14 @@ func (t *Tree) GetProof(version uint64, key []byte) (*ics23.CommitmentProof, err
 	return nil, nil
 }
 
+func (t *Tree) GetProofs(version uint64, keys [][]byte) (*ics23.CommitmentProof, error) {
+	return nil, nil
+}
+
+func (t *Tree) GetRangeProof(version uint64, start, end []byte) (*ics23.CommitmentProof, error) {
+	return nil, nil
+}
+
 func (t *Tree) Get(version uint64, key []byte) ([]byte, error) {
 	return t.MemDB.Get(key)
 }
@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"io"
 	"math"
+	"reflect"
+	"sort"
 
 	protoio "github.com/cosmos/gogoproto/io"
 
@@ -26,9 +28,10 @@ const (
 )
 
 var (
-	_ store.Committer             = (*CommitStore)(nil)
-	_ snapshots.CommitSnapshotter = (*CommitStore)(nil)
-	_ store.PausablePruner        = (*CommitStore)(nil)
+	_ store.Committer                  = (*CommitStore)(nil)
+	_ snapshots.DeltaCommitSnapshotter = (*CommitStore)(nil)
+	_ store.PausablePruner             = (*CommitStore)(nil)
+	_ store.StorePruner                = (*CommitStore)(nil)
 )
 
 // CommitStore is a wrapper around multiple Tree objects mapped by a unique store
@@ -272,6 +275,47 @@ func (c *CommitStore) GetProof(storeKey []byte, version uint64, key []byte) ([]p
 	if err != nil {
 		return nil, err
 	}
+
+	return c.withStoreProof(storeKey, version, proof.NewIAVLCommitmentOp(key, iProof))
+}
+
+// GetProofs returns the proof of existence or absence of each of the given keys
+// of a store, as a batch proof of the store tree followed by the proof of the
+// store in the commit info.
+func (c *CommitStore) GetProofs(storeKey []byte, version uint64, keys [][]byte) ([]proof.CommitmentOp, error) {
+	tree, ok := c.multiTrees[conv.UnsafeBytesToStr(storeKey)]
+	if !ok {
+		return nil, fmt.Errorf("store %s not found", storeKey)
+	}
+
+	iProof, err := tree.GetProofs(version, keys)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.withStoreProof(storeKey, version, proof.NewIAVLCommitmentOp(nil, iProof))
+}
+
+// GetRangeProof returns the proof of the key/value pairs of a store in the range
+// [start, end), as a batch proof of the store tree followed by the proof of the
+// store in the commit info.
+func (c *CommitStore) GetRangeProof(storeKey []byte, version uint64, start, end []byte) ([]proof.CommitmentOp, error) {
+	tree, ok := c.multiTrees[conv.UnsafeBytesToStr(storeKey)]
+	if !ok {
+		return nil, fmt.Errorf("store %s not found", storeKey)
+	}
+
+	iProof, err := tree.GetRangeProof(version, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.withStoreProof(storeKey, version, proof.NewIAVLCommitmentOp(nil, iProof))
+}
+
+// withStoreProof appends the proof of the store in the commit info of the given
+// version to the proof of the store tree.
+func (c *CommitStore) withStoreProof(storeKey []byte, version uint64, commitOp proof.CommitmentOp) ([]proof.CommitmentOp, error) {
 	cInfo, err := c.GetCommitInfo(version)
 	if err != nil {
 		return nil, err
@@ -279,7 +323,6 @@ func (c *CommitStore) GetProof(storeKey []byte, version uint64, key []byte) ([]p
 	if cInfo == nil {
 		return nil, fmt.Errorf("commit info not found for version %d", version)
 	}
-	commitOp := proof.NewIAVLCommitmentOp(key, iProof)
 	_, storeCommitmentOp, err := cInfo.GetStoreProof(storeKey)
 	if err != nil {
 		return nil, err
@@ -302,11 +345,91 @@ func (c *CommitStore) Get(storeKey []byte, version uint64, key []byte) ([]byte,
 	return bz, nil
 }
 
+// IterateLeaves calls fn with the key/value pairs of the tree of the store key at
+// the given version, in the ascending order of their keys. A tree which can't be
+// exported, e.g. an in-memory one, is iterated at its latest state.
+func (c *CommitStore) IterateLeaves(storeKey string, version uint64, fn func(key, value []byte) error) error {
+	tree, ok := c.multiTrees[storeKey]
+	if !ok {
+		return fmt.Errorf("store %s not found", storeKey)
+	}
+
+	exporter, err := tree.Export(version)
+	if err != nil {
+		return fmt.Errorf("failed to export tree of store %s for version %d: %w", storeKey, version, err)
+	}
+	if exporter == nil {
+		itr, ok := tree.(interface {
+			Iterator(start, end []byte) (corestore.Iterator, error)
+		})
+		if !ok {
+			return fmt.Errorf("tree of store %s can't be iterated", storeKey)
+		}
+
+		it, err := itr.Iterator(nil, nil)
+		if err != nil {
+			return err
+		}
+		defer it.Close()
+
+		for ; it.Valid(); it.Next() {
+			if err := fn(it.Key(), it.Value()); err != nil {
+				return err
+			}
+		}
+		return it.Error()
+	}
+	defer exporter.Close()
+
+	for {
+		item, err := exporter.Next()
+		if errors.Is(err, ErrorExportDone) {
+			return nil
+		} else if err != nil {
+			return fmt.Errorf("failed to get the next export node: %w", err)
+		}
+
+		// the leaves of the exported nodes have no height
+		if item.Height != 0 {
+			continue
+		}
+		if err := fn(item.Key, item.Value); err != nil {
+			return err
+		}
+	}
+}
+
+// SharesHashingScheme returns whether the trees of the store key in c and other
+// are of the same type, and so hash the same key/value pairs to the same root
+// hash.
+func (c *CommitStore) SharesHashingScheme(other *CommitStore, storeKey string) bool {
+	tree, ok := c.multiTrees[storeKey]
+	if !ok {
+		return false
+	}
+	otherTree, ok := other.multiTrees[storeKey]
+	if !ok {
+		return false
+	}
+
+	return reflect.TypeOf(tree) == reflect.TypeOf(otherTree)
+}
+
 // Prune implements store.Pruner.
-func (c *CommitStore) Prune(version uint64) (ferr error) {
+func (c *CommitStore) Prune(version uint64) error {
+	return c.PruneStores(version, nil)
+}
+
+// PruneStores implements store.StorePruner. The commit infos are pruned to the
+// lowest version of all stores.
+func (c *CommitStore) PruneStores(version uint64, storeVersions map[string]uint64) (ferr error) {
 	// prune the metadata
+	metadataVersion := version
+	for _, storeVersion := range storeVersions {
+		metadataVersion = min(metadataVersion, storeVersion)
+	}
 	batch := c.db.NewBatch()
-	for v := version; v > 0; v-- {
+	for v := metadataVersion; v > 0; v-- {
 		cInfoKey := []byte(fmt.Sprintf(commitInfoKeyFmt, v))
 		if exist, _ := c.db.Has(cInfoKey); !exist {
 			break
@@ -319,8 +442,15 @@ func (c *CommitStore) Prune(version uint64) (ferr error) {
 		return err
 	}
 
-	for _, tree := range c.multiTrees {
-		if err := tree.Prune(version); err != nil {
+	for storeKey, tree := range c.multiTrees {
+		treeVersion := version
+		if storeVersion, ok := storeVersions[storeKey]; ok {
+			treeVersion = storeVersion
+		}
+		if treeVersion == 0 {
+			continue
+		}
+		if err := tree.Prune(treeVersion); err != nil {
 			ferr = errors.Join(ferr, err)
 		}
 	}
@@ -352,12 +482,18 @@ func (c *CommitStore) Snapshot(version uint64, protoWriter protoio.Writer) error
 	}
 
 	for storeKey, tree := range c.multiTrees {
+		if internal.IsMemoryStoreKey(storeKey) {
+			continue
+		}
 		// TODO: check the parallelism of this loop
 		if err := func() error {
 			exporter, err := tree.Export(version)
 			if err != nil {
 				return fmt.Errorf("failed to export tree for version %d: %w", version, err)
 			}
+			if exporter == nil {
+				return fmt.Errorf("tree of store %s does not support export", storeKey)
+			}
 			defer exporter.Close()
 
 			err = protoWriter.WriteMsg(&snapshotstypes.SnapshotItem{
@@ -404,6 +540,10 @@ func (c *CommitStore) Restore(
 	protoReader protoio.Reader,
 	chStorage chan<- *corestore.StateChanges,
 ) (snapshotstypes.SnapshotItem, error) {
+	if format == snapshotstypes.DeltaFormat {
+		return snapshotstypes.SnapshotItem{}, fmt.Errorf("delta snapshots are restored with RestoreDelta")
+	}
+
 	var (
 		importer     Importer
 		snapshotItem snapshotstypes.SnapshotItem
@@ -440,6 +580,11 @@ loop:
 			if err != nil {
 				return snapshotstypes.SnapshotItem{}, fmt.Errorf("failed to import tree for version %d: %w", version, err)
 			}
+			if importer == nil {
+				// the tree does not support importing nodes, e.g. the snapshot was
+				// taken from another Tree implementation, so only write the leaves
+				importer = &leafImporter{tree: tree, version: version}
+			}
 			defer importer.Close()
 
 		case *snapshotstypes.SnapshotItem_IAVL:
@@ -490,6 +635,243 @@ loop:
 	return snapshotItem, c.LoadVersion(version)
 }
 
+// SnapshotDelta implements snapshots.DeltaCommitSnapshotter. The delta snapshot
+// starts with a version item of the base version, followed by a version item per
+// version up to the given one, each followed by the changed key/value pairs of
+// every store. The version items hold the commit hashes the restored state is
+// verified against.
+//
+// All the trees must implement ChangesetTraverser, and the versions since the
+// base version must not be pruned.
+func (c *CommitStore) SnapshotDelta(baseVersion, version uint64, protoWriter protoio.Writer) error {
+	if baseVersion == 0 || baseVersion >= version {
+		return fmt.Errorf("the base version %d must be greater than 0 and less than the snapshot version %d", baseVersion, version)
+	}
+
+	latestVersion, err := c.GetLatestVersion()
+	if err != nil {
+		return err
+	}
+	if version > latestVersion {
+		return fmt.Errorf("the snapshot version %d is greater than the latest version %d", version, latestVersion)
+	}
+
+	// sort the store keys for the snapshot to be deterministic
+	storeKeys := make([]string, 0, len(c.multiTrees))
+	for storeKey, tree := range c.multiTrees {
+		if internal.IsMemoryStoreKey(storeKey) {
+			continue
+		}
+		if _, ok := tree.(ChangesetTraverser); !ok {
+			return fmt.Errorf("tree of store %s does not support delta snapshots", storeKey)
+		}
+		storeKeys = append(storeKeys, storeKey)
+	}
+	sort.Strings(storeKeys)
+
+	for v := baseVersion; v <= version; v++ {
+		cInfo, err := c.GetCommitInfo(v)
+		if err != nil {
+			return fmt.Errorf("failed to get commit info for version %d: %w", v, err)
+		}
+		if cInfo == nil {
+			return fmt.Errorf("commit info for version %d not found", v)
+		}
+		if err := protoWriter.WriteMsg(&snapshotstypes.SnapshotItem{
+			Item: &snapshotstypes.SnapshotItem_Version{
+				Version: &snapshotstypes.SnapshotVersionItem{
+					Version: v,
+					Hash:    cInfo.Hash(),
+				},
+			},
+		}); err != nil {
+			return fmt.Errorf("failed to write version item: %w", err)
+		}
+		if v == baseVersion {
+			continue
+		}
+
+		for _, storeKey := range storeKeys {
+			traverser := c.multiTrees[storeKey].(ChangesetTraverser)
+			if err := traverser.TraverseStateChanges(v, v, func(_ uint64, changes []corestore.KVPair) error {
+				if len(changes) == 0 {
+					return nil
+				}
+				if err := protoWriter.WriteMsg(&snapshotstypes.SnapshotItem{
+					Item: &snapshotstypes.SnapshotItem_Store{
+						Store: &snapshotstypes.SnapshotStoreItem{
+							Name: storeKey,
+						},
+					},
+				}); err != nil {
+					return fmt.Errorf("failed to write store name: %w", err)
+				}
+				for _, kv := range changes {
+					if err := protoWriter.WriteMsg(&snapshotstypes.SnapshotItem{
+						Item: &snapshotstypes.SnapshotItem_KV{
+							KV: &snapshotstypes.SnapshotKVItem{
+								Key:    kv.Key,
+								Value:  kv.Value,
+								Delete: kv.Remove,
+							},
+						},
+					}); err != nil {
+						return fmt.Errorf("failed to write key/value pair: %w", err)
+					}
+				}
+				return nil
+			}); err != nil {
+				return fmt.Errorf("failed to traverse the changes of store %s at version %d: %w", storeKey, v, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// RestoreDelta implements snapshots.DeltaCommitSnapshotter. The delta snapshot is
+// restored on top of the latest version, which must be the base version of the
+// snapshot. The changes of every version are committed and verified against the
+// commit hash of the version, then sent to the channel.
+func (c *CommitStore) RestoreDelta(
+	version uint64,
+	protoReader protoio.Reader,
+	chChangesets chan<- *snapshots.VersionedChangeset,
+) (snapshotstypes.SnapshotItem, error) {
+	latestVersion, err := c.GetLatestVersion()
+	if err != nil {
+		return snapshotstypes.SnapshotItem{}, err
+	}
+
+	var (
+		snapshotItem snapshotstypes.SnapshotItem
+		current      *snapshotstypes.SnapshotVersionItem
+		changeset    *corestore.Changeset // nil for the base version
+		storeKey     []byte
+	)
+
+	// commit writes and verifies the changes of the current version
+	commit := func() error {
+		if err := c.WriteChangeset(changeset); err != nil {
+			return fmt.Errorf("failed to write changeset of version %d: %w", current.Version, err)
+		}
+		cInfo, err := c.Commit(current.Version)
+		if err != nil {
+			return fmt.Errorf("failed to commit version %d: %w", current.Version, err)
+		}
+		if !bytes.Equal(cInfo.Hash(), current.Hash) {
+			return fmt.Errorf("commit hash mismatch at version %d; expected %X, got %X", current.Version, current.Hash, cInfo.Hash())
+		}
+		chChangesets <- &snapshots.VersionedChangeset{Version: current.Version, Changeset: changeset}
+		return nil
+	}
+
+loop:
+	for {
+		snapshotItem = snapshotstypes.SnapshotItem{}
+		err := protoReader.ReadMsg(&snapshotItem)
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return snapshotstypes.SnapshotItem{}, fmt.Errorf("invalid protobuf message: %w", err)
+		}
+
+		switch item := snapshotItem.Item.(type) {
+		case *snapshotstypes.SnapshotItem_Version:
+			if current == nil {
+				// the delta must be applied on top of its base version
+				if item.Version.Version != latestVersion {
+					return snapshotstypes.SnapshotItem{}, fmt.Errorf("the base version %d of the delta snapshot is not the latest version %d", item.Version.Version, latestVersion)
+				}
+				cInfo, err := c.GetCommitInfo(latestVersion)
+				if err != nil {
+					return snapshotstypes.SnapshotItem{}, err
+				}
+				if cInfo == nil || !bytes.Equal(cInfo.Hash(), item.Version.Hash) {
+					return snapshotstypes.SnapshotItem{}, fmt.Errorf("the commit hash of the base version %d does not match", latestVersion)
+				}
+			} else {
+				if changeset != nil {
+					if err := commit(); err != nil {
+						return snapshotstypes.SnapshotItem{}, err
+					}
+				}
+				if item.Version.Version != current.Version+1 {
+					return snapshotstypes.SnapshotItem{}, fmt.Errorf("unexpected version %d after version %d", item.Version.Version, current.Version)
+				}
+				changeset = corestore.NewChangeset()
+			}
+			current = item.Version
+			storeKey = nil
+
+		case *snapshotstypes.SnapshotItem_Store:
+			if changeset == nil {
+				return snapshotstypes.SnapshotItem{}, fmt.Errorf("received store item before version item")
+			}
+			if _, ok := c.multiTrees[item.Store.Name]; !ok {
+				return snapshotstypes.SnapshotItem{}, fmt.Errorf("store %s not found", item.Store.Name)
+			}
+			storeKey = []byte(item.Store.Name)
+
+		case *snapshotstypes.SnapshotItem_KV:
+			if storeKey == nil {
+				return snapshotstypes.SnapshotItem{}, fmt.Errorf("received key/value item before store item")
+			}
+			kv := corestore.KVPair{Key: item.KV.Key, Value: item.KV.Value, Remove: item.KV.Delete}
+			// Protobuf does not differentiate between []byte{} and nil
+			if kv.Key == nil {
+				kv.Key = []byte{}
+			}
+			if !kv.Remove && kv.Value == nil {
+				kv.Value = []byte{}
+			}
+			changeset.AddKVPair(storeKey, kv)
+
+		default:
+			break loop
+		}
+	}
+
+	if changeset == nil {
+		return snapshotstypes.SnapshotItem{}, fmt.Errorf("the delta snapshot has no changes")
+	}
+	if current.Version != version {
+		return snapshotstypes.SnapshotItem{}, fmt.Errorf("the delta snapshot ends at version %d, expected %d", current.Version, version)
+	}
+	if err := commit(); err != nil {
+		return snapshotstypes.SnapshotItem{}, err
+	}
+
+	return snapshotItem, c.LoadVersion(version)
+}
+
+// leafImporter is an Importer writing only the leaf nodes of a snapshot, for a
+// Tree which does not support importing nodes.
+type leafImporter struct {
+	tree    Tree
+	version uint64
+}
+
+func (i *leafImporter) Add(node *snapshotstypes.SnapshotIAVLItem) error {
+	if node.Height != 0 {
+		return nil
+	}
+
+	return i.tree.Set(node.Key, node.Value)
+}
+
+func (i *leafImporter) Commit() error {
+	if err := i.tree.SetInitialVersion(i.version); err != nil {
+		return err
+	}
+	_, _, err := i.tree.Commit()
+	return err
+}
+
+func (i *leafImporter) Close() error {
+	return nil
+}
+
 func (c *CommitStore) Close() (ferr error) {
 	for _, tree := range c.multiTrees {
 		if err := tree.Close(); err != nil {
@@ -6,12 +6,16 @@ import (
 	"io"
 	"sync"
 
+	protoio "github.com/cosmos/gogoproto/io"
+	"github.com/cosmos/gogoproto/proto"
+	ics23 "github.com/cosmos/ics23/go"
 	"github.com/stretchr/testify/suite"
 
 	"cosmossdk.io/core/log"
 	corestore "cosmossdk.io/core/store"
 	"cosmossdk.io/store/v2"
 	dbm "cosmossdk.io/store/v2/db"
+	"cosmossdk.io/store/v2/proof"
 	"cosmossdk.io/store/v2/snapshots"
 	snapshotstypes "cosmossdk.io/store/v2/snapshots/types"
 )
@@ -123,6 +127,151 @@ func (s *CommitStoreTestSuite) TestStore_Snapshotter() {
 	}
 }
 
+func (s *CommitStoreTestSuite) TestStore_DeltaSnapshotter() {
+	storeKeys := []string{storeKey1, storeKey2}
+	commitStore, err := s.NewStore(dbm.NewMemDB(), storeKeys, log.NewNopLogger())
+	s.Require().NoError(err)
+
+	baseVersion, latestVersion := uint64(5), uint64(10)
+	kvCount := 10
+	for i := uint64(1); i <= latestVersion; i++ {
+		kvPairs := make(map[string]corestore.KVPairs)
+		for _, storeKey := range storeKeys {
+			kvPairs[storeKey] = corestore.KVPairs{}
+			for j := 0; j < kvCount; j++ {
+				key := []byte(fmt.Sprintf("key-%d", j))
+				value := []byte(fmt.Sprintf("value-%d-%d", i, j))
+				// remove some keys after the base version
+				remove := i > baseVersion && j%3 == int(i%3)
+				kvPairs[storeKey] = append(kvPairs[storeKey], corestore.KVPair{Key: key, Value: value, Remove: remove})
+			}
+		}
+		s.Require().NoError(commitStore.WriteChangeset(corestore.NewChangesetWithPairs(kvPairs)))
+
+		_, err = commitStore.Commit(i)
+		s.Require().NoError(err)
+	}
+
+	// restore streams a snapshot written by snapshot into the target store, and
+	// returns the restored changesets of a delta snapshot
+	restore := func(target *CommitStore, version uint64, format uint32, snapshot func(protoWriter protoio.Writer) error) ([]*snapshots.VersionedChangeset, error) {
+		chunks := make(chan io.ReadCloser, 100)
+		go func() {
+			streamWriter := snapshots.NewStreamWriter(chunks)
+			s.Require().NotNil(streamWriter)
+			if err := snapshot(streamWriter); err != nil {
+				streamWriter.CloseWithError(err)
+				return
+			}
+			s.Require().NoError(streamWriter.Close())
+		}()
+
+		streamReader, err := snapshots.NewStreamReader(chunks)
+		s.Require().NoError(err)
+		defer streamReader.Close()
+
+		if format != snapshotstypes.DeltaFormat {
+			chStorage := make(chan *corestore.StateChanges, 1000)
+			_, err = target.Restore(version, format, streamReader, chStorage)
+			close(chStorage)
+			return nil, err
+		}
+
+		chChangesets := make(chan *snapshots.VersionedChangeset, 100)
+		_, err = target.RestoreDelta(version, streamReader, chChangesets)
+		close(chChangesets)
+		var changesets []*snapshots.VersionedChangeset
+		for vc := range chChangesets {
+			changesets = append(changesets, vc)
+		}
+		return changesets, err
+	}
+
+	// restore the base version from a full snapshot, then the delta snapshot
+	targetStore, err := s.NewStore(dbm.NewMemDB(), storeKeys, log.NewNopLogger())
+	s.Require().NoError(err)
+	_, err = restore(targetStore, baseVersion, snapshotstypes.CurrentFormat, func(protoWriter protoio.Writer) error {
+		return commitStore.Snapshot(baseVersion, protoWriter)
+	})
+	s.Require().NoError(err)
+
+	// a delta snapshot is not restored as a full snapshot
+	_, err = targetStore.Restore(latestVersion, snapshotstypes.DeltaFormat, protoio.NewDelimitedReader(bytes.NewReader(nil), 0), nil)
+	s.Require().Error(err)
+
+	// the changeset of every version is restored with its version
+	changesets, err := restore(targetStore, latestVersion, snapshotstypes.DeltaFormat, func(protoWriter protoio.Writer) error {
+		return commitStore.SnapshotDelta(baseVersion, latestVersion, protoWriter)
+	})
+	s.Require().NoError(err)
+	s.Require().Len(changesets, int(latestVersion-baseVersion))
+	for i, vc := range changesets {
+		s.Require().Equal(baseVersion+uint64(i)+1, vc.Version)
+		s.Require().Equal(len(storeKeys)*kvCount, vc.Changeset.Size())
+		removed := 0
+		for _, changes := range vc.Changeset.Changes {
+			for _, kv := range changes.StateChanges {
+				if kv.Remove {
+					removed++
+					continue
+				}
+				s.Require().True(bytes.HasPrefix(kv.Value, []byte(fmt.Sprintf("value-%d-", vc.Version))))
+			}
+		}
+		s.Require().NotZero(removed)
+	}
+
+	version, err := targetStore.GetLatestVersion()
+	s.Require().NoError(err)
+	s.Require().Equal(latestVersion, version)
+	for v := baseVersion + 1; v <= latestVersion; v++ {
+		sourceInfo, err := commitStore.GetCommitInfo(v)
+		s.Require().NoError(err)
+		targetInfo, err := targetStore.GetCommitInfo(v)
+		s.Require().NoError(err)
+		s.Require().Equal(sourceInfo.Hash(), targetInfo.Hash())
+	}
+
+	// the delta snapshot must be restored on top of its base version
+	targetStore, err = s.NewStore(dbm.NewMemDB(), storeKeys, log.NewNopLogger())
+	s.Require().NoError(err)
+	_, err = restore(targetStore, baseVersion-1, snapshotstypes.CurrentFormat, func(protoWriter protoio.Writer) error {
+		return commitStore.Snapshot(baseVersion-1, protoWriter)
+	})
+	s.Require().NoError(err)
+	_, err = restore(targetStore, latestVersion, snapshotstypes.DeltaFormat, func(protoWriter protoio.Writer) error {
+		return commitStore.SnapshotDelta(baseVersion, latestVersion, protoWriter)
+	})
+	s.Require().ErrorContains(err, "is not the latest version")
+
+	// the restored versions are verified against the commit hashes
+	targetStore, err = s.NewStore(dbm.NewMemDB(), storeKeys, log.NewNopLogger())
+	s.Require().NoError(err)
+	_, err = restore(targetStore, baseVersion, snapshotstypes.CurrentFormat, func(protoWriter protoio.Writer) error {
+		return commitStore.Snapshot(baseVersion, protoWriter)
+	})
+	s.Require().NoError(err)
+	_, err = restore(targetStore, latestVersion, snapshotstypes.DeltaFormat, func(protoWriter protoio.Writer) error {
+		return commitStore.SnapshotDelta(baseVersion, latestVersion, &tamperingWriter{Writer: protoWriter})
+	})
+	s.Require().ErrorContains(err, "commit hash mismatch")
+
+	s.Require().Error(commitStore.SnapshotDelta(latestVersion, latestVersion, protoio.NewDelimitedWriter(io.Discard)))
+}
+
+// tamperingWriter is a protoio.Writer changing the values of the key/value items of
+// a delta snapshot.
+type tamperingWriter struct {
+	protoio.Writer
+}
+
+func (w *tamperingWriter) WriteMsg(msg proto.Message) error {
+	if kv := msg.(*snapshotstypes.SnapshotItem).GetKV(); kv != nil && !kv.Delete {
+		kv.Value = append([]byte("tampered-"), kv.Value...)
+	}
+	return w.Writer.WriteMsg(msg)
+}
+
 func (s *CommitStoreTestSuite) TestStore_Pruning() {
 	storeKeys := []string{storeKey1, storeKey2}
 	pruneOpts := &store.PruneOptions{
@@ -166,3 +315,90 @@ func (s *CommitStoreTestSuite) TestStore_Pruning() {
 		}
 	}
 }
+
+func (s *CommitStoreTestSuite) TestStore_BatchAndRangeProofs() {
+	storeKeys := []string{storeKey1, storeKey2}
+	commitStore, err := s.NewStore(dbm.NewMemDB(), storeKeys, log.NewNopLogger())
+	s.Require().NoError(err)
+
+	// keys key-00, key-02, ..., key-18 in both stores
+	kvPairs := make(map[string]corestore.KVPairs)
+	for _, storeKey := range storeKeys {
+		for j := 0; j < 20; j += 2 {
+			key := []byte(fmt.Sprintf("key-%02d", j))
+			value := []byte(fmt.Sprintf("value-%02d", j))
+			kvPairs[storeKey] = append(kvPairs[storeKey], corestore.KVPair{Key: key, Value: value})
+		}
+	}
+	s.Require().NoError(commitStore.WriteChangeset(corestore.NewChangesetWithPairs(kvPairs)))
+	cInfo, err := commitStore.Commit(1)
+	s.Require().NoError(err)
+	root := cInfo.Hash()
+
+	// batch proof of existing and missing keys
+	items := map[string][]byte{
+		"key-00": []byte("value-00"),
+		"key-10": []byte("value-10"),
+		"key-18": []byte("value-18"),
+	}
+	absentKeys := [][]byte{[]byte("a"), []byte("key-05"), []byte("key-19"), []byte("z")}
+	keys := append([][]byte{[]byte("key-00"), []byte("key-10"), []byte("key-18")}, absentKeys...)
+	ops, err := commitStore.GetProofs([]byte(storeKey1), 1, keys)
+	s.Require().NoError(err)
+	s.Require().Len(ops, 2)
+	s.Require().NoError(proof.VerifyBatchProof(ops, root, items, absentKeys))
+
+	s.Require().Error(proof.VerifyBatchProof(ops, root, map[string][]byte{"key-10": []byte("value-12")}, nil))
+	s.Require().Error(proof.VerifyBatchProof(ops, root, nil, [][]byte{[]byte("key-10")}))
+	s.Require().Error(proof.VerifyBatchProof(ops, root, map[string][]byte{"key-12": []byte("value-12")}, nil))
+	s.Require().Error(proof.VerifyBatchProof(ops, []byte("invalid root"), items, absentKeys))
+
+	_, err = commitStore.GetProofs([]byte("unknown"), 1, keys)
+	s.Require().Error(err)
+
+	// range proofs
+	testCases := []struct {
+		name       string
+		start, end []byte
+		expKeys    []string
+	}{
+		{"middle range", []byte("key-03"), []byte("key-09"), []string{"key-04", "key-06", "key-08"}},
+		{"range bounded by existing keys", []byte("key-04"), []byte("key-08"), []string{"key-04", "key-06"}},
+		{"open start", nil, []byte("key-03"), []string{"key-00", "key-02"}},
+		{"open end", []byte("key-15"), nil, []string{"key-16", "key-18"}},
+		{"whole store", nil, nil, []string{"key-00", "key-02", "key-04", "key-06", "key-08", "key-10", "key-12", "key-14", "key-16", "key-18"}},
+		{"empty prefix range", []byte("key-05"), []byte("key-06"), []string{}},
+		{"empty range before all keys", []byte("a"), []byte("b"), []string{}},
+		{"empty range after all keys", []byte("z"), nil, []string{}},
+	}
+	for _, tc := range testCases {
+		ops, err := commitStore.GetRangeProof([]byte(storeKey2), 1, tc.start, tc.end)
+		s.Require().NoError(err, tc.name)
+
+		pairs, err := proof.VerifyRangeProof(ops, root, tc.start, tc.end)
+		s.Require().NoError(err, tc.name)
+		s.Require().Len(pairs, len(tc.expKeys), tc.name)
+		for i, key := range tc.expKeys {
+			s.Require().Equal(key, string(pairs[i].Key), tc.name)
+			s.Require().Equal("value"+key[3:], string(pairs[i].Value), tc.name)
+		}
+	}
+
+	// the proof of a range doesn't prove a wider range
+	ops, err = commitStore.GetRangeProof([]byte(storeKey2), 1, []byte("key-03"), []byte("key-09"))
+	s.Require().NoError(err)
+	_, err = proof.VerifyRangeProof(ops, root, nil, []byte("key-09"))
+	s.Require().ErrorContains(err, "missing the key before the range")
+	_, err = proof.VerifyRangeProof(ops, root, []byte("key-03"), nil)
+	s.Require().ErrorContains(err, "missing the key after the range")
+
+	// a range proof with a missing key in the middle doesn't verify
+	batch := ics23.Decompress(ops[0].Proof).GetBatch()
+	batch.Entries = append(batch.Entries[:2], batch.Entries[3:]...)
+	ops[0].Proof = &ics23.CommitmentProof{Proof: &ics23.CommitmentProof_Batch{Batch: batch}}
+	_, err = proof.VerifyRangeProof(ops, root, []byte("key-03"), []byte("key-09"))
+	s.Require().ErrorContains(err, "not neighbors")
+
+	_, err = commitStore.GetRangeProof([]byte(storeKey2), 1, []byte("key-09"), []byte("key-03"))
+	s.Require().Error(err)
+}
@@ -6,6 +6,7 @@ import (
 
 	ics23 "github.com/cosmos/ics23/go"
 
+	corestore "cosmossdk.io/core/store"
 	snapshotstypes "cosmossdk.io/store/v2/snapshots/types"
 )
 
@@ -29,6 +30,16 @@ type Tree interface {
 	SetInitialVersion(version uint64) error
 	GetProof(version uint64, key []byte) (*ics23.CommitmentProof, error)
 
+	// GetProofs returns a batch proof of the existence or absence of each of the
+	// given keys at the given version.
+	GetProofs(version uint64, keys [][]byte) (*ics23.CommitmentProof, error)
+
+	// GetRangeProof returns a batch proof of the key/value pairs in the range
+	// [start, end) at the given version, along with the pairs right before and
+	// right after the range, which prove that no other key exists in the range.
+	// A nil start or end leaves the range open on that side.
+	GetRangeProof(version uint64, start, end []byte) (*ics23.CommitmentProof, error)
+
 	// Get attempts to retrieve a value from the tree for a given version.
 	//
 	// NOTE: This method only exists to support migration from IAVL v0/v1 to v2.
@@ -42,6 +53,15 @@ type Tree interface {
 	io.Closer
 }
 
+// ChangesetTraverser is an optional interface of a Tree which can traverse the
+// changes committed at its past versions, it is required to take delta snapshots.
+type ChangesetTraverser interface {
+	// TraverseStateChanges calls fn with the key/value pairs changed at each
+	// version from startVersion to endVersion, both inclusive. The version before
+	// startVersion must not be pruned.
+	TraverseStateChanges(startVersion, endVersion uint64, fn func(version uint64, changes []corestore.KVPair) error) error
+}
+
 // Exporter is the interface that wraps the basic Export methods.
 type Exporter interface {
 	Next() (*snapshotstypes.SnapshotIAVLItem, error)
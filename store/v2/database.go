@@ -2,6 +2,7 @@ package store
 
 import (
 	"io"
+	"time"
 
 	corestore "cosmossdk.io/core/store"
 	"cosmossdk.io/store/v2/proof"
@@ -25,6 +26,18 @@ type VersionedDatabase interface {
 	io.Closer
 }
 
+// VersionTimeIndexer defines an API for a VersionedDatabase keeping an index of
+// the block time of each version, to query state by block time.
+type VersionTimeIndexer interface {
+	// ApplyChangesetAtTime behaves identically to ApplyChangeset except it also
+	// indexes the block time of the version, in the same batch as the changeset.
+	ApplyChangesetAtTime(version uint64, cs *corestore.Changeset, t time.Time) error
+
+	// GetVersionAtTime returns the last version committed at or before the given
+	// block time.
+	GetVersionAtTime(t time.Time) (uint64, error)
+}
+
 // Committer defines an API for committing state.
 type Committer interface {
 	// WriteChangeset writes the changeset to the commitment state.
@@ -45,6 +58,14 @@ type Committer interface {
 	// GetProof returns the proof of existence or non-existence for the given key.
 	GetProof(storeKey []byte, version uint64, key []byte) ([]proof.CommitmentOp, error)
 
+	// GetProofs returns a batch proof of existence or non-existence for each of the
+	// given keys. It is verified with proof.VerifyBatchProof.
+	GetProofs(storeKey []byte, version uint64, keys [][]byte) ([]proof.CommitmentOp, error)
+
+	// GetRangeProof returns a proof of the key/value pairs in the range [start, end).
+	// It is verified with proof.VerifyRangeProof.
+	GetRangeProof(storeKey []byte, version uint64, start, end []byte) ([]proof.CommitmentOp, error)
+
 	// Get returns the value for the given key at the given version.
 	//
 	// NOTE: This method only exists to support migration from IAVL v0/v1 to v2.
@@ -0,0 +1,179 @@
+// Package diff compares the state of two versions of RootStores, e.g. two
+// versions of the same RootStore, or the data directories of two nodes at the
+// same version, to find where their state diverges.
+//
+// The stores are compared store key by store key. The commit hashes of the
+// stores are compared first, and the stores with equal commit hashes are
+// skipped unless Options.CompareAll is set. The key/value pairs of the other
+// stores are then read from the SS of both versions and merged in key order.
+package diff
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+
+	"cosmossdk.io/store/v2"
+)
+
+// Version is a version of a RootStore to compare.
+type Version struct {
+	Store   store.RootStore
+	Version uint64
+}
+
+// Entry is a key whose value differs between the two compared versions. The
+// value of a version is nil if the key doesn't exist in it.
+type Entry struct {
+	Key    []byte
+	ValueA []byte
+	ValueB []byte
+}
+
+// StoreDiff is the difference of the state of a store key between the two
+// compared versions. The commit hash of a version is nil if the store key
+// doesn't exist in it.
+type StoreDiff struct {
+	StoreKey string
+	HashA    []byte
+	HashB    []byte
+	// Entries are the differing keys in ascending order, up to Options.Limit.
+	Entries []Entry
+}
+
+// Options defines the options of a comparison.
+type Options struct {
+	// StoreKeys are the store keys to compare. If empty, all the store keys of
+	// the commit infos of both versions are compared.
+	StoreKeys []string
+	// Limit is the maximum number of entries of each StoreDiff, e.g. 1 to only get
+	// the first divergent key of each store. 0 means no limit.
+	Limit int
+	// CompareAll compares the state of the stores with equal commit hashes too,
+	// e.g. to check the SS of a node against the SS of another one.
+	CompareAll bool
+}
+
+// Compare compares the state of the two versions, and returns the StoreDiff of
+// each store key whose commit hashes or key/value pairs differ, sorted by store
+// key.
+func Compare(a, b Version, opts Options) ([]StoreDiff, error) {
+	hashesA, err := storeHashes(a)
+	if err != nil {
+		return nil, err
+	}
+	hashesB, err := storeHashes(b)
+	if err != nil {
+		return nil, err
+	}
+
+	storeKeys := opts.StoreKeys
+	if len(storeKeys) == 0 {
+		seen := make(map[string]struct{}, len(hashesA))
+		for _, hashes := range []map[string][]byte{hashesA, hashesB} {
+			for storeKey := range hashes {
+				if _, ok := seen[storeKey]; !ok {
+					seen[storeKey] = struct{}{}
+					storeKeys = append(storeKeys, storeKey)
+				}
+			}
+		}
+	}
+	storeKeys = append([]string(nil), storeKeys...)
+	sort.Strings(storeKeys)
+
+	var diffs []StoreDiff
+	for _, storeKey := range storeKeys {
+		storeDiff := StoreDiff{
+			StoreKey: storeKey,
+			HashA:    hashesA[storeKey],
+			HashB:    hashesB[storeKey],
+		}
+		hashesEqual := bytes.Equal(storeDiff.HashA, storeDiff.HashB)
+		if hashesEqual && !opts.CompareAll {
+			continue
+		}
+
+		if storeDiff.Entries, err = CompareStore(a, b, storeKey, opts.Limit); err != nil {
+			return nil, err
+		}
+		if !hashesEqual || len(storeDiff.Entries) > 0 {
+			diffs = append(diffs, storeDiff)
+		}
+	}
+
+	return diffs, nil
+}
+
+// CompareStore compares the state of the store key between the two versions,
+// and returns the differing keys in ascending order, up to the limit if not 0.
+func CompareStore(a, b Version, storeKey string, limit int) ([]Entry, error) {
+	itrA, err := a.Store.GetStateStorage().Iterator([]byte(storeKey), a.Version, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to iterate store %s at version %d: %w", storeKey, a.Version, err)
+	}
+	defer itrA.Close()
+
+	itrB, err := b.Store.GetStateStorage().Iterator([]byte(storeKey), b.Version, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to iterate store %s at version %d: %w", storeKey, b.Version, err)
+	}
+	defer itrB.Close()
+
+	var entries []Entry
+	for (itrA.Valid() || itrB.Valid()) && (limit == 0 || len(entries) < limit) {
+		var order int
+		switch {
+		case !itrA.Valid():
+			order = 1
+		case !itrB.Valid():
+			order = -1
+		default:
+			order = bytes.Compare(itrA.Key(), itrB.Key())
+		}
+
+		switch {
+		case order < 0:
+			entries = append(entries, Entry{Key: bytes.Clone(itrA.Key()), ValueA: bytes.Clone(itrA.Value())})
+			itrA.Next()
+		case order > 0:
+			entries = append(entries, Entry{Key: bytes.Clone(itrB.Key()), ValueB: bytes.Clone(itrB.Value())})
+			itrB.Next()
+		default:
+			if !bytes.Equal(itrA.Value(), itrB.Value()) {
+				entries = append(entries, Entry{
+					Key:    bytes.Clone(itrA.Key()),
+					ValueA: bytes.Clone(itrA.Value()),
+					ValueB: bytes.Clone(itrB.Value()),
+				})
+			}
+			itrA.Next()
+			itrB.Next()
+		}
+	}
+
+	if err := errors.Join(itrA.Error(), itrB.Error()); err != nil {
+		return nil, fmt.Errorf("failed to iterate store %s: %w", storeKey, err)
+	}
+
+	return entries, nil
+}
+
+// storeHashes returns the commit hash of each store key of the version.
+func storeHashes(v Version) (map[string][]byte, error) {
+	commitInfo, err := v.Store.GetStateCommitment().GetCommitInfo(v.Version)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get commit info of version %d: %w", v.Version, err)
+	}
+	if commitInfo == nil {
+		return nil, fmt.Errorf("no commit info of version %d", v.Version)
+	}
+
+	hashes := make(map[string][]byte, len(commitInfo.StoreInfos))
+	for _, si := range commitInfo.StoreInfos {
+		hashes[string(si.Name)] = si.GetHash()
+	}
+
+	return hashes, nil
+}
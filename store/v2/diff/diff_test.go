@@ -0,0 +1,120 @@
+package diff
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/log"
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/commitment"
+	"cosmossdk.io/store/v2/commitment/iavl"
+	dbm "cosmossdk.io/store/v2/db"
+	"cosmossdk.io/store/v2/pruning"
+	"cosmossdk.io/store/v2/root"
+	"cosmossdk.io/store/v2/storage"
+	"cosmossdk.io/store/v2/storage/sqlite"
+)
+
+var storeKeys = []string{"bank", "gov", "staking"}
+
+func newRootStore(t *testing.T) store.RootStore {
+	t.Helper()
+	nopLog := log.NewNopLogger()
+
+	sqliteDB, err := sqlite.New(t.TempDir())
+	require.NoError(t, err)
+	ss := storage.NewStorageStore(sqliteDB, nopLog)
+
+	trees := make(map[string]commitment.Tree, len(storeKeys))
+	for _, storeKey := range storeKeys {
+		trees[storeKey] = iavl.NewIavlTree(dbm.NewMemDB(), nopLog, iavl.DefaultConfig())
+	}
+	sc, err := commitment.NewCommitStore(trees, dbm.NewMemDB(), nopLog)
+	require.NoError(t, err)
+
+	rs, err := root.New(nopLog, ss, sc, pruning.NewManager(sc, ss, nil, nil), nil, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, rs.Close()) })
+
+	return rs
+}
+
+func commit(t *testing.T, rs store.RootStore, pairs map[string]corestore.KVPairs) {
+	t.Helper()
+	_, err := rs.Commit(corestore.NewChangesetWithPairs(pairs))
+	require.NoError(t, err)
+}
+
+func TestCompare(t *testing.T) {
+	rsA, rsB := newRootStore(t), newRootStore(t)
+
+	genesis := map[string]corestore.KVPairs{
+		"bank":    {{Key: []byte("a"), Value: []byte("1")}, {Key: []byte("b"), Value: []byte("2")}},
+		"gov":     {{Key: []byte("a"), Value: []byte("1")}},
+		"staking": {{Key: []byte("a"), Value: []byte("1")}},
+	}
+	commit(t, rsA, genesis)
+	commit(t, rsB, genesis)
+
+	// the nodes diverge in bank and gov at version 2
+	commit(t, rsA, map[string]corestore.KVPairs{
+		"bank": {{Key: []byte("b"), Value: []byte("3")}, {Key: []byte("c"), Value: []byte("4")}},
+		"gov":  {{Key: []byte("a"), Remove: true}},
+	})
+	commit(t, rsB, map[string]corestore.KVPairs{
+		"bank": {{Key: []byte("b"), Value: []byte("5")}, {Key: []byte("d"), Value: []byte("6")}},
+		"gov":  {{Key: []byte("b"), Value: []byte("7")}},
+	})
+
+	t.Run("same version of two stores", func(t *testing.T) {
+		diffs, err := Compare(Version{Store: rsA, Version: 2}, Version{Store: rsB, Version: 2}, Options{})
+		require.NoError(t, err)
+		require.Len(t, diffs, 2)
+
+		require.Equal(t, "bank", diffs[0].StoreKey)
+		require.NotEqual(t, diffs[0].HashA, diffs[0].HashB)
+		require.Equal(t, []Entry{
+			{Key: []byte("b"), ValueA: []byte("3"), ValueB: []byte("5")},
+			{Key: []byte("c"), ValueA: []byte("4")},
+			{Key: []byte("d"), ValueB: []byte("6")},
+		}, diffs[0].Entries)
+
+		require.Equal(t, "gov", diffs[1].StoreKey)
+		require.Equal(t, []Entry{
+			{Key: []byte("a"), ValueB: []byte("1")},
+			{Key: []byte("b"), ValueB: []byte("7")},
+		}, diffs[1].Entries)
+	})
+
+	t.Run("two versions of a store", func(t *testing.T) {
+		diffs, err := Compare(Version{Store: rsA, Version: 1}, Version{Store: rsA, Version: 2}, Options{StoreKeys: []string{"bank"}})
+		require.NoError(t, err)
+		require.Len(t, diffs, 1)
+		require.Equal(t, []Entry{
+			{Key: []byte("b"), ValueA: []byte("2"), ValueB: []byte("3")},
+			{Key: []byte("c"), ValueB: []byte("4")},
+		}, diffs[0].Entries)
+	})
+
+	t.Run("first divergent key", func(t *testing.T) {
+		diffs, err := Compare(Version{Store: rsA, Version: 2}, Version{Store: rsB, Version: 2}, Options{Limit: 1})
+		require.NoError(t, err)
+		require.Len(t, diffs, 2)
+		for _, diff := range diffs {
+			require.Len(t, diff.Entries, 1)
+		}
+	})
+
+	t.Run("equal stores", func(t *testing.T) {
+		diffs, err := Compare(Version{Store: rsA, Version: 1}, Version{Store: rsB, Version: 1}, Options{CompareAll: true})
+		require.NoError(t, err)
+		require.Empty(t, diffs)
+	})
+
+	t.Run("unknown version", func(t *testing.T) {
+		_, err := Compare(Version{Store: rsA, Version: 3}, Version{Store: rsB, Version: 3}, Options{})
+		require.Error(t, err)
+	})
+}
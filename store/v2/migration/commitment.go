@@ -0,0 +1,217 @@
+package migration
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"golang.org/x/sync/errgroup"
+
+	"cosmossdk.io/core/log"
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/store/v2/commitment"
+	"cosmossdk.io/store/v2/internal"
+	"cosmossdk.io/store/v2/metrics"
+	snapshotstypes "cosmossdk.io/store/v2/snapshots/types"
+)
+
+// copiedKeysGaugeInterval is the number of copied keys between the updates of the
+// copied keys gauge.
+const copiedKeysGaugeInterval = 10_000
+
+// VerifyCommitment checks that every store has the same key/value pairs in the
+// source and migrated state commitments at the given version. The root hashes
+// are also compared for the stores whose trees share the hashing scheme, see
+// CommitStore.SharesHashingScheme.
+func VerifyCommitment(version uint64, source, target *commitment.CommitStore) error {
+	sourceInfo, err := source.GetCommitInfo(version)
+	if err != nil {
+		return fmt.Errorf("failed to get source commit info: %w", err)
+	}
+	targetInfo, err := target.GetCommitInfo(version)
+	if err != nil {
+		return fmt.Errorf("failed to get target commit info: %w", err)
+	}
+	if sourceInfo == nil || targetInfo == nil {
+		return fmt.Errorf("commit info of version %d not found", version)
+	}
+
+	targetHashes := make(map[string][]byte, len(targetInfo.StoreInfos))
+	for _, si := range targetInfo.StoreInfos {
+		targetHashes[string(si.Name)] = si.CommitID.Hash
+	}
+	for _, si := range sourceInfo.StoreInfos {
+		storeKey := string(si.Name)
+		targetHash, ok := targetHashes[storeKey]
+		if !ok {
+			return fmt.Errorf("store %s not found in target at version %d", si.Name, version)
+		}
+		delete(targetHashes, storeKey)
+
+		if internal.IsMemoryStoreKey(storeKey) {
+			continue
+		}
+		if source.SharesHashingScheme(target, storeKey) && !bytes.Equal(si.CommitID.Hash, targetHash) {
+			return fmt.Errorf("root hash mismatch of store %s at version %d; source: %X, target: %X", si.Name, version, si.CommitID.Hash, targetHash)
+		}
+		if err := verifyLeaves(version, storeKey, source, target); err != nil {
+			return err
+		}
+	}
+	for name := range targetHashes {
+		return fmt.Errorf("store %s not found in source at version %d", name, version)
+	}
+
+	return nil
+}
+
+// verifyLeaves checks that the store has the same key/value pairs in the source
+// and target state commitments at the given version, walking both side by side.
+func verifyLeaves(version uint64, storeKey string, source, target *commitment.CommitStore) error {
+	eg, ctx := errgroup.WithContext(context.Background())
+	iterate := func(sc *commitment.CommitStore, ch chan<- corestore.KVPair) {
+		eg.Go(func() error {
+			defer close(ch)
+			return sc.IterateLeaves(storeKey, version, func(key, value []byte) error {
+				select {
+				case ch <- corestore.KVPair{Key: key, Value: value}:
+					return nil
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			})
+		})
+	}
+
+	chSource := make(chan corestore.KVPair, defaultChannelBufferSize)
+	chTarget := make(chan corestore.KVPair, defaultChannelBufferSize)
+	iterate(source, chSource)
+	iterate(target, chTarget)
+
+	// a mismatch cancels the context, stopping both iterations
+	eg.Go(func() error {
+		for {
+			sourcePair, sourceOK := <-chSource
+			targetPair, targetOK := <-chTarget
+			switch {
+			case !sourceOK && !targetOK:
+				return nil
+			case !targetOK:
+				return fmt.Errorf("key %X of store %s not found in target at version %d", sourcePair.Key, storeKey, version)
+			case !sourceOK:
+				return fmt.Errorf("key %X of store %s not found in source at version %d", targetPair.Key, storeKey, version)
+			case !bytes.Equal(sourcePair.Key, targetPair.Key):
+				return fmt.Errorf("key mismatch of store %s at version %d; source: %X, target: %X", storeKey, version, sourcePair.Key, targetPair.Key)
+			case !bytes.Equal(sourcePair.Value, targetPair.Value):
+				return fmt.Errorf("value mismatch of key %X of store %s at version %d", sourcePair.Key, storeKey, version)
+			}
+		}
+	})
+
+	return eg.Wait()
+}
+
+// NewCommitmentMigrationManager returns a Manager migrating the state commitment
+// from source to target, e.g. to trees with another configuration or database,
+// while the RootStore keeps committing to source. The state storage is not
+// migrated.
+//
+// The state of source at the start version is copied into target, then the
+// Changesets committed in the meantime are replayed. The migrated state
+// commitment is verified against source once copied and at the cut over, see
+// VerifyCommitment and RootStore.StartCommitmentMigration, so the trees of target
+// may use another hashing scheme than source. The progress is exposed via the
+// given metrics, if not nil.
+func NewCommitmentMigrationManager(
+	db corestore.KVStoreWithBatch,
+	source, target *commitment.CommitStore,
+	m metrics.StoreMetrics,
+	logger log.Logger,
+) *Manager {
+	return &Manager{
+		logger:           logger,
+		stateCommitment:  target,
+		sourceCommitment: source,
+		telemetry:        m,
+		db:               db,
+	}
+}
+
+// Verify verifies the migrated state commitment against the source one at the
+// given version, see VerifyCommitment.
+func (m *Manager) Verify(version uint64) error {
+	if m.sourceCommitment == nil {
+		return errors.New("only the migration of the state commitment can be verified")
+	}
+
+	return VerifyCommitment(version, m.sourceCommitment, m.stateCommitment)
+}
+
+// migrateCommitment copies the state of the source state commitment at the given
+// version into the target one, and verifies it. The nodes of the trees are
+// imported if the target trees support it, so that trees with the same hashing
+// scheme keep the same root hashes, otherwise only the leaves are written.
+func (m *Manager) migrateCommitment(version uint64) error {
+	// stream the source state commitment as a snapshot, without writing to disk
+	ms := NewMigrationStream(defaultChannelBufferSize)
+	chStorage := make(chan *corestore.StateChanges, defaultStorageBufferSize)
+
+	eg := new(errgroup.Group)
+	eg.Go(func() error {
+		if err := m.sourceCommitment.Snapshot(version, ms); err != nil {
+			ms.CloseWithError(err)
+			return err
+		}
+		return ms.Close()
+	})
+	eg.Go(func() error {
+		defer close(chStorage)
+		if _, err := m.stateCommitment.Restore(version, 0, ms, chStorage); err != nil {
+			// drain the stream to release the snapshot
+			for {
+				if err := ms.ReadMsg(&snapshotstypes.SnapshotItem{}); err != nil {
+					break
+				}
+			}
+			return err
+		}
+		return nil
+	})
+
+	// the state storage is not migrated, only count the copied keys
+	var copiedKeys int
+	for range chStorage {
+		copiedKeys++
+		if copiedKeys%copiedKeysGaugeInterval == 0 {
+			m.setGauge(float32(copiedKeys), "copied_keys")
+		}
+	}
+	m.setGauge(float32(copiedKeys), "copied_keys")
+
+	if err := eg.Wait(); err != nil {
+		return fmt.Errorf("failed to copy state commitment: %w", err)
+	}
+	if err := m.Verify(version); err != nil {
+		return fmt.Errorf("failed to verify migrated state commitment: %w", err)
+	}
+
+	m.mtx.Lock()
+	m.migratedVersion = version
+	m.mtx.Unlock()
+	m.setGauge(float32(version), "migrated_version")
+
+	m.logger.Info("state commitment copied", "version", version, "keys", copiedKeys)
+
+	return nil
+}
+
+// setGauge sets the migration gauge with the given keys, if the Manager has
+// metrics.
+func (m *Manager) setGauge(val float32, keys ...string) {
+	if m.telemetry == nil {
+		return
+	}
+
+	m.telemetry.SetGauge(val, append([]string{"migration"}, keys...)...)
+}
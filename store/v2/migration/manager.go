@@ -1,6 +1,7 @@
 package migration
 
 import (
+	"context"
 	"encoding/binary"
 	"errors"
 	"fmt"
@@ -12,8 +13,10 @@ import (
 
 	"cosmossdk.io/core/log"
 	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/store/v2"
 	"cosmossdk.io/store/v2/commitment"
 	"cosmossdk.io/store/v2/internal/encoding"
+	"cosmossdk.io/store/v2/metrics"
 	"cosmossdk.io/store/v2/snapshots"
 	snapshotstypes "cosmossdk.io/store/v2/snapshots/types"
 	"cosmossdk.io/store/v2/storage"
@@ -42,9 +45,15 @@ type Manager struct {
 	stateStorage    *storage.StorageStore
 	stateCommitment *commitment.CommitStore
 
+	// sourceCommitment is the state commitment migrated from, set when only the
+	// state commitment is migrated, see NewCommitmentMigrationManager.
+	sourceCommitment *commitment.CommitStore
+	telemetry        metrics.StoreMetrics
+
 	db              corestore.KVStoreWithBatch
-	mtx             sync.Mutex // mutex for migratedVersion
+	mtx             sync.Mutex // mutex for migratedVersion and err
 	migratedVersion uint64
+	err             error // the error which stopped the migration, if any
 
 	chChangeset <-chan *VersionedChangeset
 	chDone      <-chan struct{}
@@ -69,10 +78,16 @@ func NewManager(db corestore.KVStoreWithBatch, sm *snapshots.Manager, ss *storag
 // `chChangeset` is the channel to receive the committed Changesets from the RootStore.
 // `chDone` is the channel to receive the done signal from the RootStore.
 // NOTE: It should be called by the RootStore, running in the background.
-func (m *Manager) Start(version uint64, chChangeset <-chan *VersionedChangeset, chDone <-chan struct{}) error {
+func (m *Manager) Start(version uint64, chChangeset <-chan *VersionedChangeset, chDone <-chan struct{}) (err error) {
 	m.chChangeset = chChangeset
 	m.chDone = chDone
 
+	defer func() {
+		m.mtx.Lock()
+		m.err = err
+		m.mtx.Unlock()
+	}()
+
 	go func() {
 		if err := m.writeChangeset(); err != nil {
 			m.logger.Error("failed to write changeset", "err", err)
@@ -86,6 +101,13 @@ func (m *Manager) Start(version uint64, chChangeset <-chan *VersionedChangeset,
 	return m.Sync()
 }
 
+// Err returns the error which stopped the migration, if any.
+func (m *Manager) Err() error {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	return m.err
+}
+
 // GetStateCommitment returns the state commitment.
 func (m *Manager) GetStateCommitment() *commitment.CommitStore {
 	return m.stateCommitment
@@ -93,6 +115,10 @@ func (m *Manager) GetStateCommitment() *commitment.CommitStore {
 
 // Migrate migrates the whole state at the given height to the new store/v2.
 func (m *Manager) Migrate(height uint64) error {
+	if m.sourceCommitment != nil {
+		return m.migrateCommitment(height)
+	}
+
 	// create the migration stream and snapshot,
 	// which acts as protoio.Reader and snapshots.WriteCloser.
 	ms := NewMigrationStream(defaultChannelBufferSize)
@@ -168,6 +194,64 @@ func (m *Manager) Migrate(height uint64) error {
 	return nil
 }
 
+// MigrateStorage migrates the state of the given store keys at the given version
+// from the source state storage to the state storage of the Manager, e.g. to move
+// an existing state storage into a partitioned layout. The version is usually the
+// latest version of the source. Only the state at that version is migrated, the
+// history of the source is not carried over: the state storage of the Manager
+// can't be queried at the versions before it, and must not have any version at or
+// above it.
+func (m *Manager) MigrateStorage(src store.VersionedDatabase, version uint64, storeKeys []string) error {
+	if version == 0 {
+		return errors.New("invalid version 0")
+	}
+
+	chStorage := make(chan *corestore.StateChanges, defaultStorageBufferSize)
+
+	eg, ctx := errgroup.WithContext(context.Background())
+	eg.Go(func() error {
+		return m.stateStorage.Restore(version, chStorage)
+	})
+	eg.Go(func() error {
+		defer close(chStorage)
+		for _, storeKey := range storeKeys {
+			if err := migrateStoreKey(ctx, src, version, []byte(storeKey), chStorage); err != nil {
+				return fmt.Errorf("failed to migrate store key %s: %w", storeKey, err)
+			}
+		}
+		return nil
+	})
+
+	return eg.Wait()
+}
+
+// migrateStoreKey streams the state of the store key at the given version.
+func migrateStoreKey(ctx context.Context, src store.VersionedDatabase, version uint64, storeKey []byte, chStorage chan<- *corestore.StateChanges) error {
+	itr, err := src.Iterator(storeKey, version, nil, nil)
+	if err != nil {
+		return err
+	}
+	defer itr.Close()
+
+	for ; itr.Valid(); itr.Next() {
+		select {
+		case chStorage <- &corestore.StateChanges{
+			Actor: storeKey,
+			StateChanges: []corestore.KVPair{
+				{
+					Key:   itr.Key(),
+					Value: itr.Value(),
+				},
+			},
+		}:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	return itr.Error()
+}
+
 // writeChangeset writes the Changeset to the db.
 func (m *Manager) writeChangeset() error {
 	for vc := range m.chChangeset {
@@ -250,13 +334,21 @@ func (m *Manager) Sync() error {
 					return fmt.Errorf("failed to commit changeset to commitment: %w", err)
 				}
 			}
-			if err := m.stateStorage.ApplyChangeset(version, cs); err != nil {
-				return fmt.Errorf("failed to write changeset to storage: %w", err)
+			if m.stateStorage != nil {
+				if err := m.stateStorage.ApplyChangeset(version, cs); err != nil {
+					return fmt.Errorf("failed to write changeset to storage: %w", err)
+				}
 			}
 
 			m.mtx.Lock()
 			m.migratedVersion = version
 			m.mtx.Unlock()
+			m.setGauge(float32(version), "migrated_version")
+			if m.sourceCommitment != nil {
+				if latestVersion, err := m.sourceCommitment.GetLatestVersion(); err == nil && latestVersion >= version {
+					m.setGauge(float32(latestVersion-version), "lag")
+				}
+			}
 
 			version += 1
 		}
@@ -269,7 +361,7 @@ func (m *Manager) Close() error {
 	if err := m.db.Close(); err != nil {
 		return fmt.Errorf("failed to close db: %w", err)
 	}
-	if m.stateCommitment != nil {
+	if m.stateCommitment != nil && m.snapshotsManager != nil {
 		m.snapshotsManager.EndMigration(m.stateCommitment)
 	}
 
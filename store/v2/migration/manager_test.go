@@ -10,9 +10,11 @@ import (
 	corestore "cosmossdk.io/core/store"
 	"cosmossdk.io/store/v2/commitment"
 	"cosmossdk.io/store/v2/commitment/iavl"
+	"cosmossdk.io/store/v2/commitment/mem"
 	dbm "cosmossdk.io/store/v2/db"
 	"cosmossdk.io/store/v2/snapshots"
 	"cosmossdk.io/store/v2/storage"
+	"cosmossdk.io/store/v2/storage/partitioned"
 	"cosmossdk.io/store/v2/storage/pebbledb"
 )
 
@@ -112,3 +114,182 @@ func TestMigrateState(t *testing.T) {
 		})
 	}
 }
+
+func TestMigrateStorage(t *testing.T) {
+	srcDB, err := pebbledb.New(t.TempDir())
+	require.NoError(t, err)
+	src := storage.NewStorageStore(srcDB, log.NewNopLogger())
+	defer src.Close()
+
+	// each version updates key-0, adds key-<version> and removes key-<version-2>
+	latestVersion := uint64(10)
+	for version := uint64(1); version <= latestVersion; version++ {
+		cs := corestore.NewChangeset()
+		for _, storeKey := range storeKeys {
+			cs.Add([]byte(storeKey), []byte("key-0"), []byte(fmt.Sprintf("value-%d", version)), false)
+			cs.Add([]byte(storeKey), []byte(fmt.Sprintf("key-%d", version)), []byte(fmt.Sprintf("value-%d", version)), false)
+			if version > 2 {
+				cs.Add([]byte(storeKey), []byte(fmt.Sprintf("key-%d", version-2)), nil, true)
+			}
+		}
+		require.NoError(t, src.ApplyChangeset(version, cs))
+	}
+
+	defaultDB, err := pebbledb.New(t.TempDir())
+	require.NoError(t, err)
+	store1DB, err := pebbledb.New(t.TempDir())
+	require.NoError(t, err)
+	partitionedDB, err := partitioned.New(defaultDB, partitioned.Partition{StoreKey: "store1", DB: store1DB})
+	require.NoError(t, err)
+	dst := storage.NewStorageStore(partitionedDB, log.NewNopLogger())
+	defer dst.Close()
+
+	m := NewManager(dbm.NewMemDB(), nil, dst, nil, log.NewNopLogger())
+	require.Error(t, m.MigrateStorage(src, 0, storeKeys))
+	require.NoError(t, m.MigrateStorage(src, latestVersion, storeKeys))
+
+	version, err := dst.GetLatestVersion()
+	require.NoError(t, err)
+	require.Equal(t, latestVersion, version)
+
+	// the latest version has the state of the source, the history is not migrated
+	for _, storeKey := range storeKeys {
+		for i := uint64(0); i <= latestVersion; i++ {
+			key := []byte(fmt.Sprintf("key-%d", i))
+			expected, err := src.Get([]byte(storeKey), latestVersion, key)
+			require.NoError(t, err)
+			val, err := dst.Get([]byte(storeKey), latestVersion, key)
+			require.NoError(t, err)
+			require.Equal(t, expected, val, "store %s, key %s", storeKey, key)
+		}
+
+		val, err := dst.Get([]byte(storeKey), latestVersion-1, []byte("key-0"))
+		require.NoError(t, err)
+		require.Nil(t, val)
+	}
+
+	// the state of store1 is moved into its own partition
+	val, err := store1DB.Get([]byte("store1"), latestVersion, []byte("key-0"))
+	require.NoError(t, err)
+	require.Equal(t, []byte(fmt.Sprintf("value-%d", latestVersion)), val)
+	val, err = defaultDB.Get([]byte("store1"), latestVersion, []byte("key-0"))
+	require.NoError(t, err)
+	require.Nil(t, val)
+
+	// the state storage must not have the version yet
+	require.Error(t, m.MigrateStorage(src, latestVersion, storeKeys))
+}
+
+func TestMigrateCommitment(t *testing.T) {
+	testCases := []struct {
+		name        string
+		newTree     func() commitment.Tree
+		expMismatch bool
+	}{
+		{"iavl to iavl", func() commitment.Tree {
+			return iavl.NewIavlTree(dbm.NewMemDB(), log.NewNopLogger(), iavl.DefaultConfig())
+		}, false},
+		// the trees of another hashing scheme are verified by their leaves
+		{"iavl to mem", func() commitment.Tree { return mem.New() }, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, orgCommitStore := setupMigrationManager(t, true)
+
+			toVersion := uint64(20)
+			keyCount := 10
+			for version := uint64(1); version <= toVersion; version++ {
+				cs := corestore.NewChangeset()
+				for _, storeKey := range storeKeys {
+					for i := 0; i < keyCount; i++ {
+						cs.Add([]byte(storeKey), []byte(fmt.Sprintf("key-%d-%d", version, i)), []byte(fmt.Sprintf("value-%d-%d", version, i)), false)
+					}
+				}
+				require.NoError(t, orgCommitStore.WriteChangeset(cs))
+				_, err := orgCommitStore.Commit(version)
+				require.NoError(t, err)
+			}
+
+			trees := make(map[string]commitment.Tree)
+			for _, storeKey := range storeKeys {
+				trees[storeKey] = tc.newTree()
+			}
+			target, err := commitment.NewCommitStore(trees, dbm.NewMemDB(), log.NewNopLogger())
+			require.NoError(t, err)
+
+			m := NewCommitmentMigrationManager(dbm.NewMemDB(), orgCommitStore, target, nil, log.NewNopLogger())
+			if tc.expMismatch {
+				require.ErrorContains(t, m.Migrate(toVersion), "value mismatch")
+				require.Zero(t, m.GetMigratedVersion())
+				return
+			} else {
+				require.NoError(t, m.Migrate(toVersion))
+				require.Equal(t, toVersion, m.GetMigratedVersion())
+				require.NoError(t, m.Verify(toVersion))
+			}
+
+			for version := uint64(1); version <= toVersion; version++ {
+				for _, storeKey := range storeKeys {
+					for i := 0; i < keyCount; i++ {
+						val, err := target.Get([]byte(storeKey), toVersion, []byte(fmt.Sprintf("key-%d-%d", version, i)))
+						require.NoError(t, err)
+						require.Equal(t, []byte(fmt.Sprintf("value-%d-%d", version, i)), val)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestVerifyCommitment(t *testing.T) {
+	_, source := setupMigrationManager(t, true)
+	_, target := setupMigrationManager(t, true)
+
+	for _, sc := range []*commitment.CommitStore{source, target} {
+		cs := corestore.NewChangeset()
+		cs.Add([]byte("store1"), []byte("key"), []byte("value"), false)
+		require.NoError(t, sc.WriteChangeset(cs))
+		_, err := sc.Commit(1)
+		require.NoError(t, err)
+	}
+	require.NoError(t, VerifyCommitment(1, source, target))
+	require.Error(t, VerifyCommitment(2, source, target))
+
+	cs := corestore.NewChangeset()
+	cs.Add([]byte("store2"), []byte("key"), []byte("value"), false)
+	require.NoError(t, target.WriteChangeset(cs))
+	_, err := target.Commit(2)
+	require.NoError(t, err)
+	_, err = source.Commit(2)
+	require.NoError(t, err)
+	require.ErrorContains(t, VerifyCommitment(2, source, target), "root hash mismatch of store store2")
+
+	// the trees of another hashing scheme are compared by their leaves
+	newMemCommitStore := func(pairs map[string]string) *commitment.CommitStore {
+		trees := make(map[string]commitment.Tree)
+		for _, storeKey := range storeKeys {
+			trees[storeKey] = mem.New()
+		}
+		sc, err := commitment.NewCommitStore(trees, dbm.NewMemDB(), log.NewNopLogger())
+		require.NoError(t, err)
+
+		cs := corestore.NewChangeset()
+		for key, value := range pairs {
+			cs.Add([]byte("store1"), []byte(key), []byte(value), false)
+		}
+		require.NoError(t, sc.WriteChangeset(cs))
+		for version := uint64(1); version <= 2; version++ {
+			_, err = sc.Commit(version)
+			require.NoError(t, err)
+		}
+		return sc
+	}
+	require.NoError(t, VerifyCommitment(2, source, newMemCommitStore(map[string]string{"key": "value"})))
+	require.ErrorContains(t, VerifyCommitment(2, source, newMemCommitStore(map[string]string{"key": "other"})),
+		"value mismatch of key 6B6579 of store store1")
+	require.ErrorContains(t, VerifyCommitment(2, source, newMemCommitStore(map[string]string{"key": "value", "key2": "value"})),
+		"key 6B657932 of store store1 not found in source")
+	require.ErrorContains(t, VerifyCommitment(2, source, newMemCommitStore(nil)),
+		"key 6B6579 of store store1 not found in target")
+}
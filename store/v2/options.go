@@ -1,13 +1,28 @@
 package store
 
+import "time"
+
 // PruneOptions defines the pruning configuration.
 type PruneOptions struct {
 	// KeepRecent sets the number of recent versions to keep.
-	KeepRecent uint64
+	KeepRecent uint64 `mapstructure:"keep_recent"`
 
 	// Interval sets the number of how often to prune.
 	// If set to 0, no pruning will be done.
-	Interval uint64
+	Interval uint64 `mapstructure:"interval"`
+
+	// KeepDuration sets the minimum duration of history to keep, based on the
+	// block times of the versions indexed by the SS. The versions are kept if
+	// either KeepRecent or KeepDuration requires it. If set to 0, only KeepRecent
+	// applies.
+	KeepDuration time.Duration `mapstructure:"keep_duration"`
+
+	// StoreOptions overrides the pruning of the stores of the given store keys,
+	// e.g. with an Interval of 0 to keep the full history of a module. The Interval
+	// of an override is otherwise ignored, the store being pruned whenever the
+	// other stores are. A pruner which can't prune its stores separately is pruned
+	// up to the lowest version of all of them.
+	StoreOptions map[string]*PruneOptions `mapstructure:"store_options"`
 }
 
 // DefaultPruneOptions returns the default pruning options.
@@ -38,6 +53,17 @@ func (opts *PruneOptions) ShouldPrune(version uint64) (bool, uint64) {
 	return false, 0
 }
 
+// PruneVersion returns the version to prune up to at the given version to keep
+// KeepRecent versions, regardless of the Interval, and false if no version can
+// be pruned.
+func (opts *PruneOptions) PruneVersion(version uint64) (bool, uint64) {
+	if opts.Interval == 0 || version <= opts.KeepRecent+1 {
+		return false, 0
+	}
+
+	return true, version - opts.KeepRecent - 1
+}
+
 // DBOptions defines the interface of a database options.
 type DBOptions interface {
 	Get(string) interface{}
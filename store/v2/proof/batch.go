@@ -0,0 +1,161 @@
+package proof
+
+import (
+	"bytes"
+
+	ics23 "github.com/cosmos/ics23/go"
+
+	"cosmossdk.io/errors"
+	storeerrors "cosmossdk.io/store/v2/errors"
+)
+
+// KVPair is a key/value pair proven by a range proof.
+type KVPair struct {
+	Key   []byte
+	Value []byte
+}
+
+// VerifyBatchProof verifies that the proof ops, as returned by GetProofs of the
+// Committer, prove the existence of each key of items with its value and the
+// absence of each of absentKeys, against the given root.
+func VerifyBatchProof(ops []CommitmentOp, root []byte, items map[string][]byte, absentKeys [][]byte) error {
+	storeRoot, err := verifyStoreRoot(ops, root)
+	if err != nil {
+		return err
+	}
+
+	op := ops[0]
+	if !ics23.BatchVerifyMembership(op.Spec, storeRoot, op.Proof, items) {
+		return errors.Wrap(storeerrors.ErrInvalidProof, "proof did not verify existence of the given keys")
+	}
+	if !ics23.BatchVerifyNonMembership(op.Spec, storeRoot, op.Proof, absentKeys) {
+		return errors.Wrap(storeerrors.ErrInvalidProof, "proof did not verify absence of the given keys")
+	}
+
+	return nil
+}
+
+// VerifyRangeProof verifies that the proof ops, as returned by GetRangeProof of
+// the Committer, prove the key/value pairs in the range [start, end) against the
+// given root, and returns them in ascending order of keys. A nil start or end
+// leaves the range open on that side.
+//
+// The returned pairs are all the pairs of the store in the range, so an empty
+// result proves the absence of any key in the range, e.g. of any key with a given
+// prefix.
+func VerifyRangeProof(ops []CommitmentOp, root, start, end []byte) ([]KVPair, error) {
+	if start != nil && end != nil && bytes.Compare(start, end) >= 0 {
+		return nil, errors.Wrapf(storeerrors.ErrInvalidProof, "invalid range [%X, %X)", start, end)
+	}
+
+	storeRoot, err := verifyStoreRoot(ops, root)
+	if err != nil {
+		return nil, err
+	}
+
+	return verifyRange(ops[0].Spec, storeRoot, ops[0].Proof, start, end)
+}
+
+// verifyStoreRoot calculates the root of the store tree from the first op, and
+// verifies that the next ops chain it up to the given root.
+func verifyStoreRoot(ops []CommitmentOp, root []byte) ([]byte, error) {
+	if len(ops) == 0 {
+		return nil, errors.Wrap(storeerrors.ErrInvalidProof, "empty proof ops")
+	}
+
+	storeRoot, err := ops[0].Proof.Calculate()
+	if err != nil {
+		return nil, errors.Wrapf(storeerrors.ErrInvalidProof, "could not calculate root for proof: %v", err)
+	}
+
+	args := [][]byte{storeRoot}
+	for _, op := range ops[1:] {
+		args, err = op.Run(args)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if !bytes.Equal(args[0], root) {
+		return nil, errors.Wrapf(storeerrors.ErrInvalidProof, "calculated root %X doesn't match root %X", args[0], root)
+	}
+
+	return storeRoot, nil
+}
+
+// verifyRange verifies that the batch proof holds existence proofs of consecutive
+// keys of the tree, covering the range [start, end) and, if any, the keys right
+// before and right after the range. It returns the pairs of the keys in the range.
+func verifyRange(spec *ics23.ProofSpec, root []byte, proof *ics23.CommitmentProof, start, end []byte) ([]KVPair, error) {
+	if spec.PrehashKeyBeforeComparison {
+		return nil, errors.Wrap(storeerrors.ErrInvalidProof, "range proofs are not supported for trees ordered by hashed keys")
+	}
+
+	batch := ics23.Decompress(proof).GetBatch()
+	if batch == nil || len(batch.Entries) == 0 {
+		return nil, errors.Wrap(storeerrors.ErrInvalidProof, "range proof must be a non-empty batch proof")
+	}
+
+	exists := make([]*ics23.ExistenceProof, len(batch.Entries))
+	for i, entry := range batch.Entries {
+		exist := entry.GetExist()
+		if exist == nil {
+			return nil, errors.Wrapf(storeerrors.ErrInvalidProof, "entry %d of the range proof is not an existence proof", i)
+		}
+		if err := exist.Verify(spec, root, exist.Key, exist.Value); err != nil {
+			return nil, errors.Wrapf(storeerrors.ErrInvalidProof, "proof did not verify existence of key %X: %v", exist.Key, err)
+		}
+
+		if i > 0 {
+			prev := exists[i-1]
+			if bytes.Compare(prev.Key, exist.Key) >= 0 {
+				return nil, errors.Wrapf(storeerrors.ErrInvalidProof, "keys %X and %X of the range proof are not in ascending order", prev.Key, exist.Key)
+			}
+			if !ics23.IsLeftNeighbor(spec.InnerSpec, prev.Path, exist.Path) {
+				return nil, errors.Wrapf(storeerrors.ErrInvalidProof, "keys %X and %X of the range proof are not neighbors", prev.Key, exist.Key)
+			}
+		}
+		exists[i] = exist
+	}
+
+	// the keys before the range, if any, must be the first key of the proof, and
+	// the keys after the range the last one, otherwise the first and last keys of
+	// the proof must be the leftmost and rightmost keys of the tree
+	pairs := make([]KVPair, 0, len(exists))
+	for i, exist := range exists {
+		switch {
+		case start != nil && bytes.Compare(exist.Key, start) < 0:
+			if i != 0 {
+				return nil, errors.Wrapf(storeerrors.ErrInvalidProof, "unexpected key %X before the range", exist.Key)
+			}
+
+		case end != nil && bytes.Compare(exist.Key, end) >= 0:
+			if i != len(exists)-1 {
+				return nil, errors.Wrapf(storeerrors.ErrInvalidProof, "unexpected key %X after the range", exist.Key)
+			}
+
+		default:
+			if i == 0 && !ics23.IsLeftMost(spec.InnerSpec, exist.Path) {
+				return nil, errors.Wrap(storeerrors.ErrInvalidProof, "range proof is missing the key before the range")
+			}
+			if i == len(exists)-1 && !ics23.IsRightMost(spec.InnerSpec, exist.Path) {
+				return nil, errors.Wrap(storeerrors.ErrInvalidProof, "range proof is missing the key after the range")
+			}
+			pairs = append(pairs, KVPair{Key: exist.Key, Value: exist.Value})
+		}
+	}
+
+	// a single key before or after the range must be the rightmost or leftmost key
+	// of the tree to prove the range is empty
+	if len(exists) == 1 && len(pairs) == 0 {
+		exist := exists[0]
+		if start != nil && bytes.Compare(exist.Key, start) < 0 && !ics23.IsRightMost(spec.InnerSpec, exist.Path) {
+			return nil, errors.Wrap(storeerrors.ErrInvalidProof, "range proof is missing the key after the range")
+		}
+		if end != nil && bytes.Compare(exist.Key, end) >= 0 && !ics23.IsLeftMost(spec.InnerSpec, exist.Path) {
+			return nil, errors.Wrap(storeerrors.ErrInvalidProof, "range proof is missing the key before the range")
+		}
+	}
+
+	return pairs, nil
+}
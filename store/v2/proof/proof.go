@@ -94,6 +94,9 @@ func (op CommitmentOp) GetKey() []byte {
 // the CommitmentRoot of the proof. If length 0 args is passed in, then CommitmentOp
 // will attempt to prove the absence of the key in the CommitmentOp and return the
 // CommitmentRoot of the proof.
+//
+// Batch proofs, as returned for many keys or a range of keys, are verified with
+// VerifyBatchProof and VerifyRangeProof instead.
 func (op CommitmentOp) Run(args [][]byte) ([][]byte, error) {
 	// calculate root from proof
 	root, err := op.Proof.Calculate()
@@ -1,6 +1,14 @@
 package pruning
 
-import "cosmossdk.io/store/v2"
+import (
+	"sync"
+	"time"
+
+	"cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/snapshots"
+)
+
+var _ snapshots.PruningSignaler = (*Manager)(nil)
 
 // Manager is a struct that manages the pruning of old versions of the SC and SS.
 type Manager struct {
@@ -12,6 +20,14 @@ type Manager struct {
 	ssPruner store.Pruner
 	// ssPruningOptions are the pruning options for the SS.
 	ssPruningOptions *store.PruneOptions
+
+	mtx sync.Mutex // guards the fields below
+	// commitTime is the block time of the version being committed, from which the
+	// KeepDuration of the pruning options is measured.
+	commitTime time.Time
+	// snapshotVersions counts the snapshots being taken by the first version they
+	// read, which must not be pruned until they are done.
+	snapshotVersions map[uint64]int
 }
 
 // NewManager creates a new Pruning Manager.
@@ -21,34 +37,118 @@ func NewManager(scPruner, ssPruner store.Pruner, scPruningOptions, ssPruningOpti
 		scPruningOptions: scPruningOptions,
 		ssPruner:         ssPruner,
 		ssPruningOptions: ssPruningOptions,
+		snapshotVersions: make(map[uint64]int),
 	}
 }
 
+// SetSCPruner sets the pruner for the SC, e.g. once the SC is migrated.
+func (m *Manager) SetSCPruner(scPruner store.Pruner) {
+	m.scPruner = scPruner
+}
+
+// SetCommitTime sets the block time of the version being committed. The
+// KeepDuration of the pruning options is measured from it, using the index of
+// the block times of the SS, without which no version is pruned.
+func (m *Manager) SetCommitTime(t time.Time) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	m.commitTime = t
+}
+
 // Prune prunes the SC and SS to the provided version.
 //
 // NOTE: It can be called outside of the store manually.
 func (m *Manager) Prune(version uint64) error {
 	// Prune the SC.
 	if m.scPruningOptions != nil {
-		if prune, pruneTo := m.scPruningOptions.ShouldPrune(version); prune {
-			if err := m.scPruner.Prune(pruneTo); err != nil {
-				return err
-			}
+		if err := m.prune(m.scPruner, m.scPruningOptions, version); err != nil {
+			return err
 		}
 	}
 
 	// Prune the SS.
 	if m.ssPruningOptions != nil {
-		if prune, pruneTo := m.ssPruningOptions.ShouldPrune(version); prune {
-			if err := m.ssPruner.Prune(pruneTo); err != nil {
-				return err
-			}
+		if err := m.prune(m.ssPruner, m.ssPruningOptions, version); err != nil {
+			return err
 		}
 	}
 
 	return nil
 }
 
+// prune prunes the pruner at the given version according to the given options.
+// The stores whose pruning is overridden are pruned to their own version if the
+// pruner is a StorePruner, otherwise the pruner is pruned to the lowest version
+// of all stores.
+func (m *Manager) prune(pruner store.Pruner, opts *store.PruneOptions, version uint64) error {
+	prune, pruneTo := opts.ShouldPrune(version)
+	if !prune {
+		return nil
+	}
+	if pruneTo = m.retainedVersion(opts, version, pruneTo); pruneTo == 0 {
+		return nil
+	}
+
+	if len(opts.StoreOptions) == 0 {
+		return pruner.Prune(pruneTo)
+	}
+
+	storeVersions := make(map[string]uint64, len(opts.StoreOptions))
+	minVersion := pruneTo
+	for storeKey, storeOpts := range opts.StoreOptions {
+		var storeVersion uint64
+		if storeOpts != nil {
+			if prune, storePruneTo := storeOpts.PruneVersion(version); prune {
+				storeVersion = m.retainedVersion(storeOpts, version, storePruneTo)
+			}
+		}
+		storeVersions[storeKey] = storeVersion
+		minVersion = min(minVersion, storeVersion)
+	}
+
+	if storePruner, ok := pruner.(store.StorePruner); ok {
+		return storePruner.PruneStores(pruneTo, storeVersions)
+	}
+	if minVersion == 0 {
+		return nil
+	}
+
+	return pruner.Prune(minVersion)
+}
+
+// retainedVersion lowers the version to prune up to at the given version, to
+// keep the KeepDuration of the options and the versions read by the snapshots
+// being taken. It returns 0 if no version can be pruned.
+func (m *Manager) retainedVersion(opts *store.PruneOptions, version, pruneTo uint64) uint64 {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	for snapshotVersion := range m.snapshotVersions {
+		if snapshotVersion <= pruneTo {
+			if snapshotVersion == 0 {
+				return 0
+			}
+			pruneTo = snapshotVersion - 1
+		}
+	}
+
+	if opts.KeepDuration > 0 {
+		indexer, ok := m.ssPruner.(store.VersionTimeIndexer)
+		if !ok || m.commitTime.IsZero() {
+			return 0
+		}
+		// keep the last version committed at or before the cutoff
+		keptVersion, err := indexer.GetVersionAtTime(m.commitTime.Add(-opts.KeepDuration))
+		if err != nil || keptVersion == 0 || keptVersion > version {
+			return 0
+		}
+		pruneTo = min(pruneTo, keptVersion-1)
+	}
+
+	return pruneTo
+}
+
 // SignalCommit signals to the manager that a commit has started or finished.
 // It is used to trigger the pruning of the SC and SS.
 // It pauses or resumes the pruning of the SC and SS if the pruner implements
@@ -67,3 +167,18 @@ func (m *Manager) SignalCommit(start bool, version uint64) error {
 
 	return nil
 }
+
+// SignalSnapshot signals to the manager that a snapshot reading the versions from
+// the given one has started or finished. The versions read by the snapshots being
+// taken are not pruned, including by the background pruning of a PausablePruner
+// which is only given versions to prune up to below them.
+func (m *Manager) SignalSnapshot(start bool, version uint64) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	if start {
+		m.snapshotVersions[version]++
+	} else if m.snapshotVersions[version]--; m.snapshotVersions[version] <= 0 {
+		delete(m.snapshotVersions, version)
+	}
+}
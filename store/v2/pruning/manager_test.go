@@ -112,6 +112,99 @@ func (s *PruningManagerTestSuite) TestPrune() {
 	}
 }
 
+// applySSChangesets applies a changeset per version to the SS, indexing the block
+// time of each version as an hour after the previous one.
+func (s *PruningManagerTestSuite) applySSChangesets(toVersion uint64, genesisTime time.Time) {
+	for version := uint64(1); version <= toVersion; version++ {
+		cs := corestore.NewChangeset()
+		for _, storeKey := range storeKeys {
+			cs.Add([]byte(storeKey), []byte(fmt.Sprintf("key-%d", version)), []byte(fmt.Sprintf("value-%d", version)), false)
+		}
+		s.Require().NoError(s.ss.ApplyChangesetAtTime(version, cs, genesisTime.Add(time.Duration(version)*time.Hour)))
+	}
+}
+
+// requireSSPruned checks that the versions of the store up to prunedVersion are
+// pruned and the later ones are kept.
+func (s *PruningManagerTestSuite) requireSSPruned(storeKey string, toVersion, prunedVersion uint64) {
+	for version := uint64(1); version <= toVersion; version++ {
+		value, err := s.ss.Get([]byte(storeKey), version, []byte(fmt.Sprintf("key-%d", version)))
+		if version <= prunedVersion {
+			s.Require().Error(err, "store %s version %d", storeKey, version)
+		} else {
+			s.Require().NoError(err, "store %s version %d", storeKey, version)
+			s.Require().Equal([]byte(fmt.Sprintf("value-%d", version)), value)
+		}
+	}
+}
+
+func (s *PruningManagerTestSuite) TestPruneKeepDuration() {
+	genesisTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.applySSChangesets(100, genesisTime)
+
+	manager := NewManager(s.sc, s.ss, nil, &store.PruneOptions{
+		KeepRecent:   5,
+		Interval:     10,
+		KeepDuration: 10 * time.Hour,
+	})
+
+	// without the block time, the KeepDuration can't be measured
+	s.Require().NoError(manager.Prune(100))
+	s.requireSSPruned("store1", 100, 0)
+
+	// the versions committed within the last 10 hours, and the last one before, are kept
+	manager.SetCommitTime(genesisTime.Add(100 * time.Hour))
+	s.Require().NoError(manager.Prune(100))
+	s.requireSSPruned("store1", 100, 89)
+}
+
+func (s *PruningManagerTestSuite) TestPruneStoreOptions() {
+	s.applySSChangesets(100, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	ssPruneOptions := &store.PruneOptions{
+		KeepRecent: 5,
+		Interval:   10,
+		StoreOptions: map[string]*store.PruneOptions{
+			"store1": {KeepRecent: 20, Interval: 10},
+		},
+	}
+	manager := NewManager(s.sc, s.ss, nil, ssPruneOptions)
+
+	// the SS can't prune the stores separately, so it is pruned to the lowest version
+	s.Require().NoError(manager.Prune(100))
+	for _, storeKey := range storeKeys {
+		s.requireSSPruned(storeKey, 100, 79)
+	}
+
+	// a store kept forever prevents the whole SS from being pruned further
+	ssPruneOptions.StoreOptions["store1"] = &store.PruneOptions{}
+	s.Require().NoError(manager.Prune(100))
+	s.requireSSPruned("store2", 100, 79)
+}
+
+func (s *PruningManagerTestSuite) TestSignalSnapshot() {
+	s.applySSChangesets(100, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	manager := NewManager(s.sc, s.ss, nil, &store.PruneOptions{
+		KeepRecent: 5,
+		Interval:   10,
+	})
+
+	// the versions read by the snapshots being taken are kept
+	manager.SignalSnapshot(true, 50)
+	manager.SignalSnapshot(true, 50)
+	s.Require().NoError(manager.Prune(100))
+	s.requireSSPruned("store1", 100, 49)
+
+	manager.SignalSnapshot(false, 50)
+	s.Require().NoError(manager.Prune(100))
+	s.requireSSPruned("store1", 100, 49)
+
+	manager.SignalSnapshot(false, 50)
+	s.Require().NoError(manager.Prune(100))
+	s.requireSSPruned("store1", 100, 94)
+}
+
 func TestPruneOptions(t *testing.T) {
 	testCases := []struct {
 		name         string
@@ -1,8 +1,12 @@
 package root
 
 import (
+	"errors"
 	"fmt"
 	"os"
+	"sort"
+
+	"github.com/cockroachdb/pebble"
 
 	"cosmossdk.io/core/log"
 	corestore "cosmossdk.io/core/store"
@@ -13,7 +17,9 @@ import (
 	"cosmossdk.io/store/v2/db"
 	"cosmossdk.io/store/v2/internal"
 	"cosmossdk.io/store/v2/pruning"
+	"cosmossdk.io/store/v2/snapshots"
 	"cosmossdk.io/store/v2/storage"
+	"cosmossdk.io/store/v2/storage/partitioned"
 	"cosmossdk.io/store/v2/storage/pebbledb"
 	"cosmossdk.io/store/v2/storage/sqlite"
 )
@@ -27,20 +33,52 @@ const (
 	SSTypeSQLite SSType = 0
 	SSTypePebble SSType = 1
 	SSTypeRocks  SSType = 2
-	SCTypeIavl   SCType = 0
-	SCTypeIavlV2 SCType = 1
+	// SSTypePebblePartitioned gives the store keys of SSPartitions their own
+	// PebbleDB instance, see the storage/partitioned package.
+	SSTypePebblePartitioned SSType = 3
+	SCTypeIavl              SCType = 0
+	SCTypeIavlV2            SCType = 1
 )
 
+// SSPartitionOptions defines the options of the partition of a store key, used
+// with SSTypePebblePartitioned.
+type SSPartitionOptions struct {
+	// PebbleOptions tunes the PebbleDB instance of the partition. If nil, the
+	// default options are used.
+	PebbleOptions *pebble.Options `mapstructure:"-"`
+	// PruneOptions defines the pruning of the partition. If nil, the partition is
+	// pruned with SSPruneOptions.
+	PruneOptions *store.PruneOptions `mapstructure:"prune_options"`
+}
+
+// FactoryOptions defines the options of CreateRootStore. The fields with a
+// mapstructure tag can be decoded from the node configuration, e.g. the pruning
+// options, including their KeepDuration and per store StoreOptions.
 type FactoryOptions struct {
-	Logger         log.Logger
-	RootDir        string
-	SSType         SSType
-	SCType         SCType
-	SSPruneOptions *store.PruneOptions
-	SCPruneOptions *store.PruneOptions
-	IavlConfig     *iavl.Config
-	StoreKeys      []string
-	SCRawDB        corestore.KVStoreWithBatch
+	Logger         log.Logger                    `mapstructure:"-"`
+	RootDir        string                        `mapstructure:"-"`
+	SSType         SSType                        `mapstructure:"ss_type"`
+	SCType         SCType                        `mapstructure:"sc_type"`
+	SSPruneOptions *store.PruneOptions           `mapstructure:"ss_prune_options"`
+	SCPruneOptions *store.PruneOptions           `mapstructure:"sc_prune_options"`
+	IavlConfig     *iavl.Config                  `mapstructure:"iavl_config"`
+	StoreKeys      []string                      `mapstructure:"-"`
+	SCRawDB        corestore.KVStoreWithBatch    `mapstructure:"-"`
+	SSPartitions   map[string]SSPartitionOptions `mapstructure:"ss_partitions"`
+
+	// SCRawDBType and SCRawDBName define the database of the SC, opened in the data
+	// directory of RootDir when SCRawDB is nil, e.g. from the node configuration, so
+	// that the tools opening the store of a node home find the same database. The
+	// database is then closed with the root store.
+	SCRawDBType db.DBType `mapstructure:"sc_raw_db_type"`
+	SCRawDBName string    `mapstructure:"sc_raw_db_name"`
+
+	// SnapshotStore, if not nil, is the store of the state sync snapshots taken by
+	// a snapshots.Manager created with SnapshotOptions, see Store.GetSnapshotManager.
+	// The pruning manager is notified of the snapshots being taken, so that the
+	// versions they read are not pruned meanwhile.
+	SnapshotStore   *snapshots.Store          `mapstructure:"-"`
+	SnapshotOptions snapshots.SnapshotOptions `mapstructure:"-"`
 }
 
 // CreateRootStore is a convenience function to create a root store based on the
@@ -61,6 +99,13 @@ func CreateRootStore(opts *FactoryOptions) (store.RootStore, error) {
 		}
 	)
 
+	if opts.SCRawDB == nil && opts.SCRawDBName == "" {
+		return nil, errors.New("either SCRawDB or SCRawDBName is required")
+	}
+	if err := validateSSStoreOptions(opts); err != nil {
+		return nil, err
+	}
+
 	switch opts.SSType {
 	case SSTypeSQLite:
 		dir := fmt.Sprintf("%s/data/ss/sqlite", opts.RootDir)
@@ -74,6 +119,8 @@ func CreateRootStore(opts *FactoryOptions) (store.RootStore, error) {
 			return nil, err
 		}
 		ssDb, err = pebbledb.New(dir)
+	case SSTypePebblePartitioned:
+		ssDb, err = createPartitionedDB(fmt.Sprintf("%s/data/ss/pebble-partitioned", opts.RootDir), opts.SSPartitions, ensureDir)
 	case SSTypeRocks:
 		// TODO: rocksdb requires build tags so is not supported here by default
 		return nil, fmt.Errorf("rocksdb not supported")
@@ -83,6 +130,17 @@ func CreateRootStore(opts *FactoryOptions) (store.RootStore, error) {
 	}
 	ss = storage.NewStorageStore(ssDb, opts.Logger)
 
+	scRawDB := opts.SCRawDB
+	if scRawDB == nil {
+		dir := fmt.Sprintf("%s/data", opts.RootDir)
+		if err = ensureDir(dir); err != nil {
+			return nil, err
+		}
+		if scRawDB, err = db.NewDB(opts.SCRawDBType, opts.SCRawDBName, dir, nil); err != nil {
+			return nil, fmt.Errorf("failed to open the SC database %s: %w", opts.SCRawDBName, err)
+		}
+	}
+
 	trees := make(map[string]commitment.Tree)
 	for _, key := range opts.StoreKeys {
 		if internal.IsMemoryStoreKey(key) {
@@ -90,18 +148,108 @@ func CreateRootStore(opts *FactoryOptions) (store.RootStore, error) {
 		} else {
 			switch opts.SCType {
 			case SCTypeIavl:
-				trees[key] = iavl.NewIavlTree(db.NewPrefixDB(opts.SCRawDB, []byte(key)), opts.Logger, opts.IavlConfig)
+				trees[key] = iavl.NewIavlTree(db.NewPrefixDB(scRawDB, []byte(key)), opts.Logger, opts.IavlConfig)
 			case SCTypeIavlV2:
 				return nil, fmt.Errorf("iavl v2 not supported")
 			}
 		}
 	}
-	sc, err = commitment.NewCommitStore(trees, opts.SCRawDB, opts.Logger)
+	sc, err = commitment.NewCommitStore(trees, scRawDB, opts.Logger)
 	if err != nil {
 		return nil, err
 	}
 
 	pm := pruning.NewManager(sc, ss, opts.SCPruneOptions, opts.SSPruneOptions)
 
-	return New(opts.Logger, ss, sc, pm, nil, nil)
+	rs, err := New(opts.Logger, ss, sc, pm, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if opts.SCRawDB == nil {
+		rs.(*Store).scRawDB = scRawDB
+	}
+
+	if opts.SnapshotStore != nil {
+		sm := snapshots.NewManager(opts.SnapshotStore, opts.SnapshotOptions, sc, ss, nil, opts.Logger)
+		sm.SetPruningSignaler(pm)
+		rs.(*Store).snapshotManager = sm
+	}
+
+	return rs, nil
+}
+
+// validateSSStoreOptions checks that the stores whose SS pruning is overridden by
+// the StoreOptions of SSPruneOptions have their own partition. The other state
+// storages, and the default partition, can only be pruned up to the lowest version
+// of their stores, so overriding one of them to keep its history would silently
+// stop pruning all of them.
+func validateSSStoreOptions(opts *FactoryOptions) error {
+	if opts.SSPruneOptions == nil || len(opts.SSPruneOptions.StoreOptions) == 0 {
+		return nil
+	}
+
+	storeKeys := make([]string, 0, len(opts.SSPruneOptions.StoreOptions))
+	for storeKey := range opts.SSPruneOptions.StoreOptions {
+		storeKeys = append(storeKeys, storeKey)
+	}
+	sort.Strings(storeKeys)
+
+	for _, storeKey := range storeKeys {
+		if opts.SSType != SSTypePebblePartitioned {
+			return fmt.Errorf("the SS pruning of store key %s can only be overridden with a partitioned SS", storeKey)
+		}
+		if _, ok := opts.SSPartitions[storeKey]; !ok {
+			return fmt.Errorf("the SS pruning of store key %s can only be overridden with a partition of the SS for it", storeKey)
+		}
+	}
+
+	return nil
+}
+
+// createPartitionedDB creates a partitioned state storage with a PebbleDB
+// instance for each partition, and one for the rest of the store keys.
+func createPartitionedDB(dir string, partitionOpts map[string]SSPartitionOptions, ensureDir func(string) error) (storage.Database, error) {
+	newPebbleDB := func(dir string, opts *pebble.Options) (*pebbledb.Database, error) {
+		if err := ensureDir(dir); err != nil {
+			return nil, err
+		}
+		if opts == nil {
+			opts = &pebble.Options{}
+		}
+		return pebbledb.NewWithOptions(dir, opts)
+	}
+
+	defaultDB, err := newPebbleDB(fmt.Sprintf("%s/_default", dir), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// close the databases already opened if one fails to open
+	partitions := make([]partitioned.Partition, 0, len(partitionOpts))
+	closeAll := func(err error) error {
+		errs := []error{err, defaultDB.Close()}
+		for _, p := range partitions {
+			errs = append(errs, p.DB.Close())
+		}
+		return errors.Join(errs...)
+	}
+
+	for storeKey, opts := range partitionOpts {
+		db, err := newPebbleDB(fmt.Sprintf("%s/%s", dir, storeKey), opts.PebbleOptions)
+		if err != nil {
+			return nil, closeAll(err)
+		}
+		partitions = append(partitions, partitioned.Partition{
+			StoreKey:     storeKey,
+			DB:           db,
+			PruneOptions: opts.PruneOptions,
+		})
+	}
+
+	partitionedDB, err := partitioned.New(defaultDB, partitions...)
+	if err != nil {
+		return nil, closeAll(err)
+	}
+
+	return partitionedDB, nil
 }
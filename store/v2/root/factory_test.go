@@ -0,0 +1,166 @@
+package root
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/log"
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/commitment/iavl"
+	dbm "cosmossdk.io/store/v2/db"
+	storeerrors "cosmossdk.io/store/v2/errors"
+	"cosmossdk.io/store/v2/snapshots"
+)
+
+func TestCreatePartitionedDB(t *testing.T) {
+	dir := t.TempDir()
+	ensureDir := func(dir string) error {
+		if filepath.Base(dir) == "bad" {
+			return errors.New("bad directory")
+		}
+		return os.MkdirAll(dir, 0o0755)
+	}
+
+	// the databases already opened are closed when a partition fails to open
+	_, err := createPartitionedDB(dir, map[string]SSPartitionOptions{"bank": {}, "dex": {}, "bad": {}}, ensureDir)
+	require.ErrorContains(t, err, "bad directory")
+
+	db, err := createPartitionedDB(dir, map[string]SSPartitionOptions{"bank": {}, "dex": {}}, ensureDir)
+	require.NoError(t, err)
+	require.NoError(t, db.SetLatestVersion(10))
+	require.NoError(t, db.Close())
+
+	// a partition added to the existing state storage misses the state of its store key
+	_, err = createPartitionedDB(dir, map[string]SSPartitionOptions{"bank": {}, "dex": {}, "gov": {}}, ensureDir)
+	require.ErrorContains(t, err, "partition of store key gov is at version 0 while the state storage is at version 10")
+
+	db, err = createPartitionedDB(dir, map[string]SSPartitionOptions{"bank": {}, "dex": {}}, ensureDir)
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+}
+
+func TestCreateRootStore_SnapshotManager(t *testing.T) {
+	newOpts := func() *FactoryOptions {
+		return &FactoryOptions{
+			Logger:     log.NewNopLogger(),
+			RootDir:    t.TempDir(),
+			SSType:     SSTypePebble,
+			SCType:     SCTypeIavl,
+			IavlConfig: iavl.DefaultConfig(),
+			StoreKeys:  []string{testStoreKey},
+			SCRawDB:    dbm.NewMemDB(),
+		}
+	}
+
+	// without a snapshot store, the root store has no snapshot manager
+	rs, err := CreateRootStore(newOpts())
+	require.NoError(t, err)
+	require.Nil(t, rs.(*Store).GetSnapshotManager())
+	require.NoError(t, rs.Close())
+
+	opts := newOpts()
+	opts.SnapshotStore, err = snapshots.NewStore(t.TempDir())
+	require.NoError(t, err)
+	rs, err = CreateRootStore(opts)
+	require.NoError(t, err)
+	defer rs.Close()
+
+	for v := uint64(1); v <= 5; v++ {
+		_, err := rs.Commit(corestore.NewChangesetWithPairs(map[string]corestore.KVPairs{
+			testStoreKey: {{Key: []byte("key"), Value: []byte{byte(v)}}},
+		}))
+		require.NoError(t, err)
+	}
+
+	// the snapshots are taken from the stores of the root store
+	sm := rs.(*Store).GetSnapshotManager()
+	require.NotNil(t, sm)
+	snapshot, err := sm.Create(5)
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), snapshot.Height)
+}
+
+func TestCreateRootStore_SSStoreOptions(t *testing.T) {
+	newOpts := func(ssType SSType, partitions map[string]SSPartitionOptions) *FactoryOptions {
+		return &FactoryOptions{
+			Logger:     log.NewNopLogger(),
+			RootDir:    t.TempDir(),
+			SSType:     ssType,
+			SCType:     SCTypeIavl,
+			IavlConfig: iavl.DefaultConfig(),
+			StoreKeys:  []string{"bank", "gov"},
+			SCRawDB:    dbm.NewMemDB(),
+			SSPruneOptions: &store.PruneOptions{
+				KeepRecent: 1,
+				Interval:   1,
+				// keep the history of gov forever
+				StoreOptions: map[string]*store.PruneOptions{"gov": {Interval: 0}},
+			},
+			SSPartitions: partitions,
+		}
+	}
+
+	// the SS pruning of a store without a partition can't be overridden
+	_, err := CreateRootStore(newOpts(SSTypePebble, nil))
+	require.ErrorContains(t, err, "the SS pruning of store key gov can only be overridden with a partitioned SS")
+	_, err = CreateRootStore(newOpts(SSTypePebblePartitioned, map[string]SSPartitionOptions{"bank": {}}))
+	require.ErrorContains(t, err, "the SS pruning of store key gov can only be overridden with a partition of the SS for it")
+
+	rs, err := CreateRootStore(newOpts(SSTypePebblePartitioned, map[string]SSPartitionOptions{"gov": {}}))
+	require.NoError(t, err)
+	defer rs.Close()
+
+	for v := uint64(1); v <= 5; v++ {
+		_, err := rs.Commit(corestore.NewChangesetWithPairs(map[string]corestore.KVPairs{
+			"bank": {{Key: []byte("key"), Value: []byte{byte(v)}}},
+			"gov":  {{Key: []byte("key"), Value: []byte{byte(v)}}},
+		}))
+		require.NoError(t, err)
+	}
+
+	// the default partition is pruned while the history of gov is kept
+	ss := rs.(*Store).GetStateStorage()
+	_, err = ss.Get([]byte("bank"), 1, []byte("key"))
+	require.ErrorAs(t, err, &storeerrors.ErrVersionPruned{})
+	bz, err := ss.Get([]byte("gov"), 1, []byte("key"))
+	require.NoError(t, err)
+	require.Equal(t, []byte{1}, bz)
+}
+
+func TestCreateRootStore_SCRawDBName(t *testing.T) {
+	opts := &FactoryOptions{
+		Logger:     log.NewNopLogger(),
+		RootDir:    t.TempDir(),
+		SSType:     SSTypePebble,
+		SCType:     SCTypeIavl,
+		IavlConfig: iavl.DefaultConfig(),
+		StoreKeys:  []string{testStoreKey},
+	}
+
+	_, err := CreateRootStore(opts)
+	require.ErrorContains(t, err, "either SCRawDB or SCRawDBName is required")
+
+	// the database of the SC is opened in the data directory, and closed with the store
+	opts.SCRawDBType = dbm.DBTypeGoLevelDB
+	opts.SCRawDBName = "state"
+	rs, err := CreateRootStore(opts)
+	require.NoError(t, err)
+	_, err = rs.Commit(corestore.NewChangesetWithPairs(map[string]corestore.KVPairs{
+		testStoreKey: {{Key: []byte("key"), Value: []byte("value")}},
+	}))
+	require.NoError(t, err)
+	require.DirExists(t, filepath.Join(opts.RootDir, "data", "state.db"))
+	require.NoError(t, rs.Close())
+
+	rs, err = CreateRootStore(opts)
+	require.NoError(t, err)
+	defer rs.Close()
+	version, err := rs.GetLatestVersion()
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), version)
+}
@@ -13,6 +13,7 @@ import (
 	"cosmossdk.io/store/v2"
 	"cosmossdk.io/store/v2/commitment"
 	"cosmossdk.io/store/v2/commitment/iavl"
+	"cosmossdk.io/store/v2/commitment/mem"
 	dbm "cosmossdk.io/store/v2/db"
 	"cosmossdk.io/store/v2/migration"
 	"cosmossdk.io/store/v2/pruning"
@@ -161,3 +162,114 @@ func (s *MigrateStoreTestSuite) TestMigrateState() {
 	s.Require().NoError(err)
 	s.Require().Equal(latestVersion+10, version)
 }
+
+// divergingTree is a commitment.Tree writing a wrong value for the key of version
+// 21, the first version replayed after the state commitment is copied at version
+// 20, so that the leaves only differ at the checkpoint.
+type divergingTree struct {
+	commitment.Tree
+}
+
+func (t divergingTree) Set(key, value []byte) error {
+	if string(key) == "key-21" {
+		value = []byte("diverged")
+	}
+	return t.Tree.Set(key, value)
+}
+
+func TestMigrateCommitment(t *testing.T) {
+	// newTree returns the migrated tree of store2
+	testCases := []struct {
+		name        string
+		newTree     func() commitment.Tree
+		expMigrated bool
+		// provable is false when the migrated SC store can't prove its values
+		provable bool
+	}{
+		{"verified root hashes", func() commitment.Tree {
+			return iavl.NewIavlTree(dbm.NewMemDB(), corelog.NewNopLogger(), iavl.DefaultConfig())
+		}, true, true},
+		{"verified leaves of another hashing scheme", func() commitment.Tree { return mem.New() }, true, false},
+		{"leaf mismatch at the checkpoint", func() commitment.Tree {
+			return divergingTree{iavl.NewIavlTree(dbm.NewMemDB(), corelog.NewNopLogger(), iavl.DefaultConfig())}
+		}, false, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			testLog := log.NewTestLogger(t)
+			nopLog := corelog.NewNopLogger()
+			require := suite.Suite{}
+			require.SetT(t)
+
+			newCommitStore := func(newStore2Tree func() commitment.Tree) *commitment.CommitStore {
+				trees := make(map[string]commitment.Tree)
+				for _, storeKey := range storeKeys {
+					trees[storeKey] = iavl.NewIavlTree(dbm.NewMemDB(), nopLog, iavl.DefaultConfig())
+				}
+				if newStore2Tree != nil {
+					trees["store2"] = newStore2Tree()
+				}
+				sc, err := commitment.NewCommitStore(trees, dbm.NewMemDB(), testLog)
+				require.Require().NoError(err)
+				return sc
+			}
+
+			sqliteDB, err := sqlite.New(t.TempDir())
+			require.Require().NoError(err)
+			ss := storage.NewStorageStore(sqliteDB, testLog)
+			orgSC := newCommitStore(nil)
+			rs, err := New(testLog, ss, orgSC, pruning.NewManager(orgSC, ss, nil, nil), nil, nil)
+			require.Require().NoError(err)
+			rootStore := rs.(*Store)
+
+			commit := func(version uint64) {
+				cs := corestore.NewChangeset()
+				for _, storeKey := range storeKeys {
+					cs.Add([]byte(storeKey), []byte(fmt.Sprintf("key-%d", version)), []byte(fmt.Sprintf("value-%d", version)), false)
+				}
+				_, err := rootStore.Commit(cs)
+				require.Require().NoError(err)
+			}
+
+			version := uint64(1)
+			for ; version <= 20; version++ {
+				commit(version)
+			}
+
+			targetSC := newCommitStore(tc.newTree)
+			mm := migration.NewCommitmentMigrationManager(dbm.NewMemDB(), orgSC, targetSC, nil, testLog)
+			require.Require().NoError(rootStore.StartCommitmentMigration(mm))
+			require.Require().Error(rootStore.StartCommitmentMigration(mm))
+
+			// keep committing while the SC backend is migrated
+			for ; version <= 200 && (rootStore.isMigratingSC || version <= 25); version++ {
+				commit(version)
+				time.Sleep(10 * time.Millisecond)
+			}
+			require.Require().False(rootStore.isMigratingSC)
+
+			if tc.expMigrated {
+				require.Require().Same(targetSC, rootStore.GetStateCommitment())
+			} else {
+				require.Require().Same(orgSC, rootStore.GetStateCommitment())
+			}
+
+			// keep committing and querying against the resulting SC backend
+			latestVersion := version + 5
+			for ; version <= latestVersion; version++ {
+				commit(version)
+			}
+			for v := uint64(1); v <= latestVersion; v++ {
+				for _, storeKey := range storeKeys {
+					res, err := rootStore.Query([]byte(storeKey), latestVersion, []byte(fmt.Sprintf("key-%d", v)), tc.provable)
+					require.Require().NoError(err)
+					require.Require().Equal([]byte(fmt.Sprintf("value-%d", v)), res.Value)
+					if tc.provable {
+						require.Require().NotEmpty(res.ProofOps)
+					}
+				}
+			}
+		})
+	}
+}
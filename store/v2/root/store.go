@@ -17,6 +17,7 @@ import (
 	"cosmossdk.io/store/v2/migration"
 	"cosmossdk.io/store/v2/proof"
 	"cosmossdk.io/store/v2/pruning"
+	"cosmossdk.io/store/v2/snapshots"
 )
 
 var _ store.RootStore = (*Store)(nil)
@@ -47,6 +48,17 @@ type Store struct {
 	// pruningManager reflects the pruning manager used to prune state of the SS and SC backends
 	pruningManager *pruning.Manager
 
+	// commitListeners reflects the listeners notified of each committed changeset
+	commitListeners []store.CommitListener
+
+	// snapshotManager reflects the state sync snapshot manager created with the
+	// store, if any, see FactoryOptions.SnapshotStore
+	snapshotManager *snapshots.Manager
+
+	// scRawDB reflects the database of the SC backend opened by CreateRootStore, if
+	// any, which is closed with the store, see FactoryOptions.SCRawDBName
+	scRawDB corestore.KVStoreWithBatch
+
 	// Migration related fields
 	// migrationManager reflects the migration manager used to migrate state from v1 to v2
 	migrationManager *migration.Manager
@@ -57,6 +69,9 @@ type Store struct {
 	chDone chan struct{}
 	// isMigrating reflects whether the store is currently migrating
 	isMigrating bool
+	// isMigratingSC reflects whether the store is currently migrating only the
+	// SC backend, while committing to both the SS and SC backends
+	isMigratingSC bool
 }
 
 // New creates a new root Store instance.
@@ -87,6 +102,9 @@ func New(
 func (s *Store) Close() (err error) {
 	err = errors.Join(err, s.stateStorage.Close())
 	err = errors.Join(err, s.stateCommitment.Close())
+	if s.scRawDB != nil {
+		err = errors.Join(err, s.scRawDB.Close())
+	}
 
 	s.stateStorage = nil
 	s.stateCommitment = nil
@@ -100,6 +118,10 @@ func (s *Store) SetMetrics(m metrics.Metrics) {
 	s.telemetry = m
 }
 
+func (s *Store) AddCommitListener(l store.CommitListener) {
+	s.commitListeners = append(s.commitListeners, l)
+}
+
 func (s *Store) SetInitialVersion(v uint64) error {
 	s.initialVersion = v
 
@@ -135,6 +157,12 @@ func (s *Store) GetStateCommitment() store.Committer {
 	return s.stateCommitment
 }
 
+// GetSnapshotManager returns the state sync snapshot manager created with the
+// store by CreateRootStore, or nil if the store has none.
+func (s *Store) GetSnapshotManager() *snapshots.Manager {
+	return s.snapshotManager
+}
+
 // LastCommitID returns a CommitID based off of the latest internal CommitInfo.
 // If an internal CommitInfo is not set, a new one will be returned with only the
 // latest version set, which is based off of the SC view.
@@ -210,6 +238,24 @@ func (s *Store) Query(storeKey []byte, version uint64, key []byte, prove bool) (
 	return result, nil
 }
 
+func (s *Store) GetVersionAtTime(t time.Time) (uint64, error) {
+	indexer, ok := s.stateStorage.(store.VersionTimeIndexer)
+	if !ok {
+		return 0, errors.New("SS store does not index block times")
+	}
+
+	return indexer.GetVersionAtTime(t)
+}
+
+func (s *Store) QueryAtTime(storeKey []byte, t time.Time, key []byte, prove bool) (store.QueryResult, error) {
+	version, err := s.GetVersionAtTime(t)
+	if err != nil {
+		return store.QueryResult{}, fmt.Errorf("failed to get version at time %s: %w", t.UTC().Format(time.RFC3339Nano), err)
+	}
+
+	return s.Query(storeKey, version, key, prove)
+}
+
 func (s *Store) LoadLatestVersion() error {
 	if s.telemetry != nil {
 		now := time.Now()
@@ -292,6 +338,17 @@ func (s *Store) Commit(cs *corestore.Changeset) ([]byte, error) {
 	if !s.isMigrating {
 		// commit SS async
 		eg.Go(func() error {
+			// index the block time of the version with its changes to support
+			// queries by time
+			indexer, ok := s.stateStorage.(store.VersionTimeIndexer)
+			if ok && s.commitHeader != nil && !s.commitHeader.Time.IsZero() {
+				if err := indexer.ApplyChangesetAtTime(version, cs, s.commitHeader.Time); err != nil {
+					return fmt.Errorf("failed to commit SS: %w", err)
+				}
+
+				return nil
+			}
+
 			if err := s.stateStorage.ApplyChangeset(version, cs); err != nil {
 				return fmt.Errorf("failed to commit SS: %w", err)
 			}
@@ -313,7 +370,11 @@ func (s *Store) Commit(cs *corestore.Changeset) ([]byte, error) {
 		return nil, err
 	}
 
-	// signal to the pruning manager that the commit is done
+	// signal to the pruning manager that the commit is done, the KeepDuration of
+	// the pruning options being measured from the block time of the version
+	if s.commitHeader != nil {
+		s.pruningManager.SetCommitTime(s.commitHeader.Time)
+	}
 	if err := s.pruningManager.SignalCommit(false, version); err != nil {
 		s.logger.Error("failed to signal commit done to pruning manager", "err", err)
 	}
@@ -322,6 +383,10 @@ func (s *Store) Commit(cs *corestore.Changeset) ([]byte, error) {
 		s.lastCommitInfo.Timestamp = s.commitHeader.Time
 	}
 
+	for _, l := range s.commitListeners {
+		l.ListenCommit(version, cs)
+	}
+
 	return s.lastCommitInfo.Hash(), nil
 }
 
@@ -354,31 +419,95 @@ func (s *Store) startMigration() {
 	defer mtx.Unlock()
 }
 
+// StartCommitmentMigration starts migrating the SC backend with the given
+// migration manager, created with migration.NewCommitmentMigrationManager from
+// the current SC backend, while the store keeps committing. Once the migration
+// has caught up with the latest version, the migrated SC backend is verified and
+// the store cuts over to it at the next commit. If the verification fails, the
+// migration is aborted and the store keeps the current SC backend.
+func (s *Store) StartCommitmentMigration(mm *migration.Manager) error {
+	if s.isMigrating || s.isMigratingSC {
+		return errors.New("a migration is already in progress")
+	}
+	if s.lastCommitInfo == nil || s.lastCommitInfo.Version == 0 {
+		return errors.New("no version committed to migrate")
+	}
+
+	s.migrationManager = mm
+	s.isMigratingSC = true
+	s.startMigration()
+
+	return nil
+}
+
+// completeMigration closes the migration channels and the migration manager,
+// and replaces the SC backend with the migrated one. If only the SC backend is
+// migrated, it is verified first, and the migration is aborted on failure.
+func (s *Store) completeMigration() error {
+	if s.isMigratingSC {
+		if err := s.migrationManager.Verify(s.lastCommitInfo.Version); err != nil {
+			return s.abortSCMigration(fmt.Errorf("failed to verify the migrated SC store: %w", err))
+		}
+	}
+
+	close(s.chDone)
+	close(s.chChangeset)
+	s.isMigrating = false
+	s.isMigratingSC = false
+
+	// close the old state commitment and replace it with the new one
+	newStateCommitment := s.migrationManager.GetStateCommitment()
+	if newStateCommitment != nil {
+		if err := s.stateCommitment.Close(); err != nil {
+			return fmt.Errorf("failed to close the old SC store: %w", err)
+		}
+		s.stateCommitment = newStateCommitment
+		s.pruningManager.SetSCPruner(newStateCommitment)
+	}
+	if err := s.migrationManager.Close(); err != nil {
+		return fmt.Errorf("failed to close migration manager: %w", err)
+	}
+	s.logger.Info("migration completed", "version", s.lastCommitInfo.Version)
+
+	return nil
+}
+
+// abortSCMigration stops the migration of the SC backend, closing the migrated
+// SC backend, and keeps the current one.
+func (s *Store) abortSCMigration(cause error) error {
+	s.logger.Error("SC migration failed, keeping the current SC store", "version", s.lastCommitInfo.Version, "err", cause)
+
+	close(s.chDone)
+	close(s.chChangeset)
+	s.isMigratingSC = false
+
+	if err := errors.Join(s.migrationManager.GetStateCommitment().Close(), s.migrationManager.Close()); err != nil {
+		return fmt.Errorf("failed to close migration manager: %w", err)
+	}
+
+	return nil
+}
+
 // writeSC accepts a Changeset and writes that as a batch to the underlying SC
 // tree, which allows us to retrieve the working hash of the SC tree. Finally,
 // we construct a *CommitInfo and set that as lastCommitInfo. Note, this should
 // only be called once per block!
 // If migration is in progress, the changeset is sent to the migration manager.
 func (s *Store) writeSC(cs *corestore.Changeset) error {
-	if s.isMigrating {
+	// if the migration of the SC backend failed, keep the current one
+	if s.isMigratingSC && s.migrationManager.Err() != nil {
+		if err := s.abortSCMigration(s.migrationManager.Err()); err != nil {
+			return err
+		}
+	}
+
+	if s.isMigrating || s.isMigratingSC {
 		// if the migration manager has already migrated to the version, close the
 		// channels and replace the state commitment
 		if s.migrationManager.GetMigratedVersion() == s.lastCommitInfo.Version {
-			close(s.chDone)
-			close(s.chChangeset)
-			s.isMigrating = false
-			// close the old state commitment and replace it with the new one
-			if err := s.stateCommitment.Close(); err != nil {
-				return fmt.Errorf("failed to close the old SC store: %w", err)
-			}
-			newStateCommitment := s.migrationManager.GetStateCommitment()
-			if newStateCommitment != nil {
-				s.stateCommitment = newStateCommitment
-			}
-			if err := s.migrationManager.Close(); err != nil {
-				return fmt.Errorf("failed to close migration manager: %w", err)
+			if err := s.completeMigration(); err != nil {
+				return err
 			}
-			s.logger.Info("migration completed", "version", s.lastCommitInfo.Version)
 		} else {
 			s.chChangeset <- &migration.VersionedChangeset{Version: s.lastCommitInfo.Version + 1, Changeset: cs}
 		}
@@ -3,6 +3,7 @@ package root
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
@@ -88,6 +89,28 @@ func (s *RootStoreTestSuite) TestSetCommitHeader() {
 	s.Require().Equal(h, s.rootStore.(*Store).commitHeader)
 }
 
+type recordingListener struct {
+	versions []uint64
+}
+
+func (l *recordingListener) ListenCommit(version uint64, _ *corestore.Changeset) {
+	l.versions = append(l.versions, version)
+}
+
+func (s *RootStoreTestSuite) TestCommitListener() {
+	l := &recordingListener{}
+	s.rootStore.AddCommitListener(l)
+
+	for v := 1; v <= 3; v++ {
+		cs := corestore.NewChangeset()
+		cs.Add(testStoreKeyBytes, []byte("foo"), []byte(fmt.Sprintf("bar%d", v)), false)
+		_, err := s.rootStore.Commit(cs)
+		s.Require().NoError(err)
+	}
+
+	s.Require().Equal([]uint64{1, 2, 3}, l.versions)
+}
+
 func (s *RootStoreTestSuite) TestQuery() {
 	_, err := s.rootStore.Query([]byte{}, 1, []byte("foo"), true)
 	s.Require().Error(err)
@@ -107,6 +130,53 @@ func (s *RootStoreTestSuite) TestQuery() {
 	s.Require().Equal([]byte("foo"), result.ProofOps[0].Key)
 }
 
+func (s *RootStoreTestSuite) TestQueryAtTime() {
+	genesisTime := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// no version is committed yet
+	_, err := s.rootStore.QueryAtTime(testStoreKeyBytes, genesisTime, []byte("foo"), false)
+	s.Require().Error(err)
+
+	// commit a version every 10 seconds
+	for v := uint64(1); v <= 5; v++ {
+		s.rootStore.SetCommitHeader(&coreheader.Info{
+			Height: int64(v),
+			Time:   genesisTime.Add(time.Duration(v-1) * 10 * time.Second),
+		})
+
+		cs := corestore.NewChangeset()
+		cs.Add(testStoreKeyBytes, []byte("foo"), []byte(fmt.Sprintf("bar%d", v)), false)
+		_, err := s.rootStore.Commit(cs)
+		s.Require().NoError(err)
+	}
+
+	testCases := []struct {
+		t          time.Time
+		expVersion uint64
+	}{
+		{genesisTime, 1},
+		{genesisTime.Add(5 * time.Second), 1},
+		{genesisTime.Add(10 * time.Second), 2},
+		{genesisTime.Add(35 * time.Second), 4},
+		{genesisTime.Add(time.Hour), 5},
+	}
+	for _, tc := range testCases {
+		version, err := s.rootStore.GetVersionAtTime(tc.t)
+		s.Require().NoError(err)
+		s.Require().Equal(tc.expVersion, version)
+
+		result, err := s.rootStore.QueryAtTime(testStoreKeyBytes, tc.t, []byte("foo"), true)
+		s.Require().NoError(err)
+		s.Require().Equal(tc.expVersion, result.Version)
+		s.Require().Equal([]byte(fmt.Sprintf("bar%d", tc.expVersion)), result.Value)
+		s.Require().NotNil(result.ProofOps)
+	}
+
+	// before the first version
+	_, err = s.rootStore.GetVersionAtTime(genesisTime.Add(-time.Second))
+	s.Require().Error(err)
+}
+
 func (s *RootStoreTestSuite) TestGetFallback() {
 	sc := s.rootStore.GetStateCommitment()
 
@@ -155,12 +155,67 @@ func (m *mockCommitSnapshotter) SupportedFormats() []uint32 {
 	return []uint32{snapshotstypes.CurrentFormat}
 }
 
-type mockStorageSnapshotter struct{}
+// mockDeltaCommitSnapshotter is a mockCommitSnapshotter which also takes delta snapshots,
+// and records the heights of the restored snapshots.
+type mockDeltaCommitSnapshotter struct {
+	mockCommitSnapshotter
+	restored []uint64
+}
+
+var _ snapshots.DeltaCommitSnapshotter = (*mockDeltaCommitSnapshotter)(nil)
+
+func (m *mockDeltaCommitSnapshotter) SnapshotDelta(baseHeight, height uint64, protoWriter protoio.Writer) error {
+	return snapshotstypes.WriteExtensionPayload(protoWriter, []byte{byte(baseHeight), byte(height)})
+}
+
+func (m *mockDeltaCommitSnapshotter) Restore(
+	height uint64, format uint32, protoReader protoio.Reader, chStorage chan<- *corestore.StateChanges,
+) (snapshotstypes.SnapshotItem, error) {
+	m.items = nil
+	item, err := m.mockCommitSnapshotter.Restore(height, format, protoReader, chStorage)
+	if err != nil {
+		return item, err
+	}
+	m.restored = append(m.restored, height)
+	return item, nil
+}
+
+// RestoreDelta restores the delta snapshot as a full one, and sends an empty
+// changeset of its height.
+func (m *mockDeltaCommitSnapshotter) RestoreDelta(
+	height uint64, protoReader protoio.Reader, chChangesets chan<- *snapshots.VersionedChangeset,
+) (snapshotstypes.SnapshotItem, error) {
+	item, err := m.Restore(height, snapshotstypes.DeltaFormat, protoReader, nil)
+	if err != nil {
+		return item, err
+	}
+	chChangesets <- &snapshots.VersionedChangeset{Version: height, Changeset: corestore.NewChangeset()}
+	return item, nil
+}
+
+func (m *mockDeltaCommitSnapshotter) GetLatestVersion() (uint64, error) {
+	if len(m.restored) == 0 {
+		return 0, nil
+	}
+	return m.restored[len(m.restored)-1], nil
+}
+
+// mockStorageSnapshotter records the versions of the restored delta snapshots.
+type mockStorageSnapshotter struct {
+	restoredDeltas []uint64
+}
 
 func (m *mockStorageSnapshotter) Restore(version uint64, chStorage <-chan *corestore.StateChanges) error {
 	return nil
 }
 
+func (m *mockStorageSnapshotter) RestoreDelta(chChangesets <-chan *snapshots.VersionedChangeset) error {
+	for vc := range chChangesets {
+		m.restoredDeltas = append(m.restoredDeltas, vc.Version)
+	}
+	return nil
+}
+
 type mockErrorCommitSnapshotter struct{}
 
 var _ snapshots.CommitSnapshotter = (*mockErrorCommitSnapshotter)(nil)
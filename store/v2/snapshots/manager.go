@@ -40,6 +40,9 @@ type Manager struct {
 	commitSnapshotter CommitSnapshotter
 	// storageSnapshotter is the snapshotter for the storage state.
 	storageSnapshotter StorageSnapshotter
+	// pruningSignaler, if not nil, is notified of the versions read by the
+	// snapshots being taken.
+	pruningSignaler PruningSignaler
 
 	logger log.Logger
 
@@ -90,6 +93,23 @@ func NewManager(store *Store, opts SnapshotOptions, commitSnapshotter CommitSnap
 	}
 }
 
+// SetPruningSignaler sets the PruningSignaler notified of the versions read by the
+// snapshots being taken, so that they are not pruned meanwhile.
+func (m *Manager) SetPruningSignaler(signaler PruningSignaler) {
+	m.pruningSignaler = signaler
+}
+
+// retainVersions signals to the PruningSignaler, if any, that the versions from
+// the given one are read by a snapshot, until the returned function is called.
+func (m *Manager) retainVersions(version uint64) func() {
+	if m.pruningSignaler == nil {
+		return func() {}
+	}
+
+	m.pruningSignaler.SignalSnapshot(true, version)
+	return func() { m.pruningSignaler.SignalSnapshot(false, version) }
+}
+
 // RegisterExtensions register extension snapshotters to manager
 func (m *Manager) RegisterExtensions(extensions ...ExtensionSnapshotter) error {
 	if m.extensions == nil {
@@ -185,16 +205,55 @@ func (m *Manager) Create(height uint64) (*types.Snapshot, error) {
 			"a more recent snapshot already exists at height %v", latest.Height)
 	}
 
+	defer m.retainVersions(height)()
+
 	// Spawn goroutine to generate snapshot chunks and pass their io.ReadClosers through a channel
 	ch := make(chan io.ReadCloser)
-	go m.createSnapshot(height, ch)
+	go m.createSnapshot(0, height, ch)
 
 	return m.store.Save(height, types.CurrentFormat, ch)
 }
 
+// CreateDelta creates a delta snapshot on top of the latest snapshot and returns its
+// metadata. The commit snapshotter must be a DeltaCommitSnapshotter.
+func (m *Manager) CreateDelta(height uint64) (*types.Snapshot, error) {
+	if m == nil {
+		return nil, errorsmod.Wrap(storeerrors.ErrLogic, "Snapshot Manager is nil")
+	}
+	if _, ok := m.commitSnapshotter.(DeltaCommitSnapshotter); !ok {
+		return nil, errorsmod.Wrap(storeerrors.ErrLogic, "commit snapshotter does not support delta snapshots")
+	}
+
+	err := m.begin(opSnapshot)
+	if err != nil {
+		return nil, err
+	}
+	defer m.end()
+
+	base, err := m.store.GetLatest()
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to examine latest snapshot")
+	}
+	if base == nil {
+		return nil, errorsmod.Wrap(storeerrors.ErrLogic, "no base snapshot to take a delta snapshot on top of")
+	}
+	if base.Height >= height {
+		return nil, errorsmod.Wrapf(storeerrors.ErrConflict,
+			"a more recent snapshot already exists at height %v", base.Height)
+	}
+
+	defer m.retainVersions(base.Height)()
+
+	ch := make(chan io.ReadCloser)
+	go m.createSnapshot(base.Height, height, ch)
+
+	return m.store.SaveDelta(height, base.Height, ch)
+}
+
 // createSnapshot do the heavy work of snapshotting after the validations of request are done
-// the produced chunks are written to the channel.
-func (m *Manager) createSnapshot(height uint64, ch chan<- io.ReadCloser) {
+// the produced chunks are written to the channel. A delta snapshot is created if the base
+// height is not 0.
+func (m *Manager) createSnapshot(baseHeight, height uint64, ch chan<- io.ReadCloser) {
 	streamWriter := NewStreamWriter(ch)
 	if streamWriter == nil {
 		return
@@ -205,7 +264,13 @@ func (m *Manager) createSnapshot(height uint64, ch chan<- io.ReadCloser) {
 		}
 	}()
 
-	if err := m.commitSnapshotter.Snapshot(height, streamWriter); err != nil {
+	if baseHeight > 0 {
+		err := m.commitSnapshotter.(DeltaCommitSnapshotter).SnapshotDelta(baseHeight, height, streamWriter)
+		if err != nil {
+			streamWriter.CloseWithError(err)
+			return
+		}
+	} else if err := m.commitSnapshotter.Snapshot(height, streamWriter); err != nil {
 		streamWriter.CloseWithError(err)
 		return
 	}
@@ -310,7 +375,17 @@ func (m *Manager) Restore(snapshot types.Snapshot) error {
 	defer m.mtx.Unlock()
 
 	// check multistore supported format preemptive
-	if snapshot.Format != types.CurrentFormat {
+	switch snapshot.Format {
+	case types.CurrentFormat:
+		if snapshot.Metadata.BaseHeight != 0 {
+			return errorsmod.Wrap(types.ErrInvalidMetadata, "full snapshot with a base height")
+		}
+	case types.DeltaFormat:
+		// the base height is checked against the local state by the commit snapshotter
+		if snapshot.Metadata.BaseHeight == 0 || snapshot.Metadata.BaseHeight >= snapshot.Height {
+			return errorsmod.Wrapf(types.ErrInvalidMetadata, "delta snapshot base height %v", snapshot.Metadata.BaseHeight)
+		}
+	default:
 		return errorsmod.Wrapf(types.ErrUnknownFormat, "snapshot format %v", snapshot.Format)
 	}
 	if snapshot.Height == 0 {
@@ -398,20 +473,7 @@ func (m *Manager) doRestoreSnapshot(snapshot types.Snapshot, chChunks <-chan io.
 		return payload.Payload, nil
 	}
 
-	// chStorage is the channel to pass the KV pairs to the storage snapshotter.
-	chStorage := make(chan *corestore.StateChanges, defaultStorageChannelBufferSize)
-	defer close(chStorage)
-
-	storageErrs := make(chan error, 1)
-	go func() {
-		defer close(storageErrs)
-		err := m.storageSnapshotter.Restore(snapshot.Height, chStorage)
-		if err != nil {
-			storageErrs <- err
-		}
-	}()
-
-	nextItem, err = m.commitSnapshotter.Restore(snapshot.Height, snapshot.Format, streamReader, chStorage)
+	nextItem, storageErrs, err := m.restoreState(snapshot, streamReader)
 	if err != nil {
 		return errorsmod.Wrap(err, "multistore restore")
 	}
@@ -450,6 +512,53 @@ func (m *Manager) doRestoreSnapshot(snapshot types.Snapshot, chChunks <-chan io.
 	return nil
 }
 
+// restoreState restores the commitment state from the stream reader and passes the
+// restored state to the storage snapshotter, as the KV pairs of a full snapshot
+// or the changeset of every version of a delta snapshot. It returns the next item
+// of the stream, and the channel receiving the error of the storage snapshotter
+// once it has completed.
+func (m *Manager) restoreState(snapshot types.Snapshot, streamReader *StreamReader) (types.SnapshotItem, <-chan error, error) {
+	storageErrs := make(chan error, 1)
+
+	if snapshot.Format != types.DeltaFormat {
+		// chStorage is the channel to pass the KV pairs to the storage snapshotter.
+		chStorage := make(chan *corestore.StateChanges, defaultStorageChannelBufferSize)
+		go func() {
+			defer close(storageErrs)
+			if err := m.storageSnapshotter.Restore(snapshot.Height, chStorage); err != nil {
+				storageErrs <- err
+			}
+		}()
+		defer close(chStorage)
+
+		nextItem, err := m.commitSnapshotter.Restore(snapshot.Height, snapshot.Format, streamReader, chStorage)
+		return nextItem, storageErrs, err
+	}
+
+	commitSnapshotter, ok := m.commitSnapshotter.(DeltaCommitSnapshotter)
+	if !ok {
+		return types.SnapshotItem{}, nil, errorsmod.Wrap(storeerrors.ErrLogic, "commit snapshotter does not support delta snapshots")
+	}
+	storageSnapshotter, ok := m.storageSnapshotter.(DeltaStorageSnapshotter)
+	if !ok {
+		return types.SnapshotItem{}, nil, errorsmod.Wrap(storeerrors.ErrLogic, "storage snapshotter does not support delta snapshots")
+	}
+
+	// chChangesets is the channel to pass the changeset of every version to the
+	// storage snapshotter, which writes them at their own version.
+	chChangesets := make(chan *VersionedChangeset, defaultStorageChannelBufferSize)
+	go func() {
+		defer close(storageErrs)
+		if err := storageSnapshotter.RestoreDelta(chChangesets); err != nil {
+			storageErrs <- err
+		}
+	}()
+	defer close(chChangesets)
+
+	nextItem, err := commitSnapshotter.RestoreDelta(snapshot.Height, streamReader, chChangesets)
+	return nextItem, storageErrs, err
+}
+
 // RestoreChunk adds a chunk to an active snapshot restoration, mirroring ABCI ApplySnapshotChunk.
 // Chunks must be given until the restore is complete, returning true, or a chunk errors.
 func (m *Manager) RestoreChunk(chunk []byte) (bool, error) {
@@ -514,9 +623,11 @@ func (m *Manager) RestoreChunk(chunk []byte) (bool, error) {
 	return false, nil
 }
 
-// RestoreLocalSnapshot restores app state from a local snapshot.
+// RestoreLocalSnapshot restores app state from a local snapshot. A delta snapshot is
+// restored by chaining it with its base snapshots, starting from a full snapshot, or
+// from the latest version of the app state if some base snapshot is at that height.
 func (m *Manager) RestoreLocalSnapshot(height uint64, format uint32) error {
-	snapshot, ch, err := m.store.Load(height, format)
+	snapshot, err := m.store.Get(height, format)
 	if err != nil {
 		return err
 	}
@@ -525,6 +636,11 @@ func (m *Manager) RestoreLocalSnapshot(height uint64, format uint32) error {
 		return fmt.Errorf("snapshot doesn't exist, height: %d, format: %d", height, format)
 	}
 
+	chain, err := m.snapshotChain(snapshot)
+	if err != nil {
+		return err
+	}
+
 	m.mtx.Lock()
 	defer m.mtx.Unlock()
 
@@ -534,7 +650,48 @@ func (m *Manager) RestoreLocalSnapshot(height uint64, format uint32) error {
 	}
 	defer m.endLocked()
 
-	return m.doRestoreSnapshot(*snapshot, ch)
+	for _, snapshot := range chain {
+		_, ch, err := m.store.Load(snapshot.Height, snapshot.Format)
+		if err != nil {
+			return err
+		}
+		if err := m.doRestoreSnapshot(*snapshot, ch); err != nil {
+			DrainChunks(ch)
+			return errorsmod.Wrapf(err, "failed to restore snapshot at height %d, format %d", snapshot.Height, snapshot.Format)
+		}
+	}
+
+	return nil
+}
+
+// snapshotChain returns the snapshots to restore in order to restore the given one, i.e.
+// the snapshot itself, preceded by its base snapshots if it is a delta snapshot. The
+// chain stops at a full snapshot, or at the latest version of the app state if the
+// commit snapshotter exposes it.
+func (m *Manager) snapshotChain(snapshot *types.Snapshot) ([]*types.Snapshot, error) {
+	var latestVersion uint64
+	if versioner, ok := m.commitSnapshotter.(interface{ GetLatestVersion() (uint64, error) }); ok {
+		var err error
+		if latestVersion, err = versioner.GetLatestVersion(); err != nil {
+			return nil, err
+		}
+	}
+
+	chain := []*types.Snapshot{snapshot}
+	for snapshot.Format == types.DeltaFormat && snapshot.Metadata.BaseHeight != latestVersion {
+		base, err := m.store.GetBase(snapshot)
+		if err != nil {
+			return nil, err
+		}
+		if base == nil {
+			return nil, fmt.Errorf("base snapshot at height %d of the delta snapshot at height %d doesn't exist",
+				snapshot.Metadata.BaseHeight, snapshot.Height)
+		}
+		chain = append([]*types.Snapshot{base}, chain...)
+		snapshot = base
+	}
+
+	return chain, nil
 }
 
 // sortedExtensionNames sort extension names for deterministic iteration.
@@ -564,12 +721,30 @@ func (m *Manager) SnapshotIfApplicable(height int64) {
 	if m == nil {
 		return
 	}
+	if m.shouldTakeDeltaSnapshot(height) {
+		// retain the versions from the base snapshot before the next commit prunes them
+		baseHeight := uint64(height)
+		if base, err := m.store.GetLatest(); err == nil && base != nil && base.Height < baseHeight {
+			baseHeight = base.Height
+		}
+		release := m.retainVersions(baseHeight)
+		go func() {
+			defer release()
+			m.snapshotDelta(height)
+		}()
+		return
+	}
 	if !m.shouldTakeSnapshot(height) {
 		m.logger.Debug("snapshot is skipped", "height", height)
 		return
 	}
-	// start the routine after need to create a snapshot
-	go m.snapshot(height)
+	// start the routine after need to create a snapshot, retaining the version
+	// before the next commit prunes it
+	release := m.retainVersions(uint64(height))
+	go func() {
+		defer release()
+		m.snapshot(height)
+	}()
 }
 
 // shouldTakeSnapshot returns true is snapshot should be taken at height.
@@ -577,6 +752,29 @@ func (m *Manager) shouldTakeSnapshot(height int64) bool {
 	return m.opts.Interval > 0 && uint64(height)%m.opts.Interval == 0
 }
 
+// shouldTakeDeltaSnapshot returns true is a delta snapshot should be taken at height,
+// i.e. it is a delta snapshot height but not a snapshot height.
+func (m *Manager) shouldTakeDeltaSnapshot(height int64) bool {
+	return m.opts.DeltaInterval > 0 && uint64(height)%m.opts.DeltaInterval == 0 && !m.shouldTakeSnapshot(height)
+}
+
+func (m *Manager) snapshotDelta(height int64) {
+	m.logger.Info("creating delta state snapshot", "height", height)
+
+	if height <= 0 {
+		m.logger.Error("snapshot height must be positive", "height", height)
+		return
+	}
+
+	snapshot, err := m.CreateDelta(uint64(height))
+	if err != nil {
+		m.logger.Error("failed to create delta state snapshot", "height", height, "err", err)
+		return
+	}
+
+	m.logger.Info("completed delta state snapshot", "height", height, "base_height", snapshot.Metadata.BaseHeight)
+}
+
 func (m *Manager) snapshot(height int64) {
 	m.logger.Info("creating state snapshot", "height", height)
 
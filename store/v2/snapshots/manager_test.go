@@ -2,6 +2,7 @@ package snapshots_test
 
 import (
 	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -108,6 +109,31 @@ func TestManager_Take(t *testing.T) {
 	require.Error(t, err)
 }
 
+type mockPruningSignaler struct {
+	signals []string
+}
+
+func (m *mockPruningSignaler) SignalSnapshot(start bool, version uint64) {
+	m.signals = append(m.signals, fmt.Sprintf("%t-%d", start, version))
+}
+
+func TestManager_PruningSignaler(t *testing.T) {
+	store := setupStore(t)
+	manager := snapshots.NewManager(store, opts, &mockCommitSnapshotter{items: [][]byte{{1, 2, 3}}}, &mockStorageSnapshotter{}, nil, log.NewNopLogger())
+	signaler := &mockPruningSignaler{}
+	manager.SetPruningSignaler(signaler)
+
+	// the version read by the snapshot is retained until it is taken
+	_, err := manager.Create(5)
+	require.NoError(t, err)
+	require.Equal(t, []string{"true-5", "false-5"}, signaler.signals)
+
+	// a failed snapshot doesn't retain any version
+	_, err = manager.Create(4)
+	require.Error(t, err)
+	require.Equal(t, []string{"true-5", "false-5"}, signaler.signals)
+}
+
 func TestManager_Prune(t *testing.T) {
 	store := setupStore(t)
 	manager := snapshots.NewManager(store, opts, &mockCommitSnapshotter{}, &mockStorageSnapshotter{}, nil, log.NewNopLogger())
@@ -245,6 +271,67 @@ func TestManager_Restore(t *testing.T) {
 	}
 }
 
+func TestManager_Delta(t *testing.T) {
+	store, err := snapshots.NewStore(t.TempDir())
+	require.NoError(t, err)
+	source := &mockDeltaCommitSnapshotter{}
+	manager := snapshots.NewManager(store, opts, source, &mockStorageSnapshotter{}, nil, log.NewNopLogger())
+
+	// a delta snapshot requires a base snapshot
+	_, err = manager.CreateDelta(5)
+	require.Error(t, err)
+
+	_, err = manager.Create(3)
+	require.NoError(t, err)
+	delta, err := manager.CreateDelta(5)
+	require.NoError(t, err)
+	require.Equal(t, types.DeltaFormat, delta.Format)
+	require.Equal(t, uint64(3), delta.Metadata.BaseHeight)
+	delta, err = manager.CreateDelta(7)
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), delta.Metadata.BaseHeight)
+
+	_, err = manager.CreateDelta(6)
+	require.Error(t, err)
+
+	// the delta snapshot is restored by chaining it with its base snapshots, and
+	// the storage state of every version written at its own version
+	target := &mockDeltaCommitSnapshotter{}
+	storage := &mockStorageSnapshotter{}
+	manager = snapshots.NewManager(store, opts, target, storage, nil, log.NewNopLogger())
+	require.NoError(t, manager.RestoreLocalSnapshot(7, types.DeltaFormat))
+	require.Equal(t, []uint64{3, 5, 7}, target.restored)
+	require.Equal(t, []uint64{5, 7}, storage.restoredDeltas)
+
+	// starting from the latest version of the target
+	target = &mockDeltaCommitSnapshotter{restored: []uint64{5}}
+	manager = snapshots.NewManager(store, opts, target, &mockStorageSnapshotter{}, nil, log.NewNopLogger())
+	require.NoError(t, manager.RestoreLocalSnapshot(7, types.DeltaFormat))
+	require.Equal(t, []uint64{5, 7}, target.restored)
+
+	// the base snapshots must exist
+	require.NoError(t, store.Delete(5, types.DeltaFormat))
+	target = &mockDeltaCommitSnapshotter{}
+	manager = snapshots.NewManager(store, opts, target, &mockStorageSnapshotter{}, nil, log.NewNopLogger())
+	require.Error(t, manager.RestoreLocalSnapshot(7, types.DeltaFormat))
+	require.Empty(t, target.restored)
+
+	// Restore errors on a delta snapshot without base height
+	chunks := snapshotItems([][]byte{{3, 7}}, newExtSnapshotter(0))
+	err = manager.Restore(types.Snapshot{
+		Height:   7,
+		Format:   types.DeltaFormat,
+		Chunks:   uint32(len(chunks)),
+		Metadata: types.Metadata{ChunkHashes: checksums(chunks)},
+	})
+	require.ErrorIs(t, err, types.ErrInvalidMetadata)
+
+	// delta snapshots are not supported by every commit snapshotter
+	manager = snapshots.NewManager(store, opts, &mockCommitSnapshotter{}, &mockStorageSnapshotter{}, nil, log.NewNopLogger())
+	_, err = manager.CreateDelta(9)
+	require.Error(t, err)
+}
+
 func TestManager_TakeError(t *testing.T) {
 	snapshotter := &mockErrorCommitSnapshotter{}
 	store, err := snapshots.NewStore(t.TempDir())
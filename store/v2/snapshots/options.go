@@ -8,6 +8,12 @@ type SnapshotOptions struct {
 
 	// KeepRecent defines how many snapshots to keep in heights.
 	KeepRecent uint32
+
+	// DeltaInterval defines at which heights a delta snapshot is taken on top of the
+	// latest snapshot, the heights at which a full snapshot is taken are skipped. 0
+	// disables delta snapshots. The delta snapshots are pruned along with their base
+	// snapshot.
+	DeltaInterval uint64
 }
 
 func NewSnapshotOptions(interval uint64, keepRecent uint32) SnapshotOptions {
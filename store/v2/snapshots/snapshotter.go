@@ -17,12 +17,43 @@ type CommitSnapshotter interface {
 	Restore(version uint64, format uint32, protoReader protoio.Reader, chStorage chan<- *corestore.StateChanges) (types.SnapshotItem, error)
 }
 
+// DeltaCommitSnapshotter is a CommitSnapshotter which can also create and restore
+// delta snapshots, of the types.DeltaFormat format.
+type DeltaCommitSnapshotter interface {
+	CommitSnapshotter
+
+	// SnapshotDelta writes a delta snapshot of the changesets committed after the
+	// base version up to the given version.
+	SnapshotDelta(baseVersion, version uint64, protoWriter protoio.Writer) error
+
+	// RestoreDelta restores the commitment state from the delta snapshot reader, and
+	// sends the changeset of every restored version to the channel.
+	RestoreDelta(version uint64, protoReader protoio.Reader, chChangesets chan<- *VersionedChangeset) (types.SnapshotItem, error)
+}
+
+// VersionedChangeset is the changeset committed at a version, restored from a
+// delta snapshot.
+type VersionedChangeset struct {
+	Version   uint64
+	Changeset *corestore.Changeset
+}
+
 // StorageSnapshotter defines an API for restoring snapshots of the storage state.
 type StorageSnapshotter interface {
 	// Restore restores the storage state from the given channel.
 	Restore(version uint64, chStorage <-chan *corestore.StateChanges) error
 }
 
+// DeltaStorageSnapshotter is a StorageSnapshotter which can also restore delta
+// snapshots.
+type DeltaStorageSnapshotter interface {
+	StorageSnapshotter
+
+	// RestoreDelta writes the changeset of every version received from the channel
+	// at its own version.
+	RestoreDelta(chChangesets <-chan *VersionedChangeset) error
+}
+
 // ExtensionPayloadReader read extension payloads,
 // it returns io.EOF when reached either end of stream or the extension boundaries.
 type ExtensionPayloadReader = func() ([]byte, error)
@@ -51,3 +82,11 @@ type ExtensionSnapshotter interface {
 	// the payload reader returns `io.EOF` when reached the extension boundaries.
 	RestoreExtension(height uint64, format uint32, payloadReader ExtensionPayloadReader) error
 }
+
+// PruningSignaler is notified of the versions read by the snapshots being taken,
+// so that they are not pruned meanwhile, e.g. the pruning.Manager.
+type PruningSignaler interface {
+	// SignalSnapshot signals that a snapshot reading the versions from the given
+	// one has started or finished.
+	SignalSnapshot(start bool, version uint64)
+}
@@ -28,6 +28,10 @@ const (
 )
 
 // Store is a snapshot store, containing snapshot metadata and binary chunks.
+//
+// The snapshot commands of the cosmos-sdk client/snapshot package read and write this
+// on-disk layout directly, see pathMetadata and PathChunk, so any change to it must be
+// reflected there.
 type Store struct {
 	dir string
 
@@ -203,24 +207,70 @@ func (s *Store) loadChunkFile(height uint64, format, chunk uint32) (io.ReadClose
 	return os.Open(path)
 }
 
-// Prune removes old snapshots. The given number of most recent heights (regardless of format) are retained.
+// GetBase fetches the snapshot a delta snapshot is applied on top of, which is
+// either a full or a delta snapshot. It returns nil if the base snapshot does not
+// exist.
+func (s *Store) GetBase(snapshot *types.Snapshot) (*types.Snapshot, error) {
+	if snapshot.Format != types.DeltaFormat {
+		return nil, errors.Wrapf(storeerrors.ErrLogic, "snapshot at height %v is not a delta snapshot", snapshot.Height)
+	}
+	// the base snapshot is either a full snapshot of the current format or a delta
+	// snapshot, the former being preferred if both exist
+	for _, format := range []uint32{types.CurrentFormat, types.DeltaFormat} {
+		base, err := s.Get(snapshot.Metadata.BaseHeight, format)
+		if base != nil || err != nil {
+			return base, err
+		}
+	}
+	return nil, nil
+}
+
+// Prune removes old snapshots. The given number of most recent heights of full snapshots
+// (regardless of format) are retained, along with the delta snapshots chained on top of
+// them, i.e. the delta snapshots whose base snapshot is retained.
 func (s *Store) Prune(retain uint32) (uint64, error) {
 	metadata, err := os.ReadDir(s.pathMetadataDir())
 	if err != nil {
 		return 0, errors.Wrap(err, "failed to list snapshot metadata")
 	}
+	// file system may not guarantee the order of the files, so we sort them lexically
+	sort.Slice(metadata, func(i, j int) bool { return metadata[i].Name() < metadata[j].Name() })
+
+	// retain the most recent heights of full snapshots
+	retained := make(map[uint64]bool)
+	for i := len(metadata) - 1; i >= 0; i-- {
+		height, format, err := s.parseMetadataFilename(metadata[i].Name())
+		if err != nil {
+			return 0, err
+		}
+		if format == types.DeltaFormat {
+			continue
+		}
+		if retained[height] || uint32(len(retained)) < retain {
+			retained[height] = true
+		}
+	}
 
 	pruned := uint64(0)
 	prunedHeights := make(map[uint64]bool)
-	skip := make(map[uint64]bool)
-	for i := len(metadata) - 1; i >= 0; i-- {
+	// delta snapshots are retained along with their base snapshot, so the snapshots
+	// are iterated in ascending order of height
+	for i := 0; i < len(metadata); i++ {
 		height, format, err := s.parseMetadataFilename(metadata[i].Name())
 		if err != nil {
 			return 0, err
 		}
 
-		if skip[height] || uint32(len(skip)) < retain {
-			skip[height] = true
+		if format == types.DeltaFormat {
+			snapshot, err := s.Get(height, format)
+			if err != nil {
+				return 0, err
+			}
+			if retained[snapshot.Metadata.BaseHeight] {
+				retained[height] = true
+				continue
+			}
+		} else if retained[height] {
 			continue
 		}
 		err = s.Delete(height, format)
@@ -233,7 +283,7 @@ func (s *Store) Prune(retain uint32) (uint64, error) {
 	// Since Delete() deletes a specific format, while we want to prune a height, we clean up
 	// the height directory as well
 	for height, ok := range prunedHeights {
-		if ok {
+		if ok && !retained[height] {
 			err = os.Remove(s.pathHeight(height))
 			if err != nil {
 				return 0, errors.Wrapf(err, "failed to remove snapshot directory for height %v", height)
@@ -247,7 +297,30 @@ func (s *Store) Prune(retain uint32) (uint64, error) {
 func (s *Store) Save(
 	height uint64, format uint32, chunks <-chan io.ReadCloser,
 ) (*types.Snapshot, error) {
+	return s.save(&types.Snapshot{Height: height, Format: format}, chunks)
+}
+
+// SaveDelta saves a delta snapshot applied on top of the snapshot at the base height
+// to disk, returning it.
+func (s *Store) SaveDelta(
+	height, baseHeight uint64, chunks <-chan io.ReadCloser,
+) (*types.Snapshot, error) {
+	if baseHeight == 0 || baseHeight >= height {
+		DrainChunks(chunks)
+		return nil, errors.Wrapf(storeerrors.ErrLogic,
+			"delta snapshot base height %v must be greater than 0 and less than %v", baseHeight, height)
+	}
+	return s.save(&types.Snapshot{
+		Height:   height,
+		Format:   types.DeltaFormat,
+		Metadata: types.Metadata{BaseHeight: baseHeight},
+	}, chunks)
+}
+
+// save saves the chunks of the given snapshot to disk, filling in its chunk metadata.
+func (s *Store) save(snapshot *types.Snapshot, chunks <-chan io.ReadCloser) (*types.Snapshot, error) {
 	defer DrainChunks(chunks)
+	height, format := snapshot.Height, snapshot.Format
 	if height == 0 {
 		return nil, errors.Wrap(storeerrors.ErrLogic, "snapshot height cannot be 0")
 	}
@@ -266,11 +339,6 @@ func (s *Store) Save(
 		s.mtx.Unlock()
 	}()
 
-	snapshot := &types.Snapshot{
-		Height: height,
-		Format: format,
-	}
-
 	// create height directory or do nothing
 	if err := os.MkdirAll(s.pathHeight(height), 0o750); err != nil {
 		return nil, errors.Wrapf(err, "failed to create snapshot directory for height %v", height)
@@ -261,6 +261,53 @@ func TestStore_Prune(t *testing.T) {
 	assert.Empty(t, snapshots)
 }
 
+func TestStore_PruneDeltas(t *testing.T) {
+	store := setupStore(t)
+	_, err := store.SaveDelta(4, 3, makeChunks([][]byte{{4, 4, 0}}))
+	require.NoError(t, err)
+	_, err = store.SaveDelta(5, 4, makeChunks([][]byte{{5, 4, 0}}))
+	require.NoError(t, err)
+
+	// the delta snapshots are retained along with their base snapshot
+	pruned, err := store.Prune(1)
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, pruned)
+
+	heights := func() []uint64 {
+		snapshots, err := store.List()
+		require.NoError(t, err)
+		heights := make([]uint64, len(snapshots))
+		for i, snapshot := range snapshots {
+			heights[i] = snapshot.Height
+		}
+		return heights
+	}
+	require.Equal(t, []uint64{5, 4, 3}, heights())
+
+	_, err = store.Save(6, types.CurrentFormat, makeChunks([][]byte{{6, 3, 0}}))
+	require.NoError(t, err)
+	_, err = store.SaveDelta(7, 6, makeChunks([][]byte{{7, 4, 0}}))
+	require.NoError(t, err)
+
+	// and pruned along with it
+	pruned, err = store.Prune(1)
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, pruned)
+	require.Equal(t, []uint64{7, 6}, heights())
+
+	delta, err := store.Get(7, types.DeltaFormat)
+	require.NoError(t, err)
+	base, err := store.GetBase(delta)
+	require.NoError(t, err)
+	require.Equal(t, uint64(6), base.Height)
+	require.Equal(t, types.CurrentFormat, base.Format)
+
+	_, err = store.GetBase(base)
+	require.Error(t, err)
+	_, err = store.SaveDelta(8, 8, makeChunks([][]byte{{8, 4, 0}}))
+	require.Error(t, err)
+}
+
 func TestStore_Save(t *testing.T) {
 	t.Parallel()
 	store := setupStore(t)
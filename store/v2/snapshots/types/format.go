@@ -4,3 +4,9 @@ package types
 // must be identical across all nodes for a given height, so this must be bumped when the binary
 // snapshot output changes.
 const CurrentFormat uint32 = 3
+
+// DeltaFormat is the format of delta snapshots, which contain the changesets committed
+// since the snapshot at Metadata.BaseHeight instead of the whole state, so they must be
+// restored on top of the state at that height. The cosmos-sdk client/snapshot package
+// duplicates this value, so it must not change.
+const DeltaFormat uint32 = 4
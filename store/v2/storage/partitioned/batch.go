@@ -0,0 +1,116 @@
+package partitioned
+
+import (
+	"fmt"
+	"slices"
+
+	"cosmossdk.io/store/v2"
+)
+
+var _ store.Batch = (*Batch)(nil)
+
+// Batch is a store.Batch writing to the batches of the partitions of its store
+// keys. The batches of the partitions are created on first use.
+type Batch struct {
+	db      *Database
+	version uint64
+
+	defaultBatch store.Batch
+	batches      map[string]store.Batch
+}
+
+func NewBatch(db *Database, version uint64) (*Batch, error) {
+	defaultBatch, err := db.defaultDB.NewBatch(version)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Batch{
+		db:           db,
+		version:      version,
+		defaultBatch: defaultBatch,
+		batches:      make(map[string]store.Batch),
+	}, nil
+}
+
+// batch returns the batch of the partition of the store key.
+func (b *Batch) batch(storeKey []byte) (store.Batch, error) {
+	p, ok := b.db.partitions[string(storeKey)]
+	if !ok {
+		return b.defaultBatch, nil
+	}
+
+	if batch, ok := b.batches[p.StoreKey]; ok {
+		return batch, nil
+	}
+
+	batch, err := p.DB.NewBatch(b.version)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create batch of partition %s: %w", p.StoreKey, err)
+	}
+	b.batches[p.StoreKey] = batch
+
+	return batch, nil
+}
+
+func (b *Batch) Set(storeKey, key, value []byte) error {
+	batch, err := b.batch(storeKey)
+	if err != nil {
+		return err
+	}
+
+	return batch.Set(storeKey, key, value)
+}
+
+func (b *Batch) Delete(storeKey, key []byte) error {
+	batch, err := b.batch(storeKey)
+	if err != nil {
+		return err
+	}
+
+	return batch.Delete(storeKey, key)
+}
+
+func (b *Batch) Size() int {
+	size := b.defaultBatch.Size()
+	for _, batch := range b.batches {
+		size += batch.Size()
+	}
+
+	return size
+}
+
+// Write writes the batches of the partitions in the order of their store keys,
+// then the batch of the default partition. The latest version is only read from
+// the default partition, so it is set once every partition has been written: a
+// failure or a crash midway leaves the latest version unchanged, and the version
+// is written again on retry. Every partition records the version, even without
+// changes, so that its latest version follows the one of the default partition.
+func (b *Batch) Write() error {
+	storeKeys := make([]string, 0, len(b.db.partitions))
+	for storeKey := range b.db.partitions {
+		if _, err := b.batch([]byte(storeKey)); err != nil {
+			return err
+		}
+		storeKeys = append(storeKeys, storeKey)
+	}
+	slices.Sort(storeKeys)
+
+	for _, storeKey := range storeKeys {
+		if err := b.batches[storeKey].Write(); err != nil {
+			return fmt.Errorf("failed to write batch of partition %s: %w", storeKey, err)
+		}
+	}
+
+	return b.defaultBatch.Write()
+}
+
+func (b *Batch) Reset() error {
+	for _, batch := range b.batches {
+		if err := batch.Reset(); err != nil {
+			return err
+		}
+	}
+
+	return b.defaultBatch.Reset()
+}
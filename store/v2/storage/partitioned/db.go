@@ -0,0 +1,218 @@
+package partitioned
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/storage"
+)
+
+var (
+	_ storage.Database  = (*Database)(nil)
+	_ store.StorePruner = (*Database)(nil)
+)
+
+// Partition defines the physical partition of the state of a store key, e.g. a
+// separate PebbleDB instance with its own tuning.
+type Partition struct {
+	StoreKey string
+	DB       storage.Database
+
+	// PruneOptions defines the pruning of the partition. If nil, the partition is
+	// pruned along with the rest of the state storage. Otherwise, the partition
+	// keeps its own KeepRecent versions, and is pruned at most every Interval
+	// versions, whenever the state storage is pruned.
+	PruneOptions *store.PruneOptions
+}
+
+type partition struct {
+	Partition
+
+	// prunedVersion is the latest version at which the partition was pruned with
+	// its own PruneOptions.
+	prunedVersion uint64
+}
+
+// Database is a storage.Database giving some store keys their own physical
+// partition, so that they are compacted, tuned and pruned independently. The
+// state of the other store keys, as well as the latest version, are kept in the
+// default partition.
+type Database struct {
+	defaultDB  storage.Database
+	partitions map[string]*partition
+
+	mtx sync.Mutex // guards the pruning of the partitions
+}
+
+// New returns a Database with the given default partition and partitions.
+//
+// The latest version of each partition must be the one of the default partition,
+// or the next one if the write of a version was interrupted. Otherwise the
+// partition was added to, or removed from, an existing state storage, whose state
+// is then moved into the partitioned layout with migration.Manager.MigrateStorage.
+func New(defaultDB storage.Database, partitions ...Partition) (*Database, error) {
+	if defaultDB == nil {
+		return nil, errors.New("default partition is required")
+	}
+
+	latestVersion, err := defaultDB.GetLatestVersion()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get latest version of the default partition: %w", err)
+	}
+
+	db := &Database{
+		defaultDB:  defaultDB,
+		partitions: make(map[string]*partition, len(partitions)),
+	}
+	for _, p := range partitions {
+		if p.DB == nil {
+			return nil, fmt.Errorf("missing database of the partition of store key %s", p.StoreKey)
+		}
+		if _, ok := db.partitions[p.StoreKey]; ok {
+			return nil, fmt.Errorf("duplicate partition of store key %s", p.StoreKey)
+		}
+
+		version, err := p.DB.GetLatestVersion()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get latest version of the partition of store key %s: %w", p.StoreKey, err)
+		}
+		if version != latestVersion && version != latestVersion+1 {
+			return nil, fmt.Errorf(
+				"partition of store key %s is at version %d while the state storage is at version %d, migrate the state storage into the partitioned layout",
+				p.StoreKey, version, latestVersion)
+		}
+		db.partitions[p.StoreKey] = &partition{Partition: p}
+	}
+
+	return db, nil
+}
+
+// partitionDB returns the database of the partition of the store key.
+func (db *Database) partitionDB(storeKey []byte) storage.Database {
+	if p, ok := db.partitions[string(storeKey)]; ok {
+		return p.DB
+	}
+
+	return db.defaultDB
+}
+
+func (db *Database) NewBatch(version uint64) (store.Batch, error) {
+	return NewBatch(db, version)
+}
+
+func (db *Database) Has(storeKey []byte, version uint64, key []byte) (bool, error) {
+	return db.partitionDB(storeKey).Has(storeKey, version, key)
+}
+
+func (db *Database) Get(storeKey []byte, version uint64, key []byte) ([]byte, error) {
+	return db.partitionDB(storeKey).Get(storeKey, version, key)
+}
+
+// GetLatestVersion returns the latest version of the default partition, which is
+// written last by a batch.
+func (db *Database) GetLatestVersion() (uint64, error) {
+	return db.defaultDB.GetLatestVersion()
+}
+
+func (db *Database) SetLatestVersion(version uint64) error {
+	for storeKey, p := range db.partitions {
+		if err := p.DB.SetLatestVersion(version); err != nil {
+			return fmt.Errorf("failed to set latest version of partition %s: %w", storeKey, err)
+		}
+	}
+
+	return db.defaultDB.SetLatestVersion(version)
+}
+
+func (db *Database) Iterator(storeKey []byte, version uint64, start, end []byte) (corestore.Iterator, error) {
+	return db.partitionDB(storeKey).Iterator(storeKey, version, start, end)
+}
+
+func (db *Database) ReverseIterator(storeKey []byte, version uint64, start, end []byte) (corestore.Iterator, error) {
+	return db.partitionDB(storeKey).ReverseIterator(storeKey, version, start, end)
+}
+
+// Prune prunes the default partition and the partitions without PruneOptions up
+// to the given version, and the partitions with PruneOptions according to them.
+func (db *Database) Prune(version uint64) error {
+	return db.PruneStores(version, nil)
+}
+
+// PruneStores implements store.StorePruner. The partitions of the given store keys
+// are pruned up to their versions, or less if their PruneOptions keep more versions,
+// while the default partition is pruned up to the lowest version of the store keys
+// it contains.
+func (db *Database) PruneStores(version uint64, storeVersions map[string]uint64) error {
+	db.mtx.Lock()
+	defer db.mtx.Unlock()
+
+	latestVersion, err := db.defaultDB.GetLatestVersion()
+	if err != nil {
+		return fmt.Errorf("failed to get latest version: %w", err)
+	}
+
+	defaultVersion := version
+	for storeKey, storeVersion := range storeVersions {
+		if _, ok := db.partitions[storeKey]; !ok {
+			defaultVersion = min(defaultVersion, storeVersion)
+		}
+	}
+
+	for storeKey, p := range db.partitions {
+		storeVersion, overridden := storeVersions[storeKey]
+		if overridden && storeVersion == 0 {
+			continue
+		}
+
+		pruneVersion := version
+		if p.PruneOptions != nil {
+			var prune bool
+			if prune, pruneVersion = p.shouldPrune(latestVersion); !prune {
+				continue
+			}
+			p.prunedVersion = latestVersion
+		}
+		if overridden {
+			pruneVersion = min(pruneVersion, storeVersion)
+		}
+
+		if err := p.DB.Prune(pruneVersion); err != nil {
+			return fmt.Errorf("failed to prune partition %s: %w", storeKey, err)
+		}
+	}
+
+	if defaultVersion == 0 {
+		return nil
+	}
+
+	return db.defaultDB.Prune(defaultVersion)
+}
+
+// shouldPrune returns true if the partition should be pruned at the given latest
+// version, and the version to prune up to.
+func (p *partition) shouldPrune(latestVersion uint64) (bool, uint64) {
+	opts := p.PruneOptions
+	if opts.Interval == 0 || latestVersion <= opts.KeepRecent {
+		return false, 0
+	}
+	if p.prunedVersion != 0 && latestVersion-p.prunedVersion < opts.Interval {
+		return false, 0
+	}
+
+	return true, latestVersion - opts.KeepRecent - 1
+}
+
+func (db *Database) Close() error {
+	var errs []error
+	for storeKey, p := range db.partitions {
+		if err := p.DB.Close(); err != nil {
+			errs = append(errs, fmt.Errorf("failed to close partition %s: %w", storeKey, err))
+		}
+	}
+	errs = append(errs, db.defaultDB.Close())
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,265 @@
+package partitioned
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"cosmossdk.io/core/log"
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/store/v2"
+	storeerrors "cosmossdk.io/store/v2/errors"
+	"cosmossdk.io/store/v2/storage"
+	"cosmossdk.io/store/v2/storage/pebbledb"
+)
+
+func newPebbleDB(t require.TestingT, dir string) *pebbledb.Database {
+	db, err := pebbledb.New(dir)
+	require.NoError(t, err)
+	db.SetSync(false)
+
+	return db
+}
+
+func TestStorageTestSuite(t *testing.T) {
+	s := &storage.StorageTestSuite{
+		NewDB: func(dir string) (*storage.StorageStore, error) {
+			db, err := New(
+				newPebbleDB(t, filepath.Join(dir, "default")),
+				Partition{StoreKey: "store1", DB: newPebbleDB(t, filepath.Join(dir, "store1"))},
+			)
+
+			return storage.NewStorageStore(db, log.NewNopLogger()), err
+		},
+		EmptyBatchSize: 12,
+	}
+
+	suite.Run(t, s)
+}
+
+func TestDatabase_Partitions(t *testing.T) {
+	dir := t.TempDir()
+	dexDB := newPebbleDB(t, filepath.Join(dir, "dex"))
+	db, err := New(
+		newPebbleDB(t, filepath.Join(dir, "default")),
+		Partition{StoreKey: "bank", DB: newPebbleDB(t, filepath.Join(dir, "bank"))},
+		Partition{StoreKey: "dex", DB: dexDB, PruneOptions: &store.PruneOptions{KeepRecent: 10, Interval: 20}},
+	)
+	require.NoError(t, err)
+	defer db.Close()
+
+	ss := storage.NewStorageStore(db, log.NewNopLogger())
+	for v := uint64(1); v <= 100; v++ {
+		cs := corestore.NewChangesetWithPairs(map[string]corestore.KVPairs{
+			"bank": {{Key: []byte("key"), Value: []byte(fmt.Sprintf("bank%03d", v))}},
+			"dex":  {{Key: []byte("key"), Value: []byte(fmt.Sprintf("dex%03d", v))}},
+			"gov":  {{Key: []byte("key"), Value: []byte(fmt.Sprintf("gov%03d", v))}},
+		})
+		require.NoError(t, ss.ApplyChangeset(v, cs))
+	}
+
+	// the state of the store keys is kept in their partitions
+	bz, err := dexDB.Get([]byte("dex"), 100, []byte("key"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("dex100"), bz)
+	bz, err = dexDB.Get([]byte("bank"), 100, []byte("key"))
+	require.NoError(t, err)
+	require.Nil(t, bz)
+
+	for _, storeKey := range []string{"bank", "dex", "gov"} {
+		bz, err := ss.Get([]byte(storeKey), 42, []byte("key"))
+		require.NoError(t, err)
+		require.Equal(t, []byte(storeKey+"042"), bz)
+	}
+
+	latestVersion, err := ss.GetLatestVersion()
+	require.NoError(t, err)
+	require.Equal(t, uint64(100), latestVersion)
+
+	// the dex partition is pruned with its own options, keeping 10 versions
+	require.NoError(t, ss.Prune(50))
+
+	_, err = ss.Get([]byte("bank"), 50, []byte("key"))
+	require.ErrorAs(t, err, &storeerrors.ErrVersionPruned{})
+	_, err = ss.Get([]byte("gov"), 50, []byte("key"))
+	require.ErrorAs(t, err, &storeerrors.ErrVersionPruned{})
+	bz, err = ss.Get([]byte("bank"), 51, []byte("key"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("bank051"), bz)
+
+	_, err = ss.Get([]byte("dex"), 89, []byte("key"))
+	require.ErrorAs(t, err, &storeerrors.ErrVersionPruned{})
+	bz, err = ss.Get([]byte("dex"), 90, []byte("key"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("dex090"), bz)
+
+	// the dex partition is not pruned again before its interval
+	for v := uint64(101); v <= 110; v++ {
+		cs := corestore.NewChangesetWithPairs(map[string]corestore.KVPairs{
+			"dex": {{Key: []byte("key"), Value: []byte(fmt.Sprintf("dex%03d", v))}},
+		})
+		require.NoError(t, ss.ApplyChangeset(v, cs))
+	}
+	require.NoError(t, ss.Prune(60))
+
+	bz, err = ss.Get([]byte("dex"), 90, []byte("key"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("dex090"), bz)
+	_, err = ss.Get([]byte("bank"), 60, []byte("key"))
+	require.ErrorAs(t, err, &storeerrors.ErrVersionPruned{})
+}
+
+func TestDatabase_PruneStores(t *testing.T) {
+	dir := t.TempDir()
+	db, err := New(
+		newPebbleDB(t, filepath.Join(dir, "default")),
+		Partition{StoreKey: "bank", DB: newPebbleDB(t, filepath.Join(dir, "bank"))},
+		Partition{StoreKey: "gov", DB: newPebbleDB(t, filepath.Join(dir, "gov"))},
+	)
+	require.NoError(t, err)
+	defer db.Close()
+
+	ss := storage.NewStorageStore(db, log.NewNopLogger())
+	for v := uint64(1); v <= 100; v++ {
+		cs := corestore.NewChangesetWithPairs(map[string]corestore.KVPairs{
+			"bank": {{Key: []byte("key"), Value: []byte(fmt.Sprintf("bank%03d", v))}},
+			"gov":  {{Key: []byte("key"), Value: []byte(fmt.Sprintf("gov%03d", v))}},
+			"dex":  {{Key: []byte("key"), Value: []byte(fmt.Sprintf("dex%03d", v))}},
+		})
+		require.NoError(t, ss.ApplyChangeset(v, cs))
+	}
+
+	// gov is not pruned, bank is pruned to its own version
+	require.NoError(t, ss.PruneStores(80, map[string]uint64{"gov": 0, "bank": 50}))
+
+	bz, err := ss.Get([]byte("gov"), 1, []byte("key"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("gov001"), bz)
+	_, err = ss.Get([]byte("bank"), 50, []byte("key"))
+	require.ErrorAs(t, err, &storeerrors.ErrVersionPruned{})
+	bz, err = ss.Get([]byte("bank"), 51, []byte("key"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("bank051"), bz)
+	_, err = ss.Get([]byte("dex"), 80, []byte("key"))
+	require.ErrorAs(t, err, &storeerrors.ErrVersionPruned{})
+
+	// the default partition keeps the versions of an overridden store key it contains
+	require.NoError(t, ss.PruneStores(90, map[string]uint64{"dex": 85}))
+
+	_, err = ss.Get([]byte("dex"), 85, []byte("key"))
+	require.ErrorAs(t, err, &storeerrors.ErrVersionPruned{})
+	bz, err = ss.Get([]byte("dex"), 86, []byte("key"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("dex086"), bz)
+	_, err = ss.Get([]byte("gov"), 90, []byte("key"))
+	require.ErrorAs(t, err, &storeerrors.ErrVersionPruned{})
+}
+
+func TestNew(t *testing.T) {
+	dir := t.TempDir()
+	defaultDB := newPebbleDB(t, filepath.Join(dir, "default"))
+	defer defaultDB.Close()
+
+	_, err := New(nil)
+	require.Error(t, err)
+
+	_, err = New(defaultDB, Partition{StoreKey: "bank"})
+	require.Error(t, err)
+
+	bankDB := newPebbleDB(t, filepath.Join(dir, "bank"))
+	defer bankDB.Close()
+	_, err = New(defaultDB, Partition{StoreKey: "bank", DB: bankDB}, Partition{StoreKey: "bank", DB: bankDB})
+	require.Error(t, err)
+}
+
+func TestNew_PartitionVersion(t *testing.T) {
+	dir := t.TempDir()
+	defaultDB := newPebbleDB(t, filepath.Join(dir, "default"))
+	defer defaultDB.Close()
+	bankDB := newPebbleDB(t, filepath.Join(dir, "bank"))
+	defer bankDB.Close()
+	govDB := newPebbleDB(t, filepath.Join(dir, "gov"))
+	defer govDB.Close()
+
+	db, err := New(defaultDB, Partition{StoreKey: "bank", DB: bankDB}, Partition{StoreKey: "gov", DB: govDB})
+	require.NoError(t, err)
+	ss := storage.NewStorageStore(db, log.NewNopLogger())
+	for v := uint64(1); v <= 5; v++ {
+		require.NoError(t, ss.ApplyChangeset(v, corestore.NewChangesetWithPairs(map[string]corestore.KVPairs{
+			"bank": {{Key: []byte("key"), Value: []byte{byte(v)}}},
+			"dex":  {{Key: []byte("key"), Value: []byte{byte(v)}}},
+		})))
+	}
+
+	// the version of the partitions without changes follows the default partition
+	version, err := govDB.GetLatestVersion()
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), version)
+	_, err = New(defaultDB, Partition{StoreKey: "bank", DB: bankDB}, Partition{StoreKey: "gov", DB: govDB})
+	require.NoError(t, err)
+
+	// the state of dex is in the default partition, a new partition of dex would miss it
+	dexDB := newPebbleDB(t, filepath.Join(dir, "dex"))
+	defer dexDB.Close()
+	_, err = New(defaultDB, Partition{StoreKey: "bank", DB: bankDB}, Partition{StoreKey: "dex", DB: dexDB})
+	require.ErrorContains(t, err, "partition of store key dex is at version 0 while the state storage is at version 5")
+
+	// a partition written ahead of the default partition by an interrupted write is accepted
+	require.NoError(t, bankDB.SetLatestVersion(6))
+	_, err = New(defaultDB, Partition{StoreKey: "bank", DB: bankDB})
+	require.NoError(t, err)
+	require.NoError(t, bankDB.SetLatestVersion(7))
+	_, err = New(defaultDB, Partition{StoreKey: "bank", DB: bankDB})
+	require.ErrorContains(t, err, "partition of store key bank is at version 7")
+}
+
+// failingDB is a database whose batches fail to write.
+type failingDB struct {
+	storage.Database
+}
+
+func (db failingDB) NewBatch(version uint64) (store.Batch, error) {
+	b, err := db.Database.NewBatch(version)
+	if err != nil {
+		return nil, err
+	}
+	return failingBatch{b}, nil
+}
+
+type failingBatch struct {
+	store.Batch
+}
+
+func (failingBatch) Write() error {
+	return fmt.Errorf("write failed")
+}
+
+func TestBatch_WritePartitionFailure(t *testing.T) {
+	dir := t.TempDir()
+	db, err := New(
+		newPebbleDB(t, filepath.Join(dir, "default")),
+		Partition{StoreKey: "bank", DB: newPebbleDB(t, filepath.Join(dir, "bank"))},
+		Partition{StoreKey: "dex", DB: failingDB{newPebbleDB(t, filepath.Join(dir, "dex"))}},
+	)
+	require.NoError(t, err)
+	defer db.Close()
+
+	ss := storage.NewStorageStore(db, log.NewNopLogger())
+	cs := corestore.NewChangesetWithPairs(map[string]corestore.KVPairs{
+		"bank": {{Key: []byte("key"), Value: []byte("bank")}},
+		"dex":  {{Key: []byte("key"), Value: []byte("dex")}},
+		"gov":  {{Key: []byte("key"), Value: []byte("gov")}},
+	})
+	require.ErrorContains(t, ss.ApplyChangeset(1, cs), "failed to write batch of partition dex")
+
+	// the latest version is not set before every partition is written
+	latestVersion, err := ss.GetLatestVersion()
+	require.NoError(t, err)
+	require.Zero(t, latestVersion)
+	bz, err := ss.Get([]byte("gov"), 1, []byte("key"))
+	require.NoError(t, err)
+	require.Nil(t, bz)
+}
@@ -50,9 +50,15 @@ type Database struct {
 }
 
 func New(dataDir string) (*Database, error) {
-	opts := &pebble.Options{
-		Comparer: MVCCComparer,
-	}
+	return NewWithOptions(dataDir, &pebble.Options{})
+}
+
+// NewWithOptions returns a Database opened with the given PebbleDB options, e.g.
+// to tune a partition of the state storage. The comparer of the options is always
+// replaced by the MVCC comparer.
+func NewWithOptions(dataDir string, opts *pebble.Options) (*Database, error) {
+	opts = opts.Clone()
+	opts.Comparer = MVCCComparer
 	opts = opts.EnsureDefaults()
 
 	db, err := pebble.Open(dataDir, opts)
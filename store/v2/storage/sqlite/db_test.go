@@ -87,6 +87,30 @@ func TestDatabase_ReverseIterator(t *testing.T) {
 	require.Nil(t, iter3)
 }
 
+func TestDatabase_IteratorEmptyDomain(t *testing.T) {
+	db, err := New(t.TempDir())
+	require.NoError(t, err)
+	defer db.Close()
+
+	batch, err := db.NewBatch(1)
+	require.NoError(t, err)
+	require.NoError(t, batch.Set(storeKey1, []byte("key000"), []byte("val000")))
+	require.NoError(t, batch.Write())
+
+	// an empty domain yields an exhausted iterator without error
+	iter, err := db.Iterator(storeKey1, 1, []byte("key001"), []byte("key002"))
+	require.NoError(t, err)
+	require.False(t, iter.Valid())
+	require.NoError(t, iter.Error())
+	require.NoError(t, iter.Close())
+
+	iter, err = db.ReverseIterator([]byte("store2"), 1, nil, nil)
+	require.NoError(t, err)
+	require.False(t, iter.Valid())
+	require.NoError(t, iter.Error())
+	require.NoError(t, iter.Close())
+}
+
 func TestParallelWrites(t *testing.T) {
 	db, err := New(t.TempDir())
 	require.NoError(t, err)
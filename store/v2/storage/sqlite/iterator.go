@@ -86,7 +86,7 @@ func newIterator(db *Database, storeKey []byte, targetVersion uint64, start, end
 		valid:     rows.Next(),
 	}
 	if !itr.valid {
-		itr.err = fmt.Errorf("iterator invalid: %w", sql.ErrNoRows)
+		// an empty domain is not an error, the iterator is just exhausted
 		return itr, nil
 	}
 
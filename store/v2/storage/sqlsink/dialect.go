@@ -0,0 +1,62 @@
+package sqlsink
+
+import "fmt"
+
+// Dialect defines the SQL syntax differences between the supported databases.
+type Dialect struct {
+	// BytesType is the column type of binary keys and values.
+	BytesType string
+	// Placeholder returns the placeholder of the n-th argument of a statement,
+	// starting at 1.
+	Placeholder func(n int) string
+}
+
+var (
+	// SQLite is the Dialect of SQLite, e.g. with the github.com/mattn/go-sqlite3
+	// driver.
+	SQLite = Dialect{
+		BytesType:   "BLOB",
+		Placeholder: func(int) string { return "?" },
+	}
+
+	// Postgres is the Dialect of PostgreSQL and compatible databases, e.g. with
+	// the github.com/lib/pq or github.com/jackc/pgx drivers.
+	Postgres = Dialect{
+		BytesType:   "BYTEA",
+		Placeholder: func(n int) string { return fmt.Sprintf("$%d", n) },
+	}
+)
+
+func (d Dialect) createStmts() []string {
+	return []string{
+		fmt.Sprintf(`
+	CREATE TABLE IF NOT EXISTS state_changes (
+		store_key VARCHAR NOT NULL,
+		key %[1]s NOT NULL,
+		version BIGINT NOT NULL,
+		value %[1]s,
+		deleted BOOLEAN NOT NULL DEFAULT FALSE,
+		PRIMARY KEY (store_key, key, version)
+	)`, d.BytesType),
+		`CREATE INDEX IF NOT EXISTS idx_state_changes_version ON state_changes (version)`,
+		`CREATE TABLE IF NOT EXISTS state_export_versions (
+		version BIGINT NOT NULL PRIMARY KEY
+	)`,
+	}
+}
+
+func (d Dialect) upsertStmt() string {
+	return fmt.Sprintf(`
+	INSERT INTO state_changes (store_key, key, version, value, deleted)
+	VALUES (%s, %s, %s, %s, %s)
+	ON CONFLICT (store_key, key, version) DO UPDATE SET
+		value = excluded.value,
+		deleted = excluded.deleted`,
+		d.Placeholder(1), d.Placeholder(2), d.Placeholder(3), d.Placeholder(4), d.Placeholder(5))
+}
+
+func (d Dialect) versionStmt() string {
+	return fmt.Sprintf(`
+	INSERT INTO state_export_versions (version) VALUES (%s)
+	ON CONFLICT (version) DO NOTHING`, d.Placeholder(1))
+}
@@ -0,0 +1,273 @@
+// Package sqlsink mirrors the committed changesets of the state storage into a
+// relational schema, so that historical state can be queried with SQL without
+// touching the node.
+//
+// Every changed key is exported as a row of the state_changes table, with the
+// (store_key, key, version, value, deleted) columns, and every exported version
+// as a row of the state_export_versions table. The state of a key at a version
+// is its row with the greatest version at or below it, unless deleted.
+package sqlsink
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"cosmossdk.io/core/log"
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/store/v2"
+)
+
+const (
+	// defaultRetryInterval is the default interval between the attempts to export a
+	// changeset.
+	defaultRetryInterval = time.Second
+	// defaultMaxPending is the default maximum number of changesets waiting to be
+	// exported.
+	defaultMaxPending = 1000
+)
+
+var _ store.CommitListener = (*Sink)(nil)
+
+type versionedChangeset struct {
+	version   uint64
+	changeset *corestore.Changeset
+}
+
+// Sink is a store.CommitListener exporting the committed changesets to a SQL
+// database. It is not on the consensus path: the changesets are queued without
+// blocking the commit and exported in the background, retrying on failure, so a
+// slow or unavailable database never halts the node.
+//
+// The queue is bounded: once it holds the maximum number of pending changesets,
+// the changesets of the following commits are dropped and reported as errors
+// until the database catches up. The dropped versions are missing from the
+// state_export_versions table, so a reader can detect the gap.
+type Sink struct {
+	db      *sql.DB
+	dialect Dialect
+	logger  log.Logger
+
+	retryInterval time.Duration
+
+	mtx        sync.Mutex // guards all the fields below
+	queue      []*versionedChangeset
+	maxPending int
+	dropped    uint64
+	// firstDropped and lastDropped are the first and last dropped versions
+	firstDropped, lastDropped uint64
+	closed                    bool
+	err                       error
+
+	notify  chan struct{}
+	closeCh chan struct{}
+	doneCh  chan struct{}
+}
+
+// New returns a Sink exporting to the given database, creating the schema if it
+// does not exist, and starts exporting in the background.
+func New(db *sql.DB, dialect Dialect, logger log.Logger) (*Sink, error) {
+	for _, stmt := range dialect.createStmts() {
+		if _, err := db.Exec(stmt); err != nil {
+			return nil, fmt.Errorf("failed to exec SQL statement: %w", err)
+		}
+	}
+
+	s := &Sink{
+		db:            db,
+		dialect:       dialect,
+		logger:        logger.With("module", "sql_sink"),
+		retryInterval: defaultRetryInterval,
+		maxPending:    defaultMaxPending,
+		notify:        make(chan struct{}, 1),
+		closeCh:       make(chan struct{}),
+		doneCh:        make(chan struct{}),
+	}
+	go s.run()
+
+	return s, nil
+}
+
+// SetMaxPending sets the maximum number of changesets waiting to be exported,
+// above which the changesets are dropped.
+func (s *Sink) SetMaxPending(maxPending int) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.maxPending = maxPending
+}
+
+// ListenCommit queues the changeset of the committed version for export, or
+// drops it if the queue is full. It never blocks.
+func (s *Sink) ListenCommit(version uint64, cs *corestore.Changeset) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if s.closed {
+		s.logger.Error("changeset committed after the sink was closed", "version", version)
+		return
+	}
+	if len(s.queue) >= s.maxPending {
+		if s.dropped == 0 {
+			s.firstDropped = version
+		}
+		s.dropped++
+		s.lastDropped = version
+		s.logger.Error("export queue full, dropping changeset", "version", version, "pending", len(s.queue), "dropped", s.dropped)
+		return
+	}
+	s.queue = append(s.queue, &versionedChangeset{version: version, changeset: cs})
+
+	select {
+	case s.notify <- struct{}{}:
+	default:
+	}
+}
+
+// Pending returns the number of changesets waiting to be exported.
+func (s *Sink) Pending() int {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	return len(s.queue)
+}
+
+// Dropped returns the number of changesets dropped because the queue was full.
+func (s *Sink) Dropped() uint64 {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	return s.dropped
+}
+
+// LatestVersion returns the latest exported version, or 0 if none.
+func (s *Sink) LatestVersion() (uint64, error) {
+	var version sql.NullInt64
+	if err := s.db.QueryRow("SELECT MAX(version) FROM state_export_versions").Scan(&version); err != nil {
+		return 0, fmt.Errorf("failed to query latest exported version: %w", err)
+	}
+
+	return uint64(version.Int64), nil
+}
+
+// Close exports the queued changesets, attempting each once, and stops the
+// sink. It returns the errors of the changesets which could not be exported or
+// were dropped. It does not close the database.
+func (s *Sink) Close() error {
+	s.mtx.Lock()
+	if s.closed {
+		s.mtx.Unlock()
+		return errors.New("sink already closed")
+	}
+	s.closed = true
+	s.mtx.Unlock()
+
+	close(s.closeCh)
+	<-s.doneCh
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if s.dropped > 0 {
+		return errors.Join(s.err, fmt.Errorf("dropped %d changesets between versions %d and %d", s.dropped, s.firstDropped, s.lastDropped))
+	}
+
+	return s.err
+}
+
+func (s *Sink) run() {
+	defer close(s.doneCh)
+
+	for {
+		vc, ok := s.next()
+		if !ok {
+			return
+		}
+		s.export(vc)
+	}
+}
+
+// next returns the next queued changeset, waiting for one if the queue is empty.
+// It returns false once the sink is closed and the queue is empty.
+func (s *Sink) next() (*versionedChangeset, bool) {
+	for {
+		s.mtx.Lock()
+		if len(s.queue) > 0 {
+			vc := s.queue[0]
+			s.queue[0] = nil
+			s.queue = s.queue[1:]
+			s.mtx.Unlock()
+			return vc, true
+		}
+		closed := s.closed
+		s.mtx.Unlock()
+
+		if closed {
+			return nil, false
+		}
+
+		select {
+		case <-s.notify:
+		case <-s.closeCh:
+		}
+	}
+}
+
+// export writes the changeset, retrying until it succeeds or the sink is closed.
+func (s *Sink) export(vc *versionedChangeset) {
+	for {
+		err := s.write(vc)
+		if err == nil {
+			return
+		}
+		s.logger.Error("failed to export changeset", "version", vc.version, "err", err)
+
+		select {
+		case <-s.closeCh:
+			s.mtx.Lock()
+			s.err = errors.Join(s.err, fmt.Errorf("failed to export version %d: %w", vc.version, err))
+			s.mtx.Unlock()
+			return
+		case <-time.After(s.retryInterval):
+		}
+	}
+}
+
+// write writes the changeset in a single transaction.
+func (s *Sink) write(vc *versionedChangeset) (err error) {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to create SQL transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			err = errors.Join(err, tx.Rollback())
+		}
+	}()
+
+	stmt, err := tx.Prepare(s.dialect.upsertStmt())
+	if err != nil {
+		return fmt.Errorf("failed to prepare SQL statement: %w", err)
+	}
+	defer stmt.Close()
+
+	for _, changes := range vc.changeset.Changes {
+		for _, kv := range changes.StateChanges {
+			value := kv.Value
+			if kv.Remove {
+				value = nil
+			}
+			if _, err := stmt.Exec(string(changes.Actor), kv.Key, int64(vc.version), value, kv.Remove); err != nil {
+				return fmt.Errorf("failed to exec SQL statement: %w", err)
+			}
+		}
+	}
+
+	if _, err := tx.Exec(s.dialect.versionStmt(), int64(vc.version)); err != nil {
+		return fmt.Errorf("failed to exec SQL statement: %w", err)
+	}
+
+	return tx.Commit()
+}
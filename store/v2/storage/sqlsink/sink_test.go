@@ -0,0 +1,143 @@
+package sqlsink
+
+import (
+	"database/sql"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/log"
+	corestore "cosmossdk.io/core/store"
+)
+
+func openSQLite(t *testing.T) *sql.DB {
+	t.Helper()
+
+	db, err := sql.Open("sqlite3", filepath.Join(t.TempDir(), "export.db"))
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	return db
+}
+
+func TestSink(t *testing.T) {
+	db := openSQLite(t)
+	sink, err := New(db, SQLite, log.NewNopLogger())
+	require.NoError(t, err)
+
+	for v := uint64(1); v <= 10; v++ {
+		sink.ListenCommit(v, corestore.NewChangesetWithPairs(map[string]corestore.KVPairs{
+			"bank": {
+				{Key: []byte("balance"), Value: []byte(fmt.Sprintf("%d", v))},
+				{Key: []byte("supply"), Value: []byte("1000"), Remove: v == 5},
+			},
+		}))
+	}
+	sink.ListenCommit(11, corestore.NewChangeset())
+	require.NoError(t, sink.Close())
+	require.Zero(t, sink.Pending())
+
+	latestVersion, err := sink.LatestVersion()
+	require.NoError(t, err)
+	require.Equal(t, uint64(11), latestVersion)
+
+	// query the state of a key at a version
+	var value []byte
+	require.NoError(t, db.QueryRow(`
+	SELECT value FROM state_changes
+	WHERE store_key = ? AND key = ? AND version <= ? AND deleted = FALSE
+	ORDER BY version DESC LIMIT 1`, "bank", []byte("balance"), 7).Scan(&value))
+	require.Equal(t, []byte("7"), value)
+
+	var deleted bool
+	require.NoError(t, db.QueryRow(`
+	SELECT value, deleted FROM state_changes
+	WHERE store_key = ? AND key = ? AND version = ?`, "bank", []byte("supply"), 5).Scan(&value, &deleted))
+	require.True(t, deleted)
+	require.Nil(t, value)
+
+	var count int
+	require.NoError(t, db.QueryRow("SELECT COUNT(*) FROM state_changes").Scan(&count))
+	require.Equal(t, 20, count)
+
+	// re-exporting a version is idempotent
+	sink, err = New(db, SQLite, log.NewNopLogger())
+	require.NoError(t, err)
+	sink.ListenCommit(10, corestore.NewChangesetWithPairs(map[string]corestore.KVPairs{
+		"bank": {{Key: []byte("balance"), Value: []byte("ten")}},
+	}))
+	require.NoError(t, sink.Close())
+
+	require.NoError(t, db.QueryRow("SELECT COUNT(*) FROM state_changes").Scan(&count))
+	require.Equal(t, 20, count)
+	require.NoError(t, db.QueryRow(`
+	SELECT value FROM state_changes WHERE store_key = ? AND key = ? AND version = ?`,
+		"bank", []byte("balance"), 10).Scan(&value))
+	require.Equal(t, []byte("ten"), value)
+
+	require.Error(t, sink.Close())
+}
+
+func TestSink_Retry(t *testing.T) {
+	db := openSQLite(t)
+	sink, err := New(db, SQLite, log.NewNopLogger())
+	require.NoError(t, err)
+	sink.retryInterval = 10 * time.Millisecond
+
+	// the export fails while the table is missing, without blocking the commits
+	_, err = db.Exec("ALTER TABLE state_changes RENAME TO state_changes_tmp")
+	require.NoError(t, err)
+
+	cs := corestore.NewChangesetWithPairs(map[string]corestore.KVPairs{
+		"bank": {{Key: []byte("balance"), Value: []byte("1")}},
+	})
+	sink.ListenCommit(1, cs)
+	sink.ListenCommit(2, cs)
+	time.Sleep(50 * time.Millisecond)
+	require.Equal(t, 1, sink.Pending())
+
+	latestVersion, err := sink.LatestVersion()
+	require.NoError(t, err)
+	require.Zero(t, latestVersion)
+
+	_, err = db.Exec("ALTER TABLE state_changes_tmp RENAME TO state_changes")
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		latestVersion, err := sink.LatestVersion()
+		return err == nil && latestVersion == 2
+	}, time.Second, 10*time.Millisecond)
+	require.NoError(t, sink.Close())
+}
+
+func TestSink_DatabaseDown(t *testing.T) {
+	db := openSQLite(t)
+	sink, err := New(db, SQLite, log.NewNopLogger())
+	require.NoError(t, err)
+	sink.retryInterval = 10 * time.Millisecond
+	sink.SetMaxPending(3)
+
+	// the commits neither block nor grow the queue while the database is down
+	require.NoError(t, db.Close())
+	cs := corestore.NewChangesetWithPairs(map[string]corestore.KVPairs{
+		"bank": {{Key: []byte("balance"), Value: []byte("1")}},
+	})
+	sink.ListenCommit(1, cs)
+	require.Eventually(t, func() bool { return sink.Pending() == 0 }, time.Second, time.Millisecond)
+
+	for v := uint64(2); v <= 10; v++ {
+		sink.ListenCommit(v, cs)
+	}
+	require.Equal(t, 3, sink.Pending())
+	require.Equal(t, uint64(6), sink.Dropped())
+
+	err = sink.Close()
+	require.ErrorContains(t, err, "failed to export version 1")
+	require.ErrorContains(t, err, "failed to export version 4")
+	require.ErrorContains(t, err, "dropped 6 changesets between versions 5 and 10")
+	require.Zero(t, sink.Pending())
+}
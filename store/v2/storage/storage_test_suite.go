@@ -4,12 +4,15 @@ import (
 	"fmt"
 	"slices"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
 	corestore "cosmossdk.io/core/store"
 	"cosmossdk.io/store/v2"
+	storeerrors "cosmossdk.io/store/v2/errors"
+	"cosmossdk.io/store/v2/snapshots"
 )
 
 const (
@@ -183,6 +186,51 @@ func (s *StorageTestSuite) TestDatabase_ApplyChangeset() {
 	}
 }
 
+func (s *StorageTestSuite) TestDatabase_RestoreDelta() {
+	db, err := s.NewDB(s.T().TempDir())
+	s.Require().NoError(err)
+	defer db.Close()
+
+	s.Require().NoError(db.ApplyChangeset(5, corestore.NewChangesetWithPairs(map[string]corestore.KVPairs{
+		storeKey1: {{Key: []byte("key"), Value: []byte("value005")}, {Key: []byte("removed"), Value: []byte("value")}},
+	})))
+
+	// every version of the delta is written at its own version
+	chChangesets := make(chan *snapshots.VersionedChangeset, 10)
+	for v := uint64(6); v <= 10; v++ {
+		cs := corestore.NewChangesetWithPairs(map[string]corestore.KVPairs{
+			storeKey1: {{Key: []byte("key"), Value: []byte(fmt.Sprintf("value%03d", v))}},
+		})
+		if v == 8 {
+			cs.Add(storeKey1Bytes, []byte("removed"), nil, true)
+		}
+		chChangesets <- &snapshots.VersionedChangeset{Version: v, Changeset: cs}
+	}
+	close(chChangesets)
+	s.Require().NoError(db.RestoreDelta(chChangesets))
+
+	lv, err := db.GetLatestVersion()
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(10), lv)
+	for v := uint64(5); v <= 10; v++ {
+		bz, err := db.Get(storeKey1Bytes, v, []byte("key"))
+		s.Require().NoError(err)
+		s.Require().Equal([]byte(fmt.Sprintf("value%03d", v)), bz)
+
+		ok, err := db.Has(storeKey1Bytes, v, []byte("removed"))
+		s.Require().NoError(err)
+		s.Require().Equal(v < 8, ok)
+	}
+
+	// the versions must be greater than the latest version, the channel is drained
+	chChangesets = make(chan *snapshots.VersionedChangeset, 10)
+	chChangesets <- &snapshots.VersionedChangeset{Version: 10, Changeset: corestore.NewChangeset()}
+	chChangesets <- &snapshots.VersionedChangeset{Version: 11, Changeset: corestore.NewChangeset()}
+	close(chChangesets)
+	s.Require().ErrorContains(db.RestoreDelta(chChangesets), "not greater than latest version")
+	s.Require().Empty(chChangesets)
+}
+
 func (s *StorageTestSuite) TestDatabase_IteratorEmptyDomain() {
 	db, err := s.NewDB(s.T().TempDir())
 	s.Require().NoError(err)
@@ -586,6 +634,69 @@ func (s *StorageTestSuite) TestDatabase_Prune() {
 	}
 }
 
+func (s *StorageTestSuite) TestDatabase_VersionTime() {
+	if slices.Contains(s.SkipTests, s.T().Name()) {
+		s.T().SkipNow()
+	}
+
+	db, err := s.NewDB(s.T().TempDir())
+	s.Require().NoError(err)
+	defer db.Close()
+
+	genesisTime := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	_, err = db.GetVersionAtTime(genesisTime)
+	s.Require().ErrorIs(err, storeerrors.ErrRecordNotFound)
+
+	// commit a version every 5 seconds
+	for v := uint64(1); v <= 50; v++ {
+		cs := corestore.NewChangesetWithPairs(map[string]corestore.KVPairs{storeKey1: {
+			{Key: []byte("key"), Value: []byte(fmt.Sprintf("val%03d", v))},
+		}})
+		s.Require().NoError(db.ApplyChangesetAtTime(v, cs, genesisTime.Add(time.Duration(v-1)*5*time.Second)))
+	}
+
+	// a version is not written without its time
+	cs := corestore.NewChangesetWithPairs(map[string]corestore.KVPairs{storeKey1: {
+		{Key: []byte("key"), Value: []byte("val051")},
+	}})
+	s.Require().Error(db.ApplyChangesetAtTime(51, cs, time.Unix(-1, 0)))
+	latestVersion, err := db.GetLatestVersion()
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(50), latestVersion)
+
+	testCases := []struct {
+		t          time.Time
+		expVersion uint64
+	}{
+		{genesisTime, 1},
+		{genesisTime.Add(time.Nanosecond), 1},
+		{genesisTime.Add(4 * time.Second), 1},
+		{genesisTime.Add(5 * time.Second), 2},
+		{genesisTime.Add(123 * time.Second), 25},
+		{genesisTime.Add(245 * time.Second), 50},
+		{genesisTime.Add(24 * time.Hour), 50},
+	}
+	for _, tc := range testCases {
+		version, err := db.GetVersionAtTime(tc.t)
+		s.Require().NoError(err)
+		s.Require().Equal(tc.expVersion, version, tc.t)
+	}
+
+	_, err = db.GetVersionAtTime(genesisTime.Add(-time.Nanosecond))
+	s.Require().ErrorIs(err, storeerrors.ErrRecordNotFound)
+
+	// the index is kept through pruning, but the pruned versions can't be resolved
+	s.Require().NoError(db.Prune(25))
+
+	version, err := db.GetVersionAtTime(genesisTime.Add(200 * time.Second))
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(41), version)
+
+	_, err = db.GetVersionAtTime(genesisTime.Add(100 * time.Second))
+	s.Require().ErrorAs(err, &storeerrors.ErrVersionPruned{})
+}
+
 func (s *StorageTestSuite) TestDatabase_Prune_KeepRecent() {
 	if slices.Contains(s.SkipTests, s.T().Name()) {
 		s.T().SkipNow()
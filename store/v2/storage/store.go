@@ -1,11 +1,15 @@
 package storage
 
 import (
+	"encoding/binary"
 	"fmt"
+	"math"
+	"time"
 
 	"cosmossdk.io/core/log"
 	corestore "cosmossdk.io/core/store"
 	"cosmossdk.io/store/v2"
+	storeerrors "cosmossdk.io/store/v2/errors"
 	"cosmossdk.io/store/v2/snapshots"
 )
 
@@ -14,10 +18,18 @@ const (
 	defaultBatchBufferSize = 100000
 )
 
+// timeIndexStoreKey is the reserved store key of the index of the block time of
+// each version. Its keys are the big-endian Unix nanoseconds of the block times,
+// and its values the big-endian versions.
+var timeIndexStoreKey = []byte("_time_index")
+
 var (
-	_ store.VersionedDatabase      = (*StorageStore)(nil)
-	_ snapshots.StorageSnapshotter = (*StorageStore)(nil)
-	_ store.Pruner                 = (*StorageStore)(nil)
+	_ store.VersionedDatabase           = (*StorageStore)(nil)
+	_ store.VersionTimeIndexer          = (*StorageStore)(nil)
+	_ snapshots.StorageSnapshotter      = (*StorageStore)(nil)
+	_ snapshots.DeltaStorageSnapshotter = (*StorageStore)(nil)
+	_ store.Pruner                      = (*StorageStore)(nil)
+	_ store.StorePruner                 = (*StorageStore)(nil)
 )
 
 // StorageStore is a wrapper around the store.VersionedDatabase interface.
@@ -46,11 +58,36 @@ func (ss *StorageStore) Get(storeKey []byte, version uint64, key []byte) ([]byte
 
 // ApplyChangeset applies the given changeset to the storage.
 func (ss *StorageStore) ApplyChangeset(version uint64, cs *corestore.Changeset) error {
+	return ss.applyChangeset(version, cs, nil)
+}
+
+// ApplyChangesetAtTime applies the given changeset to the storage and indexes the
+// block time of the version in the same batch, so that a version is never written
+// without its time. A version with the same block time as a previous one replaces
+// it in the index.
+func (ss *StorageStore) ApplyChangesetAtTime(version uint64, cs *corestore.Changeset, t time.Time) error {
+	timeKey, err := encodeTimeKey(t)
+	if err != nil {
+		return err
+	}
+
+	return ss.applyChangeset(version, cs, timeKey)
+}
+
+// applyChangeset writes the changeset, and the time index entry of the version
+// if a time key is given, in a single batch.
+func (ss *StorageStore) applyChangeset(version uint64, cs *corestore.Changeset, timeKey []byte) error {
 	b, err := ss.db.NewBatch(version)
 	if err != nil {
 		return err
 	}
 
+	if timeKey != nil {
+		if err := b.Set(timeIndexStoreKey, timeKey, binary.BigEndian.AppendUint64(nil, version)); err != nil {
+			return err
+		}
+	}
+
 	for _, pairs := range cs.Changes {
 		for _, kvPair := range pairs.StateChanges {
 			if kvPair.Remove {
@@ -97,6 +134,73 @@ func (ss *StorageStore) Prune(version uint64) error {
 	return ss.db.Prune(version)
 }
 
+// PruneStores implements store.StorePruner. If the database can't prune the
+// stores separately, e.g. without a partition per store key, the whole store is
+// pruned up to the lowest version of all stores.
+func (ss *StorageStore) PruneStores(version uint64, storeVersions map[string]uint64) error {
+	if pruner, ok := ss.db.(store.StorePruner); ok {
+		return pruner.PruneStores(version, storeVersions)
+	}
+
+	for _, storeVersion := range storeVersions {
+		version = min(version, storeVersion)
+	}
+	if version == 0 {
+		return nil
+	}
+
+	return ss.db.Prune(version)
+}
+
+// GetVersionAtTime returns the last version committed at or before the given
+// block time. Versions committed before the index was written, e.g. restored from
+// a snapshot, can't be resolved.
+func (ss *StorageStore) GetVersionAtTime(t time.Time) (uint64, error) {
+	timeKey, err := encodeTimeKey(t)
+	if err != nil {
+		return 0, err
+	}
+
+	latestVersion, err := ss.db.GetLatestVersion()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get latest version: %w", err)
+	}
+
+	// the end of the iteration is the key right after the time, if any
+	var end []byte
+	if nanos := binary.BigEndian.Uint64(timeKey); nanos < math.MaxUint64 {
+		end = binary.BigEndian.AppendUint64(nil, nanos+1)
+	}
+
+	itr, err := ss.db.ReverseIterator(timeIndexStoreKey, latestVersion, nil, end)
+	if err != nil {
+		return 0, err
+	}
+	defer itr.Close()
+
+	if !itr.Valid() {
+		return 0, fmt.Errorf("no version committed at or before %s: %w", t.UTC().Format(time.RFC3339Nano), storeerrors.ErrRecordNotFound)
+	}
+
+	version := binary.BigEndian.Uint64(itr.Value())
+
+	// the index outlives pruning, which the version must not have been through
+	if _, err := ss.db.Get(timeIndexStoreKey, version, itr.Key()); err != nil {
+		return 0, err
+	}
+
+	return version, nil
+}
+
+// encodeTimeKey encodes a block time as a key of the time index.
+func encodeTimeKey(t time.Time) ([]byte, error) {
+	if t.Before(time.Unix(0, 0)) {
+		return nil, fmt.Errorf("block time %s is before the Unix epoch", t.UTC().Format(time.RFC3339Nano))
+	}
+
+	return binary.BigEndian.AppendUint64(nil, uint64(t.UnixNano())), nil
+}
+
 // Restore restores the store from the given channel.
 func (ss *StorageStore) Restore(version uint64, chStorage <-chan *corestore.StateChanges) error {
 	latestVersion, err := ss.db.GetLatestVersion()
@@ -137,6 +241,34 @@ func (ss *StorageStore) Restore(version uint64, chStorage <-chan *corestore.Stat
 	return nil
 }
 
+// RestoreDelta implements snapshots.DeltaStorageSnapshotter. The changeset of
+// every version is written in its own batch at its own version, so that the
+// intermediate versions of a delta snapshot can be queried. The channel is
+// drained on failure, and the first error returned.
+func (ss *StorageStore) RestoreDelta(chChangesets <-chan *snapshots.VersionedChangeset) error {
+	var restoreErr error
+	for vc := range chChangesets {
+		if restoreErr != nil {
+			continue
+		}
+
+		latestVersion, err := ss.db.GetLatestVersion()
+		if err != nil {
+			restoreErr = fmt.Errorf("failed to get latest version: %w", err)
+			continue
+		}
+		if vc.Version <= latestVersion {
+			restoreErr = fmt.Errorf("the delta snapshot version %d is not greater than latest version %d", vc.Version, latestVersion)
+			continue
+		}
+		if err := ss.ApplyChangeset(vc.Version, vc.Changeset); err != nil {
+			restoreErr = fmt.Errorf("failed to restore version %d: %w", vc.Version, err)
+		}
+	}
+
+	return restoreErr
+}
+
 // Close closes the store.
 func (ss *StorageStore) Close() error {
 	return ss.db.Close()
@@ -2,6 +2,7 @@ package store
 
 import (
 	"io"
+	"time"
 
 	coreheader "cosmossdk.io/core/header"
 	corestore "cosmossdk.io/core/store"
@@ -9,6 +10,14 @@ import (
 	"cosmossdk.io/store/v2/proof"
 )
 
+// CommitListener is notified of the changeset of each version committed by a
+// RootStore, e.g. to export state changes to an external system. It is called
+// synchronously once the version is committed, so it must not block, and it must
+// not modify the changeset.
+type CommitListener interface {
+	ListenCommit(version uint64, cs *corestore.Changeset)
+}
+
 // RootStore defines an abstraction layer containing a State Storage (SS) engine
 // and one or more State Commitment (SC) engines.
 type RootStore interface {
@@ -31,6 +40,15 @@ type RootStore interface {
 	// and key tuple. Queries should be routed to the underlying SS engine.
 	Query(storeKey []byte, version uint64, key []byte, prove bool) (QueryResult, error)
 
+	// GetVersionAtTime returns the last version committed at or before the given
+	// block time. Block times are indexed from the commit headers set with
+	// SetCommitHeader, by the SS engine.
+	GetVersionAtTime(t time.Time) (uint64, error)
+
+	// QueryAtTime behaves identically to Query except it queries the last version
+	// committed at or before the given block time.
+	QueryAtTime(storeKey []byte, t time.Time, key []byte, prove bool) (QueryResult, error)
+
 	// LoadVersion loads the RootStore to the given version.
 	LoadVersion(version uint64) error
 
@@ -62,6 +80,10 @@ type RootStore interface {
 	// SetMetrics sets the telemetry handler on the RootStore.
 	SetMetrics(m metrics.Metrics)
 
+	// AddCommitListener adds a listener notified of the changeset of each
+	// committed version.
+	AddCommitListener(l CommitListener)
+
 	io.Closer
 }
 
@@ -95,6 +117,16 @@ type PausablePruner interface {
 	PausePruning(pause bool)
 }
 
+// StorePruner extends the Pruner interface to prune the stores of some store keys
+// to their own version, e.g. for the per store key pruning overrides.
+type StorePruner interface {
+	Pruner
+
+	// PruneStores prunes the stores of the given store keys to their versions, a
+	// version of 0 pruning nothing, and the other stores to the provided version.
+	PruneStores(version uint64, storeVersions map[string]uint64) error
+}
+
 // QueryResult defines the response type to performing a query on a RootStore.
 type QueryResult struct {
 	Key      []byte
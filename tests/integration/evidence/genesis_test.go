@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/log"
 	"cosmossdk.io/x/evidence"
@@ -164,6 +165,28 @@ func (suite *GenesisTestSuite) TestExportGenesis() {
 	}
 }
 
+func (suite *GenesisTestSuite) TestHandledOracleMisbehavioursRoundTrip() {
+	consAddr, err := suite.keeper.ConsensusAddressCodec().BytesToString(ed25519.GenPrivKey().PubKey().Address())
+	suite.Require().NoError(err)
+
+	genesisState := types.DefaultGenesisState()
+	genesisState.HandledOracleMisbehaviours = []types.HandledOracleMisbehaviour{
+		{ConsensusAddress: consAddr, Height: 5},
+		{ConsensusAddress: consAddr, Height: 7},
+	}
+	suite.Require().NoError(evidence.InitGenesis(suite.ctx, suite.keeper, genesisState))
+
+	consAddrBz, err := suite.keeper.ConsensusAddressCodec().StringToBytes(consAddr)
+	suite.Require().NoError(err)
+	handled, err := suite.keeper.OracleMisbehaviours.Has(suite.ctx, collections.Join(consAddrBz, int64(5)))
+	suite.Require().NoError(err)
+	suite.Require().True(handled)
+
+	exported, err := evidence.ExportGenesis(suite.ctx, suite.keeper)
+	suite.Require().NoError(err)
+	suite.Require().Equal(genesisState.HandledOracleMisbehaviours, exported.HandledOracleMisbehaviours)
+}
+
 func TestGenesisTestSuite(t *testing.T) {
 	suite.Run(t, new(GenesisTestSuite))
 }
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"testing"
 	"time"
@@ -17,6 +18,7 @@ import (
 	"cosmossdk.io/core/comet"
 	"cosmossdk.io/core/header"
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 	"cosmossdk.io/x/auth"
 	authkeeper "cosmossdk.io/x/auth/keeper"
@@ -151,10 +153,12 @@ func initFixture(tb testing.TB) *fixture {
 
 	stakingKeeper.SetHooks(stakingtypes.NewMultiStakingHooks(slashingKeeper.Hooks()))
 
-	evidenceKeeper := keeper.NewKeeper(cdc, runtime.NewEnvironment(runtime.NewKVStoreService(keys[evidencetypes.StoreKey]), log.NewNopLogger(), runtime.EnvWithQueryRouterService(grpcQueryRouter), runtime.EnvWithMsgRouterService(msgRouter)), stakingKeeper, slashingKeeper, addresscodec.NewBech32Codec(sdk.Bech32PrefixAccAddr))
+	evidenceKeeper := keeper.NewKeeper(cdc, runtime.NewEnvironment(runtime.NewKVStoreService(keys[evidencetypes.StoreKey]), log.NewNopLogger(), runtime.EnvWithQueryRouterService(grpcQueryRouter), runtime.EnvWithMsgRouterService(msgRouter)), stakingKeeper, slashingKeeper, nil, addresscodec.NewBech32Codec(sdk.Bech32PrefixAccAddr), authority.String())
 	router := evidencetypes.NewRouter()
 	router = router.AddRoute(evidencetypes.RouteEquivocation, testEquivocationHandler(evidenceKeeper))
+	router = router.AddRoute(evidencetypes.RouteOracleMisbehaviour, evidenceKeeper.NewOracleMisbehaviourHandler(testOracleMisbehaviourVerifier))
 	evidenceKeeper.SetRouter(router)
+	evidenceKeeper.SetSlashFraction(evidencetypes.RouteOracleMisbehaviour, math.LegacyNewDecWithPrec(1, 2))
 
 	authModule := auth.NewAppModule(cdc, accountKeeper, acctsModKeeper, authsims.RandomGenesisAccounts)
 	bankModule := bank.NewAppModule(cdc, bankKeeper, accountKeeper)
@@ -470,6 +474,145 @@ func TestHandleDoubleSignAfterRotation(t *testing.T) {
 	assert.Assert(t, len(evidences) == 1)
 }
 
+func TestHandleLightClientAttack(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	ctx := f.sdkCtx.WithIsCheckTx(false).WithBlockHeight(1)
+	populateValidators(t, f)
+
+	power := int64(100)
+	tstaking := stakingtestutil.NewHelper(t, ctx, f.stakingKeeper)
+	for i := 0; i < 2; i++ {
+		f.accountKeeper.SetAccount(f.sdkCtx, f.accountKeeper.NewAccountWithAddress(f.sdkCtx, sdk.AccAddress(valAddresses[i])))
+		tstaking.CreateValidatorWithValPower(valAddresses[i], pubkeys[i], power, true)
+		assert.NilError(t, f.slashingKeeper.AddrPubkeyRelation.Set(f.sdkCtx, pubkeys[i].Address(), pubkeys[i]))
+	}
+
+	_, err := f.stakingKeeper.EndBlocker(f.sdkCtx)
+	assert.NilError(t, err)
+
+	oldTokens := make([]math.Int, 2)
+	for i := 0; i < 2; i++ {
+		consAddr, err := f.stakingKeeper.ConsensusAddressCodec().BytesToString(pubkeys[i].Address())
+		assert.NilError(t, err)
+		info := slashingtypes.NewValidatorSigningInfo(consAddr, f.sdkCtx.BlockHeight(), time.Unix(0, 0), false, int64(0))
+		assert.NilError(t, f.slashingKeeper.ValidatorSigningInfo.Set(f.sdkCtx, sdk.ConsAddress(pubkeys[i].Address()), info))
+
+		val, err := f.stakingKeeper.Validator(ctx, valAddresses[i])
+		assert.NilError(t, err)
+		oldTokens[i] = val.GetTokens()
+	}
+
+	// CometBFT reports one misbehavior per byzantine validator of the attack
+	now := time.Now().UTC()
+	nci := comet.Info{
+		Evidence: []comet.Evidence{
+			{
+				Validator:        comet.Validator{Address: pubkeys[0].Address(), Power: power},
+				Type:             comet.LightClientAttack,
+				Time:             now,
+				Height:           1,
+				TotalVotingPower: 2 * power,
+			},
+			{
+				Validator:        comet.Validator{Address: pubkeys[1].Address(), Power: power},
+				Type:             comet.LightClientAttack,
+				Time:             now,
+				Height:           1,
+				TotalVotingPower: 2 * power,
+			},
+		},
+	}
+
+	assert.NilError(t, f.evidenceKeeper.BeginBlocker(ctx.WithCometInfo(nci), cometInfoService))
+
+	// both byzantine validators should be slashed, jailed and tombstoned
+	for i := 0; i < 2; i++ {
+		val, err := f.stakingKeeper.Validator(ctx, valAddresses[i])
+		assert.NilError(t, err)
+		assert.Assert(t, val.IsJailed())
+		assert.Assert(t, val.GetTokens().LT(oldTokens[i]))
+		assert.Assert(t, f.slashingKeeper.IsTombstoned(ctx, sdk.ConsAddress(pubkeys[i].Address())))
+	}
+
+	// a single evidence is stored for the attack
+	var evidences []exported.Evidence
+	assert.NilError(t, f.evidenceKeeper.Evidences.Walk(ctx, nil, func(key []byte, value exported.Evidence) (stop bool, err error) {
+		evidences = append(evidences, value)
+		return false, nil
+	}))
+	assert.Equal(t, len(evidences), 1)
+	attack, ok := evidences[0].(*evidencetypes.LightClientAttack)
+	assert.Assert(t, ok)
+	assert.Equal(t, len(attack.ByzantineValidators), 2)
+}
+
+func TestHandleOracleMisbehaviour(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	ctx := f.sdkCtx.WithIsCheckTx(false).WithHeaderInfo(header.Info{Height: 1, Time: time.Now().UTC()})
+	populateValidators(t, f)
+
+	power := int64(100)
+	operatorAddr, valpubkey := valAddresses[0], pubkeys[0]
+	tstaking := stakingtestutil.NewHelper(t, ctx, f.stakingKeeper)
+	f.accountKeeper.SetAccount(f.sdkCtx, f.accountKeeper.NewAccountWithAddress(f.sdkCtx, sdk.AccAddress(operatorAddr)))
+	tstaking.CreateValidatorWithValPower(operatorAddr, valpubkey, power, true)
+
+	_, err := f.stakingKeeper.EndBlocker(f.sdkCtx)
+	assert.NilError(t, err)
+
+	consAddr, err := f.stakingKeeper.ConsensusAddressCodec().BytesToString(valpubkey.Address())
+	assert.NilError(t, err)
+	info := slashingtypes.NewValidatorSigningInfo(consAddr, f.sdkCtx.BlockHeight(), time.Unix(0, 0), false, int64(0))
+	assert.NilError(t, f.slashingKeeper.ValidatorSigningInfo.Set(f.sdkCtx, sdk.ConsAddress(valpubkey.Address()), info))
+
+	val, err := f.stakingKeeper.Validator(ctx, operatorAddr)
+	assert.NilError(t, err)
+	oldTokens := val.GetTokens()
+
+	// the power of the evidence is ignored, the validator is slashed based on
+	// its power at the infraction height returned by the verifier
+	evidence := &evidencetypes.OracleMisbehaviour{
+		Height:           1,
+		Time:             ctx.HeaderInfo().Time,
+		Power:            1,
+		ConsensusAddress: consAddr,
+		Oracle:           "price",
+		Proof:            []byte("invalid"),
+	}
+
+	// evidence rejected by the verifier is not handled
+	assert.ErrorContains(t, f.evidenceKeeper.SubmitEvidence(ctx, evidence), "invalid oracle misbehaviour proof")
+	val, err = f.stakingKeeper.Validator(ctx, operatorAddr)
+	assert.NilError(t, err)
+	assert.Assert(t, !val.IsJailed())
+
+	evidence.Proof = []byte("valid")
+	assert.NilError(t, f.evidenceKeeper.SubmitEvidence(ctx, evidence))
+
+	// should be slashed by the route slash fraction and jailed, but not tombstoned
+	val, err = f.stakingKeeper.Validator(ctx, operatorAddr)
+	assert.NilError(t, err)
+	assert.Assert(t, val.IsJailed())
+	assert.Assert(t, !f.slashingKeeper.IsTombstoned(ctx, sdk.ConsAddress(valpubkey.Address())))
+	infractionTokens := f.stakingKeeper.TokensFromConsensusPower(ctx, testOracleMisbehaviourPower)
+	expected := oldTokens.Sub(math.LegacyNewDecFromInt(infractionTokens).Mul(math.LegacyNewDecWithPrec(1, 2)).TruncateInt())
+	assert.Assert(t, val.GetTokens().Equal(expected), "expected %s, got %s", expected, val.GetTokens())
+
+	_, err = f.evidenceKeeper.Evidences.Get(ctx, evidence.Hash())
+	assert.NilError(t, err)
+
+	// the same misbehaviour with another power, and so another hash, is rejected
+	evidence.Power = power
+	assert.ErrorContains(t, f.evidenceKeeper.SubmitEvidence(ctx, evidence), evidencetypes.ErrEvidenceExists.Error())
+	val, err = f.stakingKeeper.Validator(ctx, operatorAddr)
+	assert.NilError(t, err)
+	assert.Assert(t, val.GetTokens().Equal(expected), "expected %s, got %s", expected, val.GetTokens())
+}
+
 func populateValidators(t assert.TestingT, f *fixture) {
 	// add accounts and set total supply
 	totalSupplyAmt := initAmt.MulRaw(int64(len(valAddresses)))
@@ -492,6 +635,18 @@ func newPubKey(pk string) (res cryptotypes.PubKey) {
 	return pubkey
 }
 
+// testOracleMisbehaviourPower is the power of the validator at the height of
+// the oracle misbehaviour proven by testOracleMisbehaviourVerifier.
+const testOracleMisbehaviourPower = 40
+
+func testOracleMisbehaviourVerifier(_ context.Context, e *evidencetypes.OracleMisbehaviour) (int64, error) {
+	if !bytes.Equal(e.Proof, []byte("valid")) {
+		return 0, errors.New("invalid oracle misbehaviour proof")
+	}
+
+	return testOracleMisbehaviourPower, nil
+}
+
 func testEquivocationHandler(_ interface{}) evidencetypes.Handler {
 	return func(ctx context.Context, e exported.Evidence) error {
 		if err := e.ValidateBasic(); err != nil {
@@ -0,0 +1,108 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/tools/confix"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+// ValidateCommand creates a new command for validating configuration files.
+// The sections map registers the config of additional sections of app.toml, keyed by section name, such as the
+// configs of the server/v2 components returned by their server Configs method. It can be nil.
+func ValidateCommand(sections map[string]any) *cobra.Command {
+	flagOutputFormat := "output-format"
+
+	cmd := &cobra.Command{
+		Use:   "validate <config-path>",
+		Short: "Validate the config file",
+		Long: `Validate the values of the Cosmos SDK configuration file (app.toml or client.toml). Configuration type is app by default.
+Unknown keys, values of the wrong type, out of range values and deprecated keys are reported with a suggested fix.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var configPath string
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			switch {
+			case len(args) > 0:
+				configPath = args[0]
+			case clientCtx.HomeDir != "":
+				configPath = filepath.Join(clientCtx.HomeDir, "config", "app.toml")
+			default:
+				return errors.New("must provide a path to the app.toml or client.toml")
+			}
+
+			configType := confix.AppConfigType
+			if ok, _ := cmd.Flags().GetBool(confix.ClientConfigType); ok {
+				configPath = strings.ReplaceAll(configPath, "app.toml", "client.toml") // for the case we are using the home dir of client ctx
+				configType = confix.ClientConfigType
+			} else if strings.HasSuffix(configPath, "client.toml") {
+				return errors.New("app.toml file expected, got client.toml, use --client flag to validate client.toml")
+			}
+
+			schema, err := confix.LoadSchema(configType)
+			if err != nil {
+				return fmt.Errorf("failed to load config schema: %w", err)
+			}
+
+			if configType == confix.AppConfigType {
+				for name, cfg := range sections {
+					if err := schema.RegisterSection(name, cfg); err != nil {
+						return err
+					}
+				}
+			}
+
+			file, err := os.ReadFile(configPath)
+			if err != nil {
+				return err
+			}
+
+			issues, err := schema.Validate(file)
+			if err != nil {
+				return err
+			}
+
+			if len(issues) > 0 {
+				// the issues are the output of the command
+				cmd.SilenceUsage = true
+				err = fmt.Errorf("found %d issue(s) in %s", len(issues), configPath)
+			}
+
+			if format, _ := cmd.Flags().GetString(flagOutputFormat); format == "json" {
+				if issues == nil {
+					issues = []confix.Issue{}
+				}
+
+				e := json.NewEncoder(cmd.OutOrStdout())
+				e.SetIndent("", "  ")
+				if encodeErr := e.Encode(issues); encodeErr != nil {
+					return encodeErr
+				}
+				return err
+			}
+
+			for _, issue := range issues {
+				fmt.Fprintln(cmd.OutOrStdout(), issue.String())
+			}
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "%s is valid\n", configPath)
+			return nil
+		},
+	}
+
+	cmd.Flags().Bool(confix.ClientConfigType, false, "validate client.toml instead of app.toml")
+	cmd.Flags().String(flagOutputFormat, "text", "Output format (json|text)")
+
+	return cmd
+}
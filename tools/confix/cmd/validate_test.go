@@ -0,0 +1,59 @@
+package cmd_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"cosmossdk.io/tools/confix"
+	"cosmossdk.io/tools/confix/cmd"
+
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+)
+
+func TestValidateCmd(t *testing.T) {
+	clientCtx, cleanup := initClientContext(t)
+	defer cleanup()
+
+	_, err := clitestutil.ExecTestCLICmd(clientCtx, cmd.ValidateCommand(nil), []string{})
+	assert.ErrorContains(t, err, "no such file or directory")
+
+	out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd.ValidateCommand(nil), []string{"--client"})
+	assert.NilError(t, err)
+	assert.Equal(t, strings.TrimSpace(out.String()), fmt.Sprintf("%s/config/client.toml is valid", clientCtx.HomeDir))
+
+	_, err = clitestutil.ExecTestCLICmd(clientCtx, cmd.ValidateCommand(nil), []string{filepath.Join(clientCtx.HomeDir, "config", "client.toml")})
+	assert.ErrorContains(t, err, "app.toml file expected, got client.toml, use --client flag to validate client.toml")
+
+	appConfig := filepath.Join(clientCtx.HomeDir, "config", "app.toml")
+	assert.NilError(t, os.WriteFile(appConfig, []byte(`minimum-gas-prices = "0stake"
+pruning = "custom"
+pruning-keep-recent = "100"
+pruning-interval = "10"
+[component]
+enable = "yes"`), 0o600))
+
+	out, err = clitestutil.ExecTestCLICmd(clientCtx, cmd.ValidateCommand(nil), []string{})
+	assert.ErrorContains(t, err, fmt.Sprintf("found 1 issue(s) in %s", appConfig))
+	assert.Assert(t, strings.HasPrefix(out.String(), "component: unknown key: key is not part of the configuration (remove it)\n"))
+
+	// sections registered by the app are validated
+	sections := map[string]any{
+		"component": struct {
+			Enable bool `mapstructure:"enable"`
+		}{},
+	}
+	out, err = clitestutil.ExecTestCLICmd(clientCtx, cmd.ValidateCommand(sections), []string{appConfig, "--output-format", "json"})
+	assert.ErrorContains(t, err, "found 1 issue(s)")
+
+	var issues []confix.Issue
+	assert.NilError(t, json.NewDecoder(strings.NewReader(out.String())).Decode(&issues))
+	assert.DeepEqual(t, issues, []confix.Issue{
+		{Type: confix.WrongType, Key: "component.enable", Message: "expected a boolean, got a string", Fix: "set it to a boolean"},
+	})
+}
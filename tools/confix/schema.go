@@ -0,0 +1,473 @@
+package confix
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pelletier/go-toml/v2"
+)
+
+// IssueType is the type of a problem found when validating a configuration file.
+type IssueType string
+
+const (
+	UnknownKey IssueType = "unknown key"
+	WrongType  IssueType = "wrong type"
+	OutOfRange IssueType = "out of range"
+	Deprecated IssueType = "deprecated"
+)
+
+// Issue is a problem found when validating a configuration file.
+type Issue struct {
+	Type    IssueType `json:"type"`
+	Key     string    `json:"key"`
+	Message string    `json:"message"`
+	Fix     string    `json:"fix,omitempty"`
+}
+
+func (i Issue) String() string {
+	s := fmt.Sprintf("%s: %s: %s", i.Key, i.Type, i.Message)
+	if i.Fix != "" {
+		s += " (" + i.Fix + ")"
+	}
+
+	return s
+}
+
+// Check validates the value of a configuration key. The whole configuration is given for checks depending on
+// other keys. It returns a description of the problem and a suggested fix, or an empty problem if the value is valid.
+type Check func(value any, cfg map[string]any) (problem, fix string)
+
+// Schema describes the keys of a configuration file, their types and the checks their values must pass.
+type Schema struct {
+	// fields maps the keys to the Go type of their value
+	fields map[string]reflect.Type
+	// tables are the keys of the tables, true if the table accepts any key
+	tables     map[string]bool
+	checks     map[string][]Check
+	deprecated map[string]string
+}
+
+// NewSchema returns an empty schema.
+func NewSchema() *Schema {
+	return &Schema{
+		fields:     map[string]reflect.Type{},
+		tables:     map[string]bool{},
+		checks:     map[string][]Check{},
+		deprecated: map[string]string{},
+	}
+}
+
+// RegisterSection registers the keys of a configuration struct under the section name, or at the top level if
+// name is empty. Keys are named after the mapstructure tag of the fields, or their toml tag, falling back to the
+// field name. The keys previously registered or deprecated in the section are replaced.
+func (s *Schema) RegisterSection(name string, cfg any) error {
+	t := reflect.TypeOf(cfg)
+	for t != nil && t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return fmt.Errorf("config of section %q must be a struct, got %T", name, cfg)
+	}
+
+	if name != "" {
+		for key := range s.fields {
+			if key == name || strings.HasPrefix(key, name+".") {
+				delete(s.fields, key)
+			}
+		}
+		for key := range s.tables {
+			if key == name || strings.HasPrefix(key, name+".") {
+				delete(s.tables, key)
+			}
+		}
+		for key := range s.deprecated {
+			if key == name || strings.HasPrefix(key, name+".") {
+				delete(s.deprecated, key)
+			}
+		}
+		s.tables[name] = false
+	}
+
+	s.registerStruct(name, t)
+	return nil
+}
+
+func (s *Schema) registerStruct(prefix string, t reflect.Type) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+
+		name, squash := fieldName(f)
+		if name == "-" {
+			continue
+		}
+
+		ft := f.Type
+		for ft.Kind() == reflect.Pointer {
+			ft = ft.Elem()
+		}
+
+		switch {
+		case ft.Kind() == reflect.Func || ft.Kind() == reflect.Chan:
+			// not part of the configuration file
+		case ft.Kind() == reflect.Struct && ft != timeType && squash:
+			s.registerStruct(prefix, ft)
+		case ft.Kind() == reflect.Struct && ft != timeType:
+			key := joinKey(prefix, name)
+			s.tables[key] = false
+			s.registerStruct(key, ft)
+		default:
+			s.fields[joinKey(prefix, name)] = ft
+		}
+	}
+}
+
+// registerDocument registers the keys of a TOML document missing from the schema, with the type of their value.
+func (s *Schema) registerDocument(prefix string, doc map[string]any) {
+	for name, value := range doc {
+		key := joinKey(prefix, name)
+		if _, ok := s.fields[key]; ok {
+			continue
+		}
+
+		if table, ok := value.(map[string]any); ok {
+			if _, ok := s.tables[key]; !ok {
+				s.tables[key] = false
+			}
+			s.registerDocument(key, table)
+			continue
+		}
+
+		if _, ok := s.tables[key]; ok {
+			continue
+		}
+
+		switch value.(type) {
+		case []any:
+			s.fields[key] = reflect.TypeOf([]any{})
+		case time.Time, toml.LocalDateTime, toml.LocalDate, toml.LocalTime:
+			s.fields[key] = timeType
+		default:
+			s.fields[key] = reflect.TypeOf(value)
+		}
+	}
+}
+
+// AddCheck adds a check of the value of a key.
+func (s *Schema) AddCheck(key string, check Check) {
+	s.checks[key] = append(s.checks[key], check)
+}
+
+// Deprecate marks a key as deprecated, with the suggested fix.
+func (s *Schema) Deprecate(key, fix string) {
+	s.deprecated[key] = fix
+}
+
+// Keys returns the sorted keys of the schema.
+func (s *Schema) Keys() []string {
+	keys := make([]string, 0, len(s.fields))
+	for key := range s.fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+// Validate validates the content of a TOML configuration file against the schema.
+func (s *Schema) Validate(bz []byte) ([]Issue, error) {
+	var cfg map[string]any
+	if err := toml.Unmarshal(bz, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to decode config file: %w", err)
+	}
+
+	issues := s.validateTable("", cfg, cfg)
+	sort.SliceStable(issues, func(i, j int) bool { return issues[i].Key < issues[j].Key })
+
+	return issues, nil
+}
+
+func (s *Schema) validateTable(prefix string, table, cfg map[string]any) []Issue {
+	var issues []Issue
+	for name, value := range table {
+		key := joinKey(prefix, name)
+
+		if fix, ok := s.deprecated[key]; ok {
+			issues = append(issues, Issue{Type: Deprecated, Key: key, Message: "key is deprecated", Fix: fix})
+			continue
+		}
+
+		if t, ok := s.fields[key]; ok {
+			if issue, ok := checkType(key, t, value); !ok {
+				issues = append(issues, issue)
+				continue
+			}
+
+			for _, check := range s.checks[key] {
+				if problem, fix := check(value, cfg); problem != "" {
+					issues = append(issues, Issue{Type: OutOfRange, Key: key, Message: problem, Fix: fix})
+				}
+			}
+			continue
+		}
+
+		if open, ok := s.tables[key]; ok {
+			subtable, isTable := value.(map[string]any)
+			switch {
+			case !isTable:
+				issues = append(issues, Issue{Type: WrongType, Key: key, Message: fmt.Sprintf("expected a table, got %s", kindOf(value)), Fix: fmt.Sprintf("define it as a [%s] section", key)})
+			case !open:
+				issues = append(issues, s.validateTable(key, subtable, cfg)...)
+			}
+			continue
+		}
+
+		issue := Issue{Type: UnknownKey, Key: key, Message: "key is not part of the configuration", Fix: "remove it"}
+		if suggestion := s.closestKey(key); suggestion != "" {
+			issue.Fix = fmt.Sprintf("did you mean %q?", suggestion)
+		}
+		issues = append(issues, issue)
+	}
+
+	return issues
+}
+
+// closestKey returns the known key of the same table closest to the given key, if close enough to be a typo.
+func (s *Schema) closestKey(key string) string {
+	parent, name, _ := cutLastKey(key)
+
+	candidates := s.Keys()
+	for table := range s.tables {
+		candidates = append(candidates, table)
+	}
+	sort.Strings(candidates)
+
+	best, bestDistance := "", len(name)/3+1
+	for _, candidate := range candidates {
+		candidateParent, candidateName, _ := cutLastKey(candidate)
+		if candidateParent != parent {
+			continue
+		}
+
+		if d := levenshtein(name, candidateName); d < bestDistance {
+			best, bestDistance = candidate, d
+		}
+	}
+
+	return best
+}
+
+var (
+	timeType     = reflect.TypeOf(time.Time{})
+	durationType = reflect.TypeOf(time.Duration(0))
+)
+
+// checkType checks a value decoded from TOML can be decoded in the Go type t.
+func checkType(key string, t reflect.Type, value any) (Issue, bool) {
+	wrongType := func(expected string) (Issue, bool) {
+		return Issue{
+			Type:    WrongType,
+			Key:     key,
+			Message: fmt.Sprintf("expected %s, got %s", expected, kindOf(value)),
+			Fix:     fmt.Sprintf("set it to %s", expected),
+		}, false
+	}
+
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	if t == durationType {
+		switch v := value.(type) {
+		case int64:
+			return Issue{}, true
+		case string:
+			if _, err := time.ParseDuration(v); err == nil {
+				return Issue{}, true
+			}
+		}
+		return wrongType("a duration")
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		if _, ok := value.(string); !ok {
+			return wrongType("a string")
+		}
+	case reflect.Bool:
+		if _, ok := value.(bool); !ok {
+			return wrongType("a boolean")
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v, ok := asInt(value)
+		if !ok {
+			return wrongType("an integer")
+		}
+		if reflect.New(t).Elem().OverflowInt(v) {
+			return Issue{Type: OutOfRange, Key: key, Message: fmt.Sprintf("%d overflows %s", v, t.Kind()), Fix: "set it to a smaller value"}, false
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		v, ok := asInt(value)
+		if !ok {
+			return wrongType("an unsigned integer")
+		}
+		if v < 0 {
+			return Issue{Type: OutOfRange, Key: key, Message: fmt.Sprintf("%d is negative", v), Fix: "set it to a positive value or 0"}, false
+		}
+		if reflect.New(t).Elem().OverflowUint(uint64(v)) {
+			return Issue{Type: OutOfRange, Key: key, Message: fmt.Sprintf("%d overflows %s", v, t.Kind()), Fix: "set it to a smaller value"}, false
+		}
+	case reflect.Float32, reflect.Float64:
+		if _, ok := asFloat(value); !ok {
+			return wrongType("a float")
+		}
+	case reflect.Slice, reflect.Array:
+		values, ok := value.([]any)
+		if !ok {
+			return wrongType("an array")
+		}
+		for i, v := range values {
+			if issue, ok := checkType(fmt.Sprintf("%s[%d]", key, i), t.Elem(), v); !ok {
+				return issue, false
+			}
+		}
+	case reflect.Map:
+		values, ok := value.(map[string]any)
+		if !ok {
+			return wrongType("a table")
+		}
+		for k, v := range values {
+			if issue, ok := checkType(joinKey(key, k), t.Elem(), v); !ok {
+				return issue, false
+			}
+		}
+	case reflect.Struct:
+		if t == timeType {
+			switch value.(type) {
+			case time.Time, toml.LocalDateTime, toml.LocalDate, toml.LocalTime:
+			default:
+				return wrongType("a date-time")
+			}
+		} else if _, ok := value.(map[string]any); !ok {
+			return wrongType("a table")
+		}
+	}
+
+	return Issue{}, true
+}
+
+// asInt returns the integer value of a decoded value. Like when the config is read by the node, integers may be
+// given as strings.
+func asInt(value any) (int64, bool) {
+	switch v := value.(type) {
+	case int64:
+		return v, true
+	case string:
+		n, err := strconv.ParseInt(v, 10, 64)
+		return n, err == nil
+	default:
+		return 0, false
+	}
+}
+
+// asFloat returns the float value of a decoded value, which may be given as an integer or a string.
+func asFloat(value any) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case int64:
+		return float64(v), true
+	case string:
+		f, err := strconv.ParseFloat(v, 64)
+		return f, err == nil
+	default:
+		return 0, false
+	}
+}
+
+// kindOf returns the TOML kind of a decoded value.
+func kindOf(value any) string {
+	switch value.(type) {
+	case string:
+		return "a string"
+	case bool:
+		return "a boolean"
+	case int64:
+		return "an integer"
+	case float64:
+		return "a float"
+	case []any:
+		return "an array"
+	case map[string]any:
+		return "a table"
+	default:
+		return "a date-time"
+	}
+}
+
+// fieldName returns the configuration key of a struct field and whether its fields are squashed in the parent.
+func fieldName(f reflect.StructField) (string, bool) {
+	for _, tag := range []string{"mapstructure", "toml"} {
+		value, ok := f.Tag.Lookup(tag)
+		if !ok {
+			continue
+		}
+
+		name, opts, _ := strings.Cut(value, ",")
+		squash := strings.Contains(opts, "squash") || (f.Anonymous && name == "")
+		if name == "" {
+			name = f.Name
+		}
+		return name, squash
+	}
+
+	return f.Name, f.Anonymous
+}
+
+func joinKey(prefix, name string) string {
+	if prefix == "" {
+		return name
+	}
+
+	return prefix + "." + name
+}
+
+// cutLastKey splits a key into its parent table key and its name.
+func cutLastKey(key string) (string, string, bool) {
+	i := strings.LastIndex(key, ".")
+	if i < 0 {
+		return "", key, false
+	}
+
+	return key[:i], key[i+1:], true
+}
+
+// levenshtein returns the edit distance between two strings.
+func levenshtein(a, b string) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+
+	return prev[len(b)]
+}
@@ -0,0 +1,210 @@
+package confix_test
+
+import (
+	"bytes"
+	"testing"
+	"text/template"
+	"time"
+
+	"gotest.tools/v3/assert"
+
+	"cosmossdk.io/tools/confix"
+
+	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
+)
+
+type componentConfig struct {
+	Enable   bool                `mapstructure:"enable" toml:"enable"`
+	Address  string              `toml:"address"`
+	MaxConns uint16              `mapstructure:"max-conns" toml:"max-conns"`
+	Timeout  time.Duration       `mapstructure:"timeout" toml:"timeout"`
+	Labels   map[string]string   `mapstructure:"labels" toml:"labels"`
+	Events   map[string]struct{} `mapstructure:"events" toml:"events"`
+	Nested   struct {
+		Level int8 `mapstructure:"level" toml:"level"`
+	} `mapstructure:"nested" toml:"nested"`
+	Filter func() bool // not part of the config file
+}
+
+func TestValidateAppConfig(t *testing.T) {
+	schema, err := confix.LoadSchema(confix.AppConfigType)
+	assert.NilError(t, err)
+
+	// the default configs are valid
+	issues, err := schema.Validate(mustReadConfig(t, "data/v0.51-app.toml"))
+	assert.NilError(t, err)
+	assert.Assert(t, len(issues) == 0, issues)
+
+	// the app.toml of the SDK confix is built with only reports the keys removed in the latest configuration
+	tmpl, err := template.New("").Parse(serverconfig.DefaultConfigTemplate)
+	assert.NilError(t, err)
+	cfg := serverconfig.DefaultConfig()
+	cfg.MinGasPrices = "0stake"
+	var appConfig bytes.Buffer
+	assert.NilError(t, tmpl.Execute(&appConfig, cfg))
+	issues, err = schema.Validate(appConfig.Bytes())
+	assert.NilError(t, err)
+	for _, issue := range issues {
+		assert.Equal(t, issue.Type, confix.Deprecated, issue)
+	}
+
+	issues, err = schema.Validate(mustReadConfig(t, "data/v0.45-app.toml"))
+	assert.NilError(t, err)
+	assert.DeepEqual(t, issues, []confix.Issue{
+		{Type: confix.Deprecated, Key: "grpc-web", Message: "key is deprecated", Fix: "removed in v0.51, remove it or run `config migrate v0.51`"},
+		{Type: confix.Deprecated, Key: "pruning-keep-every", Message: "key is deprecated", Fix: "removed in v0.46, remove it or run `config migrate v0.51`"},
+		{Type: confix.Deprecated, Key: "rosetta", Message: "key is deprecated", Fix: "removed in v0.50, remove it or run `config migrate v0.51`"},
+		{Type: confix.Deprecated, Key: "store", Message: "key is deprecated", Fix: "removed in v0.50, remove it or run `config migrate v0.51`"},
+		{Type: confix.Deprecated, Key: "streamers", Message: "key is deprecated", Fix: "removed in v0.50, remove it or run `config migrate v0.51`"},
+	})
+
+	testCases := []struct {
+		name   string
+		config string
+		issues []confix.Issue
+	}{
+		{
+			name: "unknown keys",
+			config: `pruningg = "default"
+foo = 1
+[apii]
+enable = true`,
+			issues: []confix.Issue{
+				{Type: confix.UnknownKey, Key: "apii", Message: "key is not part of the configuration", Fix: `did you mean "api"?`},
+				{Type: confix.UnknownKey, Key: "foo", Message: "key is not part of the configuration", Fix: "remove it"},
+				{Type: confix.UnknownKey, Key: "pruningg", Message: "key is not part of the configuration", Fix: `did you mean "pruning"?`},
+			},
+		},
+		{
+			name: "wrong types",
+			config: `halt-height = "ten"
+inter-block-cache = 1
+api = 1
+[telemetry]
+global-labels = [["chain-id", 1]]`,
+			issues: []confix.Issue{
+				{Type: confix.WrongType, Key: "api", Message: "expected a table, got an integer", Fix: "define it as a [api] section"},
+				{Type: confix.WrongType, Key: "halt-height", Message: "expected an unsigned integer, got a string", Fix: "set it to an unsigned integer"},
+				{Type: confix.WrongType, Key: "inter-block-cache", Message: "expected a boolean, got an integer", Fix: "set it to a boolean"},
+				{Type: confix.WrongType, Key: "telemetry.global-labels[0][1]", Message: "expected a string, got an integer", Fix: "set it to a string"},
+			},
+		},
+		{
+			name: "out of range values",
+			config: `minimum-gas-prices = "1stake,1stake"
+pruning = "sometimes"
+pruning-keep-recent = "-1"
+min-retain-blocks = -5
+[state-sync]
+snapshot-keep-recent = 4294967296`,
+			issues: []confix.Issue{
+				{Type: confix.OutOfRange, Key: "min-retain-blocks", Message: "-5 is negative", Fix: "set it to a positive value or 0"},
+				{Type: confix.OutOfRange, Key: "minimum-gas-prices", Message: "invalid minimum gas prices: duplicate denomination stake", Fix: `set it to a list of decimal coins, e.g. "0.025stake,0.01uatom"`},
+				{Type: confix.OutOfRange, Key: "pruning", Message: `invalid value "sometimes"`, Fix: `set it to one of ["default" "nothing" "everything" "custom"]`},
+				{Type: confix.OutOfRange, Key: "pruning-keep-recent", Message: `"-1" is not an unsigned integer`, Fix: `set it to an unsigned integer in a string, e.g. "100"`},
+				{Type: confix.OutOfRange, Key: "state-sync.snapshot-keep-recent", Message: "4294967296 overflows uint32", Fix: "set it to a smaller value"},
+			},
+		},
+		{
+			name: "custom pruning",
+			config: `minimum-gas-prices = "0stake"
+pruning = "custom"
+pruning-keep-recent = "1"
+pruning-interval = "0"`,
+			issues: []confix.Issue{
+				{Type: confix.OutOfRange, Key: "pruning-interval", Message: `'pruning-interval' must not be 0. If you want to disable pruning, select pruning = "nothing"`, Fix: "increase pruning-interval"},
+			},
+		},
+		{
+			name: "pruning retention",
+			config: `minimum-gas-prices = "0stake"
+pruning-keep-duration = "two weeks"
+pruning-store-overrides = ["gov=nothing", "bank=100", "staking", "upgrade=sometimes"]`,
+			issues: []confix.Issue{
+				{Type: confix.OutOfRange, Key: "pruning-keep-duration", Message: `"two weeks" is not a positive duration`, Fix: `set it to a duration, e.g. "336h", or to "0s" to disable it`},
+				{Type: confix.OutOfRange, Key: "pruning-store-overrides", Message: `invalid override "staking"`, Fix: `use the form <store-key>=<pruning>, e.g. "gov=nothing"`},
+			},
+		},
+		{
+			name: "state sync with pruning everything",
+			config: `minimum-gas-prices = "0stake"
+pruning = "everything"
+[state-sync]
+snapshot-interval = 1000`,
+			issues: []confix.Issue{
+				{Type: confix.OutOfRange, Key: "state-sync.snapshot-interval", Message: `state sync snapshots cannot be enabled with the "everything" pruning strategy`, Fix: `set it to 0, or set pruning to "default"`},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			issues, err := schema.Validate([]byte(tc.config))
+			assert.NilError(t, err)
+			assert.DeepEqual(t, issues, tc.issues)
+		})
+	}
+
+	_, err = schema.Validate([]byte("pruning = "))
+	assert.ErrorContains(t, err, "failed to decode config file")
+}
+
+func TestValidateClientConfig(t *testing.T) {
+	schema, err := confix.LoadSchema(confix.ClientConfigType)
+	assert.NilError(t, err)
+
+	issues, err := schema.Validate(mustReadConfig(t, "testdata/client.toml"))
+	assert.NilError(t, err)
+	assert.Assert(t, len(issues) == 0, issues)
+
+	issues, err = schema.Validate([]byte(`keyring-backend = "vault"
+output = "yaml"`))
+	assert.NilError(t, err)
+	assert.DeepEqual(t, issues, []confix.Issue{
+		{Type: confix.OutOfRange, Key: "keyring-backend", Message: `invalid value "vault"`, Fix: `set it to one of ["os" "file" "kwallet" "pass" "test" "memory"]`},
+		{Type: confix.OutOfRange, Key: "output", Message: `invalid value "yaml"`, Fix: `set it to one of ["text" "json"]`},
+	})
+
+	_, err = confix.LoadSchema("unknown")
+	assert.ErrorContains(t, err, `unsupported config type: "unknown"`)
+}
+
+func TestSchemaRegisterSection(t *testing.T) {
+	schema, err := confix.LoadSchema(confix.AppConfigType)
+	assert.NilError(t, err)
+
+	assert.ErrorContains(t, schema.RegisterSection("component", "config"), `config of section "component" must be a struct`)
+
+	// a section replaces the section of the same name
+	assert.NilError(t, schema.RegisterSection("grpc", &componentConfig{}))
+	assert.NilError(t, schema.RegisterSection("grpc-web", componentConfig{}))
+
+	config := `[grpc]
+enable = true
+address = "localhost:9090"
+max-conns = 100
+timeout = "10s"
+labels = { chain = "test" }
+[grpc.events.transfer]
+[grpc.nested]
+level = 1
+[grpc-web]
+max-conns = 65536
+timeout = "soon"
+labels = { chain = 1 }
+Filter = true
+[grpc-web.nested]
+level = 128
+max-recv-msg-size = 1`
+
+	issues, err := schema.Validate([]byte(config))
+	assert.NilError(t, err)
+	assert.DeepEqual(t, issues, []confix.Issue{
+		{Type: confix.UnknownKey, Key: "grpc-web.Filter", Message: "key is not part of the configuration", Fix: "remove it"},
+		{Type: confix.WrongType, Key: "grpc-web.labels.chain", Message: "expected a string, got an integer", Fix: "set it to a string"},
+		{Type: confix.OutOfRange, Key: "grpc-web.max-conns", Message: "65536 overflows uint16", Fix: "set it to a smaller value"},
+		{Type: confix.OutOfRange, Key: "grpc-web.nested.level", Message: "128 overflows int8", Fix: "set it to a smaller value"},
+		{Type: confix.UnknownKey, Key: "grpc-web.nested.max-recv-msg-size", Message: "key is not part of the configuration", Fix: "remove it"},
+		{Type: confix.WrongType, Key: "grpc-web.timeout", Message: "expected a duration, got a string", Fix: "set it to a duration"},
+	})
+}
@@ -0,0 +1,280 @@
+package confix
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/pelletier/go-toml/v2"
+
+	pruningtypes "cosmossdk.io/store/pruning/types"
+
+	clientconfig "github.com/cosmos/cosmos-sdk/client/config"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// LoadSchema returns the schema of the given config type (app or client).
+// It is generated from the SDK config structs and the default app.toml template, and completed with the keys of
+// the latest confix data for that config type. The keys removed from
+// the confix data since an older version are marked as deprecated.
+func LoadSchema(configType string) (*Schema, error) {
+	schema := NewSchema()
+
+	var err error
+	switch strings.ToLower(configType) {
+	case AppConfigType:
+		err = registerAppConfig(schema)
+		addAppChecks(schema)
+	case ClientConfigType:
+		err = schema.RegisterSection("", clientconfig.ClientConfig{})
+		addClientChecks(schema)
+	default:
+		return nil, fmt.Errorf("unsupported config type: %q", configType)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// keys of each version of the config, ordered from the oldest to the latest version
+	var (
+		versions    []string
+		versionKeys []map[string]bool
+		latestDoc   map[string]any
+	)
+	for _, version := range sortedVersions() {
+		doc, err := loadLocalData(version, configType)
+		if err != nil {
+			// not all versions have a client config
+			continue
+		}
+
+		versions = append(versions, version)
+		versionKeys = append(versionKeys, flattenKeys("", doc, map[string]bool{}))
+		latestDoc = doc
+	}
+
+	if len(versions) == 0 {
+		return schema, nil
+	}
+
+	schema.registerDocument("", latestDoc)
+
+	latest := versions[len(versions)-1]
+	for i := 1; i < len(versions); i++ {
+		for key := range versionKeys[i-1] {
+			if versionKeys[i][key] || versionKeys[len(versions)-1][key] {
+				continue
+			}
+			// only report the section if the whole section was removed
+			if parent, _, ok := cutLastKey(key); ok && !versionKeys[i][parent] && versionKeys[i-1][parent] {
+				continue
+			}
+
+			schema.Deprecate(key, fmt.Sprintf("removed in %s, remove it or run `config migrate %s`", versions[i], latest))
+		}
+	}
+
+	return schema, nil
+}
+
+// registerAppConfig registers the keys of the app config of the SDK, from its struct and its default template.
+// The sections of the server/v2 components are registered by the app, see cmd.ValidateCommand.
+func registerAppConfig(schema *Schema) error {
+	cfg := serverconfig.DefaultConfig()
+	if err := schema.RegisterSection("", cfg); err != nil {
+		return err
+	}
+
+	tmpl, err := template.New("appConfigFileTemplate").Parse(serverconfig.DefaultConfigTemplate)
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, cfg); err != nil {
+		return err
+	}
+
+	var doc map[string]any
+	if err := toml.Unmarshal(buf.Bytes(), &doc); err != nil {
+		return fmt.Errorf("failed to decode the default app config: %w", err)
+	}
+	schema.registerDocument("", doc)
+
+	return nil
+}
+
+// loadLocalData decodes the TOML document from confix data.
+func loadLocalData(name, configType string) (map[string]any, error) {
+	fileName, err := getFileName(name, configType)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := data.ReadFile(filepath.Join("data", fileName))
+	if err != nil {
+		return nil, err
+	}
+
+	var doc map[string]any
+	if err := toml.Unmarshal(bz, &doc); err != nil {
+		return nil, err
+	}
+
+	return doc, nil
+}
+
+// flattenKeys adds the keys of the tables and values of a decoded TOML document to keys.
+func flattenKeys(prefix string, doc map[string]any, keys map[string]bool) map[string]bool {
+	for name, value := range doc {
+		key := joinKey(prefix, name)
+		keys[key] = true
+		if table, ok := value.(map[string]any); ok {
+			flattenKeys(key, table, keys)
+		}
+	}
+
+	return keys
+}
+
+// sortedVersions returns the versions of the migrations, from the oldest to the latest.
+func sortedVersions() []string {
+	versions := make([]string, 0, len(Migrations))
+	for version := range Migrations {
+		versions = append(versions, version)
+	}
+
+	sort.Slice(versions, func(i, j int) bool {
+		vi := strings.Split(strings.TrimPrefix(versions[i], "v"), ".")
+		vj := strings.Split(strings.TrimPrefix(versions[j], "v"), ".")
+		for k := 0; k < len(vi) && k < len(vj); k++ {
+			ni, _ := strconv.Atoi(vi[k])
+			nj, _ := strconv.Atoi(vj[k])
+			if ni != nj {
+				return ni < nj
+			}
+		}
+		return len(vi) < len(vj)
+	})
+
+	return versions
+}
+
+func addAppChecks(schema *Schema) {
+	schema.AddCheck("minimum-gas-prices", func(value any, _ map[string]any) (string, string) {
+		gasPrices := value.(string)
+		if gasPrices == "" {
+			return "minimum gas prices are not set, the node does not start unless they are set by flag", `set it, e.g. to "0stake"`
+		}
+		if _, err := sdk.ParseDecCoins(gasPrices); err != nil {
+			return fmt.Sprintf("invalid minimum gas prices: %v", err), `set it to a list of decimal coins, e.g. "0.025stake,0.01uatom"`
+		}
+		return "", ""
+	})
+
+	schema.AddCheck("pruning", oneOf(
+		pruningtypes.PruningOptionDefault,
+		pruningtypes.PruningOptionNothing,
+		pruningtypes.PruningOptionEverything,
+		pruningtypes.PruningOptionCustom,
+	))
+	schema.AddCheck("pruning-keep-recent", checkCustomPruning("pruning-keep-recent"))
+	schema.AddCheck("pruning-interval", checkCustomPruning("pruning-interval"))
+	schema.AddCheck("pruning-keep-duration", func(value any, _ map[string]any) (string, string) {
+		if d, err := time.ParseDuration(value.(string)); err != nil || d < 0 {
+			return fmt.Sprintf("%q is not a positive duration", value), `set it to a duration, e.g. "336h", or to "0s" to disable it`
+		}
+		return "", ""
+	})
+	schema.AddCheck("pruning-store-overrides", func(value any, _ map[string]any) (string, string) {
+		overrides, _ := value.([]any)
+		for _, override := range overrides {
+			storeKey, pruning, ok := strings.Cut(fmt.Sprint(override), "=")
+			if !ok || storeKey == "" {
+				return fmt.Sprintf("invalid override %q", override), `use the form <store-key>=<pruning>, e.g. "gov=nothing"`
+			}
+
+			switch strings.ToLower(pruning) {
+			case pruningtypes.PruningOptionDefault, pruningtypes.PruningOptionNothing, pruningtypes.PruningOptionEverything:
+			default:
+				if _, err := strconv.ParseUint(pruning, 10, 64); err != nil {
+					return fmt.Sprintf("invalid pruning %q of store %s", pruning, storeKey), "set it to a pruning strategy or a number of recent heights to keep"
+				}
+			}
+		}
+		return "", ""
+	})
+
+	schema.AddCheck("state-sync.snapshot-interval", func(value any, cfg map[string]any) (string, string) {
+		if interval, _ := asInt(value); interval > 0 && cfg["pruning"] == pruningtypes.PruningOptionEverything {
+			return fmt.Sprintf("state sync snapshots cannot be enabled with the %q pruning strategy", pruningtypes.PruningOptionEverything),
+				fmt.Sprintf(`set it to 0, or set pruning to %q`, pruningtypes.PruningOptionDefault)
+		}
+		return "", ""
+	})
+
+	schema.AddCheck("telemetry.metrics-sink", oneOf("", telemetry.MetricSinkInMem, telemetry.MetricSinkStatsd, telemetry.MetricSinkDogsStatsd))
+}
+
+func addClientChecks(schema *Schema) {
+	schema.AddCheck("keyring-backend", oneOf(
+		keyring.BackendOS,
+		keyring.BackendFile,
+		keyring.BackendKWallet,
+		keyring.BackendPass,
+		keyring.BackendTest,
+		keyring.BackendMemory,
+	))
+	schema.AddCheck("output", oneOf(flags.OutputFormatText, flags.OutputFormatJSON))
+	schema.AddCheck("broadcast-mode", oneOf(flags.BroadcastSync, flags.BroadcastAsync))
+}
+
+// checkCustomPruning returns a check of the pruning-keep-recent or pruning-interval key, which are only used by
+// the custom pruning strategy.
+func checkCustomPruning(key string) Check {
+	return func(value any, cfg map[string]any) (string, string) {
+		if _, err := strconv.ParseUint(value.(string), 10, 64); err != nil {
+			return fmt.Sprintf("%q is not an unsigned integer", value), `set it to an unsigned integer in a string, e.g. "100"`
+		}
+
+		if cfg["pruning"] != pruningtypes.PruningOptionCustom {
+			return "", ""
+		}
+
+		// the other key is reported by its own check if invalid
+		keepRecent, _ := strconv.ParseUint(fmt.Sprint(cfg["pruning-keep-recent"]), 10, 64)
+		interval, _ := strconv.ParseUint(fmt.Sprint(cfg["pruning-interval"]), 10, 64)
+
+		err := pruningtypes.NewCustomPruningOptions(keepRecent, interval).Validate()
+		switch {
+		case errors.Is(err, pruningtypes.ErrPruningKeepRecentTooSmall) && key == "pruning-keep-recent",
+			(errors.Is(err, pruningtypes.ErrPruningIntervalZero) || errors.Is(err, pruningtypes.ErrPruningIntervalTooSmall)) && key == "pruning-interval":
+			return err.Error(), "increase " + key
+		default:
+			return "", ""
+		}
+	}
+}
+
+// oneOf returns a check of a string value against a list of allowed values.
+func oneOf(values ...string) Check {
+	return func(value any, _ map[string]any) (string, string) {
+		for _, v := range values {
+			if value == v {
+				return "", ""
+			}
+		}
+
+		return fmt.Sprintf("invalid value %q", value), fmt.Sprintf("set it to one of %q", values)
+	}
+}
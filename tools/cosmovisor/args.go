@@ -38,6 +38,10 @@ const (
 	EnvTimeFormatLogs           = "COSMOVISOR_TIMEFORMAT_LOGS"
 	EnvCustomPreupgrade         = "COSMOVISOR_CUSTOM_PREUPGRADE"
 	EnvDisableRecase            = "COSMOVISOR_DISABLE_RECASE"
+	EnvHealthCheckDuration      = "COSMOVISOR_HEALTH_CHECK_DURATION"
+	EnvHealthCheckRPC           = "COSMOVISOR_HEALTH_CHECK_RPC"
+	EnvPrefetchInterval         = "COSMOVISOR_PREFETCH_INTERVAL"
+	EnvGRPCAddress              = "COSMOVISOR_GRPC_ADDRESS"
 )
 
 const (
@@ -46,6 +50,12 @@ const (
 	upgradesDir = "upgrades"
 	currentLink = "current"
 
+	rollbackAlertFileName  = "rollback-alert.json"
+	pendingUpgradeFileName = "pending-upgrade.json"
+	prefetchStatusFileName = "prefetch-status.json"
+
+	defaultGRPCAddress = "localhost:9090"
+
 	cfgFileName  = "config"
 	cfgExtension = "toml"
 )
@@ -68,6 +78,10 @@ type Config struct {
 	TimeFormatLogs           string        `toml:"cosmovisor_timeformat_logs" mapstructure:"cosmovisor_timeformat_logs" default:"kitchen"`
 	CustomPreUpgrade         string        `toml:"cosmovisor_custom_preupgrade" mapstructure:"cosmovisor_custom_preupgrade" default:""`
 	DisableRecase            bool          `toml:"cosmovisor_disable_recase" mapstructure:"cosmovisor_disable_recase" default:"false"`
+	HealthCheckDuration      time.Duration `toml:"cosmovisor_health_check_duration" mapstructure:"cosmovisor_health_check_duration"`
+	HealthCheckRPC           string        `toml:"cosmovisor_health_check_rpc" mapstructure:"cosmovisor_health_check_rpc" default:""`
+	PrefetchInterval         time.Duration `toml:"cosmovisor_prefetch_interval" mapstructure:"cosmovisor_prefetch_interval"`
+	GRPCAddress              string        `toml:"cosmovisor_grpc_address" mapstructure:"cosmovisor_grpc_address" default:"localhost:9090"`
 
 	// currently running upgrade
 	currentUpgrade upgradetypes.Plan
@@ -109,6 +123,29 @@ func (cfg *Config) UpgradeInfoFilePath() string {
 	return filepath.Join(cfg.Home, "data", upgradetypes.UpgradeInfoFilename)
 }
 
+// RollbackAlertFilePath is the file written when an upgrade fails its health check and is rolled back.
+func (cfg *Config) RollbackAlertFilePath() string {
+	return filepath.Join(cfg.Root(), rollbackAlertFileName)
+}
+
+// PendingUpgradeFilePath is the file recording the upgrade whose binary has not passed the health check yet.
+func (cfg *Config) PendingUpgradeFilePath() string {
+	return filepath.Join(cfg.Root(), pendingUpgradeFileName)
+}
+
+// PrefetchStatusFilePath is the file reporting the readiness of the binary of the upgrade scheduled on chain.
+func (cfg *Config) PrefetchStatusFilePath() string {
+	return filepath.Join(cfg.Root(), prefetchStatusFileName)
+}
+
+// GRPCAddressOrDefault is the gRPC address of the node queried for the upgrade plan scheduled on chain.
+func (cfg *Config) GRPCAddressOrDefault() string {
+	if cfg.GRPCAddress == "" {
+		return defaultGRPCAddress
+	}
+	return cfg.GRPCAddress
+}
+
 // SymLinkToGenesis creates a symbolic link from "./current" to the genesis directory.
 func (cfg *Config) SymLinkToGenesis() (string, error) {
 	genesis := filepath.Join(cfg.Root(), genesisDir)
@@ -210,6 +247,8 @@ func GetConfigFromEnv() (*Config, error) {
 		Name:             os.Getenv(EnvName),
 		DataBackupPath:   os.Getenv(EnvDataBackupPath),
 		CustomPreUpgrade: os.Getenv(EnvCustomPreupgrade),
+		HealthCheckRPC:   os.Getenv(EnvHealthCheckRPC),
+		GRPCAddress:      os.Getenv(EnvGRPCAddress),
 	}
 
 	if cfg.DataBackupPath == "" {
@@ -276,6 +315,28 @@ func GetConfigFromEnv() (*Config, error) {
 		}
 	}
 
+	cfg.HealthCheckDuration = 0 // default value but makes it explicit
+	healthCheckDuration := os.Getenv(EnvHealthCheckDuration)
+	if healthCheckDuration != "" {
+		val, err := parseEnvDuration(healthCheckDuration)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("invalid: %s: %w", EnvHealthCheckDuration, err))
+		} else {
+			cfg.HealthCheckDuration = val
+		}
+	}
+
+	cfg.PrefetchInterval = 0 // default value but makes it explicit
+	prefetchInterval := os.Getenv(EnvPrefetchInterval)
+	if prefetchInterval != "" {
+		val, err := parseEnvDuration(prefetchInterval)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("invalid: %s: %w", EnvPrefetchInterval, err))
+		} else {
+			cfg.PrefetchInterval = val
+		}
+	}
+
 	envPreUpgradeMaxRetriesVal := os.Getenv(EnvPreupgradeMaxRetries)
 	if cfg.PreUpgradeMaxRetries, err = strconv.Atoi(envPreUpgradeMaxRetriesVal); err != nil && envPreUpgradeMaxRetriesVal != "" {
 		errs = append(errs, fmt.Errorf("%s could not be parsed to int: %w", EnvPreupgradeMaxRetries, err))
@@ -342,6 +403,16 @@ func (cfg *Config) validate() []error {
 		}
 	}
 
+	// validate the health check
+	if cfg.HealthCheckRPC != "" {
+		if cfg.HealthCheckDuration <= 0 {
+			errs = append(errs, fmt.Errorf("%s requires %s to be set", EnvHealthCheckRPC, EnvHealthCheckDuration))
+		}
+		if u, err := url.Parse(cfg.HealthCheckRPC); err != nil || u.Scheme == "" || u.Host == "" {
+			errs = append(errs, fmt.Errorf("%s must be a valid URL, got %q", EnvHealthCheckRPC, cfg.HealthCheckRPC))
+		}
+	}
+
 	// check the DataBackupPath
 	if cfg.UnsafeSkipBackup {
 		return errs
@@ -375,20 +446,10 @@ func (cfg *Config) SetCurrentUpgrade(u upgradetypes.Plan) (rerr error) {
 	}
 
 	// set a symbolic link
-	link := filepath.Join(cfg.Root(), currentLink)
 	safeName := url.PathEscape(u.Name)
 	upgrade := filepath.Join(cfg.Root(), upgradesDir, safeName)
-
-	// remove link if it exists
-	if _, err := os.Stat(link); err == nil {
-		if err := os.Remove(link); err != nil {
-			return fmt.Errorf("failed to remove existing link: %w", err)
-		}
-	}
-
-	// point to the new directory
-	if err := os.Symlink(upgrade, link); err != nil {
-		return fmt.Errorf("creating current symlink: %w", err)
+	if err := cfg.setCurrentLink(upgrade); err != nil {
+		return err
 	}
 
 	cfg.currentUpgrade = u
@@ -411,6 +472,25 @@ func (cfg *Config) SetCurrentUpgrade(u upgradetypes.Plan) (rerr error) {
 	return err
 }
 
+// setCurrentLink points the current link to the given directory.
+func (cfg *Config) setCurrentLink(dir string) error {
+	link := filepath.Join(cfg.Root(), currentLink)
+
+	// remove link if it exists
+	if _, err := os.Lstat(link); err == nil {
+		if err := os.Remove(link); err != nil {
+			return fmt.Errorf("failed to remove existing link: %w", err)
+		}
+	}
+
+	// point to the new directory
+	if err := os.Symlink(dir, link); err != nil {
+		return fmt.Errorf("creating current symlink: %w", err)
+	}
+
+	return nil
+}
+
 // UpgradeInfo returns the current upgrade info
 func (cfg *Config) UpgradeInfo() (upgradetypes.Plan, error) {
 	if cfg.currentUpgrade.Name != "" {
@@ -545,6 +625,10 @@ func (cfg Config) DetailString() string {
 		{EnvTimeFormatLogs, cfg.TimeFormatLogs},
 		{EnvCustomPreupgrade, cfg.CustomPreUpgrade},
 		{EnvDisableRecase, fmt.Sprintf("%t", cfg.DisableRecase)},
+		{EnvHealthCheckDuration, cfg.HealthCheckDuration.String()},
+		{EnvHealthCheckRPC, cfg.HealthCheckRPC},
+		{EnvPrefetchInterval, cfg.PrefetchInterval.String()},
+		{EnvGRPCAddress, cfg.GRPCAddressOrDefault()},
 	}
 
 	derivedEntries := []struct{ name, value string }{
@@ -553,6 +637,9 @@ func (cfg Config) DetailString() string {
 		{"Genesis Bin", cfg.GenesisBin()},
 		{"Monitored File", cfg.UpgradeInfoFilePath()},
 		{"Data Backup Dir", cfg.DataBackupPath},
+		{"Rollback Alert File", cfg.RollbackAlertFilePath()},
+		{"Pending Upgrade File", cfg.PendingUpgradeFilePath()},
+		{"Prefetch Status File", cfg.PrefetchStatusFilePath()},
 	}
 
 	var sb strings.Builder
@@ -0,0 +1,270 @@
+package cosmovisor
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/otiai10/copy"
+
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+)
+
+// ErrUpgradeRolledBack is returned when the binary of an upgrade failed its health check and the upgrade was rolled back.
+var ErrUpgradeRolledBack = errors.New("upgrade rolled back")
+
+// pendingUpgrade is an upgrade whose binary has not passed the health check yet.
+type pendingUpgrade struct {
+	plan upgradetypes.Plan
+	// previousDir is the directory the current link pointed to before the upgrade
+	previousDir string
+	// backupDir is the backup of the data directory taken before the upgrade, empty if none was taken
+	backupDir string
+}
+
+func (p pendingUpgrade) isSet() bool {
+	return p.plan.Name != ""
+}
+
+// pendingUpgradeFile is the content of the pending upgrade file, so that the health check of an upgrade binary
+// is not skipped when cosmovisor is restarted after the upgrade, e.g. with DAEMON_RESTART_AFTER_UPGRADE=false.
+type pendingUpgradeFile struct {
+	Upgrade     string `json:"upgrade"`
+	Height      int64  `json:"height"`
+	Info        string `json:"info,omitempty"`
+	PreviousDir string `json:"previous_dir"`
+	BackupDir   string `json:"backup_dir,omitempty"`
+}
+
+// loadPendingUpgrade reads the pending upgrade file, returning an unset pending upgrade if there is none.
+func loadPendingUpgrade(cfg *Config) (pendingUpgrade, error) {
+	bz, err := os.ReadFile(cfg.PendingUpgradeFilePath())
+	if os.IsNotExist(err) {
+		return pendingUpgrade{}, nil
+	}
+	if err != nil {
+		return pendingUpgrade{}, fmt.Errorf("error while reading pending upgrade file: %w", err)
+	}
+
+	var f pendingUpgradeFile
+	if err := json.Unmarshal(bz, &f); err != nil {
+		return pendingUpgrade{}, fmt.Errorf("error while decoding pending upgrade file %s: %w", cfg.PendingUpgradeFilePath(), err)
+	}
+	if f.Upgrade == "" || f.PreviousDir == "" {
+		return pendingUpgrade{}, fmt.Errorf("invalid pending upgrade file %s: missing upgrade name or previous directory", cfg.PendingUpgradeFilePath())
+	}
+
+	return pendingUpgrade{
+		plan:        upgradetypes.Plan{Name: f.Upgrade, Height: f.Height, Info: f.Info},
+		previousDir: f.PreviousDir,
+		backupDir:   f.BackupDir,
+	}, nil
+}
+
+// setPending sets the pending upgrade and writes it to the pending upgrade file, or removes the file if the
+// pending upgrade is unset.
+func (l Launcher) setPending(pending pendingUpgrade) error {
+	*l.pending = pending
+
+	if !pending.isSet() {
+		if err := os.Remove(l.cfg.PendingUpgradeFilePath()); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("error while removing pending upgrade file: %w", err)
+		}
+		return nil
+	}
+
+	bz, err := json.MarshalIndent(pendingUpgradeFile{
+		Upgrade:     pending.plan.Name,
+		Height:      pending.plan.Height,
+		Info:        pending.plan.Info,
+		PreviousDir: pending.previousDir,
+		BackupDir:   pending.backupDir,
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(l.cfg.PendingUpgradeFilePath(), bz, 0o644); err != nil {
+		return fmt.Errorf("error while writing pending upgrade file: %w", err)
+	}
+
+	return nil
+}
+
+// clearPending unsets the pending upgrade once its binary passed the health check.
+func (l Launcher) clearPending() {
+	if err := l.setPending(pendingUpgrade{}); err != nil {
+		l.logger.Error("failed to clear the pending upgrade", "error", err)
+	}
+}
+
+// healthCheckError is returned when the app fails the health check.
+type healthCheckError struct {
+	err error
+}
+
+func (e healthCheckError) Error() string {
+	return fmt.Sprintf("health check failed: %v", e.err)
+}
+
+// RollbackAlert is the content of the rollback alert file, written when an upgrade is rolled back.
+type RollbackAlert struct {
+	Upgrade        string    `json:"upgrade"`
+	Height         int64     `json:"height"`
+	Reason         string    `json:"reason"`
+	Time           time.Time `json:"time"`
+	RestoredBinary string    `json:"restored_binary"`
+	RestoredBackup string    `json:"restored_backup,omitempty"`
+}
+
+// checkHealth checks the health of the app launched right after the upgrade and sends the result on the returned channel.
+// Without a health check RPC endpoint, the app is healthy once the health check duration elapsed, the launcher
+// reporting the app exiting before. Otherwise the app is healthy once the endpoint reports a height above the
+// upgrade height, which must happen within the health check duration.
+func (l Launcher) checkHealth(ctx context.Context, plan upgradetypes.Plan) <-chan error {
+	result := make(chan error, 1)
+
+	go func() {
+		timeout := time.NewTimer(l.cfg.HealthCheckDuration)
+		defer timeout.Stop()
+
+		if l.cfg.HealthCheckRPC == "" {
+			select {
+			case <-timeout.C:
+				result <- nil
+			case <-ctx.Done():
+			}
+			return
+		}
+
+		var lastErr error
+		for {
+			height, err := queryHeight(ctx, l.cfg.HealthCheckRPC)
+			switch {
+			case err != nil:
+				lastErr = err
+			case height > plan.Height:
+				result <- nil
+				return
+			default:
+				lastErr = fmt.Errorf("height %d is not above the upgrade height %d", height, plan.Height)
+			}
+
+			select {
+			case <-timeout.C:
+				result <- fmt.Errorf("%s did not report a height above %d within %s: %w", l.cfg.HealthCheckRPC, plan.Height, l.cfg.HealthCheckDuration, lastErr)
+				return
+			case <-ctx.Done():
+				return
+			case <-time.After(l.cfg.PollInterval):
+			}
+		}
+	}()
+
+	return result
+}
+
+// queryHeight returns the latest block height reported by the status endpoint of a CometBFT RPC server.
+func queryHeight(ctx context.Context, rpc string) (int64, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(rpc, "/")+"/status", nil)
+	if err != nil {
+		return 0, err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	var status struct {
+		Result struct {
+			SyncInfo struct {
+				LatestBlockHeight string `json:"latest_block_height"`
+			} `json:"sync_info"`
+		} `json:"result"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&status); err != nil {
+		return 0, fmt.Errorf("failed to decode status: %w", err)
+	}
+
+	return strconv.ParseInt(status.Result.SyncInfo.LatestBlockHeight, 10, 64)
+}
+
+// privValidatorStateFile is the file in the data directory where the validator records its last signed height.
+const privValidatorStateFile = "priv_validator_state.json"
+
+// rollback restores the data backup taken before the pending upgrade, switches back to the previous binary
+// and writes the rollback alert file.
+// The current validator signing state is kept across the restore, restoring an older one would allow the
+// validator to sign again at heights it already signed and to double sign.
+func (l Launcher) rollback(reason error) error {
+	pending := *l.pending
+	if err := l.setPending(pendingUpgrade{}); err != nil {
+		return err
+	}
+
+	l.logger.Error("upgrade failed the health check, rolling back", "upgrade", pending.plan.Name, "error", reason)
+
+	alert := RollbackAlert{
+		Upgrade:        pending.plan.Name,
+		Height:         pending.plan.Height,
+		Reason:         reason.Error(),
+		Time:           time.Now().UTC(),
+		RestoredBinary: filepath.Join(pending.previousDir, "bin", l.cfg.Name),
+	}
+
+	if pending.backupDir != "" {
+		dataDir := filepath.Join(l.cfg.Home, "data")
+		statePath := filepath.Join(dataDir, privValidatorStateFile)
+		state, err := os.ReadFile(statePath)
+		if err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("error while reading validator state: %w", err)
+		}
+
+		if err := os.RemoveAll(dataDir); err != nil {
+			return fmt.Errorf("error while removing data directory: %w", err)
+		}
+		if err := copy.Copy(pending.backupDir, dataDir); err != nil {
+			return fmt.Errorf("error while restoring data backup: %w", err)
+		}
+
+		if state != nil {
+			if err := os.WriteFile(statePath, state, 0o600); err != nil {
+				return fmt.Errorf("error while keeping validator state: %w", err)
+			}
+		}
+
+		alert.RestoredBackup = pending.backupDir
+		l.logger.Info("data backup restored", "backup", pending.backupDir)
+	} else {
+		l.logger.Error("no data backup taken before the upgrade, the data directory is not restored")
+	}
+
+	if err := l.cfg.setCurrentLink(pending.previousDir); err != nil {
+		return fmt.Errorf("error while switching back to the previous binary: %w", err)
+	}
+	// the upgrade info is read again from the previous directory
+	l.cfg.currentUpgrade = upgradetypes.Plan{}
+	l.logger.Info("switched back to the previous binary", "path", alert.RestoredBinary)
+
+	bz, err := json.MarshalIndent(alert, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(l.cfg.RollbackAlertFilePath(), bz, 0o644); err != nil {
+		return fmt.Errorf("error while writing rollback alert file: %w", err)
+	}
+
+	return fmt.Errorf("%w: %s: %w", ErrUpgradeRolledBack, pending.plan.Name, reason)
+}
@@ -0,0 +1,364 @@
+package cosmovisor
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"  //nolint:gosec // md5 checksums are supported by go-getter
+	"crypto/sha1" //nolint:gosec // sha1 checksums are supported by go-getter
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"io"
+	neturl "net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"cosmossdk.io/x/upgrade/plan"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+)
+
+const (
+	// versionTimeout is the time given to an upgrade binary to run its version command.
+	versionTimeout = 30 * time.Second
+
+	// prefetchStopTimeout is the time given to an ongoing prefetch to stop once the app exited.
+	prefetchStopTimeout = 30 * time.Second
+)
+
+// PrefetchStatus is the content of the prefetch status file, reporting the readiness of the binary of
+// the upgrade scheduled on chain.
+type PrefetchStatus struct {
+	Upgrade   string    `json:"upgrade"`
+	Height    int64     `json:"height"`
+	Ready     bool      `json:"ready"`
+	Binary    string    `json:"binary"`
+	Version   string    `json:"version,omitempty"`
+	Error     string    `json:"error,omitempty"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// prefetchUpgrades polls the upgrade plan scheduled on chain every prefetch interval, using the gRPC endpoint of
+// the node, and prefetches the binary of the upgrade until ctx is done. The returned channel is closed once
+// polling stopped.
+func (l Launcher) prefetchUpgrades(ctx context.Context) <-chan struct{} {
+	done := make(chan struct{})
+	if l.cfg.PrefetchInterval <= 0 {
+		close(done)
+		return done
+	}
+
+	// the current upgrade is read before polling, as it is not safe for concurrent use
+	currentUpgrade, err := l.cfg.UpgradeInfo()
+	if err != nil {
+		currentUpgrade = upgradetypes.Plan{}
+	}
+
+	conn, err := grpc.NewClient(l.cfg.GRPCAddressOrDefault(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		l.logger.Error("failed to create gRPC client, prefetching disabled", "address", l.cfg.GRPCAddressOrDefault(), "error", err)
+		close(done)
+		return done
+	}
+	queryClient := upgradetypes.NewQueryClient(conn)
+
+	go func() {
+		defer close(done)
+		defer conn.Close()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(l.cfg.PrefetchInterval):
+			}
+
+			if err := l.prefetchUpgrade(ctx, queryClient, currentUpgrade); err != nil && ctx.Err() == nil {
+				l.logger.Error("failed to prefetch upgrade", "error", err)
+			}
+		}
+	}()
+
+	return done
+}
+
+// prefetchUpgrade prefetches the binary of the upgrade scheduled on chain, if any, and updates the prefetch status file.
+func (l Launcher) prefetchUpgrade(ctx context.Context, queryClient upgradetypes.QueryClient, currentUpgrade upgradetypes.Plan) error {
+	resp, err := queryClient.CurrentPlan(ctx, &upgradetypes.QueryCurrentPlanRequest{})
+	if err != nil {
+		return fmt.Errorf("failed to query upgrade plan: %w", err)
+	}
+
+	if resp.Plan == nil || resp.Plan.Name == "" {
+		// no upgrade scheduled anymore
+		if err := os.Remove(l.cfg.PrefetchStatusFilePath()); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return nil
+	}
+
+	p := *resp.Plan
+	// normalize name the same way the upgrade-info.json file is
+	if !l.cfg.DisableRecase {
+		p.Name = strings.ToLower(p.Name)
+	}
+
+	if p.Name == currentUpgrade.Name {
+		return nil
+	}
+
+	if status, err := readPrefetchStatus(l.cfg.PrefetchStatusFilePath()); err == nil &&
+		status.Ready && status.Upgrade == p.Name && status.Height == p.Height {
+		return nil
+	}
+
+	status := l.prefetchBinary(ctx, p)
+	bz, err := json.MarshalIndent(status, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(l.cfg.PrefetchStatusFilePath(), bz, 0o644)
+}
+
+// prefetchBinary downloads the binary of the upgrade if it is not present yet, or verifies the present one against
+// the checksum declared in the plan, and checks it runs.
+func (l Launcher) prefetchBinary(ctx context.Context, p upgradetypes.Plan) PrefetchStatus {
+	status := PrefetchStatus{
+		Upgrade:   p.Name,
+		Height:    p.Height,
+		Binary:    l.cfg.UpgradeBin(p.Name),
+		UpdatedAt: time.Now().UTC(),
+	}
+
+	if err := plan.EnsureBinary(status.Binary); err == nil {
+		if err := l.verifyBinaryChecksum(p, status.Binary); err != nil {
+			status.Error = fmt.Sprintf("checksum verification failed: %v", err)
+			return status
+		}
+	} else {
+		if !l.cfg.AllowDownloadBinaries {
+			status.Error = fmt.Sprintf("binary not present, downloading disabled: %v", err)
+			return status
+		}
+
+		l.logger.Info("prefetching upgrade binary", "upgrade", p.Name, "height", p.Height)
+		if err := l.downloadPrefetchedBinary(p); err != nil {
+			status.Error = err.Error()
+			return status
+		}
+	}
+
+	version, err := binaryVersion(ctx, status.Binary)
+	if err != nil {
+		status.Error = fmt.Sprintf("version command failed: %v", err)
+		return status
+	}
+
+	status.Version = version
+	status.Ready = true
+	l.logger.Info("upgrade binary ready", "upgrade", p.Name, "height", p.Height, "version", version)
+
+	return status
+}
+
+// downloadPrefetchedBinary downloads the binary of the upgrade into a staging directory, which is moved to the
+// upgrade directory once the download is verified. The upgrade directory is never left with a partial download,
+// and is not overwritten if it was created meanwhile.
+func (l Launcher) downloadPrefetchedBinary(p upgradetypes.Plan) error {
+	url, err := upgradeBinaryURL(l.cfg, p)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(l.cfg.BaseUpgradeDir(), 0o755); err != nil {
+		return err
+	}
+	stagingDir, err := os.MkdirTemp(l.cfg.BaseUpgradeDir(), ".prefetch-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(stagingDir)
+
+	if err := plan.DownloadUpgrade(stagingDir, url, l.cfg.Name); err != nil {
+		return fmt.Errorf("cannot download binary. %w", err)
+	}
+
+	upgradeDir := l.cfg.UpgradeDir(p.Name)
+	if _, err := os.Stat(upgradeDir); os.IsNotExist(err) {
+		if err := os.Rename(stagingDir, upgradeDir); err != nil {
+			return fmt.Errorf("cannot move downloaded binary to the upgrade directory: %w", err)
+		}
+		return nil
+	}
+
+	// the upgrade directory exists without the binary, e.g. holding only the upgrade-info.json file
+	if err := l.moveStagedBinaries(stagingDir, upgradeDir); err != nil {
+		return fmt.Errorf("cannot move downloaded binary to the upgrade directory: %w", err)
+	}
+
+	return nil
+}
+
+// moveStagedBinaries moves the content of the bin directory of the staging directory into the existing upgrade
+// directory. A valid binary created in the upgrade directory meanwhile is not overwritten.
+func (l Launcher) moveStagedBinaries(stagingDir, upgradeDir string) error {
+	binDir := filepath.Join(upgradeDir, "bin")
+	if err := plan.EnsureBinary(filepath.Join(binDir, l.cfg.Name)); err == nil {
+		return nil
+	}
+
+	if err := os.MkdirAll(binDir, 0o755); err != nil {
+		return err
+	}
+
+	stagingBinDir := filepath.Join(stagingDir, "bin")
+	entries, err := os.ReadDir(stagingBinDir)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if err := os.Rename(filepath.Join(stagingBinDir, entry.Name()), filepath.Join(binDir, entry.Name())); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// verifyBinaryChecksum verifies the present binary of the upgrade against the checksum of the url declared in the
+// plan info for this platform. Nothing is verified if the plan declares no such url or the url has no checksum.
+// When the binary does not match the checksum directly, e.g. because the url points to an archive, it is compared
+// with a verified download of the url, if downloading is enabled.
+func (l Launcher) verifyBinaryChecksum(p upgradetypes.Plan, bin string) error {
+	url, err := upgradeBinaryURL(l.cfg, p)
+	if err != nil {
+		// the binary was provided for a plan declaring no binary to download
+		return nil
+	}
+
+	u, err := neturl.Parse(url)
+	if err != nil {
+		return err
+	}
+	checksum := u.Query().Get("checksum")
+	if checksum == "" {
+		return nil
+	}
+
+	if ok, err := matchesChecksum(bin, checksum); err != nil || ok {
+		return err
+	}
+
+	if !l.cfg.AllowDownloadBinaries {
+		return fmt.Errorf("binary does not match the checksum of %s, which can only be verified against a download when downloading is enabled", url)
+	}
+
+	tmpDir, err := os.MkdirTemp("", "cosmovisor-verify-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	if err := plan.DownloadUpgrade(tmpDir, url, l.cfg.Name); err != nil {
+		return fmt.Errorf("cannot download binary. %w", err)
+	}
+
+	downloaded, err := os.ReadFile(filepath.Join(tmpDir, "bin", l.cfg.Name))
+	if err != nil {
+		return err
+	}
+	present, err := os.ReadFile(bin)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(downloaded, present) {
+		return fmt.Errorf("binary differs from the one downloaded from %s", url)
+	}
+
+	return nil
+}
+
+// matchesChecksum returns whether the file matches the checksum, given as in go-getter urls with an optional type
+// prefix. A checksum of an unsupported type never matches.
+func matchesChecksum(filename, checksum string) (bool, error) {
+	checksumType, value, found := strings.Cut(checksum, ":")
+	if !found {
+		// the type is guessed from the length of the checksum, as go-getter does
+		value = checksumType
+		switch len(value) {
+		case md5.Size * 2:
+			checksumType = "md5"
+		case sha1.Size * 2:
+			checksumType = "sha1"
+		case sha256.Size * 2:
+			checksumType = "sha256"
+		case sha512.Size * 2:
+			checksumType = "sha512"
+		}
+	}
+
+	var h hash.Hash
+	switch checksumType {
+	case "md5":
+		h = md5.New() //nolint:gosec // md5 checksums are supported by go-getter
+	case "sha1":
+		h = sha1.New() //nolint:gosec // sha1 checksums are supported by go-getter
+	case "sha256":
+		h = sha256.New()
+	case "sha512":
+		h = sha512.New()
+	default:
+		return false, nil
+	}
+
+	expected, err := hex.DecodeString(value)
+	if err != nil {
+		return false, fmt.Errorf("invalid checksum %q: %w", checksum, err)
+	}
+
+	f, err := os.Open(filename)
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+
+	if _, err := io.Copy(h, f); err != nil {
+		return false, err
+	}
+
+	return bytes.Equal(h.Sum(nil), expected), nil
+}
+
+// binaryVersion returns the output of the version command of the binary.
+func binaryVersion(ctx context.Context, bin string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, versionTimeout)
+	defer cancel()
+
+	result, err := exec.CommandContext(ctx, bin, "version").CombinedOutput() //nolint:gosec // we want to execute the version command
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(string(result)), nil
+}
+
+// readPrefetchStatus reads the prefetch status file.
+func readPrefetchStatus(filename string) (PrefetchStatus, error) {
+	var status PrefetchStatus
+	bz, err := os.ReadFile(filename)
+	if err != nil {
+		return status, err
+	}
+
+	err = json.Unmarshal(bz, &status)
+	return status, err
+}
@@ -1,6 +1,7 @@
 package cosmovisor
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -25,6 +26,10 @@ type Launcher struct {
 	logger log.Logger
 	cfg    *Config
 	fw     *fileWatcher
+
+	// pending is the upgrade whose binary has not passed the health check yet.
+	// It is a pointer as the launcher methods have value receivers.
+	pending *pendingUpgrade
 }
 
 func NewLauncher(logger log.Logger, cfg *Config) (Launcher, error) {
@@ -33,12 +38,20 @@ func NewLauncher(logger log.Logger, cfg *Config) (Launcher, error) {
 		return Launcher{}, err
 	}
 
-	return Launcher{logger: logger, cfg: cfg, fw: fw}, nil
+	// an upgrade applied before cosmovisor was restarted is still health checked
+	pending, err := loadPendingUpgrade(cfg)
+	if err != nil {
+		return Launcher{}, err
+	}
+
+	return Launcher{logger: logger, cfg: cfg, fw: fw, pending: &pending}, nil
 }
 
 // Run launches the app in a subprocess and returns when the subprocess (app)
 // exits (either when it dies, or *after* a successful upgrade.) and upgrade finished.
 // Returns true if the upgrade request was detected and the upgrade process started.
+// If the app was launched right after an upgrade and fails the health check, the upgrade
+// is rolled back and an error wrapping ErrUpgradeRolledBack is returned.
 func (l Launcher) Run(args []string, stdout, stderr io.Writer) (bool, error) {
 	bin, err := l.cfg.CurrentBin()
 	if err != nil {
@@ -67,14 +80,37 @@ func (l Launcher) Run(args []string, stdout, stderr io.Writer) (bool, error) {
 		}
 	}()
 
-	if needsUpdate, err := l.WaitForUpgradeOrExit(cmd); err != nil || !needsUpdate {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var health <-chan error
+	if l.pending.isSet() {
+		health = l.checkHealth(ctx, l.pending.plan)
+	}
+	prefetchDone := l.prefetchUpgrades(ctx)
+
+	needsUpdate, err := l.waitForUpgradeOrExit(cmd, health)
+	// an ongoing prefetch should be over before the upgrade directory is used, a download still ongoing after
+	// the timeout never overwrites the upgrade directory
+	cancel()
+	select {
+	case <-prefetchDone:
+	case <-time.After(prefetchStopTimeout):
+		l.logger.Error("prefetch did not stop in time, continuing without it", "timeout", prefetchStopTimeout)
+	}
+
+	if healthErr := (healthCheckError{}); errors.As(err, &healthErr) {
+		return false, l.rollback(healthErr.err)
+	}
+	if err != nil || !needsUpdate {
 		return false, err
 	}
 
 	if !IsSkipUpgradeHeight(args, l.fw.currentInfo) {
 		l.cfg.WaitRestartDelay()
 
-		if err := l.doBackup(); err != nil {
+		backupDir, err := l.doBackup()
+		if err != nil {
 			return false, err
 		}
 
@@ -86,6 +122,17 @@ func (l Launcher) Run(args []string, stdout, stderr io.Writer) (bool, error) {
 			return false, err
 		}
 
+		if l.cfg.HealthCheckDuration > 0 {
+			// the binary of the upgrade is checked the next time the app is launched
+			if err := l.setPending(pendingUpgrade{
+				plan:        l.fw.currentInfo,
+				previousDir: filepath.Dir(filepath.Dir(bin)),
+				backupDir:   backupDir,
+			}); err != nil {
+				return false, err
+			}
+		}
+
 		if err = l.doPreUpgrade(); err != nil {
 			return false, err
 		}
@@ -104,6 +151,13 @@ func (l Launcher) Run(args []string, stdout, stderr io.Writer) (bool, error) {
 // It returns (false, nil) if the process exited normally without triggering an upgrade. This is very unlikely
 // to happen with "start" but may happen with short-lived commands like `simd genesis export ...`
 func (l Launcher) WaitForUpgradeOrExit(cmd *exec.Cmd) (bool, error) {
+	return l.waitForUpgradeOrExit(cmd, nil)
+}
+
+// waitForUpgradeOrExit is WaitForUpgradeOrExit, additionally waiting for the result of the health check of the
+// process if health is not nil. It returns a healthCheckError if the health check fails, in which case the
+// process is killed, or if the process exits before passing the health check.
+func (l Launcher) waitForUpgradeOrExit(cmd *exec.Cmd, health <-chan error) (bool, error) {
 	currentUpgrade, err := l.cfg.UpgradeInfo()
 	if err != nil {
 		// upgrade info not found do nothing
@@ -115,37 +169,44 @@ func (l Launcher) WaitForUpgradeOrExit(cmd *exec.Cmd) (bool, error) {
 		cmdDone <- cmd.Wait()
 	}()
 
-	select {
-	case <-l.fw.MonitorUpdate(currentUpgrade):
-		// upgrade - kill the process and restart
-		l.logger.Info("daemon shutting down in an attempt to restart")
-
-		if l.cfg.ShutdownGrace > 0 {
-			// Interrupt signal
-			l.logger.Info("sent interrupt to app, waiting for exit")
-			_ = cmd.Process.Signal(os.Interrupt)
-
-			// Wait app exit
-			psChan := make(chan *os.ProcessState)
-			go func() {
-				pstate, _ := cmd.Process.Wait()
-				psChan <- pstate
-			}()
-
-			// Timeout and kill
-			select {
-			case <-psChan:
-				// Normal Exit
-				l.logger.Info("app exited normally")
-			case <-time.After(l.cfg.ShutdownGrace):
-				l.logger.Info("DAEMON_SHUTDOWN_GRACE exceeded, killing app")
-				// Kill after grace period
-				_ = cmd.Process.Kill()
+	upgradeDetected := l.fw.MonitorUpdate(currentUpgrade)
+	for health != nil {
+		select {
+		case err := <-health:
+			if err == nil {
+				l.logger.Info("upgrade passed the health check", "upgrade", l.pending.plan.Name)
+				l.clearPending()
+				health = nil
+				continue
 			}
-		} else {
-			// Default: Immediate app kill
+
+			l.fw.Stop()
 			_ = cmd.Process.Kill()
+			<-cmdDone
+			return false, healthCheckError{err}
+		case <-upgradeDetected:
+			// a new upgrade can only be requested by an app making progress, so it is healthy
+			l.clearPending()
+			l.stopForUpgrade(cmd)
+			return true, nil
+		case err := <-cmdDone:
+			l.fw.Stop()
+			// the app x/upgrade causes a panic and the app can die before the filwatcher finds the
+			// update, in which case the app made progress and is healthy.
+			if err != nil && l.fw.CheckUpdate(currentUpgrade) {
+				l.clearPending()
+				return true, nil
+			}
+			if err == nil {
+				err = errors.New("exit status 0")
+			}
+			return false, healthCheckError{fmt.Errorf("app exited before passing the health check: %w", err)}
 		}
+	}
+
+	select {
+	case <-upgradeDetected:
+		l.stopForUpgrade(cmd)
 	case err := <-cmdDone:
 		l.fw.Stop()
 		// no error -> command exits normally (eg. short command like `gaiad version`)
@@ -161,42 +222,78 @@ func (l Launcher) WaitForUpgradeOrExit(cmd *exec.Cmd) (bool, error) {
 	return true, nil
 }
 
-func (l Launcher) doBackup() error {
-	// take backup if `UNSAFE_SKIP_BACKUP` is not set.
-	if !l.cfg.UnsafeSkipBackup {
-		// check if upgrade-info.json is not empty.
-		var uInfo upgradetypes.Plan
-		upgradeInfoFile, err := os.ReadFile(l.cfg.UpgradeInfoFilePath())
-		if err != nil {
-			return fmt.Errorf("error while reading upgrade-info.json: %w", err)
+// stopForUpgrade stops the app once an upgrade is detected.
+func (l Launcher) stopForUpgrade(cmd *exec.Cmd) {
+	// upgrade - kill the process and restart
+	l.logger.Info("daemon shutting down in an attempt to restart")
+
+	if l.cfg.ShutdownGrace > 0 {
+		// Interrupt signal
+		l.logger.Info("sent interrupt to app, waiting for exit")
+		_ = cmd.Process.Signal(os.Interrupt)
+
+		// Wait app exit
+		psChan := make(chan *os.ProcessState)
+		go func() {
+			pstate, _ := cmd.Process.Wait()
+			psChan <- pstate
+		}()
+
+		// Timeout and kill
+		select {
+		case <-psChan:
+			// Normal Exit
+			l.logger.Info("app exited normally")
+		case <-time.After(l.cfg.ShutdownGrace):
+			l.logger.Info("DAEMON_SHUTDOWN_GRACE exceeded, killing app")
+			// Kill after grace period
+			_ = cmd.Process.Kill()
 		}
+	} else {
+		// Default: Immediate app kill
+		_ = cmd.Process.Kill()
+	}
+}
 
-		if err = json.Unmarshal(upgradeInfoFile, &uInfo); err != nil {
-			return err
-		}
+// doBackup takes a backup of the data directory, unless UNSAFE_SKIP_BACKUP is set, and returns its path.
+func (l Launcher) doBackup() (string, error) {
+	// take backup if `UNSAFE_SKIP_BACKUP` is not set.
+	if l.cfg.UnsafeSkipBackup {
+		return "", nil
+	}
 
-		if uInfo.Name == "" {
-			return fmt.Errorf("upgrade-info.json is empty")
-		}
+	// check if upgrade-info.json is not empty.
+	var uInfo upgradetypes.Plan
+	upgradeInfoFile, err := os.ReadFile(l.cfg.UpgradeInfoFilePath())
+	if err != nil {
+		return "", fmt.Errorf("error while reading upgrade-info.json: %w", err)
+	}
 
-		// a destination directory, Format YYYY-MM-DD
-		st := time.Now()
-		ymd := fmt.Sprintf("%d-%d-%d", st.Year(), st.Month(), st.Day())
-		dst := filepath.Join(l.cfg.DataBackupPath, fmt.Sprintf("data"+"-backup-%s", ymd))
+	if err = json.Unmarshal(upgradeInfoFile, &uInfo); err != nil {
+		return "", err
+	}
 
-		l.logger.Info("starting to take backup of data directory", "backup start time", st)
+	if uInfo.Name == "" {
+		return "", fmt.Errorf("upgrade-info.json is empty")
+	}
 
-		// copy the $DAEMON_HOME/data to a backup dir
-		if err = copy.Copy(filepath.Join(l.cfg.Home, "data"), dst); err != nil {
-			return fmt.Errorf("error while taking data backup: %w", err)
-		}
+	// a destination directory, Format YYYY-MM-DD
+	st := time.Now()
+	ymd := fmt.Sprintf("%d-%d-%d", st.Year(), st.Month(), st.Day())
+	dst := filepath.Join(l.cfg.DataBackupPath, fmt.Sprintf("data"+"-backup-%s", ymd))
+
+	l.logger.Info("starting to take backup of data directory", "backup start time", st)
 
-		// backup is done, lets check endtime to calculate total time taken for backup process
-		et := time.Now()
-		l.logger.Info("backup completed", "backup saved at", dst, "backup completion time", et, "time taken to complete backup", et.Sub(st))
+	// copy the $DAEMON_HOME/data to a backup dir
+	if err = copy.Copy(filepath.Join(l.cfg.Home, "data"), dst); err != nil {
+		return "", fmt.Errorf("error while taking data backup: %w", err)
 	}
 
-	return nil
+	// backup is done, lets check endtime to calculate total time taken for backup process
+	et := time.Now()
+	l.logger.Info("backup completed", "backup saved at", dst, "backup completion time", et, "time taken to complete backup", et.Sub(st))
+
+	return dst, nil
 }
 
 // doCustomPreUpgrade executes the custom preupgrade script if provided.
@@ -5,8 +5,15 @@ package cosmovisor_test
 
 import (
 	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
 	"fmt"
 	"io/fs"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
 	"path/filepath"
 	"sync"
 	"testing"
@@ -14,6 +21,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
 
 	"cosmossdk.io/log"
 	"cosmossdk.io/tools/cosmovisor"
@@ -370,6 +378,337 @@ func (s *processTestSuite) TestLaunchProcessWithDownloadsAndPreupgrade() {
 	require.Equal(cfg.UpgradeBin("chain3"), currentBin)
 }
 
+// TestLaunchProcessWithHealthCheck will run an upgrade whose binary passes the health check
+func (s *processTestSuite) TestLaunchProcessWithHealthCheck() {
+	// binaries from testdata/validate directory
+	require := s.Require()
+	home := copyTestData(s.T(), "validate")
+	cfg := &cosmovisor.Config{Home: home, Name: "dummyd", PollInterval: 20, UnsafeSkipBackup: true, HealthCheckDuration: 500 * time.Millisecond}
+	logger := log.NewTestLogger(s.T()).With(log.ModuleKey, "cosmosvisor")
+
+	launcher, err := cosmovisor.NewLauncher(logger, cfg)
+	require.NoError(err)
+
+	stdout, stderr := newBuffer(), newBuffer()
+	doUpgrade, err := launcher.Run([]string{"foo", "bar", "1234", cfg.UpgradeInfoFilePath()}, stdout, stderr)
+	require.NoError(err)
+	require.True(doUpgrade)
+
+	// chain2 stays alive longer than the health check duration
+	stdout.Reset()
+	doUpgrade, err = launcher.Run([]string{"second", "run"}, stdout, stderr)
+	require.NoError(err)
+	require.False(doUpgrade)
+	require.Equal("Chain 2 is live!\nArgs: second run\nFinished successfully\n", stdout.String())
+
+	currentBin, err := cfg.CurrentBin()
+	require.NoError(err)
+	require.Equal(cfg.UpgradeBin("chain2"), currentBin)
+	require.NoFileExists(cfg.RollbackAlertFilePath())
+	require.NoFileExists(cfg.PendingUpgradeFilePath())
+}
+
+// TestLaunchProcessWithHealthCheckAfterRestart will roll back an upgrade whose binary crashes right away when
+// cosmovisor is restarted between the upgrade and the launch of the upgrade binary
+func (s *processTestSuite) TestLaunchProcessWithHealthCheckAfterRestart() {
+	// binaries from testdata/rollback directory
+	require := s.Require()
+	home := copyTestData(s.T(), "rollback")
+	cfg := &cosmovisor.Config{Home: home, Name: "dummyd", PollInterval: 20, DataBackupPath: home, HealthCheckDuration: 2 * time.Second}
+	logger := log.NewTestLogger(s.T()).With(log.ModuleKey, "cosmosvisor")
+
+	launcher, err := cosmovisor.NewLauncher(logger, cfg)
+	require.NoError(err)
+
+	stdout, stderr := newBuffer(), newBuffer()
+	doUpgrade, err := launcher.Run([]string{"foo", "bar", "1234", cfg.UpgradeInfoFilePath()}, stdout, stderr)
+	require.NoError(err)
+	require.True(doUpgrade)
+	require.FileExists(cfg.PendingUpgradeFilePath())
+
+	// cosmovisor stops after the upgrade and is started again
+	launcher, err = cosmovisor.NewLauncher(logger, cfg)
+	require.NoError(err)
+
+	corrupted := filepath.Join(home, "data", "corrupted")
+	doUpgrade, err = launcher.Run([]string{"start", corrupted}, stdout, stderr)
+	require.ErrorIs(err, cosmovisor.ErrUpgradeRolledBack)
+	require.False(doUpgrade)
+	require.NoFileExists(corrupted)
+	require.NoFileExists(cfg.PendingUpgradeFilePath())
+	require.FileExists(cfg.RollbackAlertFilePath())
+
+	currentBin, err := cfg.CurrentBin()
+	require.NoError(err)
+	require.Equal(cfg.GenesisBin(), currentBin)
+
+	// a malformed pending upgrade file is reported instead of skipping the health check
+	require.NoError(os.WriteFile(cfg.PendingUpgradeFilePath(), []byte("{}"), 0o644))
+	_, err = cosmovisor.NewLauncher(logger, cfg)
+	require.ErrorContains(err, "invalid pending upgrade file")
+}
+
+// TestLaunchProcessWithHealthCheckRPC will check the health of an upgrade binary using the height reported by a RPC endpoint
+func (s *processTestSuite) TestLaunchProcessWithHealthCheckRPC() {
+	for name, tc := range map[string]struct {
+		height     int64
+		expectFail bool
+	}{
+		"height above upgrade height": {height: 50},
+		"height at upgrade height":    {height: 49, expectFail: true},
+	} {
+		s.Run(name, func() {
+			// binaries from testdata/validate directory
+			require := s.Require()
+			home := copyTestData(s.T(), "validate")
+
+			rpc := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				fmt.Fprintf(w, `{"jsonrpc":"2.0","id":-1,"result":{"sync_info":{"latest_block_height":"%d"}}}`, tc.height)
+			}))
+			defer rpc.Close()
+
+			cfg := &cosmovisor.Config{
+				Home:                home,
+				Name:                "dummyd",
+				PollInterval:        50 * time.Millisecond,
+				UnsafeSkipBackup:    true,
+				HealthCheckDuration: 500 * time.Millisecond,
+				HealthCheckRPC:      rpc.URL,
+			}
+			logger := log.NewTestLogger(s.T()).With(log.ModuleKey, "cosmosvisor")
+
+			launcher, err := cosmovisor.NewLauncher(logger, cfg)
+			require.NoError(err)
+
+			stdout, stderr := newBuffer(), newBuffer()
+			doUpgrade, err := launcher.Run([]string{"foo", "bar", "1234", cfg.UpgradeInfoFilePath()}, stdout, stderr)
+			require.NoError(err)
+			require.True(doUpgrade)
+
+			doUpgrade, err = launcher.Run([]string{"second", "run"}, stdout, stderr)
+			require.False(doUpgrade)
+			currentBin, cerr := cfg.CurrentBin()
+			require.NoError(cerr)
+			if !tc.expectFail {
+				require.NoError(err)
+				require.Equal(cfg.UpgradeBin("chain2"), currentBin)
+				return
+			}
+
+			require.ErrorIs(err, cosmovisor.ErrUpgradeRolledBack)
+			require.ErrorContains(err, "did not report a height above 49")
+			require.Equal(cfg.GenesisBin(), currentBin)
+			require.FileExists(cfg.RollbackAlertFilePath())
+		})
+	}
+}
+
+// TestLaunchProcessWithRollback will roll back an upgrade whose binary crashes right away
+func (s *processTestSuite) TestLaunchProcessWithRollback() {
+	// binaries from testdata/rollback directory
+	require := s.Require()
+	home := copyTestData(s.T(), "rollback")
+	cfg := &cosmovisor.Config{Home: home, Name: "dummyd", PollInterval: 20, DataBackupPath: home, HealthCheckDuration: 2 * time.Second}
+	logger := log.NewTestLogger(s.T()).With(log.ModuleKey, "cosmosvisor")
+
+	launcher, err := cosmovisor.NewLauncher(logger, cfg)
+	require.NoError(err)
+
+	stdout, stderr := newBuffer(), newBuffer()
+	doUpgrade, err := launcher.Run([]string{"foo", "bar", "1234", cfg.UpgradeInfoFilePath()}, stdout, stderr)
+	require.NoError(err)
+	require.True(doUpgrade)
+
+	currentBin, err := cfg.CurrentBin()
+	require.NoError(err)
+	require.Equal(cfg.UpgradeBin("chain2"), currentBin)
+
+	// the validator signs blocks after the data backup is taken
+	statePath := filepath.Join(home, "data", "priv_validator_state.json")
+	state := []byte(`{"height":"50","round":0,"step":3}`)
+	require.NoError(os.WriteFile(statePath, state, 0o600))
+
+	// chain2 corrupts the data directory and crashes
+	corrupted := filepath.Join(home, "data", "corrupted")
+	doUpgrade, err = launcher.Run([]string{"start", corrupted}, stdout, stderr)
+	require.ErrorIs(err, cosmovisor.ErrUpgradeRolledBack)
+	require.ErrorContains(err, "app exited before passing the health check")
+	require.False(doUpgrade)
+
+	// the data backup is restored and the genesis binary is current again
+	require.NoFileExists(corrupted)
+	require.FileExists(cfg.UpgradeInfoFilePath())
+
+	// the validator signing state is not rolled back
+	restored, err := os.ReadFile(statePath)
+	require.NoError(err)
+	require.Equal(state, restored)
+	currentBin, err = cfg.CurrentBin()
+	require.NoError(err)
+	require.Equal(cfg.GenesisBin(), currentBin)
+
+	bz, err := os.ReadFile(cfg.RollbackAlertFilePath())
+	require.NoError(err)
+	var alert cosmovisor.RollbackAlert
+	require.NoError(json.Unmarshal(bz, &alert))
+	require.Equal("chain2", alert.Upgrade)
+	require.Equal(int64(49), alert.Height)
+	require.Equal(cfg.GenesisBin(), alert.RestoredBinary)
+	require.NotEmpty(alert.RestoredBackup)
+	require.Contains(alert.Reason, "exit status 1")
+}
+
+// TestLaunchProcessWithPrefetch will prefetch the binary of the upgrade scheduled on chain while the app is running
+func (s *processTestSuite) TestLaunchProcessWithPrefetch() {
+	// an upgrade binary served for downloads
+	binDir := s.T().TempDir()
+	bin := []byte("#!/bin/sh\n\ntest \"$1\" = \"version\" && echo v3.0.0\n")
+	require.NoError(s.T(), os.WriteFile(filepath.Join(binDir, "dummyd"), bin, 0o755))
+	binURL := fmt.Sprintf("file://%s?checksum=sha256:%x", filepath.Join(binDir, "dummyd"), sha256.Sum256(bin))
+	badChecksumURL := fmt.Sprintf("file://%s?checksum=sha256:%x", filepath.Join(binDir, "dummyd"), sha256.Sum256(nil))
+
+	// the binary of chain2 present in testdata/prefetch
+	presentBin, err := os.ReadFile(filepath.Join("testdata", "prefetch", "cosmovisor", "upgrades", "chain2", "bin", "dummyd"))
+	require.NoError(s.T(), err)
+	presentURL := fmt.Sprintf("file://%s?checksum=sha256:%x", filepath.Join(binDir, "dummyd"), sha256.Sum256(presentBin))
+
+	binariesInfo := func(url string) string {
+		return fmt.Sprintf(`{"binaries":{"any":"%s"}}`, url)
+	}
+
+	cases := map[string]struct {
+		plan          *upgradetypes.Plan
+		allowDownload bool
+		expectStatus  *cosmovisor.PrefetchStatus
+		expectError   string
+		// a failed download leaves nothing behind
+		expectNoUpgradeDir bool
+		// the upgrade directory exists without the binary
+		upgradeInfoOnly bool
+	}{
+		"no upgrade scheduled": {},
+		"binary present": {
+			plan:         &upgradetypes.Plan{Name: "Chain2", Height: 49},
+			expectStatus: &cosmovisor.PrefetchStatus{Upgrade: "chain2", Height: 49, Ready: true, Version: "v2.0.0"},
+		},
+		"binary present, checksum verified": {
+			plan:         &upgradetypes.Plan{Name: "chain2", Height: 49, Info: binariesInfo(presentURL)},
+			expectStatus: &cosmovisor.PrefetchStatus{Upgrade: "chain2", Height: 49, Ready: true, Version: "v2.0.0"},
+		},
+		"binary present, checksum mismatch": {
+			plan:         &upgradetypes.Plan{Name: "chain2", Height: 49, Info: binariesInfo(binURL)},
+			expectStatus: &cosmovisor.PrefetchStatus{Upgrade: "chain2", Height: 49},
+			expectError:  "checksum verification failed: binary does not match the checksum",
+		},
+		"binary present, differs from download": {
+			plan:          &upgradetypes.Plan{Name: "chain2", Height: 49, Info: binariesInfo(binURL)},
+			allowDownload: true,
+			expectStatus:  &cosmovisor.PrefetchStatus{Upgrade: "chain2", Height: 49},
+			expectError:   "checksum verification failed: binary differs from the one downloaded",
+		},
+		"binary missing, downloading disabled": {
+			plan:         &upgradetypes.Plan{Name: "chain3", Height: 49},
+			expectStatus: &cosmovisor.PrefetchStatus{Upgrade: "chain3", Height: 49},
+			expectError:  "binary not present, downloading disabled",
+		},
+		"binary downloaded": {
+			plan:          &upgradetypes.Plan{Name: "chain3", Height: 49, Info: binariesInfo(binURL)},
+			allowDownload: true,
+			expectStatus:  &cosmovisor.PrefetchStatus{Upgrade: "chain3", Height: 49, Ready: true, Version: "v3.0.0"},
+		},
+		"binary downloaded into an upgrade directory without binary": {
+			plan:            &upgradetypes.Plan{Name: "chain3", Height: 49, Info: binariesInfo(binURL)},
+			allowDownload:   true,
+			upgradeInfoOnly: true,
+			expectStatus:    &cosmovisor.PrefetchStatus{Upgrade: "chain3", Height: 49, Ready: true, Version: "v3.0.0"},
+		},
+		"binary with bad checksum": {
+			plan:               &upgradetypes.Plan{Name: "chain3", Height: 49, Info: binariesInfo(badChecksumURL)},
+			allowDownload:      true,
+			expectStatus:       &cosmovisor.PrefetchStatus{Upgrade: "chain3", Height: 49},
+			expectError:        "Checksums did not match",
+			expectNoUpgradeDir: true,
+		},
+	}
+
+	for name, tc := range cases {
+		s.Run(name, func() {
+			// binaries from testdata/prefetch directory
+			require := s.Require()
+			home := copyTestData(s.T(), "prefetch")
+			upgradeInfo := filepath.Join(home, "cosmovisor", "upgrades", "chain3", upgradetypes.UpgradeInfoFilename)
+			if tc.upgradeInfoOnly {
+				require.NoError(os.MkdirAll(filepath.Dir(upgradeInfo), 0o755))
+				require.NoError(os.WriteFile(upgradeInfo, []byte(`{"name":"chain3","height":49}`), 0o644))
+			}
+
+			// the node answers the upgrade plan query with the plan of the case
+			lis, err := net.Listen("tcp", "127.0.0.1:0")
+			require.NoError(err)
+			grpcSrv := grpc.NewServer()
+			upgradetypes.RegisterQueryServer(grpcSrv, &planQueryServer{plan: tc.plan})
+			go func() { _ = grpcSrv.Serve(lis) }()
+			defer grpcSrv.Stop()
+
+			cfg := &cosmovisor.Config{
+				Home:                  home,
+				Name:                  "dummyd",
+				PollInterval:          20,
+				UnsafeSkipBackup:      true,
+				AllowDownloadBinaries: tc.allowDownload,
+				PrefetchInterval:      200 * time.Millisecond,
+				GRPCAddress:           lis.Addr().String(),
+			}
+			logger := log.NewTestLogger(s.T()).With(log.ModuleKey, "cosmosvisor")
+
+			launcher, err := cosmovisor.NewLauncher(logger, cfg)
+			require.NoError(err)
+
+			doUpgrade, err := launcher.Run([]string{"start"}, newBuffer(), newBuffer())
+			require.NoError(err)
+			require.False(doUpgrade)
+
+			if tc.expectStatus == nil {
+				require.NoFileExists(cfg.PrefetchStatusFilePath())
+				return
+			}
+
+			bz, err := os.ReadFile(cfg.PrefetchStatusFilePath())
+			require.NoError(err)
+			var status cosmovisor.PrefetchStatus
+			require.NoError(json.Unmarshal(bz, &status))
+			require.Equal(tc.expectStatus.Upgrade, status.Upgrade)
+			require.Equal(tc.expectStatus.Height, status.Height)
+			require.Equal(tc.expectStatus.Ready, status.Ready)
+			require.Equal(tc.expectStatus.Version, status.Version)
+			require.Equal(cfg.UpgradeBin(status.Upgrade), status.Binary)
+			if tc.expectError != "" {
+				require.Contains(status.Error, tc.expectError)
+				if tc.expectNoUpgradeDir {
+					require.NoDirExists(cfg.UpgradeDir(status.Upgrade))
+				}
+				return
+			}
+			require.Empty(status.Error)
+			require.FileExists(status.Binary)
+			if tc.upgradeInfoOnly {
+				require.FileExists(upgradeInfo)
+			}
+		})
+	}
+}
+
+// planQueryServer is an upgrade query server answering the current plan query with a fixed plan.
+type planQueryServer struct {
+	upgradetypes.UnimplementedQueryServer
+
+	plan *upgradetypes.Plan
+}
+
+func (s *planQueryServer) CurrentPlan(context.Context, *upgradetypes.QueryCurrentPlanRequest) (*upgradetypes.QueryCurrentPlanResponse, error) {
+	return &upgradetypes.QueryCurrentPlanResponse{Plan: s.plan}, nil
+}
+
 // TestSkipUpgrade tests heights that are identified to be skipped and return if upgrade height matches the skip heights
 func TestSkipUpgrade(t *testing.T) {
 	cases := []struct {
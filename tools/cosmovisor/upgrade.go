@@ -27,6 +27,15 @@ func UpgradeBinary(logger log.Logger, cfg *Config, p upgradetypes.Plan) error {
 		return fmt.Errorf("binary not present, downloading disabled: %w", err)
 	}
 
+	if err := downloadUpgradeBinary(logger, cfg, p); err != nil {
+		return err
+	}
+
+	return cfg.SetCurrentUpgrade(p)
+}
+
+// downloadUpgradeBinary downloads the binary of the upgrade declared in the plan info into the upgrade directory.
+func downloadUpgradeBinary(logger log.Logger, cfg *Config, p upgradetypes.Plan) error {
 	// if the dir is there already, don't download either
 	switch fi, err := os.Stat(cfg.UpgradeDir(p.Name)); {
 	case fi != nil: // The directory exists, do not overwrite.
@@ -39,16 +48,7 @@ func UpgradeBinary(logger log.Logger, cfg *Config, p upgradetypes.Plan) error {
 		return fmt.Errorf("unhandled error: %w", err)
 	}
 
-	upgradeInfo, err := plan.ParseInfo(p.Info, plan.ParseOptionEnforceChecksum(cfg.DownloadMustHaveChecksum))
-	if err != nil {
-		return fmt.Errorf("cannot parse upgrade info: %w", err)
-	}
-
-	if err := upgradeInfo.ValidateFull(cfg.Name); err != nil {
-		return fmt.Errorf("invalid binaries: %w", err)
-	}
-
-	url, err := GetBinaryURL(upgradeInfo.Binaries)
+	url, err := upgradeBinaryURL(cfg, p)
 	if err != nil {
 		return err
 	}
@@ -65,7 +65,21 @@ func UpgradeBinary(logger log.Logger, cfg *Config, p upgradetypes.Plan) error {
 		return fmt.Errorf("downloaded binary doesn't check out: %w", err)
 	}
 
-	return cfg.SetCurrentUpgrade(p)
+	return nil
+}
+
+// upgradeBinaryURL returns the url of the binary declared in the plan info for this platform.
+func upgradeBinaryURL(cfg *Config, p upgradetypes.Plan) (string, error) {
+	upgradeInfo, err := plan.ParseInfo(p.Info, plan.ParseOptionEnforceChecksum(cfg.DownloadMustHaveChecksum))
+	if err != nil {
+		return "", fmt.Errorf("cannot parse upgrade info: %w", err)
+	}
+
+	if err := upgradeInfo.ValidateFull(cfg.Name); err != nil {
+		return "", fmt.Errorf("invalid binaries: %w", err)
+	}
+
+	return GetBinaryURL(upgradeInfo.Binaries)
 }
 
 func GetBinaryURL(binaries plan.BinaryDownloadURLMap) (string, error) {
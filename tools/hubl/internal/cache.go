@@ -0,0 +1,318 @@
+package internal
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"sort"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	cmtv1beta1 "cosmossdk.io/api/cosmos/base/tendermint/v1beta1"
+)
+
+// maxCachedVersions is the number of descriptor versions kept in the cache of a chain.
+const maxCachedVersions = 5
+
+// descriptorCache is the index of the versions of the descriptors of a chain stored in the cache.
+type descriptorCache struct {
+	Current  string              `json:"current"`
+	Versions []descriptorVersion `json:"versions"`
+}
+
+// descriptorVersion is a version of the descriptors of a chain, identified by the hash of its file descriptor set.
+type descriptorVersion struct {
+	Version    string    `json:"version"`
+	AppVersion string    `json:"app_version,omitempty"`
+	FetchedAt  time.Time `json:"fetched_at"`
+}
+
+// DescriptorUpdate describes the changes of the descriptors of a chain after an update.
+type DescriptorUpdate struct {
+	Previous string
+	Current  string
+	Added    []string
+	Removed  []string
+	Modified []string
+}
+
+// Changed returns true if the descriptors changed.
+func (u DescriptorUpdate) Changed() bool {
+	return u.Previous != u.Current
+}
+
+func (u DescriptorUpdate) String() string {
+	switch {
+	case u.Previous == "":
+		return fmt.Sprintf("descriptors cached (version %s)", u.Current)
+	case !u.Changed():
+		return fmt.Sprintf("descriptors unchanged (version %s)", u.Current)
+	default:
+		return fmt.Sprintf("descriptors changed (version %s -> %s): %d file(s) added, %d removed, %d modified",
+			u.Previous, u.Current, len(u.Added), len(u.Removed), len(u.Modified))
+	}
+}
+
+func (c *ChainInfo) chainCacheDir() (string, error) {
+	cacheDir, err := c.getCacheDir()
+	if err != nil {
+		return "", err
+	}
+
+	chainCacheDir := path.Join(cacheDir, c.Chain)
+	return chainCacheDir, os.MkdirAll(chainCacheDir, 0o750)
+}
+
+func (c *ChainInfo) cacheIndexFilename() (string, error) {
+	chainCacheDir, err := c.chainCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return path.Join(chainCacheDir, "index.json"), nil
+}
+
+func (c *ChainInfo) versionFilenames(version string) (fdsFilename, appOptsFilename string, err error) {
+	chainCacheDir, err := c.chainCacheDir()
+	if err != nil {
+		return "", "", err
+	}
+	return path.Join(chainCacheDir, version+".fds"), path.Join(chainCacheDir, version+".autocli"), nil
+}
+
+// loadDescriptorCache loads the cache index of the chain, importing the unversioned cache files of older versions of hubl.
+func (c *ChainInfo) loadDescriptorCache() (*descriptorCache, error) {
+	indexFilename, err := c.cacheIndexFilename()
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := os.ReadFile(indexFilename)
+	if err == nil {
+		cache := &descriptorCache{}
+		if err := json.Unmarshal(bz, cache); err != nil {
+			return nil, fmt.Errorf("can't load descriptor cache index %s: %w", indexFilename, err)
+		}
+		return cache, nil
+	} else if !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	cache := &descriptorCache{}
+	fdsFilename, err := c.fdsCacheFilename()
+	if err != nil {
+		return nil, err
+	}
+	appOptsFilename, err := c.appOptsCacheFilename()
+	if err != nil {
+		return nil, err
+	}
+
+	fdsBz, fdsErr := os.ReadFile(fdsFilename)
+	appOptsBz, appOptsErr := os.ReadFile(appOptsFilename)
+	if fdsErr != nil || appOptsErr != nil {
+		return cache, nil
+	}
+
+	fdSet := &descriptorpb.FileDescriptorSet{}
+	if err := proto.Unmarshal(fdsBz, fdSet); err != nil {
+		return cache, nil
+	}
+
+	appOpts := &autocliv1.AppOptionsResponse{}
+	if err := proto.Unmarshal(appOptsBz, appOpts); err != nil {
+		return cache, nil
+	}
+
+	if _, err := c.storeVersion(cache, fdSet, appOpts, ""); err != nil {
+		return nil, err
+	}
+
+	return cache, errors.Join(os.Remove(fdsFilename), os.Remove(appOptsFilename))
+}
+
+// storeVersion stores the descriptors in the cache as its current version and returns the update of the descriptors.
+func (c *ChainInfo) storeVersion(cache *descriptorCache, fdSet *descriptorpb.FileDescriptorSet, appOpts *autocliv1.AppOptionsResponse, appVersion string) (DescriptorUpdate, error) {
+	version, err := descriptorsVersion(fdSet)
+	if err != nil {
+		return DescriptorUpdate{}, err
+	}
+
+	update := DescriptorUpdate{Previous: cache.Current, Current: version}
+	if update.Previous != "" && update.Changed() {
+		if previous, err := c.loadFileDescriptorSet(update.Previous); err == nil {
+			update.Added, update.Removed, update.Modified = diffFileDescriptorSets(previous, fdSet)
+		}
+	}
+
+	fdsFilename, appOptsFilename, err := c.versionFilenames(version)
+	if err != nil {
+		return DescriptorUpdate{}, err
+	}
+
+	bz, err := proto.MarshalOptions{Deterministic: true}.Marshal(fdSet)
+	if err != nil {
+		return DescriptorUpdate{}, err
+	}
+	if err := os.WriteFile(fdsFilename, bz, 0o600); err != nil {
+		return DescriptorUpdate{}, err
+	}
+
+	// the autocli options are refreshed even if the descriptors did not change
+	bz, err = proto.Marshal(appOpts)
+	if err != nil {
+		return DescriptorUpdate{}, err
+	}
+	if err := os.WriteFile(appOptsFilename, bz, 0o600); err != nil {
+		return DescriptorUpdate{}, err
+	}
+
+	versions := []descriptorVersion{{Version: version, AppVersion: appVersion, FetchedAt: time.Now().UTC()}}
+	for _, v := range cache.Versions {
+		if v.Version == version {
+			continue
+		}
+
+		if len(versions) == maxCachedVersions {
+			if err := c.removeVersion(v.Version); err != nil {
+				return DescriptorUpdate{}, err
+			}
+			continue
+		}
+
+		versions = append(versions, v)
+	}
+
+	cache.Current = version
+	cache.Versions = versions
+
+	indexFilename, err := c.cacheIndexFilename()
+	if err != nil {
+		return DescriptorUpdate{}, err
+	}
+
+	bz, err = json.MarshalIndent(cache, "", "  ")
+	if err != nil {
+		return DescriptorUpdate{}, err
+	}
+
+	return update, os.WriteFile(indexFilename, bz, 0o600)
+}
+
+func (c *ChainInfo) removeVersion(version string) error {
+	fdsFilename, appOptsFilename, err := c.versionFilenames(version)
+	if err != nil {
+		return err
+	}
+
+	for _, filename := range []string{fdsFilename, appOptsFilename} {
+		if err := os.Remove(filename); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *ChainInfo) loadFileDescriptorSet(version string) (*descriptorpb.FileDescriptorSet, error) {
+	fdsFilename, _, err := c.versionFilenames(version)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := os.ReadFile(fdsFilename)
+	if err != nil {
+		return nil, err
+	}
+
+	fdSet := &descriptorpb.FileDescriptorSet{}
+	return fdSet, proto.Unmarshal(bz, fdSet)
+}
+
+func (c *ChainInfo) loadAppOptions(version string) (*autocliv1.AppOptionsResponse, error) {
+	_, appOptsFilename, err := c.versionFilenames(version)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := os.ReadFile(appOptsFilename)
+	if err != nil {
+		return nil, err
+	}
+
+	appOpts := &autocliv1.AppOptionsResponse{}
+	return appOpts, proto.Unmarshal(bz, appOpts)
+}
+
+// fetchAppVersion returns the version of the application of the chain, or an empty string if the node does not expose it.
+func (c *ChainInfo) fetchAppVersion() string {
+	client, err := c.OpenClient()
+	if err != nil {
+		return ""
+	}
+
+	res, err := cmtv1beta1.NewServiceClient(client).GetNodeInfo(c.Context, &cmtv1beta1.GetNodeInfoRequest{})
+	if err != nil || res.ApplicationVersion == nil {
+		return ""
+	}
+
+	return res.ApplicationVersion.Version
+}
+
+// descriptorsVersion returns the version of a file descriptor set, which is the hash of its files sorted by name.
+func descriptorsVersion(fdSet *descriptorpb.FileDescriptorSet) (string, error) {
+	files := make([]*descriptorpb.FileDescriptorProto, len(fdSet.File))
+	copy(files, fdSet.File)
+	sort.Slice(files, func(i, j int) bool { return files[i].GetName() < files[j].GetName() })
+
+	hash := sha256.New()
+	for _, file := range files {
+		bz, err := proto.MarshalOptions{Deterministic: true}.Marshal(file)
+		if err != nil {
+			return "", err
+		}
+		hash.Write(bz)
+	}
+
+	return hex.EncodeToString(hash.Sum(nil))[:16], nil
+}
+
+// diffFileDescriptorSets returns the names of the files added, removed and modified between two file descriptor sets.
+func diffFileDescriptorSets(from, to *descriptorpb.FileDescriptorSet) (added, removed, modified []string) {
+	marshal := func(fdSet *descriptorpb.FileDescriptorSet) map[string][]byte {
+		files := make(map[string][]byte, len(fdSet.File))
+		for _, file := range fdSet.File {
+			bz, _ := proto.MarshalOptions{Deterministic: true}.Marshal(file)
+			files[file.GetName()] = bz
+		}
+		return files
+	}
+
+	fromFiles, toFiles := marshal(from), marshal(to)
+	for name, bz := range toFiles {
+		fromBz, ok := fromFiles[name]
+		switch {
+		case !ok:
+			added = append(added, name)
+		case !bytes.Equal(fromBz, bz):
+			modified = append(modified, name)
+		}
+	}
+	for name := range fromFiles {
+		if _, ok := toFiles[name]; !ok {
+			removed = append(removed, name)
+		}
+	}
+
+	sort.Strings(added)
+	sort.Strings(removed)
+	sort.Strings(modified)
+	return added, removed, modified
+}
@@ -0,0 +1,204 @@
+package internal
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	"cosmossdk.io/tools/hubl/internal/config"
+)
+
+// testFile returns a file descriptor declaring the messages in a package named after the file.
+func testFile(name string, messages ...string) *descriptorpb.FileDescriptorProto {
+	file := &descriptorpb.FileDescriptorProto{
+		Name:    proto.String(name),
+		Package: proto.String(strings.TrimSuffix(name, ".proto")),
+		Syntax:  proto.String("proto3"),
+	}
+	for _, message := range messages {
+		file.MessageType = append(file.MessageType, &descriptorpb.DescriptorProto{Name: proto.String(message)})
+	}
+
+	return file
+}
+
+func testFileDescriptorSet(files ...*descriptorpb.FileDescriptorProto) *descriptorpb.FileDescriptorSet {
+	return &descriptorpb.FileDescriptorSet{File: files}
+}
+
+func testAppOptions(modules ...string) *autocliv1.AppOptionsResponse {
+	appOpts := &autocliv1.AppOptionsResponse{ModuleOptions: map[string]*autocliv1.ModuleOptions{}}
+	for _, module := range modules {
+		appOpts.ModuleOptions[module] = &autocliv1.ModuleOptions{}
+	}
+
+	return appOpts
+}
+
+func TestDescriptorsVersion(t *testing.T) {
+	version, err := descriptorsVersion(testFileDescriptorSet(testFile("a.proto", "A"), testFile("b.proto", "B")))
+	require.NoError(t, err)
+	require.Len(t, version, 16)
+
+	// the order of the files does not matter
+	reordered, err := descriptorsVersion(testFileDescriptorSet(testFile("b.proto", "B"), testFile("a.proto", "A")))
+	require.NoError(t, err)
+	require.Equal(t, version, reordered)
+
+	modified, err := descriptorsVersion(testFileDescriptorSet(testFile("a.proto", "A", "A2"), testFile("b.proto", "B")))
+	require.NoError(t, err)
+	require.NotEqual(t, version, modified)
+}
+
+func TestDiffFileDescriptorSets(t *testing.T) {
+	from := testFileDescriptorSet(testFile("a.proto", "A"), testFile("b.proto", "B"), testFile("c.proto", "C"))
+	to := testFileDescriptorSet(testFile("c.proto", "C"), testFile("a.proto", "A", "A2"), testFile("e.proto", "E"), testFile("d.proto", "D"))
+
+	added, removed, modified := diffFileDescriptorSets(from, to)
+	require.Equal(t, []string{"d.proto", "e.proto"}, added)
+	require.Equal(t, []string{"b.proto"}, removed)
+	require.Equal(t, []string{"a.proto"}, modified)
+
+	added, removed, modified = diffFileDescriptorSets(from, from)
+	require.Empty(t, added)
+	require.Empty(t, removed)
+	require.Empty(t, modified)
+}
+
+func TestDescriptorCache(t *testing.T) {
+	chainInfo := NewChainInfo(t.TempDir(), "test", &config.ChainConfig{})
+	require.ErrorIs(t, chainInfo.Load(), ErrNotCached)
+
+	cache, err := chainInfo.loadDescriptorCache()
+	require.NoError(t, err)
+
+	v1 := testFileDescriptorSet(testFile("a.proto", "A"))
+	update, err := chainInfo.storeVersion(cache, v1, testAppOptions("bank"), "v1.0.0")
+	require.NoError(t, err)
+	require.Empty(t, update.Previous)
+	require.True(t, update.Changed())
+	require.Equal(t, fmt.Sprintf("descriptors cached (version %s)", update.Current), update.String())
+	version1 := update.Current
+
+	require.NoError(t, chainInfo.Load())
+	_, err = chainInfo.ProtoFiles.FindDescriptorByName("a.A")
+	require.NoError(t, err)
+	require.Contains(t, chainInfo.ModuleOptions, "bank")
+
+	// the same descriptors are unchanged, but the autocli options are refreshed
+	update, err = chainInfo.storeVersion(cache, v1, testAppOptions("bank", "gov"), "v1.0.0")
+	require.NoError(t, err)
+	require.False(t, update.Changed())
+	require.Equal(t, version1, update.Current)
+	require.NoError(t, chainInfo.Load())
+	require.Contains(t, chainInfo.ModuleOptions, "gov")
+
+	// an upgrade adds a version and reports the changed files
+	v2 := testFileDescriptorSet(testFile("a.proto", "A", "A2"), testFile("b.proto", "B"))
+	update, err = chainInfo.storeVersion(cache, v2, testAppOptions("bank", "gov"), "v2.0.0")
+	require.NoError(t, err)
+	require.True(t, update.Changed())
+	require.Equal(t, version1, update.Previous)
+	require.Equal(t, []string{"b.proto"}, update.Added)
+	require.Empty(t, update.Removed)
+	require.Equal(t, []string{"a.proto"}, update.Modified)
+	require.Contains(t, update.String(), "1 file(s) added, 0 removed, 1 modified")
+	version2 := update.Current
+
+	// the index is persisted, with the current version first
+	cache, err = chainInfo.loadDescriptorCache()
+	require.NoError(t, err)
+	require.Equal(t, version2, cache.Current)
+	require.Len(t, cache.Versions, 2)
+	require.Equal(t, version2, cache.Versions[0].Version)
+	require.Equal(t, "v2.0.0", cache.Versions[0].AppVersion)
+	require.Equal(t, version1, cache.Versions[1].Version)
+
+	require.NoError(t, chainInfo.Load())
+	_, err = chainInfo.ProtoFiles.FindDescriptorByName("b.B")
+	require.NoError(t, err)
+}
+
+func TestDescriptorCacheEviction(t *testing.T) {
+	chainInfo := NewChainInfo(t.TempDir(), "test", &config.ChainConfig{})
+	cache, err := chainInfo.loadDescriptorCache()
+	require.NoError(t, err)
+
+	var versions []string
+	for i := 0; i <= maxCachedVersions; i++ {
+		fdSet := testFileDescriptorSet(testFile("a.proto", fmt.Sprintf("A%d", i)))
+		update, err := chainInfo.storeVersion(cache, fdSet, testAppOptions(), "")
+		require.NoError(t, err)
+		versions = append(versions, update.Current)
+	}
+
+	// the oldest version is evicted with its files
+	require.Len(t, cache.Versions, maxCachedVersions)
+	require.Equal(t, versions[maxCachedVersions], cache.Current)
+	for _, v := range cache.Versions {
+		require.NotEqual(t, versions[0], v.Version)
+	}
+
+	fdsFilename, appOptsFilename, err := chainInfo.versionFilenames(versions[0])
+	require.NoError(t, err)
+	require.NoFileExists(t, fdsFilename)
+	require.NoFileExists(t, appOptsFilename)
+
+	fdsFilename, appOptsFilename, err = chainInfo.versionFilenames(versions[1])
+	require.NoError(t, err)
+	require.FileExists(t, fdsFilename)
+	require.FileExists(t, appOptsFilename)
+
+	// storing a cached version again makes it current without evicting another version
+	update, err := chainInfo.storeVersion(cache, testFileDescriptorSet(testFile("a.proto", "A1")), testAppOptions(), "")
+	require.NoError(t, err)
+	require.Equal(t, versions[1], update.Current)
+	require.Len(t, cache.Versions, maxCachedVersions)
+	require.Equal(t, versions[1], cache.Versions[0].Version)
+}
+
+func TestDescriptorCacheImportsUnversionedFiles(t *testing.T) {
+	chainInfo := NewChainInfo(t.TempDir(), "test", &config.ChainConfig{})
+
+	// the cache files of older versions of hubl
+	fdSet := testFileDescriptorSet(testFile("a.proto", "A"))
+	fdsFilename, err := chainInfo.fdsCacheFilename()
+	require.NoError(t, err)
+	bz, err := proto.Marshal(fdSet)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(fdsFilename, bz, 0o600))
+
+	appOptsFilename, err := chainInfo.appOptsCacheFilename()
+	require.NoError(t, err)
+	bz, err = proto.Marshal(testAppOptions("bank"))
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(appOptsFilename, bz, 0o600))
+
+	require.NoError(t, chainInfo.Load())
+	require.Contains(t, chainInfo.ModuleOptions, "bank")
+
+	version, err := descriptorsVersion(fdSet)
+	require.NoError(t, err)
+	cache, err := chainInfo.loadDescriptorCache()
+	require.NoError(t, err)
+	require.Equal(t, version, cache.Current)
+
+	// the unversioned files are removed once imported
+	require.NoFileExists(t, fdsFilename)
+	require.NoFileExists(t, appOptsFilename)
+
+	// an invalid index is reported
+	indexFilename, err := chainInfo.cacheIndexFilename()
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(indexFilename, []byte("{"), 0o600))
+	_, err = chainInfo.loadDescriptorCache()
+	require.ErrorContains(t, err, "can't load descriptor cache index")
+	require.Equal(t, path.Join(chainInfo.ConfigDir, "cache", "test", "index.json"), indexFilename)
+}
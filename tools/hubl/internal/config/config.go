@@ -22,9 +22,11 @@ type Config struct {
 }
 
 type ChainConfig struct {
+	ChainID        string         `toml:"chain-id,omitempty"`
 	GRPCEndpoints  []GRPCEndpoint `toml:"trusted-grpc-endpoints"`
 	AddressPrefix  string         `toml:"address-prefix"`
 	KeyringBackend string         `toml:"keyring-backend"`
+	FeeTokens      []FeeToken     `toml:"fee-tokens,omitempty"`
 }
 
 type GRPCEndpoint struct {
@@ -32,6 +34,13 @@ type GRPCEndpoint struct {
 	Insecure bool   `toml:"insecure"`
 }
 
+// FeeToken is a token accepted for the fees of a chain.
+type FeeToken struct {
+	Denom string `toml:"denom"`
+	// GasPrice is the average gas price of the token, 0 if unknown.
+	GasPrice float64 `toml:"gas-price,omitempty"`
+}
+
 var EmptyConfig = &Config{
 	Chains:         map[string]*ChainConfig{},
 	KeyringBackend: flags.DefaultKeyringBackend,
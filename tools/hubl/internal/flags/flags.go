@@ -7,7 +7,19 @@ const (
 	FlagLong     = "long"
 	FlagOutput   = "output"
 
+	FlagRegistryDir = "registry-dir"
+
 	FlagKeyringBackend = "keyring-backend"
+
+	FlagFrom          = "from"
+	FlagFees          = "fees"
+	FlagGas           = "gas"
+	FlagGasPrices     = "gas-prices"
+	FlagGasAdjustment = "gas-adjustment"
+	FlagSignMode      = "sign-mode"
+	FlagNote          = "note"
+	FlagDryRun        = "dry-run"
+	FlagYes           = "yes"
 )
 
 const (
@@ -15,4 +27,10 @@ const (
 	OutputFormatJSON = "json"
 
 	DefaultKeyringBackend = "os"
+
+	GasAuto              = "auto"
+	DefaultGasAdjustment = 1.5
+
+	SignModeDirect          = "direct"
+	SignModeLegacyAminoJSON = "amino-json"
 )
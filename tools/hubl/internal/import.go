@@ -0,0 +1,125 @@
+package internal
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/tools/hubl/internal/config"
+	"cosmossdk.io/tools/hubl/internal/flags"
+)
+
+// ImportCmd returns the command importing chains from a local chain registry directory.
+func ImportCmd(cfg *config.Config, configDir string) *cobra.Command {
+	var insecure bool
+
+	cmd := &cobra.Command{
+		Use:   "import [registry-dir] [chain...]",
+		Short: "Import chains from a local chain registry directory",
+		Long: `Import the chain ID, gRPC endpoints, bech32 prefix and fee tokens of chains from a local clone of the chain registry (https://github.com/cosmos/chain-registry).
+All the chains of the directory are imported when no chain is given.
+The AutoCLI data of an imported chain is fetched when its command is first run, and can be refreshed with the --update flag of its command.`,
+		Example: "hubl import ~/chain-registry osmosis juno",
+		Args:    cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			registryDir, chains := args[0], args[1:]
+			if len(chains) == 0 {
+				var err error
+				if chains, err = listRegistryChains(registryDir); err != nil {
+					return err
+				}
+			}
+
+			imported := 0
+			for _, chain := range chains {
+				entry, err := LoadChainRegistryEntry(registryDir, chain)
+				if err != nil {
+					return fmt.Errorf("failed to load %s from the chain registry: %w", chain, err)
+				}
+
+				chainConfig, err := chainConfigFromRegistry(entry, insecure)
+				if err != nil {
+					cmd.Printf("Skipping %s: %v\n", chain, err)
+					continue
+				}
+
+				chain = strings.ToLower(chain)
+				chainConfig.KeyringBackend = flags.DefaultKeyringBackend
+				if previous, ok := cfg.Chains[chain]; ok && previous.KeyringBackend != "" {
+					chainConfig.KeyringBackend = previous.KeyringBackend
+				}
+
+				cfg.Chains[chain] = chainConfig
+				imported++
+			}
+
+			if err := config.Save(configDir, cfg); err != nil {
+				return err
+			}
+
+			cmd.Printf("%d chain(s) imported, configuration saved to %s\n", imported, configDir)
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&insecure, flags.FlagInsecure, false, "use insecure gRPC connections to the imported endpoints")
+
+	return cmd
+}
+
+// listRegistryChains returns the chains of a chain registry directory, skipping its special directories.
+func listRegistryChains(registryDir string) ([]string, error) {
+	entries, err := os.ReadDir(registryDir)
+	if err != nil {
+		return nil, err
+	}
+
+	var chains []string
+	for _, entry := range entries {
+		if !entry.IsDir() || strings.HasPrefix(entry.Name(), "_") || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+
+		if _, err := os.Stat(filepath.Join(registryDir, entry.Name(), "chain.json")); err == nil {
+			chains = append(chains, entry.Name())
+		}
+	}
+
+	return chains, nil
+}
+
+// chainConfigFromRegistry returns the configuration of a chain from its chain registry entry.
+func chainConfigFromRegistry(entry *ChainRegistryEntry, insecure bool) (*config.ChainConfig, error) {
+	if entry.Bech32Prefix == "" {
+		return nil, errors.New("no bech32 prefix")
+	}
+
+	if len(entry.APIs.GRPC) == 0 {
+		return nil, errors.New("no gRPC endpoint")
+	}
+
+	chainConfig := &config.ChainConfig{
+		ChainID:       entry.ChainID,
+		AddressPrefix: entry.Bech32Prefix,
+	}
+
+	for _, api := range entry.APIs.GRPC {
+		chainConfig.GRPCEndpoints = append(chainConfig.GRPCEndpoints, config.GRPCEndpoint{
+			Endpoint: api.Address,
+			Insecure: insecure,
+		})
+	}
+
+	for _, token := range entry.Fees.FeeTokens {
+		chainConfig.FeeTokens = append(chainConfig.FeeTokens, config.FeeToken{
+			Denom:    token.Denom,
+			GasPrice: token.AverageGasPrice,
+		})
+	}
+
+	return chainConfig, nil
+}
@@ -0,0 +1,148 @@
+package internal
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/tools/hubl/internal/config"
+	"cosmossdk.io/tools/hubl/internal/flags"
+)
+
+const osmosisRegistryEntry = `{
+  "chain_name": "osmosis",
+  "chain_id": "osmosis-1",
+  "bech32_prefix": "osmo",
+  "fees": {"fee_tokens": [{"denom": "uosmo", "average_gas_price": 0.025}]},
+  "apis": {"grpc": [
+    {"address": "https://grpc.osmosis.zone:443/", "provider": "osmosis"},
+    {"address": "grpc.example.com", "provider": "no port"}
+  ]}
+}`
+
+// writeRegistryChain writes the chain.json of a chain in a chain registry directory.
+func writeRegistryChain(t *testing.T, registryDir, chain, entry string) {
+	t.Helper()
+	require.NoError(t, os.MkdirAll(filepath.Join(registryDir, chain), 0o750))
+	require.NoError(t, os.WriteFile(filepath.Join(registryDir, chain, "chain.json"), []byte(entry), 0o600))
+}
+
+func TestListRegistryChains(t *testing.T) {
+	registryDir := t.TempDir()
+	writeRegistryChain(t, registryDir, "osmosis", osmosisRegistryEntry)
+	writeRegistryChain(t, registryDir, "juno", `{}`)
+	writeRegistryChain(t, registryDir, "_IBC", `{}`)
+	writeRegistryChain(t, registryDir, ".github", `{}`)
+	require.NoError(t, os.MkdirAll(filepath.Join(registryDir, "testnets"), 0o750))
+	require.NoError(t, os.WriteFile(filepath.Join(registryDir, "README.md"), nil, 0o600))
+
+	chains, err := listRegistryChains(registryDir)
+	require.NoError(t, err)
+	require.Equal(t, []string{"juno", "osmosis"}, chains)
+
+	_, err = listRegistryChains(filepath.Join(registryDir, "missing"))
+	require.Error(t, err)
+}
+
+func TestChainConfigFromRegistry(t *testing.T) {
+	testCases := []struct {
+		name      string
+		entry     string
+		insecure  bool
+		expect    *config.ChainConfig
+		expectErr string
+	}{
+		{
+			name:     "valid entry",
+			entry:    osmosisRegistryEntry,
+			insecure: true,
+			expect: &config.ChainConfig{
+				ChainID:       "osmosis-1",
+				AddressPrefix: "osmo",
+				GRPCEndpoints: []config.GRPCEndpoint{{Endpoint: "grpc.osmosis.zone:443", Insecure: true}},
+				FeeTokens:     []config.FeeToken{{Denom: "uosmo", GasPrice: 0.025}},
+			},
+		},
+		{
+			name:  "no fee tokens",
+			entry: `{"chain_id": "juno-1", "bech32_prefix": "juno", "apis": {"grpc": [{"address": "grpc.juno.com:443"}]}}`,
+			expect: &config.ChainConfig{
+				ChainID:       "juno-1",
+				AddressPrefix: "juno",
+				GRPCEndpoints: []config.GRPCEndpoint{{Endpoint: "grpc.juno.com:443"}},
+			},
+		},
+		{
+			name:      "no bech32 prefix",
+			entry:     `{"chain_id": "juno-1", "apis": {"grpc": [{"address": "grpc.juno.com:443"}]}}`,
+			expectErr: "no bech32 prefix",
+		},
+		{
+			name:      "no gRPC endpoint with a port",
+			entry:     `{"chain_id": "juno-1", "bech32_prefix": "juno", "apis": {"grpc": [{"address": "grpc.juno.com"}]}}`,
+			expectErr: "no gRPC endpoint",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			entry, err := parseChainRegistryEntry([]byte(tc.entry))
+			require.NoError(t, err)
+
+			chainConfig, err := chainConfigFromRegistry(entry, tc.insecure)
+			if tc.expectErr != "" {
+				require.EqualError(t, err, tc.expectErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expect, chainConfig)
+		})
+	}
+}
+
+func TestImportCmd(t *testing.T) {
+	registryDir := t.TempDir()
+	writeRegistryChain(t, registryDir, "osmosis", osmosisRegistryEntry)
+	writeRegistryChain(t, registryDir, "juno", `{"chain_id": "juno-1", "bech32_prefix": "juno"}`)
+	writeRegistryChain(t, registryDir, "stargaze", `{"chain_id": "stargaze-1", "bech32_prefix": "stars", "apis": {"grpc": [{"address": "grpc.stargaze.com:443"}]}}`)
+
+	configDir := t.TempDir()
+	cfg := &config.Config{
+		Chains: map[string]*config.ChainConfig{
+			// the keyring backend of a chain already configured is kept
+			"osmosis": {KeyringBackend: "test"},
+		},
+		KeyringBackend: flags.DefaultKeyringBackend,
+	}
+
+	// all the chains of the registry are imported, skipping the invalid ones
+	out := &bytes.Buffer{}
+	cmd := ImportCmd(cfg, configDir)
+	cmd.SetOut(out)
+	cmd.SetArgs([]string{registryDir})
+	require.NoError(t, cmd.Execute())
+	require.Contains(t, out.String(), "Skipping juno: no gRPC endpoint")
+	require.Contains(t, out.String(), "2 chain(s) imported")
+
+	saved, err := config.Load(configDir)
+	require.NoError(t, err)
+	require.NotContains(t, saved.Chains, "juno")
+	require.Equal(t, &config.ChainConfig{
+		ChainID:        "osmosis-1",
+		AddressPrefix:  "osmo",
+		KeyringBackend: "test",
+		GRPCEndpoints:  []config.GRPCEndpoint{{Endpoint: "grpc.osmosis.zone:443"}},
+		FeeTokens:      []config.FeeToken{{Denom: "uosmo", GasPrice: 0.025}},
+	}, saved.Chains["osmosis"])
+	require.Equal(t, flags.DefaultKeyringBackend, saved.Chains["stargaze"].KeyringBackend)
+
+	// a chain missing from the registry fails the import
+	cmd = ImportCmd(cfg, configDir)
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetArgs([]string{registryDir, "cosmoshub"})
+	require.ErrorContains(t, cmd.Execute(), "failed to load cosmoshub from the chain registry")
+}
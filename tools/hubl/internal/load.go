@@ -11,7 +11,6 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
-	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protodesc"
 	"google.golang.org/protobuf/reflect/protoregistry"
 	"google.golang.org/protobuf/types/descriptorpb"
@@ -48,6 +47,7 @@ func (c *ChainInfo) getCacheDir() (string, error) {
 	return cacheDir, os.MkdirAll(cacheDir, 0o750)
 }
 
+// fdsCacheFilename returns the unversioned file descriptor set cache file of older versions of hubl.
 func (c *ChainInfo) fdsCacheFilename() (string, error) {
 	cacheDir, err := c.getCacheDir()
 	if err != nil {
@@ -56,6 +56,7 @@ func (c *ChainInfo) fdsCacheFilename() (string, error) {
 	return path.Join(cacheDir, fmt.Sprintf("%s.fds", c.Chain)), nil
 }
 
+// appOptsCacheFilename returns the unversioned autocli options cache file of older versions of hubl.
 func (c *ChainInfo) appOptsCacheFilename() (string, error) {
 	cacheDir, err := c.getCacheDir()
 	if err != nil {
@@ -64,95 +65,88 @@ func (c *ChainInfo) appOptsCacheFilename() (string, error) {
 	return path.Join(cacheDir, fmt.Sprintf("%s.autocli", c.Chain)), nil
 }
 
-func (c *ChainInfo) Load(reload bool) error {
-	fdSet := &descriptorpb.FileDescriptorSet{}
-	fdsFilename, err := c.fdsCacheFilename()
+// ErrNotCached is returned when the descriptors of a chain are not cached yet.
+var ErrNotCached = errors.New("descriptors not cached")
+
+// Load loads the descriptors and autocli options of the chain from the cache, without connecting to the chain.
+// ErrNotCached is returned if they were never fetched with Update.
+func (c *ChainInfo) Load() error {
+	cache, err := c.loadDescriptorCache()
 	if err != nil {
 		return err
 	}
 
-	if _, err := os.Stat(fdsFilename); os.IsNotExist(err) || reload {
-		client, err := c.OpenClient()
-		if err != nil {
-			return err
-		}
-
-		reflectionClient := reflectionv1.NewReflectionServiceClient(client)
-		fdRes, err := reflectionClient.FileDescriptors(c.Context, &reflectionv1.FileDescriptorsRequest{})
-		if err != nil {
-			fdSet, err = loadFileDescriptorsGRPCReflection(c.Context, client)
-			if err != nil {
-				return err
-			}
-		} else {
-			fdSet = &descriptorpb.FileDescriptorSet{File: fdRes.Files}
-		}
-
-		bz, err := proto.Marshal(fdSet)
-		if err != nil {
-			return err
-		}
-
-		if err = os.WriteFile(fdsFilename, bz, 0o600); err != nil {
-			return err
-		}
-	} else {
-		bz, err := os.ReadFile(fdsFilename)
-		if err != nil {
-			return err
-		}
-
-		if err = proto.Unmarshal(bz, fdSet); err != nil {
-			return err
-		}
+	if cache.Current == "" {
+		return ErrNotCached
 	}
 
-	c.ProtoFiles, err = protodesc.FileOptions{AllowUnresolvable: true}.NewFiles(fdSet)
+	fdSet, err := c.loadFileDescriptorSet(cache.Current)
 	if err != nil {
-		return fmt.Errorf("error building protoregistry.Files: %w", err)
+		return err
 	}
 
-	appOptsFilename, err := c.appOptsCacheFilename()
+	appOpts, err := c.loadAppOptions(cache.Current)
 	if err != nil {
 		return err
 	}
 
-	if _, err := os.Stat(appOptsFilename); os.IsNotExist(err) || reload {
-		client, err := c.OpenClient()
-		if err != nil {
-			return err
-		}
+	return c.setDescriptors(fdSet, appOpts)
+}
 
-		autocliQueryClient := autocliv1.NewQueryClient(client)
-		appOptsRes, err := autocliQueryClient.AppOptions(c.Context, &autocliv1.AppOptionsRequest{})
-		if err != nil {
-			appOptsRes = guessAutocli(c.ProtoFiles)
-		}
+// Update fetches the descriptors and autocli options of the chain and stores them in the cache.
+// A new version is added to the cache when the descriptors changed, for instance after a chain upgrade.
+func (c *ChainInfo) Update() (DescriptorUpdate, error) {
+	cache, err := c.loadDescriptorCache()
+	if err != nil {
+		return DescriptorUpdate{}, err
+	}
+
+	client, err := c.OpenClient()
+	if err != nil {
+		return DescriptorUpdate{}, err
+	}
 
-		bz, err := proto.Marshal(appOptsRes)
+	var fdSet *descriptorpb.FileDescriptorSet
+	reflectionClient := reflectionv1.NewReflectionServiceClient(client)
+	fdRes, err := reflectionClient.FileDescriptors(c.Context, &reflectionv1.FileDescriptorsRequest{})
+	if err != nil {
+		fdSet, err = loadFileDescriptorsGRPCReflection(c.Context, client)
 		if err != nil {
-			return err
+			return DescriptorUpdate{}, err
 		}
+	} else {
+		fdSet = &descriptorpb.FileDescriptorSet{File: fdRes.Files}
+	}
 
-		if err := os.WriteFile(appOptsFilename, bz, 0o600); err != nil {
-			return err
-		}
+	files, err := protodesc.FileOptions{AllowUnresolvable: true}.NewFiles(fdSet)
+	if err != nil {
+		return DescriptorUpdate{}, fmt.Errorf("error building protoregistry.Files: %w", err)
+	}
 
-		c.ModuleOptions = appOptsRes.ModuleOptions
-	} else {
-		bz, err := os.ReadFile(appOptsFilename)
-		if err != nil {
-			return err
-		}
+	autocliQueryClient := autocliv1.NewQueryClient(client)
+	appOptsRes, err := autocliQueryClient.AppOptions(c.Context, &autocliv1.AppOptionsRequest{})
+	if err != nil {
+		appOptsRes = guessAutocli(files)
+	}
 
-		var appOptsRes autocliv1.AppOptionsResponse
-		if err := proto.Unmarshal(bz, &appOptsRes); err != nil {
-			return err
-		}
+	update, err := c.storeVersion(cache, fdSet, appOptsRes, c.fetchAppVersion())
+	if err != nil {
+		return DescriptorUpdate{}, err
+	}
+
+	c.ProtoFiles = files
+	c.ModuleOptions = appOptsRes.ModuleOptions
+	return update, nil
+}
 
-		c.ModuleOptions = appOptsRes.ModuleOptions
+func (c *ChainInfo) setDescriptors(fdSet *descriptorpb.FileDescriptorSet, appOpts *autocliv1.AppOptionsResponse) error {
+	var err error
+	c.ProtoFiles, err = protodesc.FileOptions{AllowUnresolvable: true}.NewFiles(fdSet)
+	if err != nil {
+		return fmt.Errorf("error building protoregistry.Files: %w", err)
 	}
 
+	c.ModuleOptions = appOpts.ModuleOptions
 	return nil
 }
 
@@ -5,12 +5,23 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"os"
+	"path/filepath"
 	"strings"
 
 	"github.com/manifoldco/promptui"
 )
 
 type ChainRegistryEntry struct {
+	ChainName    string `json:"chain_name"`
+	ChainID      string `json:"chain_id"`
+	Bech32Prefix string `json:"bech32_prefix"`
+	Fees         struct {
+		FeeTokens []struct {
+			Denom           string  `json:"denom"`
+			AverageGasPrice float64 `json:"average_gas_price"`
+		} `json:"fee_tokens"`
+	} `json:"fees"`
 	APIs struct {
 		GRPC []*APIEntry `json:"grpc"`
 	} `json:"apis"`
@@ -32,8 +43,22 @@ func GetChainRegistryEntry(chain string) (*ChainRegistryEntry, error) {
 		return nil, err
 	}
 
+	return parseChainRegistryEntry(bz)
+}
+
+// LoadChainRegistryEntry loads the entry of a chain from a local chain registry directory.
+func LoadChainRegistryEntry(registryDir, chain string) (*ChainRegistryEntry, error) {
+	bz, err := os.ReadFile(filepath.Join(registryDir, chain, "chain.json"))
+	if err != nil {
+		return nil, err
+	}
+
+	return parseChainRegistryEntry(bz)
+}
+
+func parseChainRegistryEntry(bz []byte) (*ChainRegistryEntry, error) {
 	data := &ChainRegistryEntry{}
-	if err = json.Unmarshal(bz, data); err != nil {
+	if err := json.Unmarshal(bz, data); err != nil {
 		return nil, err
 	}
 
@@ -60,8 +85,18 @@ func GetChainRegistryEntry(chain string) (*ChainRegistryEntry, error) {
 	return data, nil
 }
 
-func SelectGRPCEndpoints(chain string) (string, error) {
-	entry, err := GetChainRegistryEntry(chain)
+// SelectGRPCEndpoints prompts the user to select a gRPC endpoint of the chain, proposing the endpoints listed in the
+// chain registry. The local chain registry directory is used if registryDir is set.
+func SelectGRPCEndpoints(chain, registryDir string) (string, error) {
+	var (
+		entry *ChainRegistryEntry
+		err   error
+	)
+	if registryDir != "" {
+		entry, err = LoadChainRegistryEntry(registryDir, chain)
+	} else {
+		entry, err = GetChainRegistryEntry(chain)
+	}
 	if err != nil || len(entry.APIs.GRPC) == 0 {
 		if err != nil {
 			// print error here so that user can know what happened and decide what to do next
@@ -2,6 +2,7 @@ package internal
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -37,6 +38,7 @@ If the chain is not listed in the chain registry, you can use any unique name.`,
 	}
 
 	cmd.Flags().BoolVar(&insecure, flags.FlagInsecure, false, "allow setting up insecure gRPC connection")
+	cmd.Flags().String(flags.FlagRegistryDir, "", "use a local chain registry directory instead of the remote chain registry")
 
 	return cmd
 }
@@ -49,7 +51,10 @@ func RemoteCommand(config *config.Config, configDir string) ([]*cobra.Command, e
 
 		// load chain info
 		chainInfo := NewChainInfo(configDir, chain, chainConfig)
-		if err := chainInfo.Load(false); err != nil {
+		if err := chainInfo.Load(); errors.Is(err, ErrNotCached) {
+			commands = append(commands, NotCachedCommand(chainInfo))
+			continue
+		} else if err != nil {
 			commands = append(commands, RemoteErrorCommand(config, configDir, chain, chainConfig, err))
 			continue
 		}
@@ -108,7 +113,13 @@ func RemoteCommand(config *config.Config, configDir string) ([]*cobra.Command, e
 					return reconfigure(cmd, config, configDir, chain)
 				case update:
 					cmd.Printf("Updating AutoCLI data for %s\n", chain)
-					return chainInfo.Load(true)
+					descriptorUpdate, err := chainInfo.Update()
+					if err != nil {
+						return err
+					}
+
+					cmd.Printf("%s: %s\n", chain, descriptorUpdate)
+					return nil
 				default:
 					return cmd.Help()
 				}
@@ -117,6 +128,7 @@ func RemoteCommand(config *config.Config, configDir string) ([]*cobra.Command, e
 		chainCmd.Flags().BoolVar(&update, flags.FlagUpdate, false, "update the CLI commands for the selected chain (should be used after every chain upgrade)")
 		chainCmd.Flags().BoolVar(&reconfig, flags.FlagConfig, false, "re-configure the selected chain (allows choosing a new gRPC endpoint and refreshes data")
 		chainCmd.Flags().BoolVar(&insecure, flags.FlagInsecure, false, "allow re-configuring the selected chain using an insecure gRPC connection")
+		chainCmd.Flags().String(flags.FlagRegistryDir, "", "re-configure the selected chain using a local chain registry directory")
 		chainCmd.PersistentFlags().StringVar(&output, flags.FlagOutput, flags.OutputFormatJSON, fmt.Sprintf("output format (%s|%s)", flags.OutputFormatText, flags.OutputFormatJSON))
 
 		// add chain specific keyring
@@ -126,7 +138,22 @@ func RemoteCommand(config *config.Config, configDir string) ([]*cobra.Command, e
 		clientCtx := client.Context{}.WithKeyring(kr)
 		chainCmd.SetContext(context.WithValue(context.Background(), client.ClientContextKey, &clientCtx))
 
-		if err := appOpts.EnhanceRootCommandWithBuilder(chainCmd, builder); err != nil {
+		// the tx commands are added before the autocli commands, so that autocli only adds the query commands
+		txCmd, txErr := TxCommand(chainInfo, builder, kr)
+		if txErr == nil {
+			chainCmd.AddCommand(txCmd)
+			err = appOpts.EnhanceRootCommandWithBuilder(chainCmd, builder)
+		} else {
+			// the query commands remain available when the tx commands cannot be built
+			chainCmd.AddCommand(TxErrorCommand(chain, txErr))
+
+			var queryCmd *cobra.Command
+			if queryCmd, err = builder.BuildQueryCommand(chainCmd.Context(), appOpts, nil); err == nil {
+				chainCmd.AddCommand(queryCmd)
+			}
+		}
+
+		if err != nil {
 			// when enriching the command with autocli fails, we add a command that
 			// will print the error and allow the user to reconfigure the chain instead
 			chainCmd.RunE = func(cmd *cobra.Command, args []string) error {
@@ -146,6 +173,37 @@ func RemoteCommand(config *config.Config, configDir string) ([]*cobra.Command, e
 	return commands, nil
 }
 
+// NotCachedCommand returns the command of a chain whose descriptors were not fetched yet, for instance after it was
+// imported from the chain registry. It fetches the descriptors, so that the commands of the chain are available.
+func NotCachedCommand(chainInfo *ChainInfo) *cobra.Command {
+	return &cobra.Command{
+		Use:   chainInfo.Chain,
+		Short: fmt.Sprintf("Fetch the AutoCLI data of the %s chain", chainInfo.Chain),
+		Long:  fmt.Sprintf("The AutoCLI data of the %s chain was not fetched yet, run this command to fetch it and enable the commands of the chain.", chainInfo.Chain),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Printf("Fetching AutoCLI data for %s\n", chainInfo.Chain)
+			descriptorUpdate, err := chainInfo.Update()
+			if err != nil {
+				return err
+			}
+
+			cmd.Printf("%s: %s\n", chainInfo.Chain, descriptorUpdate)
+			return nil
+		},
+	}
+}
+
+// TxErrorCommand returns the tx command of a chain whose tx commands cannot be built, which reports the error.
+func TxErrorCommand(chain string, err error) *cobra.Command {
+	return &cobra.Command{
+		Use:   "tx",
+		Short: "Transaction subcommands (unavailable)",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return fmt.Errorf("failed to build the transaction commands of %s: %w", chain, err)
+		},
+	}
+}
+
 func RemoteErrorCommand(cfg *config.Config, configDir, chain string, chainConfig *config.ChainConfig, err error) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   chain,
@@ -158,6 +216,7 @@ func RemoteErrorCommand(cfg *config.Config, configDir, chain string, chainConfig
 	}
 
 	cmd.Flags().Bool(flags.FlagInsecure, chainConfig.GRPCEndpoints[0].Insecure, "allow setting up insecure gRPC connection")
+	cmd.Flags().String(flags.FlagRegistryDir, "", "use a local chain registry directory instead of the remote chain registry")
 
 	return cmd
 }
@@ -165,8 +224,10 @@ func RemoteErrorCommand(cfg *config.Config, configDir, chain string, chainConfig
 func reconfigure(cmd *cobra.Command, cfg *config.Config, configDir, chain string) error {
 	insecure, _ := cmd.Flags().GetBool(flags.FlagInsecure)
 
+	registryDir, _ := cmd.Flags().GetString(flags.FlagRegistryDir)
+
 	cmd.Printf("Configuring %s\n", chain)
-	endpoint, err := SelectGRPCEndpoints(chain)
+	endpoint, err := SelectGRPCEndpoints(chain, registryDir)
 	if err != nil {
 		return err
 	}
@@ -181,10 +242,18 @@ func reconfigure(cmd *cobra.Command, cfg *config.Config, configDir, chain string
 		},
 	}
 
+	// keep the chain metadata imported from the chain registry
+	if previous, ok := cfg.Chains[chain]; ok {
+		chainConfig.ChainID = previous.ChainID
+		chainConfig.FeeTokens = previous.FeeTokens
+	}
+
 	chainInfo := NewChainInfo(configDir, chain, chainConfig)
-	if err = chainInfo.Load(true); err != nil {
+	descriptorUpdate, err := chainInfo.Update()
+	if err != nil {
 		return err
 	}
+	cmd.Printf("%s: %s\n", chain, descriptorUpdate)
 
 	client, err := chainInfo.OpenClient()
 	if err != nil {
@@ -30,6 +30,7 @@ func RootCommand() (*cobra.Command, error) {
 	commands = append(
 		commands,
 		InitCmd(cfg, configDir),
+		ImportCmd(cfg, configDir),
 		KeyringCmd(""),
 		VersionCmd(),
 	)
@@ -0,0 +1,188 @@
+package internal
+
+import (
+	"fmt"
+
+	"github.com/iancoleman/strcase"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	"cosmossdk.io/client/v2/autocli"
+	"cosmossdk.io/tools/hubl/internal/flags"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+)
+
+// TxCommand returns the tx command of a chain, with a command for each msg service method of its modules.
+// Contrary to the autocli tx commands, the transactions are built, signed and broadcast using the remote chain
+// only, so that no chain binary is needed.
+func TxCommand(chainInfo *ChainInfo, builder *autocli.Builder, kr keyring.Keyring) (*cobra.Command, error) {
+	if err := builder.ValidateAndComplete(); err != nil {
+		return nil, err
+	}
+
+	txBuilder := &txBuilder{
+		chainInfo: chainInfo,
+		builder:   builder,
+		keyring:   kr,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "tx",
+		Short: "Transaction subcommands",
+	}
+
+	for moduleName, modOpts := range chainInfo.ModuleOptions {
+		if modOpts == nil || modOpts.Tx == nil {
+			continue
+		}
+
+		moduleCmd := &cobra.Command{
+			Use:   moduleName,
+			Short: fmt.Sprintf("Transactions commands for the %s module", moduleName),
+		}
+		if err := txBuilder.addMsgServiceCommands(moduleCmd, modOpts.Tx); err != nil {
+			return nil, err
+		}
+
+		cmd.AddCommand(moduleCmd)
+	}
+
+	return cmd, nil
+}
+
+// addMsgServiceCommands adds a command to cmd for each method of the msg service of the descriptor and of its
+// sub-commands, as autocli does.
+func (t *txBuilder) addMsgServiceCommands(cmd *cobra.Command, cmdDescriptor *autocliv1.ServiceCommandDescriptor) error {
+	for cmdName, subCmdDescriptor := range cmdDescriptor.SubCommands {
+		subCmd := &cobra.Command{
+			Use:   cmdName,
+			Short: fmt.Sprintf("Tx commands for the %s service", subCmdDescriptor.Service),
+		}
+		if err := t.addMsgServiceCommands(subCmd, subCmdDescriptor); err != nil {
+			return err
+		}
+
+		cmd.AddCommand(subCmd)
+	}
+
+	if cmdDescriptor.Service == "" {
+		return nil
+	}
+
+	descriptor, err := t.chainInfo.ProtoFiles.FindDescriptorByName(protoreflect.FullName(cmdDescriptor.Service))
+	if err != nil {
+		return fmt.Errorf("can't find service %s: %w", cmdDescriptor.Service, err)
+	}
+	methods := descriptor.(protoreflect.ServiceDescriptor).Methods()
+
+	rpcOptions := map[protoreflect.Name]*autocliv1.RpcCommandOptions{}
+	for _, option := range cmdDescriptor.RpcCommandOptions {
+		rpcOptions[protoreflect.Name(option.RpcMethod)] = option
+	}
+
+	for i := 0; i < methods.Len(); i++ {
+		method := methods.Get(i)
+		options, ok := rpcOptions[method.Name()]
+		if !ok {
+			options = &autocliv1.RpcCommandOptions{}
+		}
+
+		if options.Skip {
+			continue
+		}
+
+		methodCmd, err := t.buildMsgMethodCommand(method, options)
+		if err != nil {
+			return err
+		}
+
+		cmd.AddCommand(methodCmd)
+	}
+
+	return nil
+}
+
+// buildMsgMethodCommand returns the command signing and broadcasting the message of a msg service method.
+func (t *txBuilder) buildMsgMethodCommand(descriptor protoreflect.MethodDescriptor, options *autocliv1.RpcCommandOptions) (*cobra.Command, error) {
+	short := options.Short
+	if short == "" {
+		short = fmt.Sprintf("Execute the %s RPC method", descriptor.Name())
+	}
+
+	long := options.Long
+	if long == "" {
+		long = descriptor.ParentFile().SourceLocations().ByDescriptor(descriptor).LeadingComments
+	}
+
+	use := options.Use
+	if use == "" {
+		use = strcase.ToKebab(string(descriptor.Name()))
+	}
+
+	cmd := &cobra.Command{
+		Use:        use,
+		Long:       long,
+		Short:      short,
+		Example:    options.Example,
+		Aliases:    options.Alias,
+		SuggestFor: options.SuggestFor,
+		Deprecated: options.Deprecated,
+		Version:    options.Version,
+	}
+
+	inputType, err := t.builder.TypeResolver.FindMessageByName(descriptor.Input().FullName())
+	if err != nil {
+		return nil, err
+	}
+
+	binder, err := t.builder.AddMessageFlags(cmd.Context(), cmd.Flags(), inputType, options)
+	if err != nil {
+		return nil, err
+	}
+
+	// the signer is set from the --from flag, unless it is a positional argument or has its own flag
+	fromFlag := binder.SignerInfo.IsFlag && binder.SignerInfo.FieldName == flags.FlagFrom
+	if fromFlag {
+		cmd.Flags().StringP(flags.FlagFrom, "f", "", "Name or address of the key with which to sign the transaction")
+		if err := cmd.MarkFlagRequired(flags.FlagFrom); err != nil {
+			return nil, err
+		}
+	}
+
+	cmd.Args = binder.CobraArgs
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		msg, err := binder.BuildMessage(args)
+		if err != nil {
+			return err
+		}
+
+		// the command output is the transaction, not the usage
+		cmd.SilenceUsage = true
+
+		var from string
+		if fromFlag {
+			from, _ = cmd.Flags().GetString(flags.FlagFrom)
+		}
+
+		return t.signAndBroadcast(cmd, msg, from)
+	}
+
+	addTxFlags(cmd)
+
+	return cmd, nil
+}
+
+// addTxFlags adds the flags controlling the fees, signature and broadcast of a transaction.
+func addTxFlags(cmd *cobra.Command) {
+	f := cmd.Flags()
+	f.String(flags.FlagFees, "", "fees to pay along with the transaction, e.g. 10uatom (estimated from the gas prices when not set)")
+	f.String(flags.FlagGasPrices, "", "gas prices in decimal format to determine the transaction fees, e.g. 0.025uatom (defaults to the fee tokens of the chain config)")
+	f.String(flags.FlagGas, flags.GasAuto, fmt.Sprintf("gas limit of the transaction, or %q to estimate it by simulation", flags.GasAuto))
+	f.Float64(flags.FlagGasAdjustment, flags.DefaultGasAdjustment, "factor applied to the simulated gas to compute the gas limit")
+	f.String(flags.FlagSignMode, "", fmt.Sprintf("sign mode (%s|%s), chosen among the sign modes advertised by the chain when not set", flags.SignModeDirect, flags.SignModeLegacyAminoJSON))
+	f.String(flags.FlagNote, "", "note (memo) to add to the transaction")
+	f.Bool(flags.FlagDryRun, false, "simulate the transaction and print the estimated gas without signing or broadcasting it")
+	f.BoolP(flags.FlagYes, "y", false, "skip the confirmation prompt before signing and broadcasting")
+}
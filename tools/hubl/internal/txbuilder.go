@@ -0,0 +1,544 @@
+package internal
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/anypb"
+	"sigs.k8s.io/yaml"
+
+	authv1beta1 "cosmossdk.io/api/cosmos/auth/v1beta1"
+	reflectionv2alpha1 "cosmossdk.io/api/cosmos/base/reflection/v2alpha1"
+	cmtv1beta1 "cosmossdk.io/api/cosmos/base/tendermint/v1beta1"
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	signingv1beta1 "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+	txv1beta1 "cosmossdk.io/api/cosmos/tx/v1beta1"
+	"cosmossdk.io/client/v2/autocli"
+	"cosmossdk.io/client/v2/autocli/flag"
+	"cosmossdk.io/math"
+	"cosmossdk.io/tools/hubl/internal/flags"
+	"cosmossdk.io/x/tx/signing"
+	"cosmossdk.io/x/tx/signing/aminojson"
+	"cosmossdk.io/x/tx/signing/direct"
+
+	"github.com/cosmos/cosmos-sdk/client/input"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdksigning "github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// signModes are the sign modes supported by hubl, by --sign-mode flag value.
+var signModes = map[string]signingv1beta1.SignMode{
+	flags.SignModeDirect:          signingv1beta1.SignMode_SIGN_MODE_DIRECT,
+	flags.SignModeLegacyAminoJSON: signingv1beta1.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+}
+
+// txBuilder builds, signs and broadcasts the transactions of a chain using its gRPC endpoint.
+type txBuilder struct {
+	chainInfo *ChainInfo
+	builder   *autocli.Builder
+	keyring   keyring.Keyring
+}
+
+// signerAccount is the key signing a transaction, with its account on the chain.
+type signerAccount struct {
+	record        *keyring.Record
+	address       string
+	pubKey        *anypb.Any
+	accountNumber uint64
+	sequence      uint64
+}
+
+// signAndBroadcast signs the transaction of the message with the key of its signer and broadcasts it.
+// The signer of the message is set from the from key if not empty.
+func (t *txBuilder) signAndBroadcast(cmd *cobra.Command, msg protoreflect.Message, from string) error {
+	ctx := cmd.Context()
+
+	conn, err := t.chainInfo.OpenClient()
+	if err != nil {
+		return err
+	}
+
+	signer, err := t.resolveSigner(ctx, conn, msg, from)
+	if err != nil {
+		return err
+	}
+
+	chainID, err := t.chainID(ctx, conn)
+	if err != nil {
+		return err
+	}
+
+	requestedSignMode, _ := cmd.Flags().GetString(flags.FlagSignMode)
+	signMode, err := selectSignMode(t.advertisedSignModes(ctx, conn), requestedSignMode, signer.record.GetType() == keyring.TypeLedger)
+	if err != nil {
+		return err
+	}
+
+	msgBz, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg.Interface())
+	if err != nil {
+		return err
+	}
+
+	note, _ := cmd.Flags().GetString(flags.FlagNote)
+	body := &txv1beta1.TxBody{
+		Messages: []*anypb.Any{{TypeUrl: "/" + string(msg.Descriptor().FullName()), Value: msgBz}},
+		Memo:     note,
+	}
+	authInfo := &txv1beta1.AuthInfo{
+		SignerInfos: []*txv1beta1.SignerInfo{
+			{
+				PublicKey: signer.pubKey,
+				ModeInfo: &txv1beta1.ModeInfo{
+					Sum: &txv1beta1.ModeInfo_Single_{Single: &txv1beta1.ModeInfo_Single{Mode: signMode}},
+				},
+				Sequence: signer.sequence,
+			},
+		},
+		Fee: &txv1beta1.Fee{},
+	}
+
+	gasSetting, _ := cmd.Flags().GetString(flags.FlagGas)
+	dryRun, _ := cmd.Flags().GetBool(flags.FlagDryRun)
+	if gasSetting == flags.GasAuto || dryRun {
+		gasUsed, err := simulate(ctx, conn, body, authInfo)
+		if err != nil {
+			return fmt.Errorf("failed to simulate the transaction: %w", err)
+		}
+
+		gasAdjustment, _ := cmd.Flags().GetFloat64(flags.FlagGasAdjustment)
+		authInfo.Fee.GasLimit = uint64(gasAdjustment * float64(gasUsed))
+
+		if dryRun {
+			cmd.Printf("gas estimate: %d\n", authInfo.Fee.GasLimit)
+			return nil
+		}
+	} else if authInfo.Fee.GasLimit, err = strconv.ParseUint(gasSetting, 10, 64); err != nil {
+		return fmt.Errorf("gas must be either an integer or %q: %w", flags.GasAuto, err)
+	}
+
+	if authInfo.Fee.Amount, err = t.fees(cmd, authInfo.Fee.GasLimit); err != nil {
+		return err
+	}
+
+	tx := &txv1beta1.Tx{Body: body, AuthInfo: authInfo}
+	if skip, _ := cmd.Flags().GetBool(flags.FlagYes); !skip {
+		preview, err := protojson.MarshalOptions{Resolver: dynamicTypeResolver{t.chainInfo}, Indent: "  "}.Marshal(tx)
+		if err != nil {
+			return err
+		}
+
+		cmd.PrintErrf("%s\n\n", preview)
+		ok, err := input.GetConfirmation("confirm transaction before signing and broadcasting", bufio.NewReader(cmd.InOrStdin()), cmd.ErrOrStderr())
+		if err != nil {
+			return err
+		}
+		if !ok {
+			cmd.PrintErrln("canceled transaction")
+			return nil
+		}
+	}
+
+	txBytes, err := t.sign(ctx, tx, signer, chainID, signMode)
+	if err != nil {
+		return err
+	}
+
+	res, err := txv1beta1.NewServiceClient(conn).BroadcastTx(ctx, &txv1beta1.BroadcastTxRequest{
+		TxBytes: txBytes,
+		Mode:    txv1beta1.BroadcastMode_BROADCAST_MODE_SYNC,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to broadcast the transaction: %w", err)
+	}
+
+	out, err := protojson.MarshalOptions{Resolver: dynamicTypeResolver{t.chainInfo}}.Marshal(res.TxResponse)
+	if err != nil {
+		return err
+	}
+
+	if output, _ := cmd.Flags().GetString(flags.FlagOutput); output == flags.OutputFormatText {
+		if out, err = yaml.JSONToYAML(out); err != nil {
+			return err
+		}
+	}
+
+	cmd.Println(strings.TrimSpace(string(out)))
+	return nil
+}
+
+// resolveSigner returns the key signing the message and its account.
+func (t *txBuilder) resolveSigner(ctx context.Context, conn grpc.ClientConnInterface, msg protoreflect.Message, from string) (*signerAccount, error) {
+	signerField := msg.Descriptor().Fields().ByName(protoreflect.Name(flag.GetSignerFieldName(msg.Descriptor())))
+	if signerField == nil {
+		return nil, fmt.Errorf("no signer defined for %s", msg.Descriptor().FullName())
+	}
+
+	// the signer field can be a validator or consensus address
+	signerCodec := t.builder.AddressCodec
+	if scalarType, ok := flag.GetScalarType(signerField); ok {
+		switch scalarType {
+		case flag.ValidatorAddressStringScalarType:
+			signerCodec = t.builder.ValidatorAddressCodec
+		case flag.ConsensusAddressStringScalarType:
+			signerCodec = t.builder.ConsensusAddressCodec
+		}
+	}
+
+	var (
+		record *keyring.Record
+		err    error
+	)
+	if from != "" {
+		record, err = t.lookupKey(from)
+		if err != nil {
+			return nil, err
+		}
+
+		addr, err := record.GetAddress()
+		if err != nil {
+			return nil, err
+		}
+
+		signerAddr, err := signerCodec.BytesToString(addr)
+		if err != nil {
+			return nil, err
+		}
+		msg.Set(signerField, protoreflect.ValueOfString(signerAddr))
+	} else {
+		addr, err := signerCodec.StringToBytes(msg.Get(signerField).String())
+		if err != nil {
+			return nil, fmt.Errorf("invalid signer %q: %w", msg.Get(signerField).String(), err)
+		}
+
+		if record, err = t.keyring.KeyByAddress(sdk.AccAddress(addr)); err != nil {
+			return nil, fmt.Errorf("no key found for signer %s: %w", msg.Get(signerField).String(), err)
+		}
+	}
+
+	return t.loadAccount(ctx, conn, record)
+}
+
+// lookupKey returns the key of the keyring with the given name or address.
+func (t *txBuilder) lookupKey(nameOrAddress string) (*keyring.Record, error) {
+	if record, err := t.keyring.Key(nameOrAddress); err == nil {
+		return record, nil
+	}
+
+	addr, err := t.builder.AddressCodec.StringToBytes(nameOrAddress)
+	if err != nil {
+		return nil, fmt.Errorf("key %s not found in the keyring", nameOrAddress)
+	}
+
+	return t.keyring.KeyByAddress(sdk.AccAddress(addr))
+}
+
+// loadAccount returns the signer of a key with its account number and sequence, queried from the x/auth module of
+// the chain.
+func (t *txBuilder) loadAccount(ctx context.Context, conn grpc.ClientConnInterface, record *keyring.Record) (*signerAccount, error) {
+	addr, err := record.GetAddress()
+	if err != nil {
+		return nil, err
+	}
+
+	addrStr, err := t.builder.AddressCodec.BytesToString(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	pubKey, err := record.GetPubKey()
+	if err != nil {
+		return nil, err
+	}
+
+	pubKeyAny, err := codectypes.NewAnyWithValue(pubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &signerAccount{
+		record:  record,
+		address: addrStr,
+		pubKey:  &anypb.Any{TypeUrl: pubKeyAny.TypeUrl, Value: pubKeyAny.Value},
+	}
+
+	queryClient := authv1beta1.NewQueryClient(conn)
+	info, err := queryClient.AccountInfo(ctx, &authv1beta1.QueryAccountInfoRequest{Address: addrStr})
+	switch status.Code(err) {
+	case codes.OK:
+		s.accountNumber, s.sequence = info.Info.GetAccountNumber(), info.Info.GetSequence()
+		return s, nil
+	case codes.Unimplemented:
+		// chains older than v0.47 only have the account query
+	case codes.NotFound:
+		return nil, fmt.Errorf("account %s not found on chain, it must receive funds before sending transactions", addrStr)
+	default:
+		return nil, fmt.Errorf("failed to query account %s: %w", addrStr, err)
+	}
+
+	res, err := queryClient.Account(ctx, &authv1beta1.QueryAccountRequest{Address: addrStr})
+	if status.Code(err) == codes.NotFound {
+		return nil, fmt.Errorf("account %s not found on chain, it must receive funds before sending transactions", addrStr)
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to query account %s: %w", addrStr, err)
+	}
+
+	accountType, err := dynamicTypeResolver{t.chainInfo}.FindMessageByURL(res.Account.GetTypeUrl())
+	if err != nil {
+		return nil, fmt.Errorf("unknown account type %s: %w", res.Account.GetTypeUrl(), err)
+	}
+
+	account := accountType.New()
+	if err := proto.Unmarshal(res.Account.GetValue(), account.Interface()); err != nil {
+		return nil, err
+	}
+
+	var ok bool
+	if s.accountNumber, s.sequence, ok = accountNumberAndSequence(account); !ok {
+		return nil, fmt.Errorf("account type %s has no account number and sequence", res.Account.GetTypeUrl())
+	}
+
+	return s, nil
+}
+
+// accountNumberAndSequence returns the account number and sequence of an account, looking for them in its embedded
+// accounts, such as the base account of a vesting account.
+func accountNumberAndSequence(account protoreflect.Message) (accountNumber, sequence uint64, ok bool) {
+	fields := account.Descriptor().Fields()
+	accountNumberField, sequenceField := fields.ByName("account_number"), fields.ByName("sequence")
+	if accountNumberField != nil && sequenceField != nil {
+		return account.Get(accountNumberField).Uint(), account.Get(sequenceField).Uint(), true
+	}
+
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		if field.Kind() != protoreflect.MessageKind || field.IsList() || field.IsMap() || !account.Has(field) {
+			continue
+		}
+
+		if accountNumber, sequence, ok = accountNumberAndSequence(account.Get(field).Message()); ok {
+			return accountNumber, sequence, true
+		}
+	}
+
+	return 0, 0, false
+}
+
+// chainID returns the chain ID of the chain config, or the network of the node when not configured.
+func (t *txBuilder) chainID(ctx context.Context, conn grpc.ClientConnInterface) (string, error) {
+	if t.chainInfo.Config.ChainID != "" {
+		return t.chainInfo.Config.ChainID, nil
+	}
+
+	res, err := cmtv1beta1.NewServiceClient(conn).GetNodeInfo(ctx, &cmtv1beta1.GetNodeInfoRequest{})
+	if err != nil {
+		return "", fmt.Errorf("failed to query the chain ID: %w", err)
+	}
+
+	return res.DefaultNodeInfo.GetNetwork(), nil
+}
+
+// advertisedSignModes returns the sign modes advertised by the chain, or nil if it does not advertise them.
+func (t *txBuilder) advertisedSignModes(ctx context.Context, conn grpc.ClientConnInterface) []signingv1beta1.SignMode {
+	res, err := reflectionv2alpha1.NewReflectionServiceClient(conn).GetAuthnDescriptor(ctx, &reflectionv2alpha1.GetAuthnDescriptorRequest{})
+	if err != nil {
+		return nil
+	}
+
+	var modes []signingv1beta1.SignMode
+	for _, mode := range res.Authn.GetSignModes() {
+		modes = append(modes, signingv1beta1.SignMode(mode.Number))
+	}
+
+	return modes
+}
+
+// selectSignMode returns the requested sign mode, or the preferred sign mode supported by both hubl and the chain.
+// Ledger keys can only sign in amino JSON. When the chain does not advertise its sign modes, they are assumed
+// to be supported.
+func selectSignMode(advertised []signingv1beta1.SignMode, requested string, ledger bool) (signingv1beta1.SignMode, error) {
+	isAdvertised := func(mode signingv1beta1.SignMode) bool {
+		if len(advertised) == 0 {
+			return true
+		}
+
+		for _, m := range advertised {
+			if m == mode {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	if requested != "" {
+		mode, ok := signModes[requested]
+		if !ok {
+			return 0, fmt.Errorf("unsupported sign mode %q, must be one of %s or %s", requested, flags.SignModeDirect, flags.SignModeLegacyAminoJSON)
+		}
+		if ledger && mode != signingv1beta1.SignMode_SIGN_MODE_LEGACY_AMINO_JSON {
+			return 0, fmt.Errorf("sign mode %s is not supported by ledger keys, use %s", requested, flags.SignModeLegacyAminoJSON)
+		}
+		if !isAdvertised(mode) {
+			return 0, fmt.Errorf("sign mode %s is not supported by the chain", requested)
+		}
+
+		return mode, nil
+	}
+
+	candidates := []signingv1beta1.SignMode{signingv1beta1.SignMode_SIGN_MODE_DIRECT, signingv1beta1.SignMode_SIGN_MODE_LEGACY_AMINO_JSON}
+	if ledger {
+		candidates = []signingv1beta1.SignMode{signingv1beta1.SignMode_SIGN_MODE_LEGACY_AMINO_JSON}
+	}
+
+	for _, mode := range candidates {
+		if isAdvertised(mode) {
+			return mode, nil
+		}
+	}
+
+	return 0, errors.New("the chain does not support any of the sign modes supported by hubl")
+}
+
+// simulate returns the gas used by the transaction, simulated without signature.
+func simulate(ctx context.Context, conn grpc.ClientConnInterface, body *txv1beta1.TxBody, authInfo *txv1beta1.AuthInfo) (uint64, error) {
+	txBytes, err := encodeTx(body, authInfo, [][]byte{{}})
+	if err != nil {
+		return 0, err
+	}
+
+	res, err := txv1beta1.NewServiceClient(conn).Simulate(ctx, &txv1beta1.SimulateRequest{TxBytes: txBytes})
+	if err != nil {
+		return 0, err
+	}
+
+	return res.GasInfo.GetGasUsed(), nil
+}
+
+// fees returns the fees of the transaction, set by flag or computed from its gas limit and the gas prices.
+func (t *txBuilder) fees(cmd *cobra.Command, gasLimit uint64) ([]*basev1beta1.Coin, error) {
+	feesStr, _ := cmd.Flags().GetString(flags.FlagFees)
+	gasPricesStr, _ := cmd.Flags().GetString(flags.FlagGasPrices)
+	if feesStr != "" && gasPricesStr != "" {
+		return nil, fmt.Errorf("cannot provide both --%s and --%s", flags.FlagFees, flags.FlagGasPrices)
+	}
+
+	if feesStr != "" {
+		fees, err := sdk.ParseCoinsNormalized(feesStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid fees: %w", err)
+		}
+
+		coins := make([]*basev1beta1.Coin, 0, len(fees))
+		for _, fee := range fees {
+			coins = append(coins, &basev1beta1.Coin{Denom: fee.Denom, Amount: fee.Amount.String()})
+		}
+		return coins, nil
+	}
+
+	var gasPrices sdk.DecCoins
+	if gasPricesStr != "" {
+		var err error
+		if gasPrices, err = sdk.ParseDecCoins(gasPricesStr); err != nil {
+			return nil, fmt.Errorf("invalid gas prices: %w", err)
+		}
+	} else {
+		// use the gas price of the first fee token of the chain
+		for _, token := range t.chainInfo.Config.FeeTokens {
+			if token.GasPrice <= 0 {
+				continue
+			}
+
+			price, err := math.LegacyNewDecFromStr(strconv.FormatFloat(token.GasPrice, 'f', -1, 64))
+			if err != nil {
+				return nil, fmt.Errorf("invalid gas price of fee token %s: %w", token.Denom, err)
+			}
+
+			gasPrices = sdk.NewDecCoins(sdk.NewDecCoinFromDec(token.Denom, price))
+			break
+		}
+	}
+
+	var coins []*basev1beta1.Coin
+	for _, gasPrice := range gasPrices {
+		amount := gasPrice.Amount.MulInt64(int64(gasLimit)).Ceil().RoundInt()
+		if amount.IsZero() {
+			continue
+		}
+
+		coins = append(coins, &basev1beta1.Coin{Denom: gasPrice.Denom, Amount: amount.String()})
+	}
+
+	return coins, nil
+}
+
+// sign returns the encoded transaction signed by the signer in the given sign mode.
+func (t *txBuilder) sign(ctx context.Context, tx *txv1beta1.Tx, signer *signerAccount, chainID string, signMode signingv1beta1.SignMode) ([]byte, error) {
+	bodyBz, err := proto.MarshalOptions{Deterministic: true}.Marshal(tx.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	authInfoBz, err := proto.MarshalOptions{Deterministic: true}.Marshal(tx.AuthInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	handlers := signing.NewHandlerMap(
+		direct.SignModeHandler{},
+		aminojson.NewSignModeHandler(aminojson.SignModeHandlerOptions{
+			FileResolver: t.chainInfo.ProtoFiles,
+			TypeResolver: dynamicTypeResolver{t.chainInfo},
+		}),
+	)
+
+	signBytes, err := handlers.GetSignBytes(ctx, signMode, signing.SignerData{
+		Address:       signer.address,
+		ChainID:       chainID,
+		AccountNumber: signer.accountNumber,
+		Sequence:      signer.sequence,
+		PubKey:        signer.pubKey,
+	}, signing.TxData{
+		Body:          tx.Body,
+		AuthInfo:      tx.AuthInfo,
+		BodyBytes:     bodyBz,
+		AuthInfoBytes: authInfoBz,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	signature, _, err := t.keyring.Sign(signer.record.Name, signBytes, sdksigning.SignMode(signMode))
+	if err != nil {
+		return nil, err
+	}
+
+	return encodeTx(tx.Body, tx.AuthInfo, [][]byte{signature})
+}
+
+// encodeTx returns the encoded transaction with the given signatures.
+func encodeTx(body *txv1beta1.TxBody, authInfo *txv1beta1.AuthInfo, signatures [][]byte) ([]byte, error) {
+	bodyBz, err := proto.MarshalOptions{Deterministic: true}.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	authInfoBz, err := proto.MarshalOptions{Deterministic: true}.Marshal(authInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	return proto.Marshal(&txv1beta1.TxRaw{BodyBytes: bodyBz, AuthInfoBytes: authInfoBz, Signatures: signatures})
+}
@@ -0,0 +1,258 @@
+package internal
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	authv1beta1 "cosmossdk.io/api/cosmos/auth/v1beta1"
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	signingv1beta1 "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+	vestingv1beta1 "cosmossdk.io/api/cosmos/vesting/v1beta1"
+	"cosmossdk.io/tools/hubl/internal/config"
+	"cosmossdk.io/tools/hubl/internal/flags"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+)
+
+func TestSelectSignMode(t *testing.T) {
+	var (
+		direct    = signingv1beta1.SignMode_SIGN_MODE_DIRECT
+		aminoJSON = signingv1beta1.SignMode_SIGN_MODE_LEGACY_AMINO_JSON
+		textual   = signingv1beta1.SignMode_SIGN_MODE_TEXTUAL
+	)
+
+	// a ledger key is identified by the type of its keyring record
+	ledgerRecord, err := keyring.NewLedgerRecord("ledger", secp256k1.GenPrivKey().PubKey(), hd.NewFundraiserParams(0, 118, 0))
+	require.NoError(t, err)
+	require.Equal(t, keyring.TypeLedger, ledgerRecord.GetType())
+
+	testCases := []struct {
+		name       string
+		advertised []signingv1beta1.SignMode
+		requested  string
+		record     *keyring.Record
+		expect     signingv1beta1.SignMode
+		expectErr  string
+	}{
+		{
+			name:   "not advertised, direct preferred",
+			expect: direct,
+		},
+		{
+			name:       "direct preferred",
+			advertised: []signingv1beta1.SignMode{textual, aminoJSON, direct},
+			expect:     direct,
+		},
+		{
+			name:       "amino json when direct is not advertised",
+			advertised: []signingv1beta1.SignMode{textual, aminoJSON},
+			expect:     aminoJSON,
+		},
+		{
+			name:       "no sign mode supported",
+			advertised: []signingv1beta1.SignMode{textual},
+			expectErr:  "the chain does not support any of the sign modes supported by hubl",
+		},
+		{
+			name:       "requested",
+			advertised: []signingv1beta1.SignMode{direct, aminoJSON},
+			requested:  flags.SignModeLegacyAminoJSON,
+			expect:     aminoJSON,
+		},
+		{
+			name:      "requested, not advertised",
+			requested: flags.SignModeDirect,
+			expect:    direct,
+		},
+		{
+			name:       "requested, not supported by the chain",
+			advertised: []signingv1beta1.SignMode{aminoJSON},
+			requested:  flags.SignModeDirect,
+			expectErr:  "sign mode direct is not supported by the chain",
+		},
+		{
+			name:      "requested, unknown",
+			requested: "textual",
+			expectErr: `unsupported sign mode "textual", must be one of direct or amino-json`,
+		},
+		{
+			name:       "ledger key",
+			advertised: []signingv1beta1.SignMode{direct, aminoJSON},
+			record:     ledgerRecord,
+			expect:     aminoJSON,
+		},
+		{
+			name:       "ledger key, amino json not advertised",
+			advertised: []signingv1beta1.SignMode{direct},
+			record:     ledgerRecord,
+			expectErr:  "the chain does not support any of the sign modes supported by hubl",
+		},
+		{
+			name:      "ledger key, direct requested",
+			requested: flags.SignModeDirect,
+			record:    ledgerRecord,
+			expectErr: "sign mode direct is not supported by ledger keys, use amino-json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ledger := tc.record != nil && tc.record.GetType() == keyring.TypeLedger
+			mode, err := selectSignMode(tc.advertised, tc.requested, ledger)
+			if tc.expectErr != "" {
+				require.EqualError(t, err, tc.expectErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expect, mode)
+		})
+	}
+}
+
+func TestAccountNumberAndSequence(t *testing.T) {
+	baseAccount := &authv1beta1.BaseAccount{Address: "cosmos1", AccountNumber: 7, Sequence: 3}
+
+	testCases := []struct {
+		name                 string
+		account              proto.Message
+		expectAccountNumber  uint64
+		expectSequence       uint64
+		expectAccountMissing bool
+	}{
+		{
+			name:                "base account",
+			account:             baseAccount,
+			expectAccountNumber: 7,
+			expectSequence:      3,
+		},
+		{
+			name:                "module account",
+			account:             &authv1beta1.ModuleAccount{BaseAccount: baseAccount, Name: "gov"},
+			expectAccountNumber: 7,
+			expectSequence:      3,
+		},
+		{
+			name: "vesting account",
+			account: &vestingv1beta1.ContinuousVestingAccount{
+				BaseVestingAccount: &vestingv1beta1.BaseVestingAccount{
+					BaseAccount:     baseAccount,
+					OriginalVesting: []*basev1beta1.Coin{{Denom: "stake", Amount: "10"}},
+				},
+				StartTime: 1,
+			},
+			expectAccountNumber: 7,
+			expectSequence:      3,
+		},
+		{
+			name:                "new account",
+			account:             &authv1beta1.BaseAccount{Address: "cosmos1"},
+			expectAccountNumber: 0,
+			expectSequence:      0,
+		},
+		{
+			name:                 "vesting account without base account",
+			account:              &vestingv1beta1.ContinuousVestingAccount{BaseVestingAccount: &vestingv1beta1.BaseVestingAccount{}},
+			expectAccountMissing: true,
+		},
+		{
+			name:                 "not an account",
+			account:              &basev1beta1.Coin{Denom: "stake", Amount: "10"},
+			expectAccountMissing: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			accountNumber, sequence, ok := accountNumberAndSequence(tc.account.ProtoReflect())
+			require.Equal(t, !tc.expectAccountMissing, ok)
+			require.Equal(t, tc.expectAccountNumber, accountNumber)
+			require.Equal(t, tc.expectSequence, sequence)
+		})
+	}
+}
+
+func TestFees(t *testing.T) {
+	testCases := []struct {
+		name      string
+		feeTokens []config.FeeToken
+		args      []string
+		gasLimit  uint64
+		expect    []*basev1beta1.Coin
+		expectErr string
+	}{
+		{
+			name:     "fees",
+			args:     []string{"--fees", "10uatom,5stake"},
+			gasLimit: 200000,
+			expect:   []*basev1beta1.Coin{{Denom: "stake", Amount: "5"}, {Denom: "uatom", Amount: "10"}},
+		},
+		{
+			name:      "invalid fees",
+			args:      []string{"--fees", "10"},
+			expectErr: "invalid fees",
+		},
+		{
+			name:     "gas prices, rounded up",
+			args:     []string{"--gas-prices", "0.025uatom"},
+			gasLimit: 100001,
+			expect:   []*basev1beta1.Coin{{Denom: "uatom", Amount: "2501"}},
+		},
+		{
+			name:      "invalid gas prices",
+			args:      []string{"--gas-prices", "0.025"},
+			expectErr: "invalid gas prices",
+		},
+		{
+			name:      "both fees and gas prices",
+			args:      []string{"--fees", "10uatom", "--gas-prices", "0.025uatom"},
+			expectErr: "cannot provide both --fees and --gas-prices",
+		},
+		{
+			name:      "gas price of the first priced fee token",
+			feeTokens: []config.FeeToken{{Denom: "ibc/ABC"}, {Denom: "uosmo", GasPrice: 0.0025}, {Denom: "stake", GasPrice: 1}},
+			gasLimit:  200000,
+			expect:    []*basev1beta1.Coin{{Denom: "uosmo", Amount: "500"}},
+		},
+		{
+			name:      "gas prices override the fee tokens",
+			feeTokens: []config.FeeToken{{Denom: "uosmo", GasPrice: 0.0025}},
+			args:      []string{"--gas-prices", "1stake"},
+			gasLimit:  10,
+			expect:    []*basev1beta1.Coin{{Denom: "stake", Amount: "10"}},
+		},
+		{
+			name:     "zero gas price",
+			args:     []string{"--gas-prices", "0uatom"},
+			gasLimit: 200000,
+		},
+		{
+			name:     "no fees",
+			gasLimit: 200000,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cmd := &cobra.Command{}
+			addTxFlags(cmd)
+			require.NoError(t, cmd.ParseFlags(tc.args))
+
+			tb := &txBuilder{chainInfo: NewChainInfo(t.TempDir(), "test", &config.ChainConfig{FeeTokens: tc.feeTokens})}
+			fees, err := tb.fees(cmd, tc.gasLimit)
+			if tc.expectErr != "" {
+				require.ErrorContains(t, err, tc.expectErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, fees, len(tc.expect))
+			for i, fee := range fees {
+				require.True(t, proto.Equal(tc.expect[i], fee), "expected %v, got %v", tc.expect[i], fee)
+			}
+		})
+	}
+}
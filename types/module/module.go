@@ -25,12 +25,14 @@ import (
 	"errors"
 	"fmt"
 	"sort"
+	"time"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/spf13/cobra"
 	"golang.org/x/exp/maps"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/appmodule"
 	appmodulev2 "cosmossdk.io/core/appmodule/v2"
 	"cosmossdk.io/core/genesis"
@@ -103,6 +105,13 @@ type HasInvariants interface {
 	RegisterInvariants(sdk.InvariantRegistry)
 }
 
+// HasStateSchema is the interface for modules defining their state with a
+// collections.Schema, so that their raw state can be decoded by tooling.
+type HasStateSchema interface {
+	// RegisterStateSchema registers the schema of the state of the module by its store key.
+	RegisterStateSchema(collections.StateDecoder)
+}
+
 // HasServices is the interface for modules to register services.
 type HasServices interface {
 	// RegisterServices allows a module to register services.
@@ -393,6 +402,19 @@ func (m *Manager) RegisterInvariants(ir sdk.InvariantRegistry) {
 	}
 }
 
+// StateDecoder returns the decoder of the raw state of all modules registering
+// their state schema.
+func (m *Manager) StateDecoder() collections.StateDecoder {
+	decoder := make(collections.StateDecoder)
+	for _, module := range m.Modules {
+		if module, ok := module.(HasStateSchema); ok {
+			module.RegisterStateSchema(decoder)
+		}
+	}
+
+	return decoder
+}
+
 // RegisterServices registers all module services
 func (m *Manager) RegisterServices(cfg Configurator) error {
 	for _, module := range m.Modules {
@@ -668,47 +690,33 @@ func (m Manager) RunMigrations(ctx context.Context, cfg Configurator, fromVM app
 	}
 
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	listener, _ := sdkCtx.Value(migrationListenerKey{}).(MigrationListener)
 	updatedVM := appmodule.VersionMap{}
 	for _, moduleName := range modules {
-		module := m.Modules[moduleName]
 		fromVersion, exists := fromVM[moduleName]
 		toVersion := uint64(0)
-		if module, ok := module.(appmodule.HasConsensusVersion); ok {
+		if module, ok := m.Modules[moduleName].(appmodule.HasConsensusVersion); ok {
 			toVersion = module.ConsensusVersion()
 		}
 
-		// We run migration if the module is specified in `fromVM`.
-		// Otherwise we run InitGenesis.
-		//
-		// The module won't exist in the fromVM in two cases:
-		// 1. A new module is added. In this case we run InitGenesis with an
-		// empty genesis state.
-		// 2. An existing chain is upgrading from version < 0.43 to v0.43+ for the first time.
-		// In this case, all modules have yet to be added to x/upgrade's VersionMap store.
-		if exists {
-			err := c.runModuleMigrations(sdkCtx, moduleName, fromVersion, toVersion)
-			if err != nil {
-				return nil, err
-			}
-		} else {
-			sdkCtx.Logger().Info(fmt.Sprintf("adding a new module: %s", moduleName))
-			if module, ok := m.Modules[moduleName].(HasGenesis); ok {
-				if err := module.InitGenesis(sdkCtx, module.DefaultGenesis()); err != nil {
-					return nil, err
-				}
-			}
-			if module, ok := m.Modules[moduleName].(HasABCIGenesis); ok {
-				moduleValUpdates, err := module.InitGenesis(sdkCtx, module.DefaultGenesis())
-				if err != nil {
-					return nil, err
-				}
-
-				// The module manager assumes only one module will update the
-				// validator set, and it can't be a new module.
-				if len(moduleValUpdates) > 0 {
-					return nil, errorsmod.Wrapf(sdkerrors.ErrLogic, "validator InitGenesis update is already set by another module")
-				}
-			}
+		var (
+			start   = time.Now()
+			gasUsed = sdkCtx.GasMeter().GasConsumed()
+		)
+		err := m.runModuleMigration(sdkCtx, c, moduleName, fromVersion, toVersion, exists)
+		if listener != nil {
+			listener(MigrationResult{
+				Module:      moduleName,
+				FromVersion: fromVersion,
+				ToVersion:   toVersion,
+				InitGenesis: !exists,
+				GasUsed:     sdkCtx.GasMeter().GasConsumed() - gasUsed,
+				Duration:    time.Since(start),
+				Err:         err,
+			})
+		}
+		if err != nil {
+			return nil, err
 		}
 
 		updatedVM[moduleName] = toVersion
@@ -717,6 +725,67 @@ func (m Manager) RunMigrations(ctx context.Context, cfg Configurator, fromVM app
 	return updatedVM, nil
 }
 
+// runModuleMigration migrates a module from fromVersion to toVersion, or runs its InitGenesis if the module
+// did not exist before.
+func (m Manager) runModuleMigration(ctx sdk.Context, c *configurator, moduleName string, fromVersion, toVersion uint64, exists bool) error {
+	// We run migration if the module is specified in `fromVM`.
+	// Otherwise we run InitGenesis.
+	//
+	// The module won't exist in the fromVM in two cases:
+	// 1. A new module is added. In this case we run InitGenesis with an
+	// empty genesis state.
+	// 2. An existing chain is upgrading from version < 0.43 to v0.43+ for the first time.
+	// In this case, all modules have yet to be added to x/upgrade's VersionMap store.
+	if exists {
+		return c.runModuleMigrations(ctx, moduleName, fromVersion, toVersion)
+	}
+
+	ctx.Logger().Info(fmt.Sprintf("adding a new module: %s", moduleName))
+	if module, ok := m.Modules[moduleName].(HasGenesis); ok {
+		if err := module.InitGenesis(ctx, module.DefaultGenesis()); err != nil {
+			return err
+		}
+	}
+	if module, ok := m.Modules[moduleName].(HasABCIGenesis); ok {
+		moduleValUpdates, err := module.InitGenesis(ctx, module.DefaultGenesis())
+		if err != nil {
+			return err
+		}
+
+		// The module manager assumes only one module will update the
+		// validator set, and it can't be a new module.
+		if len(moduleValUpdates) > 0 {
+			return errorsmod.Wrapf(sdkerrors.ErrLogic, "validator InitGenesis update is already set by another module")
+		}
+	}
+
+	return nil
+}
+
+// MigrationResult is the outcome of the migration of a module by RunMigrations.
+type MigrationResult struct {
+	Module      string
+	FromVersion uint64
+	ToVersion   uint64
+	// InitGenesis is true if the module did not exist before and was initialized with its default genesis.
+	InitGenesis bool
+	GasUsed     storetypes.Gas
+	Duration    time.Duration
+	Err         error
+}
+
+// MigrationListener is notified by RunMigrations of the outcome of the migration of each module.
+type MigrationListener func(MigrationResult)
+
+type migrationListenerKey struct{}
+
+// WithMigrationListener returns a context in which RunMigrations notifies the listener of the outcome of the
+// migration of each module. It is meant to report on upgrades, e.g. when rehearsing them, and the listener must
+// not alter the state.
+func WithMigrationListener(ctx sdk.Context, listener MigrationListener) sdk.Context {
+	return ctx.WithValue(migrationListenerKey{}, listener)
+}
+
 // PreBlock performs begin block functionality for upgrade module.
 // It takes the current context as a parameter and returns a boolean value
 // indicating whether the migration was successfully executed or not.
@@ -13,8 +13,10 @@ import (
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/log"
+	corestore "cosmossdk.io/core/store"
 	authtypes "cosmossdk.io/x/auth/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -519,6 +521,39 @@ func TestManager_Precommit(t *testing.T) {
 	require.EqualError(t, err, "some error")
 }
 
+// stateSchemaModule is a module registering its state schema.
+type stateSchemaModule struct {
+	schema collections.Schema
+}
+
+func (stateSchemaModule) Name() string        { return "schema" }
+func (stateSchemaModule) IsOnePerModuleType() {}
+func (stateSchemaModule) IsAppModule()        {}
+
+func (m stateSchemaModule) RegisterStateSchema(decoder collections.StateDecoder) {
+	decoder["schema_store"] = m.schema
+}
+
+func TestManager_StateDecoder(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	t.Cleanup(mockCtrl.Finish)
+
+	sb := collections.NewSchemaBuilderFromAccessor(func(context.Context) corestore.KVStore { return nil })
+	collections.NewItem(sb, collections.NewPrefix(1), "params", collections.StringValue)
+	schema, err := sb.Build()
+	require.NoError(t, err)
+
+	mockAppModule := mock.NewMockCoreAppModule(mockCtrl)
+	mm := module.NewManager(stateSchemaModule{schema: schema}, module.CoreAppModuleAdaptor("mockAppModule", mockAppModule))
+
+	decoder := mm.StateDecoder()
+	require.Len(t, decoder, 1)
+	kv, err := decoder.DecodeKV("schema_store", []byte{1}, []byte("value"))
+	require.NoError(t, err)
+	require.Equal(t, "params", kv.Collection)
+	require.Equal(t, "value", kv.Value)
+}
+
 // MockCoreAppModule allows us to test functions like DefaultGenesis
 type MockCoreAppModule struct{}
 
@@ -176,6 +176,7 @@ replace (
 	cosmossdk.io/core/testing => ../../../../core/testing
 	cosmossdk.io/depinject => ../../../../depinject
 	cosmossdk.io/log => ../../../../log
+	cosmossdk.io/store => ../../../../store
 	cosmossdk.io/x/accounts => ../../.
 	cosmossdk.io/x/auth => ../../../auth
 	cosmossdk.io/x/bank => ../../../bank
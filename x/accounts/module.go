@@ -7,6 +7,7 @@ import (
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/registry"
 	"cosmossdk.io/x/accounts/cli"
@@ -29,7 +30,8 @@ const (
 var ModuleAccountAddress = address.Module(ModuleName)
 
 var (
-	_ module.HasName = AppModule{}
+	_ module.HasName        = AppModule{}
+	_ module.HasStateSchema = AppModule{}
 
 	_ appmodule.AppModule           = AppModule{}
 	_ appmodule.HasServices         = AppModule{}
@@ -107,3 +109,8 @@ func (AppModule) GetQueryCmd() *cobra.Command {
 }
 
 func (AppModule) ConsensusVersion() uint64 { return ConsensusVersion }
+
+// RegisterStateSchema registers the collections schema of the state of the accounts module.
+func (m AppModule) RegisterStateSchema(decoder collections.StateDecoder) {
+	decoder[StoreKey] = m.k.Schema
+}
@@ -0,0 +1,110 @@
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+const (
+	flagMaxGas       = "max-gas"
+	flagMaxFees      = "max-fees"
+	flagMaxMsgs      = "max-msgs"
+	flagRetries      = "retries"
+	flagRetryDelay   = "retry-delay"
+	flagProgressFile = "progress-file"
+	flagResign       = "resign"
+)
+
+// GetSendBatchCommand returns the command sending the messages of a file in as many transactions
+// as needed to fit within a gas and fees budget.
+func GetSendBatchCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-batch [file]",
+		Short: "Send the messages of a JSON or CSV file in transactions within a gas budget",
+		Long: `Read messages from a JSON or CSV file, group them into transactions within the --max-gas, --max-fees
+and --max-msgs budgets, sign them with consecutive sequences and broadcast them in order.
+
+A JSON file holds an array of messages, or an object with a "messages" array, as the body of a transaction:
+
+[{"@type": "/cosmos.bank.v1beta1.MsgSend", "from_address": "cosmos1...", "to_address": "cosmos1...", "amount": [{"denom": "stake", "amount": "10"}]}]
+
+A CSV file has a header row with the "@type" column and the fields of the messages, and a message per row.
+Cells holding JSON arrays or objects, and true and false, are decoded as JSON:
+
+@type,from_address,to_address,amount
+/cosmos.bank.v1beta1.MsgSend,cosmos1...,cosmos1...,"[{""denom"": ""stake"", ""amount"": ""10""}]"
+
+The gas of each transaction is simulated with --gas=auto, otherwise --gas is the gas of each message and
+the gas of a transaction is the sum of the gas of its messages. The fees of a transaction are derived from
+--gas-prices, or are --fees.
+
+The signed transactions and their broadcast are logged in the --progress-file. If the command is interrupted,
+running it again with the same file and progress file resumes the batch: the broadcast transactions are skipped
+and the others are broadcast again with the same sequences, so that no message is sent twice.
+The transactions rejected by the node are signed again when resuming, with their sequences. When the sequence
+of the account moved past the sequence of a transaction, e.g. because another transaction was sent by the same
+key, the remaining transactions are signed again from the sequence of the account, once the node reports the
+transaction as not committed. If the node can't tell, e.g. as it doesn't index the transactions, the command
+stops: check the transactions of the account manually and run it again with --resign if they are not committed.
+`,
+		Example: fmt.Sprintf("%s tx send-batch payouts.csv --from mykey --gas auto --gas-prices 0.025stake --max-gas 5000000", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			msgs, err := tx.ReadBatchMsgs(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			opts := tx.BatchOptions{}
+			opts.MaxGas, _ = cmd.Flags().GetUint64(flagMaxGas)
+			opts.MaxMsgs, _ = cmd.Flags().GetInt(flagMaxMsgs)
+			opts.Retries, _ = cmd.Flags().GetInt(flagRetries)
+			opts.RetryDelay, _ = cmd.Flags().GetDuration(flagRetryDelay)
+			opts.ProgressFile, _ = cmd.Flags().GetString(flagProgressFile)
+			opts.Resign, _ = cmd.Flags().GetBool(flagResign)
+			if opts.ProgressFile == "" {
+				opts.ProgressFile = args[0] + ".progress"
+			}
+
+			maxFees, _ := cmd.Flags().GetString(flagMaxFees)
+			if maxFees != "" {
+				if opts.MaxFees, err = sdk.ParseCoinsNormalized(maxFees); err != nil {
+					return fmt.Errorf("invalid %s: %w", flagMaxFees, err)
+				}
+			}
+
+			return tx.BroadcastBatch(clientCtx, txf, msgs, opts)
+		},
+	}
+
+	cmd.Flags().Uint64(flagMaxGas, 10_000_000, "Gas budget of each transaction")
+	cmd.Flags().String(flagMaxFees, "", "Maximum fees of each transaction, e.g. 10000stake")
+	cmd.Flags().Int(flagMaxMsgs, 0, "Maximum number of messages of each transaction (no maximum when 0)")
+	cmd.Flags().Int(flagRetries, 3, "Number of times the broadcast of a transaction is retried when the node can't be reached or its mempool is full")
+	cmd.Flags().Duration(flagRetryDelay, time.Second, "Delay before retrying a broadcast, doubled at each retry")
+	cmd.Flags().String(flagProgressFile, "", "File logging the progress of the batch, to resume it (defaults to [file].progress)")
+	cmd.Flags().Bool(flagResign, false, "Sign the remaining transactions again from the account sequence without checking that the transactions it moved past are not committed")
+
+	flags.AddTxFlagsToCmd(cmd)
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
@@ -8,6 +8,7 @@ import (
 	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"google.golang.org/grpc"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/appmodule"
 	appmodulev2 "cosmossdk.io/core/appmodule/v2"
 	"cosmossdk.io/core/legacy"
@@ -34,6 +35,7 @@ const (
 var (
 	_ module.AppModuleSimulation = AppModule{}
 	_ module.HasName             = AppModule{}
+	_ module.HasStateSchema      = AppModule{}
 
 	_ appmodulev2.HasGenesis    = AppModule{}
 	_ appmodulev2.AppModule     = AppModule{}
@@ -199,6 +201,11 @@ func (am AppModule) RegisterStoreDecoder(sdr simtypes.StoreDecoderRegistry) {
 	sdr[types.StoreKey] = simtypes.NewStoreDecoderFuncFromCollectionsSchema(am.accountKeeper.Schema)
 }
 
+// RegisterStateSchema registers the collections schema of the state of the auth module.
+func (am AppModule) RegisterStateSchema(decoder collections.StateDecoder) {
+	decoder[types.StoreKey] = am.accountKeeper.Schema
+}
+
 // WeightedOperations doesn't return any auth module operation.
 func (AppModule) WeightedOperations(_ module.SimulationState) []simtypes.WeightedOperation {
 	return nil
@@ -9,6 +9,7 @@ import (
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/legacy"
 	"cosmossdk.io/core/registry"
@@ -29,6 +30,7 @@ const ConsensusVersion = 4
 
 var (
 	_ module.HasName             = AppModule{}
+	_ module.HasStateSchema      = AppModule{}
 	_ module.HasAminoCodec       = AppModule{}
 	_ module.HasGRPCGateway      = AppModule{}
 	_ module.AppModuleSimulation = AppModule{}
@@ -172,6 +174,11 @@ func (am AppModule) RegisterStoreDecoder(sdr simtypes.StoreDecoderRegistry) {
 	sdr[types.StoreKey] = simtypes.NewStoreDecoderFuncFromCollectionsSchema(am.keeper.(keeper.BaseKeeper).Schema)
 }
 
+// RegisterStateSchema registers the collections schema of the state of the bank module.
+func (am AppModule) RegisterStateSchema(decoder collections.StateDecoder) {
+	decoder[types.StoreKey] = am.keeper.(keeper.BaseKeeper).Schema
+}
+
 // WeightedOperations returns the all the gov module operations with their respective weights.
 func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
 	return simulation.WeightedOperations(
@@ -8,6 +8,7 @@ import (
 	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"google.golang.org/grpc"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/registry"
 	"cosmossdk.io/x/circuit/keeper"
@@ -23,6 +24,7 @@ const ConsensusVersion = 1
 
 var (
 	_ module.HasName        = AppModule{}
+	_ module.HasStateSchema = AppModule{}
 	_ module.HasGRPCGateway = AppModule{}
 
 	_ appmodule.AppModule             = AppModule{}
@@ -74,6 +76,11 @@ func NewAppModule(cdc codec.Codec, keeper keeper.Keeper) AppModule {
 // ConsensusVersion implements HasConsensusVersion
 func (AppModule) ConsensusVersion() uint64 { return ConsensusVersion }
 
+// RegisterStateSchema registers the collections schema of the state of the circuit module.
+func (am AppModule) RegisterStateSchema(decoder collections.StateDecoder) {
+	decoder[types.StoreKey] = am.keeper.Schema
+}
+
 // DefaultGenesis returns default genesis state as raw bytes for the circuit module.
 func (am AppModule) DefaultGenesis() json.RawMessage {
 	return am.cdc.MustMarshalJSON(types.DefaultGenesisState())
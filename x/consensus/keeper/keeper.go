@@ -24,6 +24,7 @@ type Keeper struct {
 	appmodule.Environment
 
 	authority   string
+	Schema      collections.Schema
 	ParamsStore collections.Item[cmtproto.ConsensusParams]
 }
 
@@ -31,11 +32,19 @@ var _ exported.ConsensusParamSetter = Keeper{}.ParamsStore
 
 func NewKeeper(cdc codec.BinaryCodec, env appmodule.Environment, authority string) Keeper {
 	sb := collections.NewSchemaBuilder(env.KVStoreService)
-	return Keeper{
+	k := Keeper{
 		Environment: env,
 		authority:   authority,
 		ParamsStore: collections.NewItem(sb, collections.NewPrefix("Consensus"), "params", codec.CollValue[cmtproto.ConsensusParams](cdc)),
 	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
 }
 
 func (k *Keeper) GetAuthority() string {
@@ -6,6 +6,7 @@ import (
 	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"google.golang.org/grpc"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/legacy"
 	"cosmossdk.io/core/registry"
@@ -22,6 +23,7 @@ const ConsensusVersion = 1
 
 var (
 	_ module.HasName        = AppModule{}
+	_ module.HasStateSchema = AppModule{}
 	_ module.HasAminoCodec  = AppModule{}
 	_ module.HasGRPCGateway = AppModule{}
 
@@ -76,6 +78,11 @@ func (am AppModule) RegisterServices(registrar grpc.ServiceRegistrar) error {
 // ConsensusVersion implements HasConsensusVersion.
 func (AppModule) ConsensusVersion() uint64 { return ConsensusVersion }
 
+// RegisterStateSchema registers the collections schema of the state of the consensus module.
+func (am AppModule) RegisterStateSchema(decoder collections.StateDecoder) {
+	decoder[types.StoreKey] = am.keeper.Schema
+}
+
 // RegisterConsensusMessages registers the consensus module's messages.
 func (am AppModule) RegisterConsensusMessages(builder any) {
 	// std.RegisterConsensusHandler(builder ,am.keeper.SetParams) // TODO uncomment when api is available
@@ -8,6 +8,7 @@ import (
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/legacy"
 	"cosmossdk.io/core/registry"
@@ -22,8 +23,9 @@ import (
 const ConsensusVersion = 2
 
 var (
-	_ module.HasName       = AppModule{}
-	_ module.HasAminoCodec = AppModule{}
+	_ module.HasName        = AppModule{}
+	_ module.HasStateSchema = AppModule{}
+	_ module.HasAminoCodec  = AppModule{}
 
 	_ appmodule.AppModule             = AppModule{}
 	_ appmodule.HasEndBlocker         = AppModule{}
@@ -141,6 +143,11 @@ func (am AppModule) ExportGenesis(ctx context.Context) (json.RawMessage, error)
 // ConsensusVersion implements HasConsensusVersion
 func (AppModule) ConsensusVersion() uint64 { return ConsensusVersion }
 
+// RegisterStateSchema registers the collections schema of the state of the crisis module.
+func (am AppModule) RegisterStateSchema(decoder collections.StateDecoder) {
+	decoder[types.StoreKey] = am.keeper.Schema
+}
+
 // EndBlock returns the end blocker for the crisis module. It returns no validator
 // updates.
 func (am AppModule) EndBlock(ctx context.Context) error {
@@ -28,6 +28,30 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:     "Query all (paginated) submitted evidence",
 					Example:   fmt.Sprintf("%s query evidence --page=2 --page-limit=50", version.AppName),
 				},
+				{
+					RpcMethod: "UntombstoneRecords",
+					Use:       "untombstone-records",
+					Short:     "Query all (paginated) untombstone decisions, optionally filtered by validator consensus address",
+					Example:   fmt.Sprintf("%s query evidence untombstone-records --consensus-address cosmosvalcons1...", version.AppName),
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service:              evidencev1beta1.Msg_ServiceDesc.ServiceName,
+			EnhanceCustomCommand: true,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "SubmitEvidence",
+					Skip:      true, // submitted through the evidence handlers' custom commands
+				},
+				{
+					RpcMethod:      "UntombstoneValidator",
+					Use:            "untombstone-validator-proposal [consensus_address] [reason]",
+					Short:          "Submit a proposal to lift the tombstone of a validator, optionally refunding it from the community pool",
+					Example:        fmt.Sprintf(`%s tx evidence untombstone-validator-proposal cosmosvalcons1... "double sign caused by a known bug" --refund 1000stake`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "consensus_address"}, {ProtoField: "reason"}},
+					GovProposal:    true,
+				},
 			},
 		},
 	}
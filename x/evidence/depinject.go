@@ -1,12 +1,18 @@
 package evidence
 
 import (
+	"fmt"
+	"slices"
+	"strings"
+
 	modulev1 "cosmossdk.io/api/cosmos/evidence/module/v1"
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/comet"
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/depinject/appconfig"
+	"cosmossdk.io/math"
+	authtypes "cosmossdk.io/x/auth/types"
 	eviclient "cosmossdk.io/x/evidence/client"
 	"cosmossdk.io/x/evidence/keeper"
 	"cosmossdk.io/x/evidence/types"
@@ -25,16 +31,42 @@ func init() {
 	)
 }
 
+// HandlerRoute registers an evidence route with the evidence module through
+// dependency injection. The handler is built from the evidence keeper once it
+// has been constructed.
+type HandlerRoute struct {
+	Route      string
+	NewHandler func(*keeper.Keeper) types.Handler
+}
+
+// IsManyPerContainerType implements the depinject.ManyPerContainerType interface.
+func (HandlerRoute) IsManyPerContainerType() {}
+
+// OracleMisbehaviourRoute returns the HandlerRoute processing OracleMisbehaviour
+// evidence. The verifier is supplied by the application and must reject any
+// evidence that cannot be proven.
+func OracleMisbehaviourRoute(verify types.OracleMisbehaviourVerifier) HandlerRoute {
+	return HandlerRoute{
+		Route: types.RouteOracleMisbehaviour,
+		NewHandler: func(k *keeper.Keeper) types.Handler {
+			return k.NewOracleMisbehaviourHandler(verify)
+		},
+	}
+}
+
 type ModuleInputs struct {
 	depinject.In
 
+	Config           *modulev1.Module
 	Environment      appmodule.Environment
 	Cdc              codec.Codec
 	EvidenceHandlers []eviclient.EvidenceHandler `optional:"true"`
+	HandlerRoutes    []HandlerRoute              `optional:"true"`
 	CometService     comet.Service
 
 	StakingKeeper  types.StakingKeeper
 	SlashingKeeper types.SlashingKeeper
+	PoolKeeper     types.PoolKeeper `optional:"true"`
 	AddressCodec   address.Codec
 }
 
@@ -46,7 +78,42 @@ type ModuleOutputs struct {
 }
 
 func ProvideModule(in ModuleInputs) ModuleOutputs {
-	k := keeper.NewKeeper(in.Cdc, in.Environment, in.StakingKeeper, in.SlashingKeeper, in.AddressCodec)
+	// default to governance authority if not provided
+	authority := authtypes.NewModuleAddress(types.GovModuleName)
+	if in.Config.Authority != "" {
+		authority = authtypes.NewModuleAddressOrBech32Address(in.Config.Authority)
+	}
+
+	authStr, err := in.AddressCodec.BytesToString(authority)
+	if err != nil {
+		panic(fmt.Errorf("unable to decode authority in evidence: %w", err))
+	}
+
+	k := keeper.NewKeeper(in.Cdc, in.Environment, in.StakingKeeper, in.SlashingKeeper, in.PoolKeeper, in.AddressCodec, authStr)
+
+	for route, fraction := range in.Config.SlashFractions {
+		dec, err := math.LegacyNewDecFromStr(fraction)
+		if err != nil {
+			panic(fmt.Errorf("invalid slash fraction for evidence route %s: %w", route, err))
+		}
+		k.SetSlashFraction(route, dec)
+	}
+
+	// The router can only be set once, leave it to the application when no
+	// route is provided through dependency injection.
+	if len(in.HandlerRoutes) > 0 {
+		// Default route order is a lexical sort by route.
+		slices.SortFunc(in.HandlerRoutes, func(x, y HandlerRoute) int {
+			return strings.Compare(x.Route, y.Route)
+		})
+
+		router := types.NewRouter()
+		for _, r := range in.HandlerRoutes {
+			router.AddRoute(r.Route, r.NewHandler(k))
+		}
+		k.SetRouter(router)
+	}
+
 	m := NewAppModule(in.Cdc, *k, in.CometService, in.EvidenceHandlers...)
 
 	return ModuleOutputs{EvidenceKeeper: *k, Module: m}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/x/evidence/exported"
 	"cosmossdk.io/x/evidence/keeper"
 	"cosmossdk.io/x/evidence/types"
@@ -32,6 +33,34 @@ func InitGenesis(ctx context.Context, k keeper.Keeper, gs *types.GenesisState) e
 			return err
 		}
 	}
+
+	var nextID uint64
+	for _, r := range gs.UntombstoneRecords {
+		consAddr, err := k.ConsensusAddressCodec().StringToBytes(r.ConsensusAddress)
+		if err != nil {
+			return fmt.Errorf("invalid untombstone record %d: %w", r.Id, err)
+		}
+		if err := k.UntombstoneRecords.Set(ctx, collections.Join(consAddr, r.Id), r); err != nil {
+			return err
+		}
+		if r.Id >= nextID {
+			nextID = r.Id + 1
+		}
+	}
+	if err := k.UntombstoneRecordSequence.Set(ctx, nextID); err != nil {
+		return err
+	}
+
+	for _, m := range gs.HandledOracleMisbehaviours {
+		consAddr, err := k.ConsensusAddressCodec().StringToBytes(m.ConsensusAddress)
+		if err != nil {
+			return fmt.Errorf("invalid handled oracle misbehaviour at height %d: %w", m.Height, err)
+		}
+		if err := k.OracleMisbehaviours.Set(ctx, collections.Join(consAddr, m.Height)); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -49,5 +78,28 @@ func ExportGenesis(ctx context.Context, k keeper.Keeper) (*types.GenesisState, e
 	if err != nil {
 		return nil, err
 	}
+
+	err = k.UntombstoneRecords.Walk(ctx, nil, func(_ collections.Pair[[]byte, uint64], value types.UntombstoneRecord) (stop bool, err error) {
+		gs.UntombstoneRecords = append(gs.UntombstoneRecords, value)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.OracleMisbehaviours.Walk(ctx, nil, func(key collections.Pair[[]byte, int64]) (stop bool, err error) {
+		consAddr, err := k.ConsensusAddressCodec().BytesToString(key.K1())
+		if err != nil {
+			return true, err
+		}
+		gs.HandledOracleMisbehaviours = append(gs.HandledOracleMisbehaviours, types.HandledOracleMisbehaviour{
+			ConsensusAddress: consAddr,
+			Height:           key.K2(),
+		})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
 	return gs, nil
 }
@@ -11,7 +11,9 @@ import (
 )
 
 // BeginBlocker iterates through and handles any newly discovered evidence of
-// misbehavior submitted by CometBFT. Currently, only equivocation is handled.
+// misbehavior submitted by CometBFT. Duplicate votes are handled as
+// equivocations, light client attacks are grouped by common height and all
+// their byzantine validators are handled alike.
 func (k Keeper) BeginBlocker(ctx context.Context, cometService comet.Service) error {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, telemetry.Now(), telemetry.MetricKeyBeginBlocker)
 
@@ -20,17 +22,26 @@ func (k Keeper) BeginBlocker(ctx context.Context, cometService comet.Service) er
 	evidences := bi.Evidence
 	for _, evidence := range evidences {
 		switch evidence.Type {
-		// It's still ongoing discussion how should we treat and slash attacks with
-		// premeditation. So for now we agree to treat them in the same way.
-		case comet.LightClientAttack, comet.DuplicateVote:
+		case comet.DuplicateVote:
 			evidence := types.FromABCIEvidence(evidence, k.stakingKeeper.ConsensusAddressCodec())
 			err := k.handleEquivocationEvidence(ctx, evidence)
 			if err != nil {
 				return err
 			}
+		case comet.LightClientAttack:
+			// handled below, once grouped by common height
 		default:
 			k.Logger.Error(fmt.Sprintf("ignored unknown evidence type: %x", evidence.Type))
 		}
 	}
+
+	// It's still ongoing discussion how should we treat and slash attacks with
+	// premeditation. So for now we agree to treat them in the same way as
+	// equivocations.
+	for _, attack := range types.LightClientAttacksFromABCIEvidence(evidences, k.stakingKeeper.ConsensusAddressCodec()) {
+		if err := k.handleLightClientAttackEvidence(ctx, attack); err != nil {
+			return err
+		}
+	}
 	return nil
 }
@@ -9,6 +9,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/x/evidence/exported"
 	"cosmossdk.io/x/evidence/types"
 
@@ -74,3 +75,28 @@ func (k Querier) AllEvidence(ctx context.Context, req *types.QueryAllEvidenceReq
 
 	return &types.QueryAllEvidenceResponse{Evidence: evidences, Pagination: pageRes}, nil
 }
+
+// UntombstoneRecords implements the Query/UntombstoneRecords gRPC method
+func (k Querier) UntombstoneRecords(ctx context.Context, req *types.QueryUntombstoneRecordsRequest) (*types.QueryUntombstoneRecordsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	var opts []func(*query.CollectionsPaginateOptions[collections.Pair[[]byte, uint64]])
+	if req.ConsensusAddress != "" {
+		consAddr, err := k.k.stakingKeeper.ConsensusAddressCodec().StringToBytes(req.ConsensusAddress)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid consensus address: %s", err)
+		}
+		opts = append(opts, query.WithCollectionPaginationPairPrefix[[]byte, uint64](consAddr))
+	}
+
+	records, pageRes, err := query.CollectionPaginate(ctx, k.k.UntombstoneRecords, req.Pagination, func(_ collections.Pair[[]byte, uint64], value types.UntombstoneRecord) (types.UntombstoneRecord, error) {
+		return value, nil
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryUntombstoneRecordsResponse{Records: records, Pagination: pageRes}, nil
+}
@@ -5,9 +5,11 @@ import (
 	"fmt"
 	"strings"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/x/evidence/exported"
 	"cosmossdk.io/x/evidence/types"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
@@ -156,3 +158,36 @@ func (suite *KeeperTestSuite) TestQueryAllEvidence() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestQueryUntombstoneRecords() {
+	suite.stakingKeeper.EXPECT().ConsensusAddressCodec().Return(suite.consAddressCodec).AnyTimes()
+
+	consAddrs := make([]string, 2)
+	for i := range consAddrs {
+		pk := ed25519.GenPrivKey()
+		consAddr, err := suite.consAddressCodec.BytesToString(pk.PubKey().Address())
+		suite.Require().NoError(err)
+		consAddrs[i] = consAddr
+
+		for j := 0; j < 3; j++ {
+			id, err := suite.evidenceKeeper.UntombstoneRecordSequence.Next(suite.ctx)
+			suite.Require().NoError(err)
+			record := types.UntombstoneRecord{Id: id, ConsensusAddress: consAddr, Authority: suite.authority}
+			suite.Require().NoError(suite.evidenceKeeper.UntombstoneRecords.Set(suite.ctx, collections.Join(pk.PubKey().Address().Bytes(), id), record))
+		}
+	}
+
+	res, err := suite.queryClient.UntombstoneRecords(suite.ctx, &types.QueryUntombstoneRecordsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Records, 6)
+
+	res, err = suite.queryClient.UntombstoneRecords(suite.ctx, &types.QueryUntombstoneRecordsRequest{ConsensusAddress: consAddrs[1]})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Records, 3)
+	for _, r := range res.Records {
+		suite.Require().Equal(consAddrs[1], r.ConsensusAddress)
+	}
+
+	_, err = suite.queryClient.UntombstoneRecords(suite.ctx, &types.QueryUntombstoneRecordsRequest{ConsensusAddress: "invalid"})
+	suite.Require().ErrorContains(err, "invalid consensus address")
+}
@@ -3,9 +3,13 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"time"
 
 	st "cosmossdk.io/api/cosmos/staking/v1beta1"
+	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
 	consensusv1 "cosmossdk.io/x/consensus/types"
+	"cosmossdk.io/x/evidence/exported"
 	"cosmossdk.io/x/evidence/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -28,14 +32,58 @@ import (
 func (k Keeper) handleEquivocationEvidence(ctx context.Context, evidence *types.Equivocation) error {
 	consAddr := evidence.GetConsensusAddress(k.stakingKeeper.ConsensusAddressCodec())
 
+	slashed, err := k.slashByzantineValidator(
+		ctx, evidence.Route(), consAddr, evidence.GetHeight(), evidence.GetTime(), evidence.GetValidatorPower(),
+	)
+	if err != nil || !slashed {
+		return err
+	}
+
+	return k.Evidences.Set(ctx, evidence.Hash(), evidence)
+}
+
+// handleLightClientAttackEvidence implements a light client attack evidence
+// handler. Every byzantine validator listed in the evidence is handled like an
+// equivocating validator: slashed, jailed and tombstoned. The evidence is
+// persisted if at least one of them was slashed.
+func (k Keeper) handleLightClientAttackEvidence(ctx context.Context, evidence *types.LightClientAttack) error {
+	var slashedAny bool
+	for _, v := range evidence.ByzantineValidators {
+		consAddr, err := k.stakingKeeper.ConsensusAddressCodec().StringToBytes(v.ConsensusAddress)
+		if err != nil {
+			return err
+		}
+
+		slashed, err := k.slashByzantineValidator(
+			ctx, evidence.Route(), consAddr, evidence.GetHeight(), evidence.GetTime(), v.Power,
+		)
+		if err != nil {
+			return err
+		}
+		slashedAny = slashedAny || slashed
+	}
+
+	if !slashedAny {
+		return nil
+	}
+
+	return k.Evidences.Set(ctx, evidence.Hash(), evidence)
+}
+
+// slashByzantineValidator slashes, jails and tombstones a validator that
+// signed conflicting blocks at the infraction height. It returns false without
+// error when the infraction is ignored, see handleEquivocationEvidence.
+func (k Keeper) slashByzantineValidator(
+	ctx context.Context, route string, consAddr sdk.ConsAddress, infractionHeight int64, infractionTime time.Time, power int64,
+) (bool, error) {
 	validator, err := k.stakingKeeper.ValidatorByConsAddr(ctx, consAddr)
 	if err != nil {
-		return err
+		return false, err
 	}
 	if validator == nil || validator.IsUnbonded() {
 		// Defensive: Simulation doesn't take unbonding periods into account, and
 		// CometBFT might break this assumption at some point.
-		return nil
+		return false, nil
 	}
 
 	if len(validator.GetOperator()) != 0 {
@@ -44,7 +92,7 @@ func (k Keeper) handleEquivocationEvidence(ctx context.Context, evidence *types.
 		// (ValidatorByConsAddr can get a validator even if the key has been rotated)
 		valConsAddr, err := validator.GetConsAddr()
 		if err != nil {
-			return err
+			return false, err
 		}
 		consAddr = valConsAddr
 
@@ -59,36 +107,16 @@ func (k Keeper) handleEquivocationEvidence(ctx context.Context, evidence *types.
 			// getting this coordination right, it is easier to relax the
 			// constraints and ignore evidence that cannot be handled.
 			k.Logger.Error(fmt.Sprintf("ignore evidence; expected public key for validator %s not found", consAddr))
-			return nil
+			return false, nil
 		}
 	}
 
-	headerInfo := k.HeaderService.HeaderInfo(ctx)
-	// calculate the age of the evidence
-	infractionHeight := evidence.GetHeight()
-	infractionTime := evidence.GetTime()
-	ageDuration := headerInfo.Time.Sub(infractionTime)
-	ageBlocks := headerInfo.Height - infractionHeight
-
 	// Reject evidence if the double-sign is too old. Evidence is considered stale
 	// if the difference in time and number of blocks is greater than the allowed
 	// parameters defined.
-	var res consensusv1.QueryParamsResponse
-	if err := k.QueryRouterService.InvokeTyped(ctx, &consensusv1.QueryParamsRequest{}, &res); err != nil {
-		return fmt.Errorf("failed to query consensus params: %w", err)
-	}
-	if res.Params.Evidence != nil {
-		if ageDuration > res.Params.Evidence.MaxAgeDuration && ageBlocks > res.Params.Evidence.MaxAgeNumBlocks {
-			k.Logger.Info(
-				"ignored equivocation; evidence too old",
-				"validator", consAddr,
-				"infraction_height", infractionHeight,
-				"max_age_num_blocks", res.Params.Evidence.MaxAgeNumBlocks,
-				"infraction_time", infractionTime,
-				"max_age_duration", res.Params.Evidence.MaxAgeDuration,
-			)
-			return nil
-		}
+	stale, err := k.isEvidenceStale(ctx, consAddr, infractionHeight, infractionTime)
+	if err != nil || stale {
+		return false, err
 	}
 
 	if ok := k.slashingKeeper.HasValidatorSigningInfo(ctx, consAddr); !ok {
@@ -103,12 +131,13 @@ func (k Keeper) handleEquivocationEvidence(ctx context.Context, evidence *types.
 			"infraction_height", infractionHeight,
 			"infraction_time", infractionTime,
 		)
-		return nil
+		return false, nil
 	}
 
 	k.Logger.Info(
 		"confirmed equivocation",
 		"validator", consAddr,
+		"route", route,
 		"infraction_height", infractionHeight,
 		"infraction_time", infractionTime,
 	)
@@ -125,20 +154,20 @@ func (k Keeper) handleEquivocationEvidence(ctx context.Context, evidence *types.
 	// to/by CometBFT. This value is validator.Tokens as sent to CometBFT via
 	// ABCI, and now received as evidence. The fraction is passed in to separately
 	// to slash unbonding and rebonding delegations.
-	slashFractionDoubleSign, err := k.slashingKeeper.SlashFractionDoubleSign(ctx)
+	slashFraction, err := k.SlashFraction(ctx, route)
 	if err != nil {
-		return err
+		return false, err
 	}
 
 	err = k.slashingKeeper.SlashWithInfractionReason(
 		ctx,
 		consAddr,
-		slashFractionDoubleSign,
-		evidence.GetValidatorPower(), distributionHeight,
+		slashFraction,
+		power, distributionHeight,
 		st.Infraction_INFRACTION_DOUBLE_SIGN,
 	)
 	if err != nil {
-		return err
+		return false, err
 	}
 
 	// Jail the validator if not already jailed. This will begin unbonding the
@@ -146,18 +175,148 @@ func (k Keeper) handleEquivocationEvidence(ctx context.Context, evidence *types.
 	if !validator.IsJailed() {
 		err = k.slashingKeeper.Jail(ctx, consAddr)
 		if err != nil {
-			return err
+			return false, err
 		}
 	}
 
 	err = k.slashingKeeper.JailUntil(ctx, consAddr, types.DoubleSignJailEndTime)
+	if err != nil {
+		return false, err
+	}
+
+	if err := k.slashingKeeper.Tombstone(ctx, consAddr); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// NewOracleMisbehaviourHandler returns an evidence Handler for OracleMisbehaviour
+// evidence. The evidence is first checked by the application provided verifier,
+// the misbehaving validator is then slashed by the slash fraction of the
+// oracle misbehaviour route and jailed, based on its power at the height of the
+// misbehaviour returned by the verifier. Contrary to equivocations, the
+// validator is not tombstoned, the misbehaviour of a validator at a given height
+// is handled only once.
+func (k Keeper) NewOracleMisbehaviourHandler(verify types.OracleMisbehaviourVerifier) types.Handler {
+	return func(ctx context.Context, e exported.Evidence) error {
+		evidence, ok := e.(*types.OracleMisbehaviour)
+		if !ok {
+			return fmt.Errorf("unexpected evidence type: %T", e)
+		}
+
+		power, err := verify(ctx, evidence)
+		if err != nil {
+			return err
+		}
+		if power < 1 {
+			return fmt.Errorf("invalid power %d of validator %s at height %d", power, evidence.ConsensusAddress, evidence.GetHeight())
+		}
+
+		return k.handleOracleMisbehaviourEvidence(ctx, evidence, power)
+	}
+}
+
+func (k Keeper) handleOracleMisbehaviourEvidence(ctx context.Context, evidence *types.OracleMisbehaviour, power int64) error {
+	consAddr := evidence.GetConsensusAddress(k.stakingKeeper.ConsensusAddressCodec())
+
+	validator, err := k.stakingKeeper.ValidatorByConsAddr(ctx, consAddr)
 	if err != nil {
 		return err
 	}
+	if validator == nil || validator.IsUnbonded() {
+		return fmt.Errorf("validator %s is unbonded or does not exist", evidence.ConsensusAddress)
+	}
 
-	err = k.slashingKeeper.Tombstone(ctx, consAddr)
+	valConsAddr, err := validator.GetConsAddr()
 	if err != nil {
 		return err
 	}
-	return k.Evidences.Set(ctx, evidence.Hash(), evidence)
+	consAddr = valConsAddr
+
+	stale, err := k.isEvidenceStale(ctx, consAddr, evidence.GetHeight(), evidence.GetTime())
+	if err != nil {
+		return err
+	}
+	if stale {
+		return fmt.Errorf("oracle misbehaviour at height %d is too old", evidence.GetHeight())
+	}
+
+	if k.slashingKeeper.IsTombstoned(ctx, consAddr) {
+		return fmt.Errorf("validator %s is tombstoned", evidence.ConsensusAddress)
+	}
+
+	// the same misbehaviour may be submitted again with another power, oracle or
+	// proof, changing the hash of the evidence, it is only handled once per
+	// validator and height.
+	key := collections.Join(consAddr.Bytes(), evidence.GetHeight())
+	handled, err := k.OracleMisbehaviours.Has(ctx, key)
+	if err != nil {
+		return err
+	}
+	if handled {
+		return errors.Wrapf(types.ErrEvidenceExists, "oracle misbehaviour of validator %s at height %d", evidence.ConsensusAddress, evidence.GetHeight())
+	}
+
+	slashFraction, err := k.SlashFraction(ctx, evidence.Route())
+	if err != nil {
+		return err
+	}
+
+	// Like equivocations, the validator is slashed based on its power at the
+	// infraction height, proven by the verifier, and not on the power of the
+	// evidence which is provided by the submitter. The staking infractions only
+	// distinguish double signing, which tombstones, and downtime, which relies on
+	// the signing info of the validator, neither of them applies to an oracle
+	// misbehaviour, so the infraction reason is left unspecified.
+	err = k.slashingKeeper.SlashWithInfractionReason(
+		ctx,
+		consAddr,
+		slashFraction,
+		power, evidence.GetHeight()-sdk.ValidatorUpdateDelay,
+		st.Infraction_INFRACTION_UNSPECIFIED,
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := k.OracleMisbehaviours.Set(ctx, key); err != nil {
+		return err
+	}
+
+	if !validator.IsJailed() {
+		return k.slashingKeeper.Jail(ctx, consAddr)
+	}
+
+	return nil
+}
+
+// isEvidenceStale returns true if the difference in time and number of blocks
+// between the infraction and the current block is greater than the evidence
+// parameters of the consensus params.
+func (k Keeper) isEvidenceStale(ctx context.Context, consAddr sdk.ConsAddress, infractionHeight int64, infractionTime time.Time) (bool, error) {
+	headerInfo := k.HeaderService.HeaderInfo(ctx)
+	// calculate the age of the evidence
+	ageDuration := headerInfo.Time.Sub(infractionTime)
+	ageBlocks := headerInfo.Height - infractionHeight
+
+	var res consensusv1.QueryParamsResponse
+	if err := k.QueryRouterService.InvokeTyped(ctx, &consensusv1.QueryParamsRequest{}, &res); err != nil {
+		return false, fmt.Errorf("failed to query consensus params: %w", err)
+	}
+	if res.Params.Evidence != nil {
+		if ageDuration > res.Params.Evidence.MaxAgeDuration && ageBlocks > res.Params.Evidence.MaxAgeNumBlocks {
+			k.Logger.Info(
+				"ignored evidence; evidence too old",
+				"validator", consAddr,
+				"infraction_height", infractionHeight,
+				"max_age_num_blocks", res.Params.Evidence.MaxAgeNumBlocks,
+				"infraction_time", infractionTime,
+				"max_age_duration", res.Params.Evidence.MaxAgeDuration,
+			)
+			return true, nil
+		}
+	}
+
+	return false, nil
 }
@@ -11,6 +11,7 @@ import (
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/event"
 	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	"cosmossdk.io/x/evidence/exported"
 	"cosmossdk.io/x/evidence/types"
 
@@ -27,26 +28,52 @@ type Keeper struct {
 	router         types.Router
 	stakingKeeper  types.StakingKeeper
 	slashingKeeper types.SlashingKeeper
+	poolKeeper     types.PoolKeeper
 	addressCodec   address.Codec
 
+	// the address capable of executing a MsgUntombstoneValidator message. Typically, this
+	// should be the x/gov module account.
+	authority string
+
+	// slashFractions overrides the x/slashing double sign slash fraction per
+	// evidence route.
+	slashFractions map[string]math.LegacyDec
+
 	Schema collections.Schema
 	// Evidences key: evidence hash bytes | value: Evidence
 	Evidences collections.Map[[]byte, exported.Evidence]
+	// UntombstoneRecords key: consensus address bytes + record id | value: UntombstoneRecord
+	UntombstoneRecords collections.Map[collections.Pair[[]byte, uint64], types.UntombstoneRecord]
+	// UntombstoneRecordSequence provides the next untombstone record id
+	UntombstoneRecordSequence collections.Sequence
+	// OracleMisbehaviours key: consensus address bytes + infraction height | value: none
+	OracleMisbehaviours collections.KeySet[collections.Pair[[]byte, int64]]
 }
 
-// NewKeeper creates a new Keeper object.
+// NewKeeper creates a new Keeper object. The pool keeper is optional, if nil
+// untombstone decisions cannot refund validators from the community pool.
 func NewKeeper(
 	cdc codec.BinaryCodec, env appmodule.Environment, stakingKeeper types.StakingKeeper,
-	slashingKeeper types.SlashingKeeper, ac address.Codec,
+	slashingKeeper types.SlashingKeeper, poolKeeper types.PoolKeeper, ac address.Codec, authority string,
 ) *Keeper {
+	if _, err := ac.StringToBytes(authority); err != nil {
+		panic(fmt.Errorf("invalid authority address: %w", err))
+	}
+
 	sb := collections.NewSchemaBuilder(env.KVStoreService)
 	k := &Keeper{
-		Environment:    env,
-		cdc:            cdc,
-		stakingKeeper:  stakingKeeper,
-		slashingKeeper: slashingKeeper,
-		addressCodec:   ac,
-		Evidences:      collections.NewMap(sb, types.KeyPrefixEvidence, "evidences", collections.BytesKey, codec.CollInterfaceValue[exported.Evidence](cdc)),
+		Environment:               env,
+		cdc:                       cdc,
+		stakingKeeper:             stakingKeeper,
+		slashingKeeper:            slashingKeeper,
+		poolKeeper:                poolKeeper,
+		addressCodec:              ac,
+		authority:                 authority,
+		slashFractions:            make(map[string]math.LegacyDec),
+		Evidences:                 collections.NewMap(sb, types.KeyPrefixEvidence, "evidences", collections.BytesKey, codec.CollInterfaceValue[exported.Evidence](cdc)),
+		UntombstoneRecords:        collections.NewMap(sb, types.KeyPrefixUntombstoneRecord, "untombstone_records", collections.PairKeyCodec(collections.BytesKey, collections.Uint64Key), codec.CollValue[types.UntombstoneRecord](cdc)),
+		UntombstoneRecordSequence: collections.NewSequence(sb, types.KeyUntombstoneRecordSequence, "untombstone_record_sequence"),
+		OracleMisbehaviours:       collections.NewKeySet(sb, types.KeyPrefixOracleMisbehaviour, "oracle_misbehaviours", collections.PairKeyCodec(collections.BytesKey, collections.Int64Key)),
 	}
 	schema, err := sb.Build()
 	if err != nil {
@@ -56,6 +83,17 @@ func NewKeeper(
 	return k
 }
 
+// GetAuthority returns the x/evidence module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// ConsensusAddressCodec returns the consensus address codec used by the
+// staking module.
+func (k Keeper) ConsensusAddressCodec() address.Codec {
+	return k.stakingKeeper.ConsensusAddressCodec()
+}
+
 // SetRouter sets the Evidence Handler router for the x/evidence module. Note,
 // we allow the ability to set the router after the Keeper is constructed as a
 // given Handler may need access the Keeper before being constructed. The router
@@ -74,6 +112,28 @@ func (k *Keeper) SetRouter(rtr types.Router) {
 	k.router = rtr
 }
 
+// SetSlashFraction sets the fraction of stake slashed for evidence handled on
+// the given route, overriding the x/slashing double sign slash fraction. It is
+// expected to be called when wiring the application and panics if the fraction
+// is not within [0, 1].
+func (k Keeper) SetSlashFraction(route string, fraction math.LegacyDec) {
+	if fraction.IsNil() || fraction.IsNegative() || fraction.GT(math.LegacyOneDec()) {
+		panic(fmt.Sprintf("invalid slash fraction %s for evidence route %s", fraction, route))
+	}
+
+	k.slashFractions[route] = fraction
+}
+
+// SlashFraction returns the fraction of stake slashed for evidence handled on
+// the given route. It defaults to the x/slashing double sign slash fraction.
+func (k Keeper) SlashFraction(ctx context.Context, route string) (math.LegacyDec, error) {
+	if fraction, ok := k.slashFractions[route]; ok {
+		return fraction, nil
+	}
+
+	return k.slashingKeeper.SlashFractionDoubleSign(ctx)
+}
+
 // GetEvidenceHandler returns a registered Handler for a given Evidence type. If
 // no handler exists, an error is returned.
 func (k Keeper) GetEvidenceHandler(evidenceRoute string) (types.Handler, error) {
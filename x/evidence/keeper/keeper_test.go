@@ -13,7 +13,9 @@ import (
 	coreaddress "cosmossdk.io/core/address"
 	"cosmossdk.io/core/header"
 	"cosmossdk.io/core/log"
+	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
+	authtypes "cosmossdk.io/x/auth/types"
 	"cosmossdk.io/x/evidence"
 	"cosmossdk.io/x/evidence/exported"
 	"cosmossdk.io/x/evidence/keeper"
@@ -82,6 +84,8 @@ type KeeperTestSuite struct {
 	accountKeeper  *evidencetestutil.MockAccountKeeper
 	slashingKeeper *evidencetestutil.MockSlashingKeeper
 	stakingKeeper  *evidencetestutil.MockStakingKeeper
+	poolKeeper     *evidencetestutil.MockPoolKeeper
+	authority      string
 	queryClient    types.QueryClient
 	encCfg         moduletestutil.TestEncodingConfig
 	msgServer      types.MsgServer
@@ -102,17 +106,25 @@ func (suite *KeeperTestSuite) SetupTest() {
 	stakingKeeper := evidencetestutil.NewMockStakingKeeper(ctrl)
 	slashingKeeper := evidencetestutil.NewMockSlashingKeeper(ctrl)
 	accountKeeper := evidencetestutil.NewMockAccountKeeper(ctrl)
+	poolKeeper := evidencetestutil.NewMockPoolKeeper(ctrl)
+
+	authority, err := suite.addressCodec.BytesToString(authtypes.NewModuleAddress(types.GovModuleName))
+	suite.Require().NoError(err)
 
 	evidenceKeeper := keeper.NewKeeper(
 		encCfg.Codec,
 		env,
 		stakingKeeper,
 		slashingKeeper,
+		poolKeeper,
 		address.NewBech32Codec("cosmos"),
+		authority,
 	)
 
 	suite.stakingKeeper = stakingKeeper
 	suite.slashingKeeper = slashingKeeper
+	suite.poolKeeper = poolKeeper
+	suite.authority = authority
 
 	router := types.NewRouter()
 	router = router.AddRoute(types.RouteEquivocation, testEquivocationHandler(evidenceKeeper))
@@ -239,3 +251,30 @@ func (suite *KeeperTestSuite) TestGetEvidenceHandler() {
 	suite.Error(err)
 	suite.Nil(handler)
 }
+
+func (suite *KeeperTestSuite) TestSlashFraction() {
+	doubleSign := math.LegacyNewDecWithPrec(5, 2)
+	suite.slashingKeeper.EXPECT().SlashFractionDoubleSign(gomock.Any()).Return(doubleSign, nil).AnyTimes()
+
+	fraction, err := suite.evidenceKeeper.SlashFraction(suite.ctx, types.RouteLightClientAttack)
+	suite.Require().NoError(err)
+	suite.Require().Equal(doubleSign, fraction)
+
+	custom := math.LegacyNewDecWithPrec(1, 1)
+	suite.evidenceKeeper.SetSlashFraction(types.RouteLightClientAttack, custom)
+
+	fraction, err = suite.evidenceKeeper.SlashFraction(suite.ctx, types.RouteLightClientAttack)
+	suite.Require().NoError(err)
+	suite.Require().Equal(custom, fraction)
+
+	fraction, err = suite.evidenceKeeper.SlashFraction(suite.ctx, types.RouteEquivocation)
+	suite.Require().NoError(err)
+	suite.Require().Equal(doubleSign, fraction)
+
+	suite.Require().Panics(func() {
+		suite.evidenceKeeper.SetSlashFraction(types.RouteOracleMisbehaviour, math.LegacyNewDec(2))
+	})
+	suite.Require().Panics(func() {
+		suite.evidenceKeeper.SetSlashFraction(types.RouteOracleMisbehaviour, math.LegacyNewDec(-1))
+	})
+}
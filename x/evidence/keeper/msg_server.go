@@ -6,6 +6,7 @@ import (
 	"cosmossdk.io/errors"
 	"cosmossdk.io/x/evidence/types"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
@@ -44,3 +45,30 @@ func (ms msgServer) SubmitEvidence(ctx context.Context, msg *types.MsgSubmitEvid
 		Hash: evidence.Hash(),
 	}, nil
 }
+
+// UntombstoneValidator implements the MsgServer.UntombstoneValidator method.
+func (ms msgServer) UntombstoneValidator(ctx context.Context, msg *types.MsgUntombstoneValidator) (*types.MsgUntombstoneValidatorResponse, error) {
+	if _, err := ms.addressCodec.StringToBytes(msg.Authority); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	if ms.authority != msg.Authority {
+		return nil, errors.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, msg.Authority)
+	}
+
+	consAddr, err := ms.stakingKeeper.ConsensusAddressCodec().StringToBytes(msg.ConsensusAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid consensus address: %s", err)
+	}
+
+	if err := msg.Refund.Validate(); err != nil {
+		return nil, sdkerrors.ErrInvalidCoins.Wrap(err.Error())
+	}
+
+	id, err := ms.Keeper.UntombstoneValidator(ctx, msg.Authority, sdk.ConsAddress(consAddr), msg.Refund, msg.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUntombstoneValidatorResponse{Id: id}, nil
+}
@@ -3,9 +3,16 @@ package keeper_test
 import (
 	"time"
 
+	"github.com/golang/mock/gomock"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/header"
 	"cosmossdk.io/x/evidence/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
 
+	"github.com/cosmos/cosmos-sdk/codec/address"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func (s *KeeperTestSuite) TestSubmitEvidence() {
@@ -82,3 +89,123 @@ func (s *KeeperTestSuite) TestSubmitEvidence() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestUntombstoneValidator() {
+	ctx := s.ctx.WithHeaderInfo(header.Info{Height: 10, Time: time.Now().UTC()})
+	s.stakingKeeper.EXPECT().ConsensusAddressCodec().Return(s.consAddressCodec).AnyTimes()
+
+	pk := ed25519.GenPrivKey()
+	consAddr := sdk.ConsAddress(pk.PubKey().Address())
+	consAddrStr, err := s.consAddressCodec.BytesToString(consAddr)
+	s.Require().NoError(err)
+
+	otherAddr, err := s.addressCodec.BytesToString(valAddress)
+	s.Require().NoError(err)
+
+	valAddrCodec := address.NewBech32Codec("cosmosvaloper")
+	operator, err := valAddrCodec.BytesToString(valAddress)
+	s.Require().NoError(err)
+
+	refund := sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))
+
+	testCases := []struct {
+		name      string
+		req       *types.MsgUntombstoneValidator
+		malleate  func()
+		expErrMsg string
+		expID     uint64
+	}{
+		{
+			name: "invalid authority",
+			req: &types.MsgUntombstoneValidator{
+				Authority:        "invalid",
+				ConsensusAddress: consAddrStr,
+			},
+			expErrMsg: "invalid authority address",
+		},
+		{
+			name: "unexpected authority",
+			req: &types.MsgUntombstoneValidator{
+				Authority:        otherAddr,
+				ConsensusAddress: consAddrStr,
+			},
+			expErrMsg: "invalid authority",
+		},
+		{
+			name: "invalid consensus address",
+			req: &types.MsgUntombstoneValidator{
+				Authority:        s.authority,
+				ConsensusAddress: "invalid",
+			},
+			expErrMsg: "invalid consensus address",
+		},
+		{
+			name: "validator not tombstoned",
+			req: &types.MsgUntombstoneValidator{
+				Authority:        s.authority,
+				ConsensusAddress: consAddrStr,
+			},
+			malleate: func() {
+				s.slashingKeeper.EXPECT().IsTombstoned(gomock.Any(), consAddr).Return(false)
+			},
+			expErrMsg: "validator is not tombstoned",
+		},
+		{
+			name: "untombstone without refund",
+			req: &types.MsgUntombstoneValidator{
+				Authority:        s.authority,
+				ConsensusAddress: consAddrStr,
+				Reason:           "operator bug",
+			},
+			malleate: func() {
+				s.slashingKeeper.EXPECT().IsTombstoned(gomock.Any(), consAddr).Return(true)
+				s.slashingKeeper.EXPECT().Untombstone(gomock.Any(), consAddr).Return(nil)
+			},
+			expID: 0,
+		},
+		{
+			name: "untombstone with refund",
+			req: &types.MsgUntombstoneValidator{
+				Authority:        s.authority,
+				ConsensusAddress: consAddrStr,
+				Refund:           refund,
+				Reason:           "operator bug",
+			},
+			malleate: func() {
+				val := stakingtypes.Validator{OperatorAddress: operator}
+				s.slashingKeeper.EXPECT().IsTombstoned(gomock.Any(), consAddr).Return(true)
+				s.slashingKeeper.EXPECT().Untombstone(gomock.Any(), consAddr).Return(nil)
+				s.stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), consAddr).Return(val, nil)
+				s.stakingKeeper.EXPECT().ValidatorAddressCodec().Return(valAddrCodec)
+				s.poolKeeper.EXPECT().DistributeFromCommunityPool(gomock.Any(), refund, sdk.AccAddress(valAddress)).Return(nil)
+			},
+			expID: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			if tc.malleate != nil {
+				tc.malleate()
+			}
+
+			res, err := s.msgServer.UntombstoneValidator(ctx, tc.req)
+			if tc.expErrMsg != "" {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expErrMsg)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expID, res.Id)
+
+			record, err := s.evidenceKeeper.UntombstoneRecords.Get(ctx, collections.Join(consAddr.Bytes(), res.Id))
+			s.Require().NoError(err)
+			s.Require().Equal(consAddrStr, record.ConsensusAddress)
+			s.Require().Equal(s.authority, record.Authority)
+			s.Require().Equal(tc.req.Refund, record.Refund)
+			s.Require().Equal(int64(10), record.Height)
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/x/evidence/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// UntombstoneValidator lifts the tombstone of a validator that was tombstoned
+// for an equivocation, optionally refunds the validator operator from the
+// community pool and records the decision. The validator remains jailed until
+// its operator unjails it. It returns the identifier of the recorded decision.
+func (k Keeper) UntombstoneValidator(ctx context.Context, authority string, consAddr sdk.ConsAddress, refund sdk.Coins, reason string) (uint64, error) {
+	consAddrStr, err := k.stakingKeeper.ConsensusAddressCodec().BytesToString(consAddr)
+	if err != nil {
+		return 0, err
+	}
+
+	if !k.slashingKeeper.IsTombstoned(ctx, consAddr) {
+		return 0, types.ErrNotTombstoned.Wrapf("validator %s", consAddrStr)
+	}
+
+	if err := k.slashingKeeper.Untombstone(ctx, consAddr); err != nil {
+		return 0, err
+	}
+
+	if !refund.IsZero() {
+		if k.poolKeeper == nil {
+			return 0, types.ErrRefundUnavailable
+		}
+
+		validator, err := k.stakingKeeper.ValidatorByConsAddr(ctx, consAddr)
+		if err != nil {
+			return 0, err
+		}
+
+		operator, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(validator.GetOperator())
+		if err != nil {
+			return 0, err
+		}
+
+		if err := k.poolKeeper.DistributeFromCommunityPool(ctx, refund, operator); err != nil {
+			return 0, err
+		}
+	}
+
+	id, err := k.UntombstoneRecordSequence.Next(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	headerInfo := k.HeaderService.HeaderInfo(ctx)
+	record := types.UntombstoneRecord{
+		Id:               id,
+		ConsensusAddress: consAddrStr,
+		Authority:        authority,
+		Refund:           refund,
+		Reason:           reason,
+		Height:           headerInfo.Height,
+		Time:             headerInfo.Time,
+	}
+	if err := k.UntombstoneRecords.Set(ctx, collections.Join(consAddr.Bytes(), id), record); err != nil {
+		return 0, err
+	}
+
+	k.Logger.Info(
+		"validator untombstoned",
+		"validator", consAddrStr,
+		"id", id,
+		"refund", refund,
+	)
+
+	if err := k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeUntombstoneValidator,
+		event.NewAttribute(types.AttributeKeyConsensusAddress, consAddrStr),
+		event.NewAttribute(types.AttributeKeyUntombstoneID, fmt.Sprintf("%d", id)),
+		event.NewAttribute(types.AttributeKeyRefund, refund.String()),
+	); err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
@@ -15,16 +15,24 @@ import (
 func RegisterLegacyAminoCodec(cdc corelegacy.Amino) {
 	cdc.RegisterInterface((*exported.Evidence)(nil), nil)
 	legacy.RegisterAminoMsg(cdc, &MsgSubmitEvidence{}, "cosmos-sdk/MsgSubmitEvidence")
+	legacy.RegisterAminoMsg(cdc, &MsgUntombstoneValidator{}, "cosmos-sdk/MsgUntombstoneValidator")
 	cdc.RegisterConcrete(&Equivocation{}, "cosmos-sdk/Equivocation")
+	cdc.RegisterConcrete(&LightClientAttack{}, "cosmos-sdk/LightClientAttack")
+	cdc.RegisterConcrete(&OracleMisbehaviour{}, "cosmos-sdk/OracleMisbehaviour")
 }
 
 // RegisterInterfaces registers the interfaces types with the interface registry.
 func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
-	registrar.RegisterImplementations((*coretransaction.Msg)(nil), &MsgSubmitEvidence{})
+	registrar.RegisterImplementations((*coretransaction.Msg)(nil),
+		&MsgSubmitEvidence{},
+		&MsgUntombstoneValidator{},
+	)
 	registrar.RegisterInterface(
 		"cosmos.evidence.v1beta1.Evidence",
 		(*exported.Evidence)(nil),
 		&Equivocation{},
+		&LightClientAttack{},
+		&OracleMisbehaviour{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registrar, &_Msg_serviceDesc)
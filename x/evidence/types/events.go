@@ -2,7 +2,11 @@ package types
 
 // evidence module events
 const (
-	EventTypeSubmitEvidence = "submit_evidence"
+	EventTypeSubmitEvidence       = "submit_evidence"
+	EventTypeUntombstoneValidator = "untombstone_validator"
 
-	AttributeKeyEvidenceHash = "evidence_hash"
+	AttributeKeyEvidenceHash     = "evidence_hash"
+	AttributeKeyConsensusAddress = "consensus_address"
+	AttributeKeyUntombstoneID    = "untombstone_id"
+	AttributeKeyRefund           = "refund"
 )
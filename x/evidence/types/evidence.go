@@ -2,6 +2,7 @@ package types
 
 import (
 	"crypto/sha256"
+	"errors"
 	"fmt"
 	"time"
 
@@ -13,9 +14,17 @@ import (
 )
 
 // Evidence type constants
-const RouteEquivocation = "equivocation"
+const (
+	RouteEquivocation       = "equivocation"
+	RouteLightClientAttack  = "lightclientattack"
+	RouteOracleMisbehaviour = "oraclemisbehaviour"
+)
 
-var _ exported.Evidence = &Equivocation{}
+var (
+	_ exported.Evidence = &Equivocation{}
+	_ exported.Evidence = &LightClientAttack{}
+	_ exported.Evidence = &OracleMisbehaviour{}
+)
 
 // Route returns the Evidence Handler route for an Equivocation type.
 func (e *Equivocation) Route() string { return RouteEquivocation }
@@ -91,3 +100,153 @@ func FromABCIEvidence(e comet.Evidence, conAc address.Codec) *Equivocation {
 		Time:             e.Time,
 	}
 }
+
+// Route returns the Evidence Handler route for a LightClientAttack type.
+func (e *LightClientAttack) Route() string { return RouteLightClientAttack }
+
+// Hash returns the hash of a LightClientAttack object.
+func (e *LightClientAttack) Hash() []byte {
+	bz, err := e.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	hash := sha256.Sum256(bz)
+
+	return hash[:]
+}
+
+// ValidateBasic performs basic stateless validation checks on a LightClientAttack object.
+func (e *LightClientAttack) ValidateBasic() error {
+	if e.Time.Unix() <= 0 {
+		return fmt.Errorf("invalid light client attack time: %s", e.Time)
+	}
+	if e.Height < 1 {
+		return fmt.Errorf("invalid light client attack height: %d", e.Height)
+	}
+	if len(e.ByzantineValidators) == 0 {
+		return errors.New("invalid light client attack: no byzantine validators")
+	}
+	for _, v := range e.ByzantineValidators {
+		if v.ConsensusAddress == "" {
+			return fmt.Errorf("invalid light client attack byzantine validator consensus address: %s", v.ConsensusAddress)
+		}
+		if v.Power < 1 {
+			return fmt.Errorf("invalid light client attack byzantine validator power: %d", v.Power)
+		}
+	}
+
+	return nil
+}
+
+// GetHeight returns the common height of the LightClientAttack.
+func (e LightClientAttack) GetHeight() int64 {
+	return e.Height
+}
+
+// GetTime returns the time of the common height of the LightClientAttack.
+func (e LightClientAttack) GetTime() time.Time {
+	return e.Time
+}
+
+// GetTotalPower returns the total power of the validator set at the common
+// height of the LightClientAttack.
+func (e LightClientAttack) GetTotalPower() int64 {
+	return e.TotalVotingPower
+}
+
+// Route returns the Evidence Handler route for an OracleMisbehaviour type.
+func (e *OracleMisbehaviour) Route() string { return RouteOracleMisbehaviour }
+
+// Hash returns the hash of an OracleMisbehaviour object.
+func (e *OracleMisbehaviour) Hash() []byte {
+	bz, err := e.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	hash := sha256.Sum256(bz)
+
+	return hash[:]
+}
+
+// ValidateBasic performs basic stateless validation checks on an OracleMisbehaviour object.
+func (e *OracleMisbehaviour) ValidateBasic() error {
+	if e.Time.Unix() <= 0 {
+		return fmt.Errorf("invalid oracle misbehaviour time: %s", e.Time)
+	}
+	if e.Height < 1 {
+		return fmt.Errorf("invalid oracle misbehaviour height: %d", e.Height)
+	}
+	if e.Power < 1 {
+		return fmt.Errorf("invalid oracle misbehaviour validator power: %d", e.Power)
+	}
+	if e.ConsensusAddress == "" {
+		return fmt.Errorf("invalid oracle misbehaviour validator consensus address: %s", e.ConsensusAddress)
+	}
+	if e.Oracle == "" {
+		return errors.New("invalid oracle misbehaviour: empty oracle")
+	}
+
+	return nil
+}
+
+// GetConsensusAddress returns the validator's consensus address at time of the
+// OracleMisbehaviour.
+func (e OracleMisbehaviour) GetConsensusAddress(consAc address.Codec) sdk.ConsAddress {
+	addr, _ := consAc.StringToBytes(e.ConsensusAddress)
+	return addr
+}
+
+// GetHeight returns the height at time of the OracleMisbehaviour.
+func (e OracleMisbehaviour) GetHeight() int64 {
+	return e.Height
+}
+
+// GetTime returns the time at time of the OracleMisbehaviour.
+func (e OracleMisbehaviour) GetTime() time.Time {
+	return e.Time
+}
+
+// GetValidatorPower returns the validator's power at time of the
+// OracleMisbehaviour.
+func (e OracleMisbehaviour) GetValidatorPower() int64 {
+	return e.Power
+}
+
+// LightClientAttacksFromABCIEvidence converts the CometBFT light client attack
+// evidence of a block to SDK Evidence. CometBFT reports one misbehavior per
+// byzantine validator, they are grouped by common height into a single
+// LightClientAttack.
+func LightClientAttacksFromABCIEvidence(evidences []comet.Evidence, conAc address.Codec) []*LightClientAttack {
+	var attacks []*LightClientAttack
+	byHeight := make(map[int64]*LightClientAttack)
+	for _, e := range evidences {
+		if e.Type != comet.LightClientAttack {
+			continue
+		}
+
+		consAddr, err := conAc.BytesToString(e.Validator.Address)
+		if err != nil {
+			panic(err)
+		}
+
+		attack, ok := byHeight[e.Height]
+		if !ok {
+			attack = &LightClientAttack{
+				Height:           e.Height,
+				Time:             e.Time,
+				TotalVotingPower: e.TotalVotingPower,
+			}
+			byHeight[e.Height] = attack
+			attacks = append(attacks, attack)
+		}
+
+		attack.ByzantineValidators = append(attack.ByzantineValidators, ByzantineValidator{
+			ConsensusAddress: consAddr,
+			Power:            e.Validator.Power,
+		})
+	}
+
+	return attacks
+}
@@ -98,3 +98,88 @@ func NewCometMisbehavior(height, tvp int64, t time.Time, tpe comet.MisbehaviorTy
 		Validator:        val,
 	}
 }
+
+func TestLightClientAttackValidateBasic(t *testing.T) {
+	var zeroTime time.Time
+	addr, err := address.NewBech32Codec("cosmosvalcons").BytesToString(sdk.ConsAddress("foo_________________"))
+	require.NoError(t, err)
+
+	n, _ := time.Parse(time.RFC3339, "2006-01-02T15:04:05Z")
+	validators := []types.ByzantineValidator{{ConsensusAddress: addr, Power: 10}}
+	testCases := []struct {
+		name      string
+		e         types.LightClientAttack
+		expectErr bool
+	}{
+		{"valid", types.LightClientAttack{100, n, 100, validators}, false},
+		{"invalid time", types.LightClientAttack{100, zeroTime, 100, validators}, true},
+		{"invalid height", types.LightClientAttack{0, n, 100, validators}, true},
+		{"no byzantine validators", types.LightClientAttack{100, n, 100, nil}, true},
+		{"invalid address", types.LightClientAttack{100, n, 100, []types.ByzantineValidator{{Power: 10}}}, true},
+		{"invalid power", types.LightClientAttack{100, n, 100, []types.ByzantineValidator{{ConsensusAddress: addr}}}, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expectErr, tc.e.ValidateBasic() != nil)
+		})
+	}
+}
+
+func TestOracleMisbehaviourValidateBasic(t *testing.T) {
+	var zeroTime time.Time
+	addr, err := address.NewBech32Codec("cosmosvalcons").BytesToString(sdk.ConsAddress("foo_________________"))
+	require.NoError(t, err)
+
+	n, _ := time.Parse(time.RFC3339, "2006-01-02T15:04:05Z")
+	testCases := []struct {
+		name      string
+		e         types.OracleMisbehaviour
+		expectErr bool
+	}{
+		{"valid", types.OracleMisbehaviour{100, n, 1000000, addr, "price", []byte("proof")}, false},
+		{"invalid time", types.OracleMisbehaviour{100, zeroTime, 1000000, addr, "price", nil}, true},
+		{"invalid height", types.OracleMisbehaviour{0, n, 1000000, addr, "price", nil}, true},
+		{"invalid power", types.OracleMisbehaviour{100, n, 0, addr, "price", nil}, true},
+		{"invalid address", types.OracleMisbehaviour{100, n, 1000000, "", "price", nil}, true},
+		{"empty oracle", types.OracleMisbehaviour{100, n, 1000000, addr, "", nil}, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expectErr, tc.e.ValidateBasic() != nil)
+			require.Equal(t, types.RouteOracleMisbehaviour, tc.e.Route())
+		})
+	}
+}
+
+func TestLightClientAttacksFromABCIEvidence(t *testing.T) {
+	consCodec := address.NewBech32Codec("cosmosvalcons")
+	n := time.Unix(1136214245, 0).UTC()
+
+	evidences := []comet.Evidence{
+		{Type: comet.LightClientAttack, Height: 10, Time: n, TotalVotingPower: 100, Validator: comet.Validator{Address: []byte("val1________________"), Power: 10}},
+		{Type: comet.DuplicateVote, Height: 10, Time: n, TotalVotingPower: 100, Validator: comet.Validator{Address: []byte("val2________________"), Power: 20}},
+		{Type: comet.LightClientAttack, Height: 11, Time: n, TotalVotingPower: 100, Validator: comet.Validator{Address: []byte("val3________________"), Power: 30}},
+		{Type: comet.LightClientAttack, Height: 10, Time: n, TotalVotingPower: 100, Validator: comet.Validator{Address: []byte("val4________________"), Power: 40}},
+	}
+
+	attacks := types.LightClientAttacksFromABCIEvidence(evidences, consCodec)
+	require.Len(t, attacks, 2)
+
+	require.Equal(t, int64(10), attacks[0].GetHeight())
+	require.Equal(t, int64(100), attacks[0].GetTotalPower())
+	require.Len(t, attacks[0].ByzantineValidators, 2)
+	require.Equal(t, int64(10), attacks[0].ByzantineValidators[0].Power)
+	require.Equal(t, int64(40), attacks[0].ByzantineValidators[1].Power)
+	require.NoError(t, attacks[0].ValidateBasic())
+
+	require.Equal(t, int64(11), attacks[1].GetHeight())
+	require.Len(t, attacks[1].ByzantineValidators, 1)
+	addr, err := consCodec.BytesToString([]byte("val3________________"))
+	require.NoError(t, err)
+	require.Equal(t, addr, attacks[1].ByzantineValidators[0].ConsensusAddress)
+	require.Equal(t, types.RouteLightClientAttack, attacks[1].Route())
+}
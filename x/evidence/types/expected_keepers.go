@@ -16,6 +16,7 @@ import (
 // evidence module.
 type StakingKeeper interface {
 	ConsensusAddressCodec() address.Codec
+	ValidatorAddressCodec() address.Codec
 	ValidatorByConsAddr(context.Context, sdk.ConsAddress) (sdk.ValidatorI, error)
 }
 
@@ -26,6 +27,7 @@ type SlashingKeeper interface {
 	IsTombstoned(context.Context, sdk.ConsAddress) bool
 	HasValidatorSigningInfo(context.Context, sdk.ConsAddress) bool
 	Tombstone(context.Context, sdk.ConsAddress) error
+	Untombstone(context.Context, sdk.ConsAddress) error
 	Slash(context.Context, sdk.ConsAddress, math.LegacyDec, int64, int64) error
 	SlashWithInfractionReason(context.Context, sdk.ConsAddress, math.LegacyDec, int64, int64, st.Infraction) error
 	SlashFractionDoubleSign(context.Context) (math.LegacyDec, error)
@@ -33,6 +35,12 @@ type SlashingKeeper interface {
 	JailUntil(context.Context, sdk.ConsAddress, time.Time) error
 }
 
+// PoolKeeper defines the protocolpool module interface contract needed by the
+// evidence module.
+type PoolKeeper interface {
+	DistributeFromCommunityPool(ctx context.Context, amount sdk.Coins, receiveAddr sdk.AccAddress) error
+}
+
 // AccountKeeper define the account keeper interface contracted needed by the evidence module
 type AccountKeeper interface {
 	SetAccount(ctx context.Context, acc sdk.AccountI)
@@ -34,7 +34,9 @@ func NewGenesisState(e []exported.Evidence) *GenesisState {
 // DefaultGenesisState returns the evidence module's default genesis state.
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Evidence: []*types.Any{},
+		Evidence:                   []*types.Any{},
+		UntombstoneRecords:         []UntombstoneRecord{},
+		HandledOracleMisbehaviours: []HandledOracleMisbehaviour{},
 	}
 }
 
@@ -51,6 +53,41 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	ids := make(map[uint64]struct{}, len(gs.UntombstoneRecords))
+	for _, r := range gs.UntombstoneRecords {
+		if _, ok := ids[r.Id]; ok {
+			return fmt.Errorf("duplicate untombstone record id %d", r.Id)
+		}
+		ids[r.Id] = struct{}{}
+
+		if r.ConsensusAddress == "" {
+			return fmt.Errorf("untombstone record %d has an empty consensus address", r.Id)
+		}
+		if err := r.Refund.Validate(); err != nil {
+			return fmt.Errorf("untombstone record %d has an invalid refund: %w", r.Id, err)
+		}
+	}
+
+	type handledKey struct {
+		consensusAddress string
+		height           int64
+	}
+	handled := make(map[handledKey]struct{}, len(gs.HandledOracleMisbehaviours))
+	for _, m := range gs.HandledOracleMisbehaviours {
+		if m.ConsensusAddress == "" {
+			return fmt.Errorf("handled oracle misbehaviour at height %d has an empty consensus address", m.Height)
+		}
+		if m.Height < 1 {
+			return fmt.Errorf("handled oracle misbehaviour of %s has an invalid height: %d", m.ConsensusAddress, m.Height)
+		}
+
+		key := handledKey{m.ConsensusAddress, m.Height}
+		if _, ok := handled[key]; ok {
+			return fmt.Errorf("duplicate handled oracle misbehaviour of %s at height %d", m.ConsensusAddress, m.Height)
+		}
+		handled[key] = struct{}{}
+	}
+
 	return nil
 }
 
@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	any "github.com/cosmos/gogoproto/types/any"
 	io "io"
@@ -27,6 +29,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	// evidence defines all the evidence at genesis.
 	Evidence []*any.Any `protobuf:"bytes,1,rep,name=evidence,proto3" json:"evidence,omitempty"`
+	// untombstone_records defines all the recorded untombstone decisions at genesis.
+	UntombstoneRecords []UntombstoneRecord `protobuf:"bytes,2,rep,name=untombstone_records,json=untombstoneRecords,proto3" json:"untombstone_records"`
+	// handled_oracle_misbehaviours defines the oracle misbehaviours already handled at genesis.
+	HandledOracleMisbehaviours []HandledOracleMisbehaviour `protobuf:"bytes,3,rep,name=handled_oracle_misbehaviours,json=handledOracleMisbehaviours,proto3" json:"handled_oracle_misbehaviours"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -69,6 +75,20 @@ func (m *GenesisState) GetEvidence() []*any.Any {
 	return nil
 }
 
+func (m *GenesisState) GetUntombstoneRecords() []UntombstoneRecord {
+	if m != nil {
+		return m.UntombstoneRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetHandledOracleMisbehaviours() []HandledOracleMisbehaviour {
+	if m != nil {
+		return m.HandledOracleMisbehaviours
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.evidence.v1beta1.GenesisState")
 }
@@ -78,19 +98,28 @@ func init() {
 }
 
 var fileDescriptor_c610c52c26e0e202 = []byte{
-	// 184 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4d, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2d, 0xcb, 0x4c, 0x49, 0xcd, 0x4b, 0x4e, 0xd5, 0x2f, 0x33, 0x4c, 0x4a,
-	0x2d, 0x49, 0x34, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f,
-	0xc9, 0x17, 0x12, 0x87, 0x28, 0xd3, 0x83, 0x29, 0xd3, 0x83, 0x2a, 0x93, 0x92, 0x4c, 0xcf, 0xcf,
-	0x4f, 0xcf, 0x49, 0xd5, 0x07, 0x2b, 0x4b, 0x2a, 0x4d, 0xd3, 0x4f, 0xcc, 0xab, 0x84, 0xe8, 0x51,
-	0x72, 0xe0, 0xe2, 0x71, 0x87, 0x18, 0x12, 0x5c, 0x92, 0x58, 0x92, 0x2a, 0x64, 0xc0, 0xc5, 0x01,
-	0xd3, 0x2e, 0xc1, 0xa8, 0xc0, 0xac, 0xc1, 0x6d, 0x24, 0xa2, 0x07, 0xd1, 0xad, 0x07, 0xd3, 0xad,
-	0xe7, 0x98, 0x57, 0x19, 0x04, 0x57, 0xe5, 0x64, 0x7e, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72,
-	0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7,
-	0x72, 0x0c, 0x51, 0xb2, 0x10, 0xf7, 0x14, 0xa7, 0x64, 0xeb, 0x65, 0xe6, 0xeb, 0x57, 0x20, 0x9c,
-	0x5f, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x36, 0xd0, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff,
-	0x17, 0x25, 0x11, 0x0c, 0xde, 0x00, 0x00, 0x00,
+	// 325 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0x31, 0x4b, 0xc3, 0x40,
+	0x14, 0xc7, 0x93, 0x16, 0x44, 0xa3, 0x8b, 0xb1, 0x60, 0x2d, 0x1a, 0x45, 0x50, 0xa4, 0xc3, 0x9d,
+	0xad, 0x83, 0xb3, 0x5d, 0x74, 0x11, 0xa1, 0xe2, 0xe2, 0x52, 0x2e, 0xc9, 0x6b, 0x1a, 0x6c, 0xee,
+	0x95, 0xbc, 0x4b, 0xb1, 0x93, 0x5f, 0xc1, 0x8f, 0xa1, 0x9b, 0x1f, 0xa3, 0x63, 0x47, 0x27, 0x91,
+	0x76, 0xf0, 0x6b, 0x88, 0x77, 0x4d, 0x5b, 0x84, 0x2c, 0xe1, 0xf1, 0xf2, 0xfb, 0xbd, 0xff, 0xbb,
+	0x3b, 0xe7, 0x24, 0x40, 0x4a, 0x90, 0x38, 0x0c, 0xe3, 0x10, 0x64, 0x00, 0x7c, 0xd8, 0xf0, 0x41,
+	0x89, 0x06, 0x8f, 0x40, 0x02, 0xc5, 0xc4, 0x06, 0x29, 0x2a, 0x74, 0x77, 0x0d, 0xc6, 0x72, 0x8c,
+	0xcd, 0xb1, 0xda, 0x5e, 0x84, 0x18, 0xf5, 0x81, 0x6b, 0xcc, 0xcf, 0xba, 0x5c, 0xc8, 0x91, 0x71,
+	0x6a, 0xa7, 0x45, 0xa3, 0x17, 0x43, 0x0c, 0x57, 0x89, 0x30, 0x42, 0x5d, 0xf2, 0xbf, 0x6a, 0xde,
+	0xdd, 0x16, 0x49, 0x2c, 0x91, 0xeb, 0xaf, 0x69, 0x1d, 0xbf, 0x97, 0x9c, 0xad, 0x6b, 0xb3, 0xd6,
+	0xbd, 0x12, 0x0a, 0xdc, 0x73, 0x67, 0x3d, 0x9f, 0x55, 0xb5, 0x8f, 0xca, 0x67, 0x9b, 0xcd, 0x0a,
+	0x33, 0xfb, 0xb0, 0x7c, 0x1f, 0x76, 0x25, 0x47, 0xed, 0x05, 0xe5, 0x76, 0x9d, 0x9d, 0x4c, 0x2a,
+	0x4c, 0x7c, 0x52, 0x28, 0xa1, 0x93, 0x42, 0x80, 0x69, 0x48, 0xd5, 0x92, 0x96, 0xeb, 0xac, 0xe0,
+	0x94, 0xec, 0x61, 0xe9, 0xb4, 0xb5, 0xd2, 0xda, 0x18, 0x7f, 0x1d, 0x5a, 0x6f, 0x3f, 0x1f, 0x75,
+	0xbb, 0xed, 0x66, 0xff, 0xff, 0x92, 0xfb, 0xe2, 0xec, 0xf7, 0x84, 0x0c, 0xfb, 0x10, 0x76, 0x30,
+	0x15, 0x41, 0x1f, 0x3a, 0x49, 0x4c, 0x3e, 0xf4, 0xc4, 0x30, 0xc6, 0x2c, 0xa5, 0x6a, 0x59, 0x07,
+	0x36, 0x0b, 0x03, 0x6f, 0x8c, 0x7c, 0xa7, 0xdd, 0xdb, 0x15, 0x75, 0x35, 0xb8, 0xd6, 0x2b, 0xa2,
+	0xa8, 0x75, 0x39, 0x9e, 0x7a, 0xf6, 0x64, 0xea, 0xd9, 0xdf, 0x53, 0xcf, 0x7e, 0x9d, 0x79, 0xd6,
+	0x64, 0xe6, 0x59, 0x9f, 0x33, 0xcf, 0x7a, 0x3c, 0x30, 0x99, 0x14, 0x3e, 0xb1, 0x18, 0xf9, 0xf3,
+	0xf2, 0x79, 0xd4, 0x68, 0x00, 0xe4, 0xaf, 0xe9, 0x9b, 0xbb, 0xf8, 0x1d, 0x00, 0x3d, 0xf9, 0xd7,
+	0xd2, 0x19, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -113,6 +142,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.HandledOracleMisbehaviours) > 0 {
+		for iNdEx := len(m.HandledOracleMisbehaviours) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HandledOracleMisbehaviours[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.UntombstoneRecords) > 0 {
+		for iNdEx := len(m.UntombstoneRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UntombstoneRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Evidence) > 0 {
 		for iNdEx := len(m.Evidence) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -153,6 +210,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.UntombstoneRecords) > 0 {
+		for _, e := range m.UntombstoneRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.HandledOracleMisbehaviours) > 0 {
+		for _, e := range m.HandledOracleMisbehaviours {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -225,6 +294,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UntombstoneRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UntombstoneRecords = append(m.UntombstoneRecords, UntombstoneRecord{})
+			if err := m.UntombstoneRecords[len(m.UntombstoneRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HandledOracleMisbehaviours", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HandledOracleMisbehaviours = append(m.HandledOracleMisbehaviours, HandledOracleMisbehaviour{})
+			if err := m.HandledOracleMisbehaviours[len(m.HandledOracleMisbehaviours)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -108,6 +108,60 @@ func TestGenesisStateValidate(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"valid untombstone records",
+			func() {
+				genesisState = types.DefaultGenesisState()
+				genesisState.UntombstoneRecords = []types.UntombstoneRecord{
+					{Id: 0, ConsensusAddress: pk.PubKey().Address().String()},
+					{Id: 1, ConsensusAddress: pk.PubKey().Address().String()},
+				}
+			},
+			true,
+		},
+		{
+			"duplicate untombstone record id",
+			func() {
+				genesisState = types.DefaultGenesisState()
+				genesisState.UntombstoneRecords = []types.UntombstoneRecord{
+					{Id: 1, ConsensusAddress: pk.PubKey().Address().String()},
+					{Id: 1, ConsensusAddress: pk.PubKey().Address().String()},
+				}
+			},
+			false,
+		},
+		{
+			"valid handled oracle misbehaviours",
+			func() {
+				genesisState = types.DefaultGenesisState()
+				genesisState.HandledOracleMisbehaviours = []types.HandledOracleMisbehaviour{
+					{ConsensusAddress: pk.PubKey().Address().String(), Height: 1},
+					{ConsensusAddress: pk.PubKey().Address().String(), Height: 2},
+				}
+			},
+			true,
+		},
+		{
+			"duplicate handled oracle misbehaviour",
+			func() {
+				genesisState = types.DefaultGenesisState()
+				genesisState.HandledOracleMisbehaviours = []types.HandledOracleMisbehaviour{
+					{ConsensusAddress: pk.PubKey().Address().String(), Height: 1},
+					{ConsensusAddress: pk.PubKey().Address().String(), Height: 1},
+				}
+			},
+			false,
+		},
+		{
+			"invalid handled oracle misbehaviour height",
+			func() {
+				genesisState = types.DefaultGenesisState()
+				genesisState.HandledOracleMisbehaviours = []types.HandledOracleMisbehaviour{
+					{ConsensusAddress: pk.PubKey().Address().String(), Height: 0},
+				}
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
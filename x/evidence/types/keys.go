@@ -8,9 +8,17 @@ const (
 
 	// StoreKey defines the primary module store key
 	StoreKey = ModuleName
+
+	// GovModuleName duplicates the gov module's name to avoid a cyclic dependency with x/gov.
+	// It should be synced with the gov module's name if it is ever changed.
+	// See: https://github.com/cosmos/cosmos-sdk/blob/b62a28aac041829da5ded4aeacfcd7a42873d1c8/x/gov/types/keys.go#L9
+	GovModuleName = "gov"
 )
 
 // KVStore key prefixes
 var (
-	KeyPrefixEvidence = collections.NewPrefix(0)
+	KeyPrefixEvidence            = collections.NewPrefix(0)
+	KeyPrefixUntombstoneRecord   = collections.NewPrefix(1)
+	KeyUntombstoneRecordSequence = collections.NewPrefix(2)
+	KeyPrefixOracleMisbehaviour  = collections.NewPrefix(3)
 )
@@ -8,6 +8,8 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	any "github.com/cosmos/gogoproto/types/any"
@@ -237,11 +239,125 @@ func (m *QueryAllEvidenceResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryUntombstoneRecordsRequest is the request type for the
+// Query/UntombstoneRecords RPC method.
+type QueryUntombstoneRecordsRequest struct {
+	// consensus_address optionally filters the records by validator consensus address.
+	ConsensusAddress string `protobuf:"bytes,1,opt,name=consensus_address,json=consensusAddress,proto3" json:"consensus_address,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryUntombstoneRecordsRequest) Reset()         { *m = QueryUntombstoneRecordsRequest{} }
+func (m *QueryUntombstoneRecordsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUntombstoneRecordsRequest) ProtoMessage()    {}
+func (*QueryUntombstoneRecordsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07043de1a84d215a, []int{4}
+}
+func (m *QueryUntombstoneRecordsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUntombstoneRecordsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUntombstoneRecordsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUntombstoneRecordsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUntombstoneRecordsRequest.Merge(m, src)
+}
+func (m *QueryUntombstoneRecordsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUntombstoneRecordsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUntombstoneRecordsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUntombstoneRecordsRequest proto.InternalMessageInfo
+
+func (m *QueryUntombstoneRecordsRequest) GetConsensusAddress() string {
+	if m != nil {
+		return m.ConsensusAddress
+	}
+	return ""
+}
+
+func (m *QueryUntombstoneRecordsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryUntombstoneRecordsResponse is the response type for the
+// Query/UntombstoneRecords RPC method.
+type QueryUntombstoneRecordsResponse struct {
+	// records returns the recorded untombstone decisions.
+	Records []UntombstoneRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryUntombstoneRecordsResponse) Reset()         { *m = QueryUntombstoneRecordsResponse{} }
+func (m *QueryUntombstoneRecordsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUntombstoneRecordsResponse) ProtoMessage()    {}
+func (*QueryUntombstoneRecordsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07043de1a84d215a, []int{5}
+}
+func (m *QueryUntombstoneRecordsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUntombstoneRecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUntombstoneRecordsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUntombstoneRecordsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUntombstoneRecordsResponse.Merge(m, src)
+}
+func (m *QueryUntombstoneRecordsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUntombstoneRecordsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUntombstoneRecordsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUntombstoneRecordsResponse proto.InternalMessageInfo
+
+func (m *QueryUntombstoneRecordsResponse) GetRecords() []UntombstoneRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+func (m *QueryUntombstoneRecordsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryEvidenceRequest)(nil), "cosmos.evidence.v1beta1.QueryEvidenceRequest")
 	proto.RegisterType((*QueryEvidenceResponse)(nil), "cosmos.evidence.v1beta1.QueryEvidenceResponse")
 	proto.RegisterType((*QueryAllEvidenceRequest)(nil), "cosmos.evidence.v1beta1.QueryAllEvidenceRequest")
 	proto.RegisterType((*QueryAllEvidenceResponse)(nil), "cosmos.evidence.v1beta1.QueryAllEvidenceResponse")
+	proto.RegisterType((*QueryUntombstoneRecordsRequest)(nil), "cosmos.evidence.v1beta1.QueryUntombstoneRecordsRequest")
+	proto.RegisterType((*QueryUntombstoneRecordsResponse)(nil), "cosmos.evidence.v1beta1.QueryUntombstoneRecordsResponse")
 }
 
 func init() {
@@ -249,36 +365,47 @@ func init() {
 }
 
 var fileDescriptor_07043de1a84d215a = []byte{
-	// 455 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xcd, 0xae, 0xd2, 0x40,
-	0x14, 0xc7, 0x99, 0xfa, 0x91, 0xeb, 0xdc, 0x6b, 0x4c, 0xc6, 0x6b, 0x2e, 0xb7, 0xd1, 0x06, 0x4b,
-	0x22, 0x48, 0xc2, 0x4c, 0x41, 0x13, 0xd6, 0x90, 0xf8, 0xb5, 0xd3, 0x2e, 0xdd, 0x90, 0x29, 0x8c,
-	0x6d, 0x03, 0xce, 0x14, 0xa6, 0x10, 0x89, 0x71, 0xe3, 0x13, 0x98, 0x18, 0x57, 0xc6, 0xb7, 0xf0,
-	0x15, 0x4c, 0x5c, 0x92, 0xb8, 0x31, 0xae, 0x0c, 0xf8, 0x20, 0x86, 0x99, 0x29, 0x20, 0x1f, 0xa2,
-	0xcb, 0xe9, 0xf9, 0x9f, 0xff, 0xff, 0xd7, 0x73, 0x0e, 0x2c, 0x76, 0x84, 0x7c, 0x29, 0x24, 0x61,
-	0xe3, 0xb8, 0xcb, 0x78, 0x87, 0x91, 0x71, 0x2d, 0x60, 0x29, 0xad, 0x91, 0xc1, 0x88, 0x0d, 0x27,
-	0x38, 0x19, 0x8a, 0x54, 0xa0, 0x33, 0x2d, 0xc2, 0x99, 0x08, 0x1b, 0x91, 0x7d, 0xae, 0x0b, 0x6d,
-	0x25, 0x23, 0x46, 0xa5, 0x1e, 0x76, 0xc5, 0x18, 0x07, 0x54, 0x32, 0x6d, 0xb6, 0xb4, 0x4e, 0x68,
-	0x18, 0x73, 0x9a, 0xc6, 0x82, 0x1b, 0xed, 0x79, 0x28, 0x44, 0xd8, 0x67, 0x44, 0xbd, 0x82, 0xd1,
-	0x0b, 0x42, 0xb9, 0x89, 0xb6, 0x6f, 0x9a, 0x12, 0x4d, 0x62, 0x42, 0x39, 0x17, 0xa9, 0xea, 0x33,
-	0x21, 0x6e, 0x04, 0x4f, 0x9f, 0x2d, 0xac, 0x1f, 0x18, 0x30, 0x9f, 0x0d, 0x46, 0x4c, 0xa6, 0xa8,
-	0x04, 0xaf, 0x66, 0xac, 0xed, 0x88, 0xca, 0x28, 0x0f, 0x0a, 0xa0, 0x7c, 0xd2, 0xb2, 0xf2, 0xc0,
-	0x3f, 0xc9, 0x0a, 0x8f, 0xa9, 0x8c, 0x50, 0x09, 0x5e, 0x54, 0x75, 0xab, 0x00, 0xca, 0x57, 0x5a,
-	0xd7, 0x7f, 0x7c, 0xae, 0x5e, 0xd3, 0xdc, 0x55, 0xd9, 0xed, 0x15, 0x3c, 0x7c, 0xbf, 0xe1, 0x2b,
-	0x81, 0xfb, 0x04, 0xde, 0xd8, 0x48, 0x92, 0x89, 0xe0, 0x92, 0x21, 0x0f, 0x1e, 0x65, 0x8e, 0x2a,
-	0xe5, 0xb8, 0x7e, 0x8a, 0x35, 0x33, 0xce, 0x7e, 0x07, 0x37, 0xf9, 0xc4, 0x5f, 0xaa, 0x5c, 0x0a,
-	0xcf, 0x94, 0x55, 0xb3, 0xdf, 0xdf, 0xe4, 0x7e, 0x08, 0xe1, 0x6a, 0x38, 0xc6, 0xee, 0x0e, 0x36,
-	0x73, 0x5d, 0x4c, 0x12, 0xeb, 0xb5, 0x98, 0x49, 0xe2, 0xa7, 0x34, 0xcc, 0x7a, 0xfd, 0xb5, 0x4e,
-	0xf7, 0x03, 0x80, 0xf9, 0xed, 0x8c, 0x9d, 0xc4, 0x17, 0x0e, 0x13, 0xa3, 0x47, 0x7f, 0x60, 0x59,
-	0x0a, 0xab, 0x74, 0x10, 0x4b, 0xc7, 0xad, 0x73, 0xd5, 0xbf, 0x58, 0xf0, 0x92, 0xe2, 0x42, 0x1f,
-	0x01, 0x3c, 0xca, 0xc8, 0x50, 0x15, 0xef, 0x39, 0x30, 0xbc, 0x6b, 0xbb, 0x36, 0xfe, 0x57, 0xb9,
-	0x26, 0x70, 0xbd, 0xb7, 0xdf, 0x7e, 0xbd, 0xb7, 0x2a, 0xa8, 0x4c, 0xf6, 0x1d, 0xfb, 0xf2, 0xc3,
-	0xeb, 0xc5, 0xb2, 0xdf, 0xa0, 0x4f, 0x00, 0x1e, 0xaf, 0x8d, 0x0e, 0x79, 0x7f, 0x4f, 0xdc, 0xde,
-	0xa4, 0x5d, 0xfb, 0x8f, 0x0e, 0x83, 0x79, 0x57, 0x61, 0x16, 0xd1, 0xed, 0x83, 0x98, 0xad, 0xc6,
-	0xd7, 0x99, 0x03, 0xa6, 0x33, 0x07, 0xfc, 0x9c, 0x39, 0xe0, 0xdd, 0xdc, 0xc9, 0x4d, 0xe7, 0x4e,
-	0xee, 0xfb, 0xdc, 0xc9, 0x3d, 0xbf, 0xa5, 0x7b, 0x65, 0xb7, 0x87, 0x63, 0x41, 0x5e, 0xad, 0x3c,
-	0xd2, 0x49, 0xc2, 0x64, 0x70, 0x59, 0x6d, 0xf8, 0xde, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x76,
-	0x21, 0xac, 0x55, 0xf7, 0x03, 0x00, 0x00,
+	// 626 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xcf, 0x6b, 0x13, 0x4f,
+	0x18, 0xc6, 0x33, 0xf9, 0xf6, 0xab, 0xed, 0xb4, 0xa2, 0x1d, 0x2b, 0x6d, 0x17, 0xdd, 0xa6, 0x29,
+	0x34, 0x31, 0x98, 0xd9, 0xa4, 0x16, 0xe2, 0x35, 0x11, 0xab, 0x5e, 0xfc, 0xb1, 0xe2, 0xc5, 0x4b,
+	0x98, 0x64, 0xc7, 0xcd, 0xd2, 0x64, 0x26, 0xdd, 0xd9, 0x14, 0x83, 0x78, 0x11, 0xbc, 0x0b, 0xe2,
+	0x49, 0xbc, 0x7b, 0xf4, 0xd0, 0x83, 0x20, 0xde, 0x7b, 0x2c, 0x7a, 0x11, 0x0f, 0x22, 0x89, 0xe0,
+	0xbf, 0x21, 0x99, 0x99, 0x4d, 0xda, 0x24, 0xdb, 0x58, 0xf1, 0x52, 0xba, 0x33, 0xcf, 0xfb, 0xbc,
+	0x1f, 0x9e, 0x79, 0xdf, 0xc0, 0xb5, 0x2a, 0x17, 0x0d, 0x2e, 0x2c, 0xba, 0xeb, 0x39, 0x94, 0x55,
+	0xa9, 0xb5, 0x9b, 0xaf, 0xd0, 0x80, 0xe4, 0xad, 0x9d, 0x16, 0xf5, 0xdb, 0xb8, 0xe9, 0xf3, 0x80,
+	0xa3, 0x45, 0x25, 0xc2, 0xa1, 0x08, 0x6b, 0x91, 0xb1, 0xac, 0x2e, 0xca, 0x52, 0x66, 0x69, 0x95,
+	0xfc, 0x30, 0x32, 0xda, 0xb8, 0x42, 0x04, 0x55, 0x66, 0x7d, 0xeb, 0x26, 0x71, 0x3d, 0x46, 0x02,
+	0x8f, 0x33, 0xad, 0x5d, 0x76, 0x39, 0x77, 0xeb, 0xd4, 0x92, 0x5f, 0x95, 0xd6, 0x63, 0x8b, 0x30,
+	0xdd, 0xda, 0xb8, 0xa8, 0xaf, 0x48, 0xd3, 0xb3, 0x08, 0x63, 0x3c, 0x90, 0x75, 0x61, 0x93, 0xf5,
+	0x28, 0xfa, 0x3e, 0xa9, 0xd2, 0x2d, 0xb8, 0xdc, 0xe5, 0x0a, 0xb2, 0xf7, 0x9f, 0x3e, 0x9d, 0x27,
+	0x0d, 0x8f, 0x71, 0x4b, 0xfe, 0x55, 0x47, 0xc9, 0x1a, 0x5c, 0xb8, 0xdf, 0x63, 0xbd, 0xa1, 0xeb,
+	0x6d, 0xba, 0xd3, 0xa2, 0x22, 0x40, 0x29, 0x78, 0x26, 0xb4, 0x2c, 0xd7, 0x88, 0xa8, 0x2d, 0x81,
+	0x04, 0x48, 0xcf, 0x95, 0xe2, 0x4b, 0xc0, 0x9e, 0x0b, 0x2f, 0x6e, 0x11, 0x51, 0x43, 0x29, 0x38,
+	0x25, 0xef, 0xe3, 0x09, 0x90, 0x9e, 0x29, 0x9d, 0xff, 0xb6, 0x97, 0x3d, 0xab, 0x18, 0xb3, 0xc2,
+	0xd9, 0x4e, 0xe4, 0xf0, 0x66, 0xc1, 0x96, 0x82, 0xe4, 0x6d, 0x78, 0x61, 0xa8, 0x93, 0x68, 0x72,
+	0x26, 0x28, 0xca, 0xc1, 0xe9, 0xd0, 0x51, 0x76, 0x99, 0xdd, 0x58, 0xc0, 0x2a, 0x04, 0x1c, 0xe6,
+	0x83, 0x8b, 0xac, 0x6d, 0xf7, 0x55, 0x49, 0x02, 0x17, 0xa5, 0x55, 0xb1, 0x5e, 0x1f, 0xe6, 0xde,
+	0x82, 0x70, 0x90, 0xb6, 0xb6, 0x5b, 0xc7, 0xfa, 0xa1, 0x7a, 0x4f, 0x83, 0xd5, 0x3b, 0xeb, 0xdc,
+	0xf0, 0x3d, 0xe2, 0x86, 0xb5, 0xf6, 0xa1, 0xca, 0xe4, 0x6b, 0x00, 0x97, 0x46, 0x7b, 0x8c, 0x25,
+	0xfe, 0x6f, 0x32, 0x31, 0xba, 0x79, 0x04, 0x2b, 0x2e, 0xb1, 0x52, 0x13, 0xb1, 0x54, 0xbb, 0x23,
+	0x5c, 0x1f, 0x00, 0x34, 0x25, 0xd7, 0x43, 0x16, 0xf0, 0x46, 0x45, 0x04, 0x9c, 0x51, 0x9b, 0x56,
+	0xb9, 0xef, 0x88, 0x30, 0x82, 0x3b, 0x70, 0xbe, 0xda, 0xab, 0x63, 0xa2, 0x25, 0xca, 0xc4, 0x71,
+	0x7c, 0x2a, 0x84, 0x4c, 0x62, 0xa6, 0xb4, 0xfa, 0x79, 0x2f, 0x7b, 0x49, 0x77, 0xbd, 0x1e, 0x6a,
+	0x8a, 0x4a, 0xf2, 0x20, 0xf0, 0x3d, 0xe6, 0xda, 0xe7, 0xaa, 0x43, 0xe7, 0x68, 0x6b, 0x0c, 0xfb,
+	0xdf, 0x44, 0xfa, 0x11, 0xc0, 0x95, 0x48, 0x74, 0x9d, 0xec, 0x5d, 0x78, 0xda, 0x57, 0x47, 0x3a,
+	0xd8, 0x0c, 0x8e, 0x58, 0x45, 0x3c, 0xe2, 0x52, 0x9a, 0xd9, 0xff, 0xbe, 0x12, 0x7b, 0xf7, 0xeb,
+	0x7d, 0x06, 0xd8, 0xa1, 0xcb, 0x3f, 0x0b, 0x7e, 0xe3, 0xc5, 0x14, 0xfc, 0x5f, 0xd2, 0xa3, 0x37,
+	0x00, 0x4e, 0x87, 0x23, 0x81, 0xb2, 0x91, 0x7c, 0xe3, 0xd6, 0xca, 0xc0, 0x7f, 0x2a, 0x57, 0x04,
+	0xc9, 0xdc, 0xf3, 0x2f, 0x3f, 0x5f, 0xc5, 0x33, 0x28, 0x6d, 0x4d, 0x5a, 0x7c, 0xeb, 0x69, 0x6f,
+	0xcb, 0x9e, 0xa1, 0xb7, 0x00, 0xce, 0x1e, 0x9a, 0x59, 0x94, 0x3b, 0xbe, 0xe3, 0xe8, 0x0a, 0x19,
+	0xf9, 0x13, 0x54, 0x68, 0xcc, 0xcb, 0x12, 0x73, 0x0d, 0xad, 0x4e, 0xc4, 0x44, 0x9f, 0x00, 0x44,
+	0xa3, 0x03, 0x80, 0x0a, 0xc7, 0x37, 0x8d, 0x9c, 0x76, 0xe3, 0xda, 0xc9, 0x0b, 0x35, 0xf4, 0xa6,
+	0x84, 0xc6, 0xe8, 0x4a, 0x24, 0x74, 0x6b, 0x50, 0x5c, 0xd6, 0x03, 0x55, 0x2a, 0xec, 0x77, 0x4c,
+	0x70, 0xd0, 0x31, 0xc1, 0x8f, 0x8e, 0x09, 0x5e, 0x76, 0xcd, 0xd8, 0x41, 0xd7, 0x8c, 0x7d, 0xed,
+	0x9a, 0xb1, 0x47, 0x7a, 0xb1, 0x84, 0xb3, 0x8d, 0x3d, 0x6e, 0x3d, 0x19, 0xd8, 0x05, 0xed, 0x26,
+	0x15, 0x95, 0x53, 0xf2, 0xa7, 0xe1, 0xea, 0xef, 0x01, 0x00, 0xa0, 0x5a, 0xaf, 0x0f, 0x81, 0x06,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -297,6 +424,9 @@ type QueryClient interface {
 	Evidence(ctx context.Context, in *QueryEvidenceRequest, opts ...grpc.CallOption) (*QueryEvidenceResponse, error)
 	// AllEvidence queries all evidence.
 	AllEvidence(ctx context.Context, in *QueryAllEvidenceRequest, opts ...grpc.CallOption) (*QueryAllEvidenceResponse, error)
+	// UntombstoneRecords queries the recorded untombstone decisions, optionally
+	// filtered by validator consensus address.
+	UntombstoneRecords(ctx context.Context, in *QueryUntombstoneRecordsRequest, opts ...grpc.CallOption) (*QueryUntombstoneRecordsResponse, error)
 }
 
 type queryClient struct {
@@ -325,12 +455,24 @@ func (c *queryClient) AllEvidence(ctx context.Context, in *QueryAllEvidenceReque
 	return out, nil
 }
 
+func (c *queryClient) UntombstoneRecords(ctx context.Context, in *QueryUntombstoneRecordsRequest, opts ...grpc.CallOption) (*QueryUntombstoneRecordsResponse, error) {
+	out := new(QueryUntombstoneRecordsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evidence.v1beta1.Query/UntombstoneRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Evidence queries evidence based on evidence hash.
 	Evidence(context.Context, *QueryEvidenceRequest) (*QueryEvidenceResponse, error)
 	// AllEvidence queries all evidence.
 	AllEvidence(context.Context, *QueryAllEvidenceRequest) (*QueryAllEvidenceResponse, error)
+	// UntombstoneRecords queries the recorded untombstone decisions, optionally
+	// filtered by validator consensus address.
+	UntombstoneRecords(context.Context, *QueryUntombstoneRecordsRequest) (*QueryUntombstoneRecordsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -343,6 +485,9 @@ func (*UnimplementedQueryServer) Evidence(ctx context.Context, req *QueryEvidenc
 func (*UnimplementedQueryServer) AllEvidence(ctx context.Context, req *QueryAllEvidenceRequest) (*QueryAllEvidenceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllEvidence not implemented")
 }
+func (*UnimplementedQueryServer) UntombstoneRecords(ctx context.Context, req *QueryUntombstoneRecordsRequest) (*QueryUntombstoneRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UntombstoneRecords not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -384,6 +529,24 @@ func _Query_AllEvidence_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_UntombstoneRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUntombstoneRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UntombstoneRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evidence.v1beta1.Query/UntombstoneRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UntombstoneRecords(ctx, req.(*QueryUntombstoneRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.evidence.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -396,6 +559,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AllEvidence",
 			Handler:    _Query_AllEvidence_Handler,
 		},
+		{
+			MethodName: "UntombstoneRecords",
+			Handler:    _Query_UntombstoneRecords_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/evidence/v1beta1/query.proto",
@@ -557,6 +724,97 @@ func (m *QueryAllEvidenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryUntombstoneRecordsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUntombstoneRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUntombstoneRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConsensusAddress) > 0 {
+		i -= len(m.ConsensusAddress)
+		copy(dAtA[i:], m.ConsensusAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsensusAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUntombstoneRecordsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUntombstoneRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUntombstoneRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -630,6 +888,42 @@ func (m *QueryAllEvidenceResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryUntombstoneRecordsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsensusAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUntombstoneRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1044,6 +1338,244 @@ func (m *QueryAllEvidenceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryUntombstoneRecordsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUntombstoneRecordsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUntombstoneRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsensusAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUntombstoneRecordsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUntombstoneRecordsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUntombstoneRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, UntombstoneRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
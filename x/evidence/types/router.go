@@ -16,6 +16,14 @@ type (
 	// slashing and potential jailing.
 	Handler func(context.Context, exported.Evidence) error
 
+	// OracleMisbehaviourVerifier defines an application provided function
+	// verifying the proof of an OracleMisbehaviour. It must return an error for
+	// evidence that cannot be proven, as the validator is slashed otherwise.
+	// It returns the power of the validator at the height of the misbehaviour,
+	// established from the proof, e.g. the extended commit of the height, as the
+	// power carried by the evidence is provided by the submitter.
+	OracleMisbehaviourVerifier func(context.Context, *OracleMisbehaviour) (int64, error)
+
 	// Router defines a contract for which any Evidence handling module must
 	// implement in order to route Evidence to registered Handlers.
 	Router interface {
@@ -8,6 +8,8 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -121,39 +123,176 @@ func (m *MsgSubmitEvidenceResponse) GetHash() []byte {
 	return nil
 }
 
+// MsgUntombstoneValidator is the Msg/UntombstoneValidator request type.
+type MsgUntombstoneValidator struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// consensus_address is the consensus address of the tombstoned validator.
+	ConsensusAddress string `protobuf:"bytes,2,opt,name=consensus_address,json=consensusAddress,proto3" json:"consensus_address,omitempty"`
+	// refund is an optional amount paid from the community pool to the validator
+	// operator, e.g. to compensate the slashed amount.
+	Refund github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=refund,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"refund"`
+	// reason is the justification for the decision, recorded in state.
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *MsgUntombstoneValidator) Reset()         { *m = MsgUntombstoneValidator{} }
+func (m *MsgUntombstoneValidator) String() string { return proto.CompactTextString(m) }
+func (*MsgUntombstoneValidator) ProtoMessage()    {}
+func (*MsgUntombstoneValidator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3242cb23c956e0, []int{2}
+}
+func (m *MsgUntombstoneValidator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUntombstoneValidator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUntombstoneValidator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUntombstoneValidator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUntombstoneValidator.Merge(m, src)
+}
+func (m *MsgUntombstoneValidator) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUntombstoneValidator) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUntombstoneValidator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUntombstoneValidator proto.InternalMessageInfo
+
+func (m *MsgUntombstoneValidator) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUntombstoneValidator) GetConsensusAddress() string {
+	if m != nil {
+		return m.ConsensusAddress
+	}
+	return ""
+}
+
+func (m *MsgUntombstoneValidator) GetRefund() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Refund
+	}
+	return nil
+}
+
+func (m *MsgUntombstoneValidator) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// MsgUntombstoneValidatorResponse is the Msg/UntombstoneValidator response type.
+type MsgUntombstoneValidatorResponse struct {
+	// id is the identifier of the recorded decision.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgUntombstoneValidatorResponse) Reset()         { *m = MsgUntombstoneValidatorResponse{} }
+func (m *MsgUntombstoneValidatorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUntombstoneValidatorResponse) ProtoMessage()    {}
+func (*MsgUntombstoneValidatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3242cb23c956e0, []int{3}
+}
+func (m *MsgUntombstoneValidatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUntombstoneValidatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUntombstoneValidatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUntombstoneValidatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUntombstoneValidatorResponse.Merge(m, src)
+}
+func (m *MsgUntombstoneValidatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUntombstoneValidatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUntombstoneValidatorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUntombstoneValidatorResponse proto.InternalMessageInfo
+
+func (m *MsgUntombstoneValidatorResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*MsgSubmitEvidence)(nil), "cosmos.evidence.v1beta1.MsgSubmitEvidence")
 	proto.RegisterType((*MsgSubmitEvidenceResponse)(nil), "cosmos.evidence.v1beta1.MsgSubmitEvidenceResponse")
+	proto.RegisterType((*MsgUntombstoneValidator)(nil), "cosmos.evidence.v1beta1.MsgUntombstoneValidator")
+	proto.RegisterType((*MsgUntombstoneValidatorResponse)(nil), "cosmos.evidence.v1beta1.MsgUntombstoneValidatorResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/evidence/v1beta1/tx.proto", fileDescriptor_3e3242cb23c956e0) }
 
 var fileDescriptor_3e3242cb23c956e0 = []byte{
-	// 383 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2d, 0xcb, 0x4c, 0x49, 0xcd, 0x4b, 0x4e, 0xd5, 0x2f, 0x33, 0x4c, 0x4a,
-	0x2d, 0x49, 0x34, 0xd4, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x87, 0xa8,
-	0xd0, 0x83, 0xa9, 0xd0, 0x83, 0xaa, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xab, 0xd1, 0x07,
-	0xb1, 0x20, 0xca, 0xa5, 0x24, 0xd3, 0xf3, 0xf3, 0xd3, 0x73, 0x52, 0xf5, 0xc1, 0xbc, 0xa4, 0xd2,
-	0x34, 0xfd, 0xc4, 0xbc, 0x4a, 0x98, 0x14, 0xc4, 0xa4, 0x78, 0x88, 0x1e, 0xa8, 0xb1, 0x10, 0x29,
-	0xa8, 0x25, 0xfa, 0xb9, 0xc5, 0xe9, 0xfa, 0x65, 0x86, 0x20, 0x0a, 0x2a, 0x21, 0x98, 0x98, 0x9b,
-	0x99, 0x97, 0xaf, 0x0f, 0x26, 0x21, 0x42, 0x4a, 0x77, 0x18, 0xb9, 0x04, 0x7d, 0x8b, 0xd3, 0x83,
-	0x4b, 0x93, 0x72, 0x33, 0x4b, 0x5c, 0xa1, 0xae, 0x12, 0x32, 0xe3, 0xe2, 0x2c, 0x06, 0x8b, 0x94,
-	0xa4, 0x16, 0x49, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x3a, 0x49, 0x5c, 0xda, 0xa2, 0x2b, 0x02, 0xb5,
-	0xc6, 0x31, 0x25, 0xa5, 0x28, 0xb5, 0xb8, 0x38, 0xb8, 0xa4, 0x28, 0x33, 0x2f, 0x3d, 0x08, 0xa1,
-	0x54, 0x28, 0x8c, 0x8b, 0x03, 0xe6, 0x33, 0x09, 0x26, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x11, 0x3d,
-	0x88, 0x17, 0xf4, 0x60, 0x5e, 0xd0, 0x73, 0xcc, 0xab, 0x74, 0x52, 0x39, 0xb5, 0x45, 0x57, 0x01,
-	0x47, 0x50, 0xe8, 0xc1, 0x5c, 0x11, 0x04, 0x37, 0xcb, 0xca, 0xbc, 0x63, 0x81, 0x3c, 0xc3, 0x8b,
-	0x05, 0xf2, 0x0c, 0x4d, 0xcf, 0x37, 0x68, 0x21, 0xec, 0xeb, 0x7a, 0xbe, 0x41, 0x4b, 0x06, 0x62,
-	0x8c, 0x6e, 0x71, 0x4a, 0xb6, 0x3e, 0x86, 0x47, 0x94, 0xf4, 0xb9, 0x24, 0x31, 0x04, 0x83, 0x52,
-	0x8b, 0x0b, 0xf2, 0xf3, 0x8a, 0x53, 0x85, 0x84, 0xb8, 0x58, 0x32, 0x12, 0x8b, 0x33, 0x24, 0x58,
-	0x14, 0x18, 0x35, 0x78, 0x82, 0xc0, 0x6c, 0xa3, 0x3a, 0x2e, 0x66, 0xdf, 0xe2, 0x74, 0xa1, 0x02,
-	0x2e, 0x3e, 0xb4, 0x20, 0xd1, 0xd2, 0xc3, 0xe5, 0x5e, 0x0c, 0x0b, 0xa4, 0x8c, 0x88, 0x57, 0x0b,
-	0x73, 0x8c, 0x14, 0x6b, 0xc3, 0xf3, 0x0d, 0x5a, 0x8c, 0x4e, 0xd6, 0x2b, 0x1e, 0xc9, 0x31, 0x9e,
-	0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31,
-	0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x2c, 0xc4, 0xdc, 0xe2, 0x94, 0x6c,
-	0xbd, 0xcc, 0x7c, 0xfd, 0x0a, 0x44, 0x42, 0x2b, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0x07,
-	0xb2, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xde, 0xaf, 0x50, 0x22, 0x88, 0x02, 0x00, 0x00,
+	// 610 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xbd, 0x6f, 0xd3, 0x4e,
+	0x18, 0xb6, 0xd3, 0xfe, 0xaa, 0x5f, 0xae, 0x55, 0x45, 0xad, 0x88, 0xa6, 0x11, 0x38, 0x26, 0x62,
+	0x88, 0x22, 0xc5, 0x47, 0x82, 0xc4, 0x47, 0x99, 0x9a, 0x0a, 0xb6, 0x32, 0xb8, 0xa2, 0x03, 0x4b,
+	0xe4, 0x8f, 0xab, 0x73, 0x6a, 0x7c, 0x17, 0xf9, 0xce, 0x51, 0xbd, 0xa1, 0xb2, 0x20, 0x26, 0x66,
+	0xa6, 0x8e, 0x15, 0x53, 0x86, 0xfc, 0x11, 0x15, 0x53, 0xd5, 0x89, 0x01, 0x01, 0x4a, 0x86, 0xb0,
+	0xf1, 0x2f, 0x20, 0xdb, 0x67, 0x87, 0x36, 0x09, 0x1f, 0x8b, 0x7d, 0xf7, 0xbe, 0xcf, 0xfb, 0xf5,
+	0xbc, 0x8f, 0x0d, 0x34, 0x9b, 0x32, 0x8f, 0x32, 0x88, 0xfa, 0xd8, 0x41, 0xc4, 0x46, 0xb0, 0xdf,
+	0xb0, 0x10, 0x37, 0x1b, 0x90, 0x1f, 0xeb, 0x3d, 0x9f, 0x72, 0xaa, 0x6c, 0x26, 0x08, 0x3d, 0x45,
+	0xe8, 0x02, 0x51, 0x2a, 0xb8, 0xd4, 0xa5, 0x31, 0x06, 0x46, 0xa7, 0x04, 0x5e, 0xda, 0x72, 0x29,
+	0x75, 0xbb, 0x08, 0xc6, 0x37, 0x2b, 0x38, 0x84, 0x26, 0x09, 0x53, 0x57, 0x92, 0xa9, 0x9d, 0xc4,
+	0x88, 0xb4, 0x89, 0x4b, 0x14, 0x81, 0x1e, 0x73, 0x61, 0xbf, 0x11, 0xbd, 0x84, 0x63, 0xc3, 0xf4,
+	0x30, 0xa1, 0x30, 0x7e, 0x0a, 0x93, 0x2a, 0xb0, 0x96, 0xc9, 0xa6, 0xed, 0xda, 0x14, 0x93, 0xc4,
+	0x5f, 0xf9, 0x2c, 0x83, 0x8d, 0x3d, 0xe6, 0xee, 0x07, 0x96, 0x87, 0xf9, 0x53, 0xd1, 0xb5, 0xf2,
+	0x00, 0xe4, 0x59, 0x6c, 0xe1, 0xc8, 0x2f, 0xca, 0x9a, 0x5c, 0xcd, 0xb7, 0x8a, 0x97, 0xc3, 0x7a,
+	0x41, 0xb4, 0xb1, 0xe3, 0x38, 0x3e, 0x62, 0x6c, 0x9f, 0xfb, 0x98, 0xb8, 0xc6, 0x14, 0xaa, 0x1c,
+	0x80, 0xff, 0xd3, 0xc9, 0x8b, 0x39, 0x4d, 0xae, 0xae, 0x36, 0x0b, 0x7a, 0x32, 0xa2, 0x9e, 0x8e,
+	0xa8, 0xef, 0x90, 0xb0, 0x75, 0xf7, 0xe3, 0xb0, 0xae, 0x2d, 0xa0, 0x4a, 0x4f, 0xbb, 0x30, 0xb2,
+	0x5c, 0xdb, 0x0f, 0xdf, 0x9c, 0x96, 0xa5, 0xef, 0xa7, 0x65, 0xe9, 0x64, 0x32, 0xa8, 0x4d, 0xeb,
+	0xbd, 0x9d, 0x0c, 0x6a, 0xb7, 0x92, 0x34, 0x75, 0xe6, 0x1c, 0xc1, 0x99, 0x41, 0x2a, 0x10, 0x6c,
+	0xcd, 0x18, 0x0d, 0xc4, 0x7a, 0x94, 0x30, 0xa4, 0x28, 0x60, 0xb9, 0x63, 0xb2, 0x4e, 0x71, 0x59,
+	0x93, 0xab, 0x6b, 0x46, 0x7c, 0xae, 0xfc, 0xc8, 0x81, 0xcd, 0x3d, 0xe6, 0xbe, 0x20, 0x9c, 0x7a,
+	0x16, 0xe3, 0x94, 0xa0, 0x03, 0xb3, 0x8b, 0x1d, 0x93, 0x53, 0x3f, 0x62, 0xc5, 0x0c, 0x78, 0x87,
+	0xfa, 0x98, 0x87, 0x7f, 0x66, 0x25, 0x83, 0x2a, 0xcf, 0xc1, 0x86, 0x1d, 0x15, 0x24, 0x2c, 0x60,
+	0x6d, 0x33, 0x41, 0xc5, 0xf4, 0xe4, 0x5b, 0x77, 0x2e, 0x87, 0xf5, 0xdb, 0x22, 0x7e, 0x37, 0xc5,
+	0x5c, 0x4d, 0x74, 0xc3, 0xbe, 0x66, 0x57, 0x42, 0xb0, 0xe2, 0xa3, 0xc3, 0x80, 0x38, 0xc5, 0x25,
+	0x6d, 0xa9, 0xba, 0xda, 0xdc, 0xd2, 0x45, 0x86, 0x68, 0xc9, 0x19, 0x8d, 0xbb, 0x14, 0x93, 0xd6,
+	0xb3, 0xf3, 0x2f, 0x65, 0xe9, 0xc3, 0xd7, 0x72, 0xd5, 0xc5, 0xbc, 0x13, 0x58, 0xba, 0x4d, 0x3d,
+	0xa1, 0x25, 0xf8, 0x0b, 0x6f, 0x3c, 0xec, 0x21, 0x16, 0x07, 0xb0, 0xf7, 0x93, 0x41, 0x6d, 0xad,
+	0x8b, 0x5c, 0xd3, 0x0e, 0xdb, 0x91, 0x4c, 0xd8, 0xd9, 0x64, 0x50, 0x93, 0x0d, 0x51, 0x50, 0xb9,
+	0x19, 0x95, 0x36, 0x19, 0x25, 0x31, 0x69, 0x79, 0x43, 0xdc, 0xb6, 0x1f, 0x67, 0xcb, 0xc9, 0xc6,
+	0x8e, 0x96, 0x53, 0xb9, 0xba, 0x9c, 0x79, 0xac, 0x56, 0x1a, 0xa0, 0xbc, 0xc0, 0x95, 0x2d, 0x6a,
+	0x1d, 0xe4, 0xb0, 0x13, 0x33, 0xbe, 0x6c, 0xe4, 0xb0, 0xd3, 0x7c, 0x9d, 0x03, 0x4b, 0x7b, 0xcc,
+	0x55, 0x7a, 0x60, 0xfd, 0x9a, 0x70, 0x6b, 0xfa, 0x22, 0x55, 0xcd, 0xc8, 0xa0, 0xd4, 0xfc, 0x7b,
+	0x6c, 0xd6, 0xc9, 0x89, 0x0c, 0x0a, 0x73, 0xb5, 0x71, 0xef, 0x77, 0xc9, 0xe6, 0x45, 0x94, 0x1e,
+	0xfd, 0x6b, 0x44, 0xda, 0x44, 0xe9, 0xbf, 0x57, 0xd1, 0x4e, 0x5a, 0x4f, 0xce, 0x46, 0xaa, 0x7c,
+	0x3e, 0x52, 0xe5, 0x8b, 0x91, 0x2a, 0x7f, 0x1b, 0xa9, 0xf2, 0xbb, 0xb1, 0x2a, 0x5d, 0x8c, 0x55,
+	0xe9, 0xd3, 0x58, 0x95, 0x5e, 0x0a, 0x55, 0x31, 0xe7, 0x48, 0xc7, 0x14, 0x1e, 0x4f, 0xff, 0x59,
+	0xf1, 0xb2, 0xad, 0x95, 0xf8, 0x7b, 0xbc, 0xff, 0x73, 0x00, 0xb5, 0x53, 0xd6, 0xec, 0xd3, 0x04,
+	0x00, 0x00,
 }
 
 func (this *MsgSubmitEvidenceResponse) Equal(that interface{}) bool {
@@ -180,6 +319,30 @@ func (this *MsgSubmitEvidenceResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MsgUntombstoneValidatorResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgUntombstoneValidatorResponse)
+	if !ok {
+		that2, ok := that.(MsgUntombstoneValidatorResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	return true
+}
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
@@ -196,6 +359,10 @@ type MsgClient interface {
 	// SubmitEvidence submits an arbitrary Evidence of misbehavior such as equivocation or
 	// counterfactual signing.
 	SubmitEvidence(ctx context.Context, in *MsgSubmitEvidence, opts ...grpc.CallOption) (*MsgSubmitEvidenceResponse, error)
+	// UntombstoneValidator defines a governance operation for lifting the tombstone
+	// of a validator, optionally refunding it from the community pool. The authority
+	// defaults to the x/gov module account.
+	UntombstoneValidator(ctx context.Context, in *MsgUntombstoneValidator, opts ...grpc.CallOption) (*MsgUntombstoneValidatorResponse, error)
 }
 
 type msgClient struct {
@@ -215,11 +382,24 @@ func (c *msgClient) SubmitEvidence(ctx context.Context, in *MsgSubmitEvidence, o
 	return out, nil
 }
 
+func (c *msgClient) UntombstoneValidator(ctx context.Context, in *MsgUntombstoneValidator, opts ...grpc.CallOption) (*MsgUntombstoneValidatorResponse, error) {
+	out := new(MsgUntombstoneValidatorResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evidence.v1beta1.Msg/UntombstoneValidator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// SubmitEvidence submits an arbitrary Evidence of misbehavior such as equivocation or
 	// counterfactual signing.
 	SubmitEvidence(context.Context, *MsgSubmitEvidence) (*MsgSubmitEvidenceResponse, error)
+	// UntombstoneValidator defines a governance operation for lifting the tombstone
+	// of a validator, optionally refunding it from the community pool. The authority
+	// defaults to the x/gov module account.
+	UntombstoneValidator(context.Context, *MsgUntombstoneValidator) (*MsgUntombstoneValidatorResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -229,6 +409,9 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) SubmitEvidence(ctx context.Context, req *MsgSubmitEvidence) (*MsgSubmitEvidenceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitEvidence not implemented")
 }
+func (*UnimplementedMsgServer) UntombstoneValidator(ctx context.Context, req *MsgUntombstoneValidator) (*MsgUntombstoneValidatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UntombstoneValidator not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -252,6 +435,24 @@ func _Msg_SubmitEvidence_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UntombstoneValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUntombstoneValidator)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UntombstoneValidator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evidence.v1beta1.Msg/UntombstoneValidator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UntombstoneValidator(ctx, req.(*MsgUntombstoneValidator))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.evidence.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -260,6 +461,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SubmitEvidence",
 			Handler:    _Msg_SubmitEvidence_Handler,
 		},
+		{
+			MethodName: "UntombstoneValidator",
+			Handler:    _Msg_UntombstoneValidator_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/evidence/v1beta1/tx.proto",
@@ -337,6 +542,92 @@ func (m *MsgSubmitEvidenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUntombstoneValidator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUntombstoneValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUntombstoneValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Refund) > 0 {
+		for iNdEx := len(m.Refund) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Refund[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ConsensusAddress) > 0 {
+		i -= len(m.ConsensusAddress)
+		copy(dAtA[i:], m.ConsensusAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConsensusAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUntombstoneValidatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUntombstoneValidatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUntombstoneValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -378,6 +669,45 @@ func (m *MsgSubmitEvidenceResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUntombstoneValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ConsensusAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Refund) > 0 {
+		for _, e := range m.Refund {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUntombstoneValidatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -586,6 +916,255 @@ func (m *MsgSubmitEvidenceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUntombstoneValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUntombstoneValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUntombstoneValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsensusAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refund", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Refund = append(m.Refund, types.Coin{})
+			if err := m.Refund[len(m.Refund)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUntombstoneValidatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUntombstoneValidatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUntombstoneValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
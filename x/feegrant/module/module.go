@@ -9,6 +9,7 @@ import (
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/legacy"
 	"cosmossdk.io/core/registry"
@@ -25,6 +26,7 @@ import (
 
 var (
 	_ module.HasName             = AppModule{}
+	_ module.HasStateSchema      = AppModule{}
 	_ module.HasAminoCodec       = AppModule{}
 	_ module.HasGRPCGateway      = AppModule{}
 	_ module.AppModuleSimulation = AppModule{}
@@ -149,6 +151,11 @@ func (am AppModule) ExportGenesis(ctx context.Context) (json.RawMessage, error)
 // ConsensusVersion implements HasConsensusVersion
 func (AppModule) ConsensusVersion() uint64 { return 2 }
 
+// RegisterStateSchema registers the collections schema of the state of the feegrant module.
+func (am AppModule) RegisterStateSchema(decoder collections.StateDecoder) {
+	decoder[feegrant.StoreKey] = am.keeper.Schema
+}
+
 // EndBlock returns the end blocker for the feegrant module.
 func (am AppModule) EndBlock(ctx context.Context) error {
 	return EndBlocker(ctx, am.keeper)
@@ -94,6 +94,34 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "constitution",
 					Short:     "Query the current chain constitution",
 				},
+				{
+					RpcMethod: "SimulateProposal",
+					Use:       "simulate-proposal [proposal-id]",
+					Short:     "Simulate the execution of the messages of a proposal against the current state",
+					Long:      fmt.Sprintf("Simulate the execution of the messages of a proposal against the current state, as if the proposal had passed. No state change is committed. To simulate a proposal before submitting it, use \"%s tx gov submit-proposal --dry-run\".", version.AppName),
+					Example:   fmt.Sprintf("%s query gov simulate-proposal 1", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "proposal_id"},
+					},
+				},
+				{
+					RpcMethod: "VoteDelegation",
+					Use:       "vote-delegation [delegator]",
+					Short:     "Query the vote delegation of an account and the account representing it",
+					Example:   fmt.Sprintf("%s query gov vote-delegation cosmos1...", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "delegator"},
+					},
+				},
+				{
+					RpcMethod: "VoteDelegators",
+					Use:       "vote-delegators [delegate]",
+					Short:     "Query the accounts delegating their voting power to an account",
+					Example:   fmt.Sprintf("%s query gov vote-delegators cosmos1...", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "delegate"},
+					},
+				},
 			},
 			EnhanceCustomCommand: true, // We still have manual commands in gov that we want to keep
 		},
@@ -134,6 +162,22 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						"metadata": {Name: "metadata", Usage: "Add a description to the vote"},
 					},
 				},
+				{
+					RpcMethod: "DelegateVote",
+					Use:       "delegate-vote [delegate]",
+					Short:     "Delegate your governance voting power to another account",
+					Long:      "Delegate your governance voting power to another account, independently of your staking delegations. The delegate votes on your behalf on every proposal you do not vote on directly.",
+					Example:   fmt.Sprintf("$ %s tx gov delegate-vote cosmos1... --from mykey", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "delegate"},
+					},
+				},
+				{
+					RpcMethod: "UndelegateVote",
+					Use:       "undelegate-vote",
+					Short:     "Revoke the delegation of your governance voting power",
+					Example:   fmt.Sprintf("$ %s tx gov undelegate-vote --from mykey", version.AppName),
+				},
 				{
 					RpcMethod:      "UpdateParams",
 					Use:            "update-params-proposal [params]",
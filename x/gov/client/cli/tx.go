@@ -123,6 +123,9 @@ metadata example:
 	"proposal_forum_url": "",
 	"vote_option_context": "",
 }
+
+With --dry-run, the proposal is not submitted. Its messages are instead executed against the current
+state of the chain, as if the proposal had passed, and the result of each message is printed.
 `,
 				version.AppName,
 			),
@@ -148,6 +151,20 @@ metadata example:
 				return fmt.Errorf("invalid message: %w", err)
 			}
 
+			if clientCtx.Simulate {
+				req, err := v1.NewQuerySimulateProposalRequest(msgs, 0)
+				if err != nil {
+					return err
+				}
+
+				res, err := v1.NewQueryClient(clientCtx).SimulateProposal(cmd.Context(), req)
+				if err != nil {
+					return err
+				}
+
+				return clientCtx.PrintProto(res)
+			}
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
@@ -16,6 +16,7 @@ import (
 	govclient "cosmossdk.io/x/gov/client"
 	"cosmossdk.io/x/gov/keeper"
 	govtypes "cosmossdk.io/x/gov/types"
+	v1 "cosmossdk.io/x/gov/types/v1"
 	"cosmossdk.io/x/gov/types/v1beta1"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -33,6 +34,17 @@ func init() {
 		appconfig.Provide(ProvideModule))
 }
 
+// TallyStrategy registers a custom tally strategy with the gov module through dependency injection.
+// The strategy is selected per message type with the message based params, or per proposal type
+// with the module config.
+type TallyStrategy struct {
+	Name string
+	Fn   keeper.TallyStrategyFn
+}
+
+// IsManyPerContainerType implements the depinject.ManyPerContainerType interface.
+func (TallyStrategy) IsManyPerContainerType() {}
+
 type ModuleInputs struct {
 	depinject.In
 
@@ -41,6 +53,7 @@ type ModuleInputs struct {
 	Environment           appmodule.Environment
 	ModuleKey             depinject.OwnModuleKey
 	LegacyProposalHandler []govclient.ProposalHandler `optional:"true"`
+	TallyStrategies       []TallyStrategy             `optional:"true"`
 
 	AccountKeeper govtypes.AccountKeeper
 	BankKeeper    govtypes.BankKeeper
@@ -67,6 +80,31 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 	if in.Config.MaxSummaryLen != 0 {
 		defaultConfig.MaxSummaryLen = in.Config.MaxSummaryLen
 	}
+	if in.Config.MaxVoteDelegationDepth != 0 {
+		defaultConfig.MaxVoteDelegationDepth = in.Config.MaxVoteDelegationDepth
+	}
+	if in.Config.MaxSimulateProposalGas != 0 {
+		defaultConfig.MaxSimulateProposalGas = in.Config.MaxSimulateProposalGas
+	}
+	if len(in.TallyStrategies) > 0 {
+		defaultConfig.TallyStrategies = make(map[string]keeper.TallyStrategyFn, len(in.TallyStrategies))
+		for _, strategy := range in.TallyStrategies {
+			if _, ok := defaultConfig.TallyStrategies[strategy.Name]; ok {
+				panic(fmt.Sprintf("tally strategy %s registered twice", strategy.Name))
+			}
+			defaultConfig.TallyStrategies[strategy.Name] = strategy.Fn
+		}
+	}
+	if len(in.Config.ProposalTypeTallyStrategies) > 0 {
+		defaultConfig.ProposalTypeTallyStrategies = make(map[v1.ProposalType]string, len(in.Config.ProposalTypeTallyStrategies))
+		for proposalType, name := range in.Config.ProposalTypeTallyStrategies {
+			pt, ok := v1.ProposalType_value[proposalType]
+			if !ok {
+				panic(fmt.Sprintf("unknown proposal type %s", proposalType))
+			}
+			defaultConfig.ProposalTypeTallyStrategies[v1.ProposalType(pt)] = name
+		}
+	}
 	if in.LegacyProposalHandler == nil {
 		in.LegacyProposalHandler = []govclient.ProposalHandler{}
 	}
@@ -55,6 +55,23 @@ func InitGenesis(ctx context.Context, ak types.AccountKeeper, bk types.BankKeepe
 		}
 	}
 
+	for _, delegation := range data.VoteDelegations {
+		delegator, err := ak.AddressCodec().StringToBytes(delegation.Delegator)
+		if err != nil {
+			return err
+		}
+		delegate, err := ak.AddressCodec().StringToBytes(delegation.Delegate)
+		if err != nil {
+			return err
+		}
+		if err := k.VoteDelegations.Set(ctx, delegator, *delegation); err != nil {
+			return err
+		}
+		if err := k.VoteDelegators.Set(ctx, collections.Join(sdk.AccAddress(delegate), sdk.AccAddress(delegator))); err != nil {
+			return err
+		}
+	}
+
 	for _, proposal := range data.Proposals {
 		switch proposal.Status {
 		case v1.StatusDepositPeriod:
@@ -131,6 +148,15 @@ func ExportGenesis(ctx context.Context, k *keeper.Keeper) (*v1.GenesisState, err
 		return nil, err
 	}
 
+	var voteDelegations []*v1.VoteDelegation
+	err = k.VoteDelegations.Walk(ctx, nil, func(_ sdk.AccAddress, value v1.VoteDelegation) (stop bool, err error) {
+		voteDelegations = append(voteDelegations, &value)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return &v1.GenesisState{
 		StartingProposalId: startingProposalID,
 		Deposits:           proposalsDeposits,
@@ -138,5 +164,6 @@ func ExportGenesis(ctx context.Context, k *keeper.Keeper) (*v1.GenesisState, err
 		Proposals:          proposals,
 		Params:             &params,
 		Constitution:       constitution,
+		VoteDelegations:    voteDelegations,
 	}, nil
 }
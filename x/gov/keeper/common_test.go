@@ -115,62 +115,7 @@ func setupGovKeeper(t *testing.T, expectations ...func(sdk.Context, mocks)) (
 	sdk.Context,
 ) {
 	t.Helper()
-	key := storetypes.NewKVStoreKey(types.StoreKey)
-	storeService := runtime.NewKVStoreService(key)
-	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
-	ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Time: time.Now()})
-	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{})
-	v1.RegisterInterfaces(encCfg.InterfaceRegistry)
-	v1beta1.RegisterInterfaces(encCfg.InterfaceRegistry)
-	banktypes.RegisterInterfaces(encCfg.InterfaceRegistry)
-
-	baseApp := baseapp.NewBaseApp(
-		"authz",
-		log.NewNopLogger(),
-		testCtx.DB,
-		encCfg.TxConfig.TxDecoder(),
-	)
-	baseApp.SetCMS(testCtx.CMS)
-	baseApp.SetInterfaceRegistry(encCfg.InterfaceRegistry)
-
-	environment := runtime.NewEnvironment(storeService, log.NewNopLogger(), runtime.EnvWithQueryRouterService(baseApp.GRPCQueryRouter()), runtime.EnvWithMsgRouterService(baseApp.MsgServiceRouter()))
-
-	// gomock initializations
-	ctrl := gomock.NewController(t)
-	m := mocks{
-		acctKeeper:    govtestutil.NewMockAccountKeeper(ctrl),
-		bankKeeper:    govtestutil.NewMockBankKeeper(ctrl),
-		stakingKeeper: govtestutil.NewMockStakingKeeper(ctrl),
-		poolKeeper:    govtestutil.NewMockPoolKeeper(ctrl),
-	}
-	if len(expectations) == 0 {
-		err := mockDefaultExpectations(ctx, m)
-		require.NoError(t, err)
-	} else {
-		for _, exp := range expectations {
-			exp(ctx, m)
-		}
-	}
-
-	govAddr, err := m.acctKeeper.AddressCodec().BytesToString(govAcct)
-	require.NoError(t, err)
-
-	// Gov keeper initializations
-	govKeeper := keeper.NewKeeper(encCfg.Codec, environment, m.acctKeeper, m.bankKeeper, m.stakingKeeper, m.poolKeeper, keeper.DefaultConfig(), govAddr)
-	require.NoError(t, govKeeper.ProposalID.Set(ctx, 1))
-	govRouter := v1beta1.NewRouter() // Also register legacy gov handlers to test them too.
-	govRouter.AddRoute(types.RouterKey, v1beta1.ProposalHandler)
-	govKeeper.SetLegacyRouter(govRouter)
-	err = govKeeper.Params.Set(ctx, v1.DefaultParams())
-	require.NoError(t, err)
-	err = govKeeper.Constitution.Set(ctx, "constitution")
-	require.NoError(t, err)
-
-	// Register all handlers for the MegServiceRouter.
-	v1.RegisterMsgServer(baseApp.MsgServiceRouter(), keeper.NewMsgServerImpl(govKeeper))
-	banktypes.RegisterMsgServer(baseApp.MsgServiceRouter(), nil) // Nil is fine here as long as we never execute the proposal's Msgs.
-
-	return govKeeper, m, encCfg, ctx
+	return setupGovKeeperWithConfig(t, keeper.DefaultConfig(), expectations...)
 }
 
 // setupGovKeeperWithMaxVoteOptionsLen creates a govKeeper with a defined maxVoteOptionsLen, as well as all its dependencies.
@@ -179,6 +124,20 @@ func setupGovKeeperWithMaxVoteOptionsLen(t *testing.T, maxVoteOptionsLen uint64,
 	mocks,
 	moduletestutil.TestEncodingConfig,
 	sdk.Context,
+) {
+	t.Helper()
+	config := keeper.DefaultConfig()
+	config.MaxVoteOptionsLen = maxVoteOptionsLen
+
+	return setupGovKeeperWithConfig(t, config, expectations...)
+}
+
+// setupGovKeeperWithConfig creates a govKeeper with the given config, as well as all its dependencies.
+func setupGovKeeperWithConfig(t *testing.T, config keeper.Config, expectations ...func(sdk.Context, mocks)) (
+	*keeper.Keeper,
+	mocks,
+	moduletestutil.TestEncodingConfig,
+	sdk.Context,
 ) {
 	t.Helper()
 	key := storetypes.NewKVStoreKey(types.StoreKey)
@@ -221,9 +180,6 @@ func setupGovKeeperWithMaxVoteOptionsLen(t *testing.T, maxVoteOptionsLen uint64,
 	govAddr, err := m.acctKeeper.AddressCodec().BytesToString(govAcct)
 	require.NoError(t, err)
 
-	config := keeper.DefaultConfig()
-	config.MaxVoteOptionsLen = maxVoteOptionsLen
-
 	// Gov keeper initializations
 	govKeeper := keeper.NewKeeper(encCfg.Codec, environment, m.acctKeeper, m.bankKeeper, m.stakingKeeper, m.poolKeeper, config, govAddr)
 	require.NoError(t, govKeeper.ProposalID.Set(ctx, 1))
@@ -18,6 +18,21 @@ type CalculateVoteResultsAndVotingPowerFn func(
 	validators map[string]v1.ValidatorGovInfo,
 ) (totalVoterPower math.LegacyDec, results map[v1.VoteOption]math.LegacyDec, err error)
 
+// TallyStrategyFn is a function signature for custom tally strategies.
+// It replaces both the vote results calculation and the quorum and threshold rules of a proposal.
+// It gets the proposal tallied, the validators governance infos (bonded tokens, voting power, etc.),
+// the total bonded tokens and the tally params applying to the proposal.
+// It must return whether the proposal passes, whether its deposits are burned and the tally results.
+// A tally strategy is responsible for removing the votes of the proposal, which VotingPowers does.
+type TallyStrategyFn func(
+	ctx context.Context,
+	keeper Keeper,
+	proposal v1.Proposal,
+	validators map[string]v1.ValidatorGovInfo,
+	totalBonded math.Int,
+	params TallyParams,
+) (passes, burnDeposits bool, tallyResults v1.TallyResult, err error)
+
 // Config is a config struct used for initializing the gov module to avoid using globals.
 type Config struct {
 	// MaxTitleLen defines the amount of characters that can be used for proposal title
@@ -30,9 +45,21 @@ type Config struct {
 	// This only applies to WeightedVoteOption messages and not to the VoteOption messages
 	// 0 means this param is disabled, hence all supported options are allowed
 	MaxVoteOptionsLen uint64
+	// MaxVoteDelegationDepth defines the maximum length of the vote delegation chains followed when tallying.
+	// An account inherits the vote of the first account voting in its delegation chain within this depth.
+	MaxVoteDelegationDepth uint64
+	// MaxSimulateProposalGas defines the maximum gas limit of the execution of each message of a proposal
+	// simulated by the SimulateProposal query. It bounds the work a public query can make a node do.
+	MaxSimulateProposalGas uint64
 	// CalculateVoteResultsAndVotingPowerFn is a function signature for calculating vote results and voting power
 	// Keeping it nil will use the default implementation
 	CalculateVoteResultsAndVotingPowerFn CalculateVoteResultsAndVotingPowerFn
+	// TallyStrategies defines the custom tally strategies by name.
+	// A strategy is selected per message type through the message based params, or per proposal type.
+	TallyStrategies map[string]TallyStrategyFn
+	// ProposalTypeTallyStrategies defines the name of the tally strategy used per proposal type.
+	// The tally strategy of the message based params of a proposal takes precedence.
+	ProposalTypeTallyStrategies map[v1.ProposalType]string
 }
 
 // DefaultConfig returns the default config for gov.
@@ -42,6 +69,10 @@ func DefaultConfig() Config {
 		MaxMetadataLen:                       255,
 		MaxSummaryLen:                        10200,
 		MaxVoteOptionsLen:                    0, // 0 means this param is disabled, hence all supported options are allowed
+		MaxVoteDelegationDepth:               3,
+		MaxSimulateProposalGas:               10_000_000,
 		CalculateVoteResultsAndVotingPowerFn: nil,
+		TallyStrategies:                      nil,
+		ProposalTypeTallyStrategies:          nil,
 	}
 }
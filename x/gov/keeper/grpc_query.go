@@ -16,6 +16,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
 )
 
 var (
@@ -206,6 +207,108 @@ func (q queryServer) Votes(ctx context.Context, req *v1.QueryVotesRequest) (*v1.
 	return &v1.QueryVotesResponse{Votes: votes, Pagination: pageRes}, nil
 }
 
+// VoteDelegation queries the vote delegation of an account and the account representing it
+func (q queryServer) VoteDelegation(ctx context.Context, req *v1.QueryVoteDelegationRequest) (*v1.QueryVoteDelegationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.Delegator == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty delegator address")
+	}
+
+	delegator, err := q.k.authKeeper.AddressCodec().StringToBytes(req.Delegator)
+	if err != nil {
+		return nil, err
+	}
+
+	delegation, err := q.k.VoteDelegations.Get(ctx, delegator)
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "vote delegation of %s not found", req.Delegator)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	representative, err := q.k.VoteRepresentative(ctx, delegator)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	representativeStr, err := q.k.authKeeper.AddressCodec().BytesToString(representative)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryVoteDelegationResponse{VoteDelegation: &delegation, Representative: representativeStr}, nil
+}
+
+// VoteDelegators queries the accounts directly delegating their voting power to an account
+func (q queryServer) VoteDelegators(ctx context.Context, req *v1.QueryVoteDelegatorsRequest) (*v1.QueryVoteDelegatorsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.Delegate == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty delegate address")
+	}
+
+	delegate, err := q.k.authKeeper.AddressCodec().StringToBytes(req.Delegate)
+	if err != nil {
+		return nil, err
+	}
+
+	delegations, pageRes, err := query.CollectionPaginate(ctx, q.k.VoteDelegators, req.Pagination, func(key collections.Pair[sdk.AccAddress, sdk.AccAddress], _ collections.NoValue) (*v1.VoteDelegation, error) {
+		delegation, err := q.k.VoteDelegations.Get(ctx, key.K2())
+		if err != nil {
+			return nil, err
+		}
+		return &delegation, nil
+	}, query.WithCollectionPaginationPairPrefix[sdk.AccAddress, sdk.AccAddress](delegate))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryVoteDelegatorsResponse{VoteDelegations: delegations, Pagination: pageRes}, nil
+}
+
+// SimulateProposal executes the messages of a proposal against a branch of the current state
+func (q queryServer) SimulateProposal(ctx context.Context, req *v1.QuerySimulateProposalRequest) (*v1.QuerySimulateProposalResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var (
+		messages []sdk.Msg
+		err      error
+	)
+	if req.ProposalId != 0 {
+		proposal, err := q.k.Proposals.Get(ctx, req.ProposalId)
+		if err != nil {
+			if errors.IsOf(err, collections.ErrNotFound) {
+				return nil, status.Errorf(codes.NotFound, "proposal %d doesn't exist", req.ProposalId)
+			}
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		messages, err = proposal.GetMsgs()
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	} else {
+		messages, err = sdktx.GetMsgs(req.Messages, "sdk.Msg")
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	if len(messages) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "no messages to simulate")
+	}
+
+	return q.k.SimulateProposal(ctx, messages, req.GasLimit)
+}
+
 // Params queries all params
 func (q queryServer) Params(ctx context.Context, req *v1.QueryParamsRequest) (*v1.QueryParamsResponse, error) {
 	if req == nil {
@@ -2041,3 +2041,62 @@ func (suite *KeeperTestSuite) TestProposalVoteOptions() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestGRPCQueryVoteDelegation() {
+	suite.reset()
+	ctx, queryClient, addrs := suite.ctx, suite.queryClient, suite.addrs
+	addrStrs := make([]string, 3)
+	for i := range addrStrs {
+		addr, err := suite.acctKeeper.AddressCodec().BytesToString(addrs[i])
+		suite.Require().NoError(err)
+		addrStrs[i] = addr
+	}
+
+	_, err := queryClient.VoteDelegation(gocontext.Background(), &v1.QueryVoteDelegationRequest{})
+	suite.Require().ErrorContains(err, "empty delegator address")
+	_, err = queryClient.VoteDelegation(gocontext.Background(), &v1.QueryVoteDelegationRequest{Delegator: addrStrs[0]})
+	suite.Require().ErrorContains(err, "not found")
+
+	suite.Require().NoError(suite.govKeeper.DelegateVote(ctx, addrs[0], addrs[1]))
+	suite.Require().NoError(suite.govKeeper.DelegateVote(ctx, addrs[1], addrs[2]))
+
+	res, err := queryClient.VoteDelegation(gocontext.Background(), &v1.QueryVoteDelegationRequest{Delegator: addrStrs[0]})
+	suite.Require().NoError(err)
+	suite.Require().Equal(addrStrs[1], res.VoteDelegation.Delegate)
+	suite.Require().Equal(addrStrs[2], res.Representative)
+
+	delegators, err := queryClient.VoteDelegators(gocontext.Background(), &v1.QueryVoteDelegatorsRequest{Delegate: addrStrs[2]})
+	suite.Require().NoError(err)
+	suite.Require().Len(delegators.VoteDelegations, 1)
+	suite.Require().Equal(addrStrs[1], delegators.VoteDelegations[0].Delegator)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQuerySimulateProposal() {
+	suite.reset()
+	ctx, queryClient := suite.ctx, suite.queryClient
+	authority, err := suite.acctKeeper.AddressCodec().BytesToString(govAcct)
+	suite.Require().NoError(err)
+	params, err := suite.govKeeper.Params.Get(ctx)
+	suite.Require().NoError(err)
+	msgs := []sdk.Msg{&v1.MsgUpdateParams{Authority: authority, Params: params}}
+
+	_, err = queryClient.SimulateProposal(gocontext.Background(), &v1.QuerySimulateProposalRequest{})
+	suite.Require().ErrorContains(err, "no messages to simulate")
+
+	_, err = queryClient.SimulateProposal(gocontext.Background(), &v1.QuerySimulateProposalRequest{ProposalId: 42})
+	suite.Require().ErrorContains(err, "proposal 42 doesn't exist")
+
+	req, err := v1.NewQuerySimulateProposalRequest(msgs, 0)
+	suite.Require().NoError(err)
+	res, err := queryClient.SimulateProposal(gocontext.Background(), req)
+	suite.Require().NoError(err)
+	suite.Require().True(res.Success)
+	suite.Require().Len(res.Results, 1)
+
+	proposal, err := suite.govKeeper.SubmitProposal(ctx, msgs, "", "title", "summary", suite.addrs[0], v1.ProposalType_PROPOSAL_TYPE_STANDARD)
+	suite.Require().NoError(err)
+	res, err = queryClient.SimulateProposal(gocontext.Background(), &v1.QuerySimulateProposalRequest{ProposalId: proposal.Id})
+	suite.Require().NoError(err)
+	suite.Require().True(res.Success)
+	suite.Require().Equal(sdk.MsgTypeURL(msgs[0]), res.Results[0].MsgTypeUrl)
+}
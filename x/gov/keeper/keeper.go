@@ -65,6 +65,11 @@ type Keeper struct {
 	ActiveProposalsQueue collections.Map[collections.Pair[time.Time, uint64], uint64] // TODO(tip): this should be simplified and go into an index.
 	// InactiveProposalsQueue key: depositEndTime+proposalID | value: proposalID
 	InactiveProposalsQueue collections.Map[collections.Pair[time.Time, uint64], uint64] // TODO(tip): this should be simplified and go into an index.
+	// VoteDelegations key: delegatorAddr | value: VoteDelegation
+	VoteDelegations collections.Map[sdk.AccAddress, v1.VoteDelegation]
+	// VoteDelegators key: delegateAddr+delegatorAddr
+	// This is used to query the accounts delegating their voting power to an account
+	VoteDelegators collections.KeySet[collections.Pair[sdk.AccAddress, sdk.AccAddress]]
 }
 
 // GetAuthority returns the x/gov module's authority.
@@ -110,6 +115,20 @@ func NewKeeper(
 	if config.MaxVoteOptionsLen == 0 {
 		config.MaxVoteOptionsLen = defaultConfig.MaxVoteOptionsLen
 	}
+	// If MaxVoteDelegationDepth not set by app developer, set to default value.
+	if config.MaxVoteDelegationDepth == 0 {
+		config.MaxVoteDelegationDepth = defaultConfig.MaxVoteDelegationDepth
+	}
+	// If MaxSimulateProposalGas not set by app developer, set to default value.
+	if config.MaxSimulateProposalGas == 0 {
+		config.MaxSimulateProposalGas = defaultConfig.MaxSimulateProposalGas
+	}
+
+	for proposalType, name := range config.ProposalTypeTallyStrategies {
+		if _, ok := config.TallyStrategies[name]; !ok {
+			panic(fmt.Sprintf("tally strategy %s of proposal type %s is not registered", name, proposalType))
+		}
+	}
 
 	sb := collections.NewSchemaBuilder(env.KVStoreService)
 	k := &Keeper{
@@ -131,6 +150,8 @@ func NewKeeper(
 		ProposalVoteOptions:    collections.NewMap(sb, types.ProposalVoteOptionsKeyPrefix, "proposal_vote_options", collections.Uint64Key, codec.CollValue[v1.ProposalVoteOptions](cdc)),
 		ActiveProposalsQueue:   collections.NewMap(sb, types.ActiveProposalQueuePrefix, "active_proposals_queue", collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key), collections.Uint64Value),     // sdk.TimeKey is needed to retain state compatibility
 		InactiveProposalsQueue: collections.NewMap(sb, types.InactiveProposalQueuePrefix, "inactive_proposals_queue", collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key), collections.Uint64Value), // sdk.TimeKey is needed to retain state compatibility
+		VoteDelegations:        collections.NewMap(sb, types.VoteDelegationsKeyPrefix, "vote_delegations", sdk.AccAddressKey, codec.CollValue[v1.VoteDelegation](cdc)),
+		VoteDelegators:         collections.NewKeySet(sb, types.VoteDelegatorsKeyPrefix, "vote_delegators", collections.PairKeyCodec(sdk.AccAddressKey, sdk.AccAddressKey)),
 	}
 	schema, err := sb.Build()
 	if err != nil {
@@ -310,6 +310,39 @@ func (k msgServer) Deposit(ctx context.Context, msg *v1.MsgDeposit) (*v1.MsgDepo
 	return &v1.MsgDepositResponse{}, nil
 }
 
+// DelegateVote implements the MsgServer.DelegateVote method.
+func (k msgServer) DelegateVote(ctx context.Context, msg *v1.MsgDelegateVote) (*v1.MsgDelegateVoteResponse, error) {
+	delegator, err := k.authKeeper.AddressCodec().StringToBytes(msg.Delegator)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	delegate, err := k.authKeeper.AddressCodec().StringToBytes(msg.Delegate)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegate address: %s", err)
+	}
+
+	if err := k.Keeper.DelegateVote(ctx, delegator, delegate); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgDelegateVoteResponse{}, nil
+}
+
+// UndelegateVote implements the MsgServer.UndelegateVote method.
+func (k msgServer) UndelegateVote(ctx context.Context, msg *v1.MsgUndelegateVote) (*v1.MsgUndelegateVoteResponse, error) {
+	delegator, err := k.authKeeper.AddressCodec().StringToBytes(msg.Delegator)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	if err := k.Keeper.UndelegateVote(ctx, delegator); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgUndelegateVoteResponse{}, nil
+}
+
 // UpdateParams implements the v1.UpdateParams method.
 func (k msgServer) UpdateParams(ctx context.Context, msg *v1.MsgUpdateParams) (*v1.MsgUpdateParamsResponse, error) {
 	if k.authority != msg.Authority {
@@ -346,6 +379,10 @@ func (k msgServer) UpdateMessageParams(ctx context.Context, msg *v1.MsgUpdateMes
 		return nil, err
 	}
 
+	if msg.Params.TallyStrategy != "" && !k.HasTallyStrategy(msg.Params.TallyStrategy) {
+		return nil, errors.Wrap(govtypes.ErrUnknownTallyStrategy, msg.Params.TallyStrategy)
+	}
+
 	// note: we don't need to validate the message URL here, as it is gov gated
 	// a chain may want to configure proposal messages before having an upgrade
 	// adding new messages.
@@ -2137,6 +2137,22 @@ func (suite *KeeperTestSuite) TestMsgUpdateMessageParams() {
 			},
 			expErrMsg: "voting period must be positive",
 		},
+		{
+			name: "unknown tally strategy",
+			input: &v1.MsgUpdateMessageParams{
+				Authority: suite.govKeeper.GetAuthority(),
+				MsgUrl:    sdk.MsgTypeURL(&v1.MsgUpdateParams{}),
+				Params: &v1.MessageBasedParams{
+					VotingPeriod:  func() *time.Duration { d := time.Hour; return &d }(),
+					Quorum:        "0.334",
+					YesQuorum:     "0.5",
+					Threshold:     "0.5",
+					VetoThreshold: "0.334",
+					TallyStrategy: "unknown",
+				},
+			},
+			expErrMsg: "unknown tally strategy",
+		},
 		{
 			name: "valid",
 			input: &v1.MsgUpdateMessageParams{
@@ -2320,3 +2336,73 @@ func (suite *KeeperTestSuite) TestMsgSudoExec() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestMsgDelegateVote() {
+	suite.reset()
+	addrs := suite.addrs
+	delegator, err := suite.acctKeeper.AddressCodec().BytesToString(addrs[0])
+	suite.Require().NoError(err)
+	delegate, err := suite.acctKeeper.AddressCodec().BytesToString(addrs[1])
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name      string
+		input     *v1.MsgDelegateVote
+		expErrMsg string
+	}{
+		{
+			name:      "invalid delegator",
+			input:     v1.NewMsgDelegateVote("invalid", delegate),
+			expErrMsg: "invalid delegator address",
+		},
+		{
+			name:      "invalid delegate",
+			input:     v1.NewMsgDelegateVote(delegator, "invalid"),
+			expErrMsg: "invalid delegate address",
+		},
+		{
+			name:      "self delegation",
+			input:     v1.NewMsgDelegateVote(delegator, delegator),
+			expErrMsg: "cannot delegate vote to itself",
+		},
+		{
+			name:  "valid",
+			input: v1.NewMsgDelegateVote(delegator, delegate),
+		},
+		{
+			name:      "cycle",
+			input:     v1.NewMsgDelegateVote(delegate, delegator),
+			expErrMsg: "would create a cycle",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			_, err := suite.msgSrvr.DelegateVote(suite.ctx, tc.input)
+			if tc.expErrMsg != "" {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgUndelegateVote() {
+	suite.reset()
+	addrs := suite.addrs
+	delegator, err := suite.acctKeeper.AddressCodec().BytesToString(addrs[0])
+	suite.Require().NoError(err)
+	delegate, err := suite.acctKeeper.AddressCodec().BytesToString(addrs[1])
+	suite.Require().NoError(err)
+
+	_, err = suite.msgSrvr.UndelegateVote(suite.ctx, v1.NewMsgUndelegateVote(delegator))
+	suite.Require().ErrorContains(err, "vote delegation not found")
+
+	_, err = suite.msgSrvr.DelegateVote(suite.ctx, v1.NewMsgDelegateVote(delegator, delegate))
+	suite.Require().NoError(err)
+	_, err = suite.msgSrvr.UndelegateVote(suite.ctx, v1.NewMsgUndelegateVote(delegator))
+	suite.Require().NoError(err)
+}
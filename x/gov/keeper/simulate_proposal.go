@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+
+	"google.golang.org/protobuf/runtime/protoiface"
+
+	"cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	v1 "cosmossdk.io/x/gov/types/v1"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// errProposalSimulated is returned by the simulation of a proposal to revert its state changes.
+var errProposalSimulated = stderrors.New("proposal simulated")
+
+// SimulateProposal executes the messages of a proposal against a branch of the current state, as
+// the EndBlocker would once the proposal passes, and returns the result of each message.
+// The execution stops at the first failing message. No state change is committed.
+// The gas limit applies to the execution of each message, it defaults to and cannot exceed the
+// MaxSimulateProposalGas of the config.
+func (k Keeper) SimulateProposal(ctx context.Context, messages []sdk.Msg, gasLimit uint64) (*v1.QuerySimulateProposalResponse, error) {
+	if gasLimit == 0 {
+		gasLimit = k.config.MaxSimulateProposalGas
+	} else if gasLimit > k.config.MaxSimulateProposalGas {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "gas limit %d exceeds the maximum gas limit %d of proposal simulations", gasLimit, k.config.MaxSimulateProposalGas)
+	}
+
+	resp := &v1.QuerySimulateProposalResponse{Success: true}
+	err := k.BranchService.Execute(ctx, func(ctx context.Context) error {
+		for _, msg := range messages {
+			result := &v1.ProposalMessageResult{MsgTypeUrl: sdk.MsgTypeURL(msg)}
+			resp.Results = append(resp.Results, result)
+
+			if err := k.validateProposalMsg(ctx, msg); err != nil {
+				result.Error = err.Error()
+				resp.Success = false
+				break
+			}
+
+			gasUsed, err := k.BranchService.ExecuteWithGasLimit(ctx, gasLimit, func(ctx context.Context) error {
+				res, err := k.simulateHandler(ctx, msg)
+				if err != nil {
+					return err
+				}
+
+				if res != nil {
+					result.MsgResponse, err = codectypes.NewAnyWithValue(res)
+					if err != nil {
+						return err
+					}
+				}
+				result.Events = eventsFromContext(ctx)
+				return nil
+			})
+			result.GasUsed = gasUsed
+			resp.GasUsed += gasUsed
+			if err != nil {
+				result.Error = err.Error()
+				resp.Success = false
+				break
+			}
+		}
+
+		return errProposalSimulated
+	})
+	if err != nil && !stderrors.Is(err, errProposalSimulated) {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// simulateHandler executes a proposal message and recovers from panics, except for out of gas panics,
+// which are handled by the branch service.
+func (k Keeper) simulateHandler(ctx context.Context, msg sdk.Msg) (res protoiface.MessageV1, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(storetypes.ErrorOutOfGas); ok {
+				panic(r)
+			}
+			err = fmt.Errorf("handling x/gov proposal msg [%s] PANICKED: %v", sdk.MsgTypeURL(msg), r)
+		}
+	}()
+
+	return k.MsgRouterService.InvokeUntyped(ctx, msg)
+}
+
+// eventsFromContext returns the events emitted in the context.
+// Execution environments not based on sdk.Context do not expose their events.
+func eventsFromContext(ctx context.Context) []*sdk.StringEvent {
+	sdkCtx, ok := ctx.Value(sdk.SdkContextKey).(sdk.Context)
+	if !ok {
+		return nil
+	}
+
+	stringEvents := sdk.StringifyEvents(sdkCtx.EventManager().Events().ToABCIEvents())
+	events := make([]*sdk.StringEvent, len(stringEvents))
+	for i := range stringEvents {
+		events[i] = &stringEvents[i]
+	}
+	return events
+}
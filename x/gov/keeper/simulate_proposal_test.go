@@ -0,0 +1,99 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/gov/keeper"
+	v1 "cosmossdk.io/x/gov/types/v1"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func TestSimulateProposal(t *testing.T) {
+	govKeeper, mocks, _, ctx := setupGovKeeper(t)
+	authority, err := mocks.acctKeeper.AddressCodec().BytesToString(govAcct)
+	require.NoError(t, err)
+	otherAddr, err := mocks.acctKeeper.AddressCodec().BytesToString(simtestutil.CreateRandomAccounts(1)[0])
+	require.NoError(t, err)
+
+	params, err := govKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	newParams := params
+	newParams.Quorum = "0.5"
+	invalidParams := params
+	invalidParams.Quorum = "invalid"
+
+	tests := []struct {
+		name            string
+		messages        []sdk.Msg
+		expectedSuccess bool
+		expectedErrors  []string
+	}{
+		{
+			name:            "valid",
+			messages:        []sdk.Msg{&v1.MsgUpdateParams{Authority: authority, Params: newParams}},
+			expectedSuccess: true,
+			expectedErrors:  []string{""},
+		},
+		{
+			name:           "wrong authority",
+			messages:       []sdk.Msg{&v1.MsgUpdateParams{Authority: otherAddr, Params: newParams}},
+			expectedErrors: []string{"expected authority account as only signer"},
+		},
+		{
+			name:           "invalid param value",
+			messages:       []sdk.Msg{&v1.MsgUpdateParams{Authority: authority, Params: invalidParams}},
+			expectedErrors: []string{"invalid quorum string"},
+		},
+		{
+			name: "execution stops at the first failing message",
+			messages: []sdk.Msg{
+				&v1.MsgUpdateParams{Authority: authority, Params: newParams},
+				&v1.MsgUpdateParams{Authority: authority, Params: invalidParams},
+				&v1.MsgUpdateParams{Authority: authority, Params: newParams},
+			},
+			expectedErrors: []string{"", "invalid quorum string"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := govKeeper.SimulateProposal(ctx, tt.messages, 0)
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedSuccess, res.Success)
+			require.Len(t, res.Results, len(tt.expectedErrors))
+
+			var gasUsed uint64
+			for i, result := range res.Results {
+				require.Equal(t, sdk.MsgTypeURL(tt.messages[i]), result.MsgTypeUrl)
+				if tt.expectedErrors[i] == "" {
+					require.Empty(t, result.Error)
+					require.NotNil(t, result.MsgResponse)
+					require.NotZero(t, result.GasUsed)
+				} else {
+					require.Contains(t, result.Error, tt.expectedErrors[i])
+				}
+				gasUsed += result.GasUsed
+			}
+			require.Equal(t, gasUsed, res.GasUsed)
+
+			// state changes are not committed
+			current, err := govKeeper.Params.Get(ctx)
+			require.NoError(t, err)
+			require.Equal(t, params, current)
+		})
+	}
+
+	// the gas limit applies to each message
+	res, err := govKeeper.SimulateProposal(ctx, []sdk.Msg{&v1.MsgUpdateParams{Authority: authority, Params: newParams}}, 1)
+	require.NoError(t, err)
+	require.False(t, res.Success)
+	require.Contains(t, res.Results[0].Error, "out of gas")
+
+	// the gas limit cannot exceed the maximum gas limit of the config
+	_, err = govKeeper.SimulateProposal(ctx, []sdk.Msg{&v1.MsgUpdateParams{Authority: authority, Params: newParams}}, keeper.DefaultConfig().MaxSimulateProposalGas+1)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+}
@@ -18,19 +18,39 @@ func (k Keeper) Tally(ctx context.Context, proposal v1.Proposal) (passes, burnDe
 		return false, false, v1.TallyResult{}, err
 	}
 
-	if k.config.CalculateVoteResultsAndVotingPowerFn == nil {
-		k.config.CalculateVoteResultsAndVotingPowerFn = defaultCalculateVoteResultsAndVotingPower
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return false, false, v1.TallyResult{}, err
 	}
 
-	totalVoterPower, results, err := k.config.CalculateVoteResultsAndVotingPowerFn(ctx, k, proposal.Id, validators)
+	// a custom tally strategy replaces both the vote results calculation and the tally rules
+	strategy, err := k.tallyStrategy(ctx, proposal)
 	if err != nil {
 		return false, false, v1.TallyResult{}, err
 	}
+	if strategy != nil {
+		totalBonded, err := k.sk.TotalBondedTokens(ctx)
+		if err != nil {
+			return false, false, v1.TallyResult{}, err
+		}
 
-	params, err := k.Params.Get(ctx)
+		tallyParams, err := k.tallyParams(ctx, proposal, params)
+		if err != nil {
+			return false, false, v1.TallyResult{}, err
+		}
+
+		return strategy(ctx, k, proposal, validators, totalBonded, tallyParams)
+	}
+
+	if k.config.CalculateVoteResultsAndVotingPowerFn == nil {
+		k.config.CalculateVoteResultsAndVotingPowerFn = defaultCalculateVoteResultsAndVotingPower
+	}
+
+	totalVoterPower, results, err := k.config.CalculateVoteResultsAndVotingPowerFn(ctx, k, proposal.Id, validators)
 	if err != nil {
 		return false, false, v1.TallyResult{}, err
 	}
+
 	tallyResults = v1.NewTallyResultFromMap(results)
 
 	// If there is no staked coins, the proposal fails
@@ -245,19 +265,22 @@ func defaultCalculateVoteResultsAndVotingPower(
 	totalVP := math.LegacyZeroDec()
 	results := createEmptyResults()
 
-	// iterate over all votes, tally up the voting power of each validator
-	rng := collections.NewPrefixedPairRange[uint64, sdk.AccAddress](proposalID)
-	votesToRemove := []collections.Pair[uint64, sdk.AccAddress]{}
-	if err := k.Votes.Walk(ctx, rng, func(key collections.Pair[uint64, sdk.AccAddress], vote v1.Vote) (bool, error) {
+	// votes inherited through vote delegations must be computed before the votes are removed
+	delegatedVotes, err := k.DelegatedVotes(ctx, proposalID)
+	if err != nil {
+		return math.LegacyDec{}, nil, err
+	}
+
+	tallyVote := func(vote v1.Vote) error {
 		// if validator, just record it in the map
 		voter, err := k.authKeeper.AddressCodec().StringToBytes(vote.Voter)
 		if err != nil {
-			return false, err
+			return err
 		}
 
 		valAddrStr, err := k.sk.ValidatorAddressCodec().BytesToString(voter)
 		if err != nil {
-			return false, err
+			return err
 		}
 
 		if val, ok := validators[valAddrStr]; ok {
@@ -289,7 +312,14 @@ func defaultCalculateVoteResultsAndVotingPower(
 
 			return false
 		})
-		if err != nil {
+		return err
+	}
+
+	// iterate over all votes, tally up the voting power of each validator
+	rng := collections.NewPrefixedPairRange[uint64, sdk.AccAddress](proposalID)
+	votesToRemove := []collections.Pair[uint64, sdk.AccAddress]{}
+	if err := k.Votes.Walk(ctx, rng, func(key collections.Pair[uint64, sdk.AccAddress], vote v1.Vote) (bool, error) {
+		if err := tallyVote(vote); err != nil {
 			return false, err
 		}
 
@@ -299,6 +329,12 @@ func defaultCalculateVoteResultsAndVotingPower(
 		return math.LegacyDec{}, nil, err
 	}
 
+	for _, vote := range delegatedVotes {
+		if err := tallyVote(vote); err != nil {
+			return math.LegacyDec{}, nil, err
+		}
+	}
+
 	// remove all votes from store
 	for _, key := range votesToRemove {
 		if err := k.Votes.Remove(ctx, key); err != nil {
@@ -0,0 +1,370 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	v1 "cosmossdk.io/x/gov/types/v1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// QuadraticTallyStrategyName is the name QuadraticTallyStrategy is commonly registered with.
+	QuadraticTallyStrategyName = "quadratic"
+	// CappedTallyStrategyName is the name a strategy returned by NewCappedTallyStrategy is commonly registered with.
+	CappedTallyStrategyName = "capped"
+)
+
+// TallyParams defines the quorum and thresholds applying to a proposal.
+// They are resolved from the message based params of the proposal, or from the module params.
+type TallyParams struct {
+	// ProposalType is the type of the proposal, selecting the tally rules applying to it.
+	ProposalType                v1.ProposalType
+	Quorum                      math.LegacyDec
+	YesQuorum                   math.LegacyDec
+	Threshold                   math.LegacyDec
+	VetoThreshold               math.LegacyDec
+	OptimisticRejectedThreshold math.LegacyDec
+	BurnVoteQuorum              bool
+	BurnVoteVeto                bool
+}
+
+// VoterPower defines the voting power of a voter on a proposal along with its vote.
+type VoterPower struct {
+	Voter   string
+	Power   math.LegacyDec
+	Options []*v1.WeightedVoteOption
+}
+
+// HasTallyStrategy returns true if a tally strategy is registered under the given name.
+func (k Keeper) HasTallyStrategy(name string) bool {
+	_, ok := k.config.TallyStrategies[name]
+	return ok
+}
+
+// tallyStrategy returns the custom tally strategy of a proposal, or nil if the proposal uses the default tally.
+// The tally strategy of the message based params of the first proposal message takes precedence over
+// the tally strategy of the proposal type.
+func (k Keeper) tallyStrategy(ctx context.Context, proposal v1.Proposal) (TallyStrategyFn, error) {
+	name := k.config.ProposalTypeTallyStrategies[proposal.ProposalType]
+	if len(proposal.Messages) > 0 {
+		customMessageParams, err := k.MessageBasedParams.Get(ctx, proposal.Messages[0].TypeUrl)
+		if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			return nil, err
+		} else if err == nil && customMessageParams.TallyStrategy != "" {
+			name = customMessageParams.TallyStrategy
+		}
+	}
+
+	if name == "" {
+		return nil, nil
+	}
+
+	strategy, ok := k.config.TallyStrategies[name]
+	if !ok {
+		// the strategy may have been removed from the application after being set in the message based params,
+		// fallback to the default tally instead of halting the chain.
+		k.Logger.Error("unknown tally strategy, using default tally", "proposal", proposal.Id, "tally_strategy", name)
+		return nil, nil
+	}
+
+	return strategy, nil
+}
+
+// tallyParams returns the tally params applying to a proposal. As in the default tally, the message
+// based params only apply to the proposals tallied as standard proposals, an expedited proposal is
+// always tallied with the expedited quorum and threshold of the module params.
+func (k Keeper) tallyParams(ctx context.Context, proposal v1.Proposal, params v1.Params) (TallyParams, error) {
+	quorumStr, thresholdStr := params.Quorum, params.Threshold
+	yesQuorumStr, vetoThresholdStr := params.YesQuorum, params.VetoThreshold
+
+	var messageBasedParams bool
+	switch proposal.ProposalType {
+	case v1.ProposalType_PROPOSAL_TYPE_EXPEDITED:
+		quorumStr, thresholdStr = params.ExpeditedQuorum, params.ExpeditedThreshold
+	case v1.ProposalType_PROPOSAL_TYPE_OPTIMISTIC, v1.ProposalType_PROPOSAL_TYPE_MULTIPLE_CHOICE:
+	default:
+		messageBasedParams = true
+	}
+
+	if messageBasedParams && len(proposal.Messages) > 0 {
+		customMessageParams, err := k.MessageBasedParams.Get(ctx, proposal.Messages[0].TypeUrl)
+		if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			return TallyParams{}, err
+		} else if err == nil {
+			quorumStr = customMessageParams.GetQuorum()
+			thresholdStr = customMessageParams.GetThreshold()
+			vetoThresholdStr = customMessageParams.GetVetoThreshold()
+			yesQuorumStr = customMessageParams.GetYesQuorum()
+		}
+	}
+
+	quorum, _ := math.LegacyNewDecFromStr(quorumStr)
+	yesQuorum, _ := math.LegacyNewDecFromStr(yesQuorumStr)
+	threshold, _ := math.LegacyNewDecFromStr(thresholdStr)
+	vetoThreshold, _ := math.LegacyNewDecFromStr(vetoThresholdStr)
+	optimisticRejectedThreshold, _ := math.LegacyNewDecFromStr(params.OptimisticRejectedThreshold)
+
+	return TallyParams{
+		ProposalType:                proposal.ProposalType,
+		Quorum:                      quorum,
+		YesQuorum:                   yesQuorum,
+		Threshold:                   threshold,
+		VetoThreshold:               vetoThreshold,
+		OptimisticRejectedThreshold: optimisticRejectedThreshold,
+		BurnVoteQuorum:              params.BurnVoteQuorum,
+		BurnVoteVeto:                params.BurnVoteVeto,
+	}, nil
+}
+
+// VotingPowers iterates over the votes of a proposal, removes them from the store and returns the
+// voting power of each voter, in the votes iteration order.
+// The voting power is computed as in the default tally: the voting power of a delegator voting is
+// deducted from the validators it delegates to, a validator votes with the remaining voting power.
+func VotingPowers(
+	ctx context.Context,
+	k Keeper,
+	proposalID uint64,
+	validators map[string]v1.ValidatorGovInfo,
+) ([]VoterPower, error) {
+	var voters []VoterPower
+	voterIndex := make(map[string]int)
+
+	// votes inherited through vote delegations must be computed before the votes are removed
+	delegatedVotes, err := k.DelegatedVotes(ctx, proposalID)
+	if err != nil {
+		return nil, err
+	}
+
+	addVoter := func(vote v1.Vote) error {
+		voter, err := k.authKeeper.AddressCodec().StringToBytes(vote.Voter)
+		if err != nil {
+			return err
+		}
+
+		valAddrStr, err := k.sk.ValidatorAddressCodec().BytesToString(voter)
+		if err != nil {
+			return err
+		}
+
+		if val, ok := validators[valAddrStr]; ok {
+			val.Vote = vote.Options
+			validators[valAddrStr] = val
+		}
+
+		votingPower := math.LegacyZeroDec()
+		err = k.sk.IterateDelegations(ctx, voter, func(index int64, delegation sdk.DelegationI) (stop bool) {
+			valAddrStr := delegation.GetValidatorAddr()
+
+			if val, ok := validators[valAddrStr]; ok {
+				val.DelegatorDeductions = val.DelegatorDeductions.Add(delegation.GetShares())
+				validators[valAddrStr] = val
+
+				// delegation shares * bonded / total shares
+				votingPower = votingPower.Add(delegation.GetShares().MulInt(val.BondedTokens).Quo(val.DelegatorShares))
+			}
+
+			return false
+		})
+		if err != nil {
+			return err
+		}
+
+		voterIndex[vote.Voter] = len(voters)
+		voters = append(voters, VoterPower{Voter: vote.Voter, Power: votingPower, Options: vote.Options})
+		return nil
+	}
+
+	rng := collections.NewPrefixedPairRange[uint64, sdk.AccAddress](proposalID)
+	votesToRemove := []collections.Pair[uint64, sdk.AccAddress]{}
+	if err := k.Votes.Walk(ctx, rng, func(key collections.Pair[uint64, sdk.AccAddress], vote v1.Vote) (bool, error) {
+		if err := addVoter(vote); err != nil {
+			return false, err
+		}
+
+		votesToRemove = append(votesToRemove, key)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	for _, vote := range delegatedVotes {
+		if err := addVoter(vote); err != nil {
+			return nil, err
+		}
+	}
+
+	// remove all votes from store
+	for _, key := range votesToRemove {
+		if err := k.Votes.Remove(ctx, key); err != nil {
+			return nil, err
+		}
+	}
+
+	// credit the validators that voted with their remaining voting power
+	for _, val := range validators {
+		if len(val.Vote) == 0 {
+			continue
+		}
+
+		voter, err := k.authKeeper.AddressCodec().BytesToString(val.Address)
+		if err != nil {
+			return nil, err
+		}
+
+		idx, ok := voterIndex[voter]
+		if !ok {
+			continue
+		}
+
+		sharesAfterDeductions := val.DelegatorShares.Sub(val.DelegatorDeductions)
+		voters[idx].Power = voters[idx].Power.Add(sharesAfterDeductions.MulInt(val.BondedTokens).Quo(val.DelegatorShares))
+	}
+
+	return voters, nil
+}
+
+// ApplyTallyRules applies the tally rules of the proposal type to the vote results of a proposal.
+// The quorum is checked against the voting power of the voters, while the thresholds are checked
+// against the results, which a tally strategy may have weighted differently.
+// If there is no staked coins, the proposal fails
+// If there are more spam votes than the sum of all other options, proposal fails and deposits are burned
+// An optimistic proposal passes unless more than the optimistic rejected threshold of the bonded tokens vote No
+// A multiple choice proposal passes if there is enough quorum of votes
+// For the other proposal types:
+// If there is not enough quorum of votes, the proposal fails
+// If no one votes (everyone abstains), proposal fails
+// If yes quorum enabled and less than yes_quorum of voters vote Yes, proposal fails
+// If more than the veto threshold of voters veto, proposal fails
+// If more than the threshold of non-abstaining voters vote Yes, proposal passes
+func ApplyTallyRules(
+	totalVoterPower math.LegacyDec,
+	totalBonded math.Int,
+	results map[v1.VoteOption]math.LegacyDec,
+	params TallyParams,
+) (passes, burnDeposits bool, tallyResults v1.TallyResult) {
+	tallyResults = v1.NewTallyResultFromMap(results)
+	if totalBonded.IsZero() {
+		return false, false, tallyResults
+	}
+
+	totalResults := math.LegacyZeroDec()
+	for _, result := range results {
+		totalResults = totalResults.Add(result)
+	}
+
+	if !totalResults.IsZero() &&
+		results[v1.OptionSpam].GTE(results[v1.OptionOne].Add(results[v1.OptionTwo].Add(results[v1.OptionThree].Add(results[v1.OptionFour])))) {
+		return false, true, tallyResults
+	}
+
+	if params.ProposalType == v1.ProposalType_PROPOSAL_TYPE_OPTIMISTIC {
+		if totalVoterPower.IsZero() {
+			return true, false, tallyResults
+		}
+		return !results[v1.OptionNo].Quo(math.LegacyNewDecFromInt(totalBonded)).GT(params.OptimisticRejectedThreshold), false, tallyResults
+	}
+
+	percentVoting := totalVoterPower.Quo(math.LegacyNewDecFromInt(totalBonded))
+	if percentVoting.LT(params.Quorum) {
+		return false, params.BurnVoteQuorum, tallyResults
+	}
+
+	// the options of a multiple choice proposal are not yes and no, there is no threshold to reach
+	if params.ProposalType == v1.ProposalType_PROPOSAL_TYPE_MULTIPLE_CHOICE {
+		return true, false, tallyResults
+	}
+
+	if totalResults.Sub(results[v1.OptionAbstain]).IsZero() {
+		return false, false, tallyResults
+	}
+
+	if params.YesQuorum.IsPositive() && results[v1.OptionYes].Quo(totalResults).LT(params.YesQuorum) {
+		return false, false, tallyResults
+	}
+
+	if results[v1.OptionNoWithVeto].Quo(totalResults).GT(params.VetoThreshold) {
+		return false, params.BurnVoteVeto, tallyResults
+	}
+
+	if results[v1.OptionYes].Quo(totalResults.Sub(results[v1.OptionAbstain])).GT(params.Threshold) {
+		return true, false, tallyResults
+	}
+
+	return false, false, tallyResults
+}
+
+// QuadraticTallyStrategy is a tally strategy weighting the vote of each voter by the square root of
+// its voting power, limiting the influence of large stakeholders. The standard tally rules apply.
+func QuadraticTallyStrategy(
+	ctx context.Context,
+	k Keeper,
+	proposal v1.Proposal,
+	validators map[string]v1.ValidatorGovInfo,
+	totalBonded math.Int,
+	params TallyParams,
+) (passes, burnDeposits bool, tallyResults v1.TallyResult, err error) {
+	return weightedTally(ctx, k, proposal, validators, totalBonded, params, func(power math.LegacyDec) (math.LegacyDec, error) {
+		return power.ApproxSqrt()
+	})
+}
+
+// NewCappedTallyStrategy returns a token weighted tally strategy where the voting power of each voter
+// is capped to maxVoterShare of the total bonded tokens. The standard tally rules apply.
+func NewCappedTallyStrategy(maxVoterShare math.LegacyDec) TallyStrategyFn {
+	if maxVoterShare.IsNil() || !maxVoterShare.IsPositive() || maxVoterShare.GT(math.LegacyOneDec()) {
+		panic("max voter share must be in (0, 1]")
+	}
+
+	return func(
+		ctx context.Context,
+		k Keeper,
+		proposal v1.Proposal,
+		validators map[string]v1.ValidatorGovInfo,
+		totalBonded math.Int,
+		params TallyParams,
+	) (passes, burnDeposits bool, tallyResults v1.TallyResult, err error) {
+		maxPower := maxVoterShare.MulInt(totalBonded)
+		return weightedTally(ctx, k, proposal, validators, totalBonded, params, func(power math.LegacyDec) (math.LegacyDec, error) {
+			return math.LegacyMinDec(power, maxPower), nil
+		})
+	}
+}
+
+// weightedTally tallies the votes of a proposal, weighting the voting power of each voter with the weight function.
+func weightedTally(
+	ctx context.Context,
+	k Keeper,
+	proposal v1.Proposal,
+	validators map[string]v1.ValidatorGovInfo,
+	totalBonded math.Int,
+	params TallyParams,
+	weight func(power math.LegacyDec) (math.LegacyDec, error),
+) (passes, burnDeposits bool, tallyResults v1.TallyResult, err error) {
+	voters, err := VotingPowers(ctx, k, proposal.Id, validators)
+	if err != nil {
+		return false, false, v1.TallyResult{}, err
+	}
+
+	totalVoterPower := math.LegacyZeroDec()
+	results := createEmptyResults()
+	for _, voter := range voters {
+		totalVoterPower = totalVoterPower.Add(voter.Power)
+
+		weightedPower, err := weight(voter.Power)
+		if err != nil {
+			return false, false, v1.TallyResult{}, err
+		}
+
+		for _, option := range voter.Options {
+			optionWeight, _ := math.LegacyNewDecFromStr(option.Weight)
+			results[option.Option] = results[option.Option].Add(weightedPower.Mul(optionWeight))
+		}
+	}
+
+	passes, burnDeposits, tallyResults = ApplyTallyRules(totalVoterPower, totalBonded, results, params)
+	return passes, burnDeposits, tallyResults, nil
+}
@@ -0,0 +1,270 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/x/gov/keeper"
+	v1 "cosmossdk.io/x/gov/types/v1"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestTally_Strategies(t *testing.T) {
+	// one whale validator votes no, three smaller validators vote yes
+	validatorTokens := []int64{7000000, 1000000, 1000000, 1000000}
+	votes := []v1.VoteOption{v1.OptionNo, v1.OptionYes, v1.OptionYes, v1.OptionYes}
+
+	tests := []struct {
+		name                string
+		proposalTypeTallies map[v1.ProposalType]string
+		messageTally        string
+		expectedPass        bool
+		expectedYes         string
+		expectedNo          string
+	}{
+		{
+			name:         "default tally: whale decides",
+			expectedPass: false,
+			expectedYes:  "3000000",
+			expectedNo:   "7000000",
+		},
+		{
+			name:                "quadratic strategy per proposal type",
+			proposalTypeTallies: map[v1.ProposalType]string{v1.ProposalType_PROPOSAL_TYPE_STANDARD: keeper.QuadraticTallyStrategyName},
+			expectedPass:        true,
+			expectedYes:         "3000",
+			expectedNo:          "2645",
+		},
+		{
+			name:                "strategy of another proposal type is not used",
+			proposalTypeTallies: map[v1.ProposalType]string{v1.ProposalType_PROPOSAL_TYPE_EXPEDITED: keeper.QuadraticTallyStrategyName},
+			expectedPass:        false,
+			expectedYes:         "3000000",
+			expectedNo:          "7000000",
+		},
+		{
+			name:         "capped strategy per message type",
+			messageTally: keeper.CappedTallyStrategyName,
+			expectedPass: true,
+			expectedYes:  "3000000",
+			expectedNo:   "1000000",
+		},
+		{
+			name:                "message type strategy takes precedence over proposal type strategy",
+			proposalTypeTallies: map[v1.ProposalType]string{v1.ProposalType_PROPOSAL_TYPE_STANDARD: keeper.QuadraticTallyStrategyName},
+			messageTally:        keeper.CappedTallyStrategyName,
+			expectedPass:        true,
+			expectedYes:         "3000000",
+			expectedNo:          "1000000",
+		},
+		{
+			name:         "unknown strategy falls back to default tally",
+			messageTally: "unknown",
+			expectedPass: false,
+			expectedYes:  "3000000",
+			expectedNo:   "7000000",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := keeper.DefaultConfig()
+			config.TallyStrategies = map[string]keeper.TallyStrategyFn{
+				keeper.QuadraticTallyStrategyName: keeper.QuadraticTallyStrategy,
+				keeper.CappedTallyStrategyName:    keeper.NewCappedTallyStrategy(sdkmath.LegacyNewDecWithPrec(1, 1)),
+			}
+			config.ProposalTypeTallyStrategies = tt.proposalTypeTallies
+
+			govKeeper, mocks, _, ctx := setupGovKeeperWithConfig(t, config, mockAccountKeeperExpectations)
+			addrs := simtestutil.CreateRandomAccounts(len(validatorTokens) + 1)
+			valAddrs := simtestutil.ConvertAddrsToValAddrs(addrs[:len(validatorTokens)])
+
+			mocks.stakingKeeper.EXPECT().
+				IterateBondedValidatorsByPower(ctx, gomock.Any()).
+				DoAndReturn(
+					func(ctx context.Context, fn func(index int64, validator sdk.ValidatorI) bool) error {
+						for i, tokens := range validatorTokens {
+							valAddr, err := mocks.stakingKeeper.ValidatorAddressCodec().BytesToString(valAddrs[i])
+							require.NoError(t, err)
+							fn(int64(i), stakingtypes.Validator{
+								OperatorAddress: valAddr,
+								Status:          stakingtypes.Bonded,
+								Tokens:          sdkmath.NewInt(tokens),
+								DelegatorShares: sdkmath.LegacyNewDec(tokens),
+							})
+						}
+						return nil
+					})
+
+			if tt.messageTally != "" {
+				require.NoError(t, govKeeper.MessageBasedParams.Set(ctx, sdk.MsgTypeURL(TestProposal[0]), v1.MessageBasedParams{
+					VotingPeriod:  func() *time.Duration { d := time.Hour; return &d }(),
+					Quorum:        "0.334",
+					YesQuorum:     "0",
+					Threshold:     "0.5",
+					VetoThreshold: "0.334",
+					TallyStrategy: tt.messageTally,
+				}))
+			}
+
+			proposal, err := govKeeper.SubmitProposal(ctx, TestProposal[:1], "", "title", "summary", addrs[len(validatorTokens)], v1.ProposalType_PROPOSAL_TYPE_STANDARD)
+			require.NoError(t, err)
+			require.NoError(t, govKeeper.ActivateVotingPeriod(ctx, proposal))
+
+			s := tallyFixture{t: t, proposal: proposal, valAddrs: valAddrs, ctx: ctx, keeper: govKeeper, mocks: mocks}
+			setTotalBonded(s, 10000000)
+			for i, vote := range votes {
+				validatorVote(s, valAddrs[i], vote)
+			}
+
+			pass, burn, tally, err := govKeeper.Tally(ctx, proposal)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedPass, pass, "wrong pass")
+			assert.False(t, burn)
+
+			yes, err := sdkmath.LegacyNewDecFromStr(tally.YesCount)
+			require.NoError(t, err)
+			no, err := sdkmath.LegacyNewDecFromStr(tally.NoCount)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedYes, yes.TruncateInt().String())
+			assert.Equal(t, tt.expectedNo, no.TruncateInt().String())
+
+			// Assert votes removal after tally
+			rng := collections.NewPrefixedPairRange[uint64, sdk.AccAddress](proposal.Id)
+			has, err := govKeeper.Votes.Iterate(ctx, rng)
+			require.NoError(t, err)
+			assert.False(t, has.Valid())
+		})
+	}
+}
+
+func TestTally_StrategyExpeditedParams(t *testing.T) {
+	// 60% of the voters vote yes, above the threshold of the message based params
+	// but below the expedited threshold
+	validatorTokens := []int64{1000000, 1000000, 1000000, 1000000, 1000000}
+	votes := []v1.VoteOption{v1.OptionYes, v1.OptionYes, v1.OptionYes, v1.OptionNo, v1.OptionNo}
+
+	for _, strategy := range []string{"", keeper.CappedTallyStrategyName} {
+		t.Run("strategy "+strategy, func(t *testing.T) {
+			config := keeper.DefaultConfig()
+			config.TallyStrategies = map[string]keeper.TallyStrategyFn{
+				keeper.CappedTallyStrategyName: keeper.NewCappedTallyStrategy(sdkmath.LegacyNewDecWithPrec(1, 1)),
+			}
+			if strategy != "" {
+				config.ProposalTypeTallyStrategies = map[v1.ProposalType]string{v1.ProposalType_PROPOSAL_TYPE_EXPEDITED: strategy}
+			}
+
+			govKeeper, mocks, _, ctx := setupGovKeeperWithConfig(t, config, mockAccountKeeperExpectations)
+			addrs := simtestutil.CreateRandomAccounts(len(validatorTokens) + 1)
+			valAddrs := simtestutil.ConvertAddrsToValAddrs(addrs[:len(validatorTokens)])
+
+			mocks.stakingKeeper.EXPECT().
+				IterateBondedValidatorsByPower(ctx, gomock.Any()).
+				DoAndReturn(
+					func(ctx context.Context, fn func(index int64, validator sdk.ValidatorI) bool) error {
+						for i, tokens := range validatorTokens {
+							valAddr, err := mocks.stakingKeeper.ValidatorAddressCodec().BytesToString(valAddrs[i])
+							require.NoError(t, err)
+							fn(int64(i), stakingtypes.Validator{
+								OperatorAddress: valAddr,
+								Status:          stakingtypes.Bonded,
+								Tokens:          sdkmath.NewInt(tokens),
+								DelegatorShares: sdkmath.LegacyNewDec(tokens),
+							})
+						}
+						return nil
+					})
+
+			proposal, err := govKeeper.SubmitProposal(ctx, TestProposal[:1], "", "title", "summary", addrs[len(validatorTokens)], v1.ProposalType_PROPOSAL_TYPE_EXPEDITED)
+			require.NoError(t, err)
+			require.NoError(t, govKeeper.ActivateVotingPeriod(ctx, proposal))
+
+			// the message based params are set while the expedited proposal is voted
+			require.NoError(t, govKeeper.MessageBasedParams.Set(ctx, sdk.MsgTypeURL(TestProposal[0]), v1.MessageBasedParams{
+				VotingPeriod:  func() *time.Duration { d := time.Hour; return &d }(),
+				Quorum:        "0.334",
+				YesQuorum:     "0",
+				Threshold:     "0.5",
+				VetoThreshold: "0.334",
+			}))
+
+			s := tallyFixture{t: t, proposal: proposal, valAddrs: valAddrs, ctx: ctx, keeper: govKeeper, mocks: mocks}
+			setTotalBonded(s, 10000000)
+			for i, vote := range votes {
+				validatorVote(s, valAddrs[i], vote)
+			}
+
+			// the expedited threshold applies with or without a tally strategy
+			pass, burn, tally, err := govKeeper.Tally(ctx, proposal)
+			require.NoError(t, err)
+			assert.False(t, pass, "wrong pass")
+			assert.False(t, burn)
+			assert.Equal(t, "3000000", tally.YesCount)
+			assert.Equal(t, "2000000", tally.NoCount)
+		})
+	}
+}
+
+func TestApplyTallyRules(t *testing.T) {
+	params := keeper.TallyParams{
+		Quorum:                      sdkmath.LegacyNewDecWithPrec(334, 3),
+		YesQuorum:                   sdkmath.LegacyZeroDec(),
+		Threshold:                   sdkmath.LegacyNewDecWithPrec(5, 1),
+		VetoThreshold:               sdkmath.LegacyNewDecWithPrec(334, 3),
+		OptimisticRejectedThreshold: sdkmath.LegacyNewDecWithPrec(1, 1),
+		BurnVoteQuorum:              true,
+		BurnVoteVeto:                true,
+	}
+	results := func(yes, no, veto, abstain, spam int64) map[v1.VoteOption]sdkmath.LegacyDec {
+		return map[v1.VoteOption]sdkmath.LegacyDec{
+			v1.OptionYes:                          sdkmath.LegacyNewDec(yes),
+			v1.OptionNo:                           sdkmath.LegacyNewDec(no),
+			v1.OptionNoWithVeto:                   sdkmath.LegacyNewDec(veto),
+			v1.OptionAbstain:                      sdkmath.LegacyNewDec(abstain),
+			v1.OptionSpam:                         sdkmath.LegacyNewDec(spam),
+			v1.VoteOption_VOTE_OPTION_UNSPECIFIED: sdkmath.LegacyZeroDec(),
+		}
+	}
+
+	tests := []struct {
+		name            string
+		proposalType    v1.ProposalType
+		totalVoterPower int64
+		totalBonded     int64
+		results         map[v1.VoteOption]sdkmath.LegacyDec
+		expectedPass    bool
+		expectedBurn    bool
+	}{
+		{"no bonded tokens", v1.ProposalType_PROPOSAL_TYPE_STANDARD, 10, 0, results(10, 0, 0, 0, 0), false, false},
+		{"spam", v1.ProposalType_PROPOSAL_TYPE_STANDARD, 10, 100, results(2, 2, 0, 0, 5), false, true},
+		{"no quorum", v1.ProposalType_PROPOSAL_TYPE_STANDARD, 10, 100, results(10, 0, 0, 0, 0), false, true},
+		{"quorum on voting power, not on weighted results", v1.ProposalType_PROPOSAL_TYPE_STANDARD, 50, 100, results(5, 2, 0, 0, 0), true, false},
+		{"everyone abstains", v1.ProposalType_PROPOSAL_TYPE_STANDARD, 50, 100, results(0, 0, 0, 5, 0), false, false},
+		{"vetoed", v1.ProposalType_PROPOSAL_TYPE_STANDARD, 50, 100, results(5, 0, 4, 0, 0), false, true},
+		{"threshold not reached", v1.ProposalType_PROPOSAL_TYPE_STANDARD, 50, 100, results(5, 5, 0, 0, 0), false, false},
+		{"multiple choice without yes majority", v1.ProposalType_PROPOSAL_TYPE_MULTIPLE_CHOICE, 50, 100, results(2, 5, 3, 0, 0), true, false},
+		{"multiple choice no quorum", v1.ProposalType_PROPOSAL_TYPE_MULTIPLE_CHOICE, 10, 100, results(2, 5, 3, 0, 0), false, true},
+		{"multiple choice spam", v1.ProposalType_PROPOSAL_TYPE_MULTIPLE_CHOICE, 50, 100, results(2, 2, 0, 0, 5), false, true},
+		{"optimistic without votes", v1.ProposalType_PROPOSAL_TYPE_OPTIMISTIC, 0, 100, results(0, 0, 0, 0, 0), true, false},
+		{"optimistic below rejected threshold", v1.ProposalType_PROPOSAL_TYPE_OPTIMISTIC, 10, 100, results(5, 5, 0, 0, 0), true, false},
+		{"optimistic rejected", v1.ProposalType_PROPOSAL_TYPE_OPTIMISTIC, 20, 100, results(0, 20, 0, 0, 0), false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := params
+			params.ProposalType = tt.proposalType
+			pass, burn, _ := keeper.ApplyTallyRules(sdkmath.LegacyNewDec(tt.totalVoterPower), sdkmath.NewInt(tt.totalBonded), tt.results, params)
+			assert.Equal(t, tt.expectedPass, pass, "wrong pass")
+			assert.Equal(t, tt.expectedBurn, burn, "wrong burn")
+		})
+	}
+}
@@ -0,0 +1,192 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	stderrors "errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/errors"
+	"cosmossdk.io/x/gov/types"
+	v1 "cosmossdk.io/x/gov/types/v1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DelegateVote delegates the governance voting power of the delegator to the delegate, independently of its
+// staking delegations. It replaces any existing vote delegation of the delegator.
+// A vote delegation cannot create a cycle of delegations.
+func (k Keeper) DelegateVote(ctx context.Context, delegator, delegate sdk.AccAddress) error {
+	if bytes.Equal(delegator, delegate) {
+		return errors.Wrap(types.ErrInvalidVoteDelegation, "cannot delegate vote to itself")
+	}
+
+	delegatorStr, err := k.authKeeper.AddressCodec().BytesToString(delegator)
+	if err != nil {
+		return err
+	}
+	delegateStr, err := k.authKeeper.AddressCodec().BytesToString(delegate)
+	if err != nil {
+		return err
+	}
+
+	// walk the delegation chain of the delegate to detect cycles
+	visited := map[string]struct{}{delegateStr: {}}
+	current := delegate
+	for {
+		delegation, err := k.VoteDelegations.Get(ctx, current)
+		if stderrors.Is(err, collections.ErrNotFound) {
+			break
+		} else if err != nil {
+			return err
+		}
+
+		if delegation.Delegate == delegatorStr {
+			return errors.Wrapf(types.ErrInvalidVoteDelegation, "delegating vote to %s would create a cycle", delegateStr)
+		}
+		if _, ok := visited[delegation.Delegate]; ok {
+			break
+		}
+		visited[delegation.Delegate] = struct{}{}
+
+		current, err = k.authKeeper.AddressCodec().StringToBytes(delegation.Delegate)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := k.removeVoteDelegation(ctx, delegator); err != nil && !stderrors.Is(err, types.ErrVoteDelegationNotFound) {
+		return err
+	}
+
+	if err := k.VoteDelegations.Set(ctx, delegator, v1.VoteDelegation{Delegator: delegatorStr, Delegate: delegateStr}); err != nil {
+		return err
+	}
+	if err := k.VoteDelegators.Set(ctx, collections.Join(delegate, delegator)); err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(types.EventTypeDelegateVote,
+		event.NewAttribute(types.AttributeKeyDelegator, delegatorStr),
+		event.NewAttribute(types.AttributeKeyDelegate, delegateStr),
+	)
+}
+
+// UndelegateVote revokes the vote delegation of the delegator.
+func (k Keeper) UndelegateVote(ctx context.Context, delegator sdk.AccAddress) error {
+	delegatorStr, err := k.authKeeper.AddressCodec().BytesToString(delegator)
+	if err != nil {
+		return err
+	}
+
+	if err := k.removeVoteDelegation(ctx, delegator); err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(types.EventTypeUndelegateVote,
+		event.NewAttribute(types.AttributeKeyDelegator, delegatorStr),
+	)
+}
+
+// removeVoteDelegation removes the vote delegation of the delegator and its index.
+func (k Keeper) removeVoteDelegation(ctx context.Context, delegator sdk.AccAddress) error {
+	delegation, err := k.VoteDelegations.Get(ctx, delegator)
+	if stderrors.Is(err, collections.ErrNotFound) {
+		return errors.Wrapf(types.ErrVoteDelegationNotFound, "%s", delegator)
+	} else if err != nil {
+		return err
+	}
+
+	delegate, err := k.authKeeper.AddressCodec().StringToBytes(delegation.Delegate)
+	if err != nil {
+		return err
+	}
+
+	if err := k.VoteDelegators.Remove(ctx, collections.Join(sdk.AccAddress(delegate), delegator)); err != nil {
+		return err
+	}
+
+	return k.VoteDelegations.Remove(ctx, delegator)
+}
+
+// VoteRepresentative returns the account at the end of the delegation chain of an account, following at most
+// MaxVoteDelegationDepth delegations. It returns the account itself if it does not delegate its vote.
+func (k Keeper) VoteRepresentative(ctx context.Context, delegator sdk.AccAddress) (sdk.AccAddress, error) {
+	current := delegator
+	for depth := uint64(0); depth < k.config.MaxVoteDelegationDepth; depth++ {
+		delegation, err := k.VoteDelegations.Get(ctx, current)
+		if stderrors.Is(err, collections.ErrNotFound) {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+
+		current, err = k.authKeeper.AddressCodec().StringToBytes(delegation.Delegate)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return current, nil
+}
+
+// DelegatedVotes returns the votes inherited on a proposal by the accounts delegating their voting power and
+// that did not vote directly. An account inherits the vote of the first account voting in its delegation chain,
+// following at most MaxVoteDelegationDepth delegations.
+// Only the delegators of the accounts that voted are visited, through the VoteDelegators index.
+func (k Keeper) DelegatedVotes(ctx context.Context, proposalID uint64) ([]v1.Vote, error) {
+	var votes []v1.Vote
+	rng := collections.NewPrefixedPairRange[uint64, sdk.AccAddress](proposalID)
+	err := k.Votes.Walk(ctx, rng, func(key collections.Pair[uint64, sdk.AccAddress], vote v1.Vote) (stop bool, err error) {
+		inherited, err := k.inheritedVotes(ctx, proposalID, key.K2(), vote.Options)
+		if err != nil {
+			return true, err
+		}
+
+		votes = append(votes, inherited...)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return votes, nil
+}
+
+// inheritedVotes returns the votes inherited from a voter by the accounts delegating to it, directly or through
+// accounts which did not vote, following at most MaxVoteDelegationDepth delegations.
+func (k Keeper) inheritedVotes(ctx context.Context, proposalID uint64, voter sdk.AccAddress, options []*v1.WeightedVoteOption) ([]v1.Vote, error) {
+	var votes []v1.Vote
+	delegates := []sdk.AccAddress{voter}
+	for depth := uint64(0); depth < k.config.MaxVoteDelegationDepth && len(delegates) > 0; depth++ {
+		var next []sdk.AccAddress
+		for _, delegate := range delegates {
+			rng := collections.NewPrefixedPairRange[sdk.AccAddress, sdk.AccAddress](delegate)
+			err := k.VoteDelegators.Walk(ctx, rng, func(key collections.Pair[sdk.AccAddress, sdk.AccAddress]) (stop bool, err error) {
+				delegator := key.K2()
+
+				// a direct vote overrides the delegation, the delegators of the account inherit its own vote
+				hasVoted, err := k.Votes.Has(ctx, collections.Join(proposalID, delegator))
+				if err != nil || hasVoted {
+					return err != nil, err
+				}
+
+				delegatorStr, err := k.authKeeper.AddressCodec().BytesToString(delegator)
+				if err != nil {
+					return true, err
+				}
+
+				votes = append(votes, v1.NewVote(proposalID, delegatorStr, options, ""))
+				next = append(next, delegator)
+				return false, nil
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+		delegates = next
+	}
+
+	return votes, nil
+}
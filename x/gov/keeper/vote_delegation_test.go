@@ -0,0 +1,194 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/x/gov/keeper"
+	"cosmossdk.io/x/gov/types"
+	v1 "cosmossdk.io/x/gov/types/v1"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestDelegateVote(t *testing.T) {
+	govKeeper, _, _, ctx := setupGovKeeper(t, mockAccountKeeperExpectations)
+	addrs := simtestutil.CreateRandomAccounts(3)
+
+	// cannot delegate to itself
+	err := govKeeper.DelegateVote(ctx, addrs[0], addrs[0])
+	require.ErrorIs(t, err, types.ErrInvalidVoteDelegation)
+
+	require.NoError(t, govKeeper.DelegateVote(ctx, addrs[0], addrs[1]))
+	require.NoError(t, govKeeper.DelegateVote(ctx, addrs[1], addrs[2]))
+
+	// cannot create a cycle
+	err = govKeeper.DelegateVote(ctx, addrs[2], addrs[0])
+	require.ErrorIs(t, err, types.ErrInvalidVoteDelegation)
+
+	representative, err := govKeeper.VoteRepresentative(ctx, addrs[0])
+	require.NoError(t, err)
+	require.Equal(t, addrs[2], representative)
+
+	// delegating again replaces the previous delegation
+	require.NoError(t, govKeeper.DelegateVote(ctx, addrs[0], addrs[2]))
+	has, err := govKeeper.VoteDelegators.Has(ctx, collections.Join(addrs[1], addrs[0]))
+	require.NoError(t, err)
+	require.False(t, has)
+	has, err = govKeeper.VoteDelegators.Has(ctx, collections.Join(addrs[2], addrs[0]))
+	require.NoError(t, err)
+	require.True(t, has)
+
+	require.NoError(t, govKeeper.UndelegateVote(ctx, addrs[0]))
+	has, err = govKeeper.VoteDelegations.Has(ctx, addrs[0])
+	require.NoError(t, err)
+	require.False(t, has)
+	has, err = govKeeper.VoteDelegators.Has(ctx, collections.Join(addrs[2], addrs[0]))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	err = govKeeper.UndelegateVote(ctx, addrs[0])
+	require.ErrorIs(t, err, types.ErrVoteDelegationNotFound)
+}
+
+func TestTally_VoteDelegation(t *testing.T) {
+	// addrs[0] and addrs[1] are validators, addrs[2] stakes with the first validator and addrs[3] does not stake.
+	tests := []struct {
+		name        string
+		maxDepth    uint64
+		setup       func(govKeeper *keeper.Keeper, ctx sdk.Context, proposalID uint64, addrs []sdk.AccAddress)
+		expectedYes string
+		expectedNo  string
+	}{
+		{
+			name:        "no delegation",
+			setup:       func(*keeper.Keeper, sdk.Context, uint64, []sdk.AccAddress) {},
+			expectedYes: "1000000",
+			expectedNo:  "1000000",
+		},
+		{
+			name: "delegator inherits the vote of its delegate",
+			setup: func(govKeeper *keeper.Keeper, ctx sdk.Context, _ uint64, addrs []sdk.AccAddress) {
+				require.NoError(t, govKeeper.DelegateVote(ctx, addrs[2], addrs[1]))
+			},
+			expectedYes: "1400000",
+			expectedNo:  "600000",
+		},
+		{
+			name: "direct vote overrides the delegation",
+			setup: func(govKeeper *keeper.Keeper, ctx sdk.Context, proposalID uint64, addrs []sdk.AccAddress) {
+				require.NoError(t, govKeeper.DelegateVote(ctx, addrs[2], addrs[1]))
+				require.NoError(t, govKeeper.AddVote(ctx, proposalID, addrs[2], v1.NewNonSplitVoteOption(v1.OptionNo), ""))
+			},
+			expectedYes: "1000000",
+			expectedNo:  "1000000",
+		},
+		{
+			name: "transitive delegation",
+			setup: func(govKeeper *keeper.Keeper, ctx sdk.Context, _ uint64, addrs []sdk.AccAddress) {
+				require.NoError(t, govKeeper.DelegateVote(ctx, addrs[2], addrs[3]))
+				require.NoError(t, govKeeper.DelegateVote(ctx, addrs[3], addrs[1]))
+			},
+			expectedYes: "1400000",
+			expectedNo:  "600000",
+		},
+		{
+			name: "delegator inherits the vote of the first voter of its chain",
+			setup: func(govKeeper *keeper.Keeper, ctx sdk.Context, proposalID uint64, addrs []sdk.AccAddress) {
+				require.NoError(t, govKeeper.DelegateVote(ctx, addrs[2], addrs[3]))
+				require.NoError(t, govKeeper.DelegateVote(ctx, addrs[3], addrs[0]))
+				require.NoError(t, govKeeper.AddVote(ctx, proposalID, addrs[3], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+			},
+			expectedYes: "1400000",
+			expectedNo:  "600000",
+		},
+		{
+			name:     "delegation beyond the maximum depth is ignored",
+			maxDepth: 1,
+			setup: func(govKeeper *keeper.Keeper, ctx sdk.Context, _ uint64, addrs []sdk.AccAddress) {
+				require.NoError(t, govKeeper.DelegateVote(ctx, addrs[2], addrs[3]))
+				require.NoError(t, govKeeper.DelegateVote(ctx, addrs[3], addrs[1]))
+			},
+			expectedYes: "1000000",
+			expectedNo:  "1000000",
+		},
+		{
+			name: "revoked delegation is ignored",
+			setup: func(govKeeper *keeper.Keeper, ctx sdk.Context, _ uint64, addrs []sdk.AccAddress) {
+				require.NoError(t, govKeeper.DelegateVote(ctx, addrs[2], addrs[1]))
+				require.NoError(t, govKeeper.UndelegateVote(ctx, addrs[2]))
+			},
+			expectedYes: "1000000",
+			expectedNo:  "1000000",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := keeper.DefaultConfig()
+			if tt.maxDepth != 0 {
+				config.MaxVoteDelegationDepth = tt.maxDepth
+			}
+			govKeeper, mocks, _, ctx := setupGovKeeperWithConfig(t, config, mockAccountKeeperExpectations)
+			addrs := simtestutil.CreateRandomAccounts(5)
+			valAddrs := simtestutil.ConvertAddrsToValAddrs(addrs[:2])
+
+			mocks.stakingKeeper.EXPECT().
+				IterateBondedValidatorsByPower(ctx, gomock.Any()).
+				DoAndReturn(
+					func(ctx context.Context, fn func(index int64, validator sdk.ValidatorI) bool) error {
+						for i, valAddr := range valAddrs {
+							valAddrStr, err := mocks.stakingKeeper.ValidatorAddressCodec().BytesToString(valAddr)
+							require.NoError(t, err)
+							fn(int64(i), stakingtypes.Validator{
+								OperatorAddress: valAddrStr,
+								Status:          stakingtypes.Bonded,
+								Tokens:          sdkmath.NewInt(1000000),
+								DelegatorShares: sdkmath.LegacyNewDec(1000000),
+							})
+						}
+						return nil
+					})
+
+			proposal, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", addrs[4], v1.ProposalType_PROPOSAL_TYPE_STANDARD)
+			require.NoError(t, err)
+			require.NoError(t, govKeeper.ActivateVotingPeriod(ctx, proposal))
+
+			s := tallyFixture{t: t, proposal: proposal, valAddrs: valAddrs, ctx: ctx, keeper: govKeeper, mocks: mocks}
+			setTotalBonded(s, 2000000)
+			validatorVote(s, valAddrs[0], v1.OptionNo)
+			validatorVote(s, valAddrs[1], v1.OptionYes)
+
+			val0Addr, err := mocks.stakingKeeper.ValidatorAddressCodec().BytesToString(valAddrs[0])
+			require.NoError(t, err)
+			del2Addr, err := mocks.acctKeeper.AddressCodec().BytesToString(addrs[2])
+			require.NoError(t, err)
+			mocks.stakingKeeper.EXPECT().
+				IterateDelegations(ctx, addrs[2], gomock.Any()).
+				DoAndReturn(
+					func(ctx context.Context, voter sdk.AccAddress, fn func(index int64, d sdk.DelegationI) bool) error {
+						fn(0, stakingtypes.Delegation{
+							DelegatorAddress: del2Addr,
+							ValidatorAddress: val0Addr,
+							Shares:           sdkmath.LegacyNewDec(400000),
+						})
+						return nil
+					}).AnyTimes()
+			mocks.stakingKeeper.EXPECT().IterateDelegations(ctx, addrs[3], gomock.Any()).Return(nil).AnyTimes()
+
+			tt.setup(govKeeper, ctx, proposal.Id, addrs)
+
+			_, _, tally, err := govKeeper.Tally(ctx, proposal)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedYes, tally.YesCount)
+			assert.Equal(t, tt.expectedNo, tally.NoCount)
+		})
+	}
+}
@@ -99,6 +99,30 @@ func ValidateGenesis(ac address.Codec, data *GenesisState) error {
 		return nil
 	})
 
+	// weed out duplicate and self vote delegations
+	errGroup.Go(func() error {
+		delegators := make(map[string]struct{})
+		for _, d := range data.VoteDelegations {
+			if _, err := ac.StringToBytes(d.Delegator); err != nil {
+				return fmt.Errorf("invalid vote delegation delegator address %s: %w", d.Delegator, err)
+			}
+			if _, err := ac.StringToBytes(d.Delegate); err != nil {
+				return fmt.Errorf("invalid vote delegation delegate address %s: %w", d.Delegate, err)
+			}
+			if d.Delegator == d.Delegate {
+				return fmt.Errorf("account %s delegates its vote to itself", d.Delegator)
+			}
+
+			if _, ok := delegators[d.Delegator]; ok {
+				return fmt.Errorf("duplicate vote delegation: %v", d)
+			}
+
+			delegators[d.Delegator] = struct{}{}
+		}
+
+		return nil
+	})
+
 	// verify params
 	errGroup.Go(func() error {
 		return data.Params.ValidateBasic(ac)
@@ -172,6 +172,34 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			expErrMsg: "deposit proposal_id:1 depositor:\"depositor\"",
 		},
+		{
+			name: "self vote delegation",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				addr, err := codec.BytesToString(sdk.AccAddress("delegator"))
+				require.NoError(t, err)
+				state.VoteDelegations = append(state.VoteDelegations, &v1.VoteDelegation{Delegator: addr, Delegate: addr})
+
+				return state
+			},
+			expErrMsg: "delegates its vote to itself",
+		},
+		{
+			name: "duplicate vote delegations",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				delegator, err := codec.BytesToString(sdk.AccAddress("delegator"))
+				require.NoError(t, err)
+				delegate, err := codec.BytesToString(sdk.AccAddress("delegate"))
+				require.NoError(t, err)
+				state.VoteDelegations = append(state.VoteDelegations,
+					&v1.VoteDelegation{Delegator: delegator, Delegate: delegate},
+					&v1.VoteDelegation{Delegator: delegator, Delegate: delegate})
+
+				return state
+			},
+			expErrMsg: "duplicate vote delegation",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -956,15 +956,15 @@ type Params struct {
 	MaxDepositPeriod *time.Duration `protobuf:"bytes,2,opt,name=max_deposit_period,json=maxDepositPeriod,proto3,stdduration" json:"max_deposit_period,omitempty"`
 	// Duration of the voting period.
 	VotingPeriod *time.Duration `protobuf:"bytes,3,opt,name=voting_period,json=votingPeriod,proto3,stdduration" json:"voting_period,omitempty"`
-	// Minimum percentage of total stake needed to vote for a result to be
-	// considered valid.
+	//  Minimum percentage of total stake needed to vote for a result to be
+	//  considered valid.
 	Quorum string `protobuf:"bytes,4,opt,name=quorum,proto3" json:"quorum,omitempty"`
-	// Minimum proportion of Yes votes for proposal to pass. Default value: 0.5.
+	//  Minimum proportion of Yes votes for proposal to pass. Default value: 0.5.
 	Threshold string `protobuf:"bytes,5,opt,name=threshold,proto3" json:"threshold,omitempty"`
-	// Minimum value of Veto votes to Total votes ratio for proposal to be
-	// vetoed. Default value: 1/3.
+	//  Minimum value of Veto votes to Total votes ratio for proposal to be
+	//  vetoed. Default value: 1/3.
 	VetoThreshold string `protobuf:"bytes,6,opt,name=veto_threshold,json=vetoThreshold,proto3" json:"veto_threshold,omitempty"`
-	// The ratio representing the proportion of the deposit value that must be paid at proposal submission.
+	//  The ratio representing the proportion of the deposit value that must be paid at proposal submission.
 	MinInitialDepositRatio string `protobuf:"bytes,7,opt,name=min_initial_deposit_ratio,json=minInitialDepositRatio,proto3" json:"min_initial_deposit_ratio,omitempty"`
 	// The cancel ratio which will not be returned back to the depositors when a proposal is cancelled.
 	ProposalCancelRatio string `protobuf:"bytes,8,opt,name=proposal_cancel_ratio,json=proposalCancelRatio,proto3" json:"proposal_cancel_ratio,omitempty"`
@@ -975,7 +975,7 @@ type Params struct {
 	ExpeditedVotingPeriod *time.Duration `protobuf:"bytes,10,opt,name=expedited_voting_period,json=expeditedVotingPeriod,proto3,stdduration" json:"expedited_voting_period,omitempty"`
 	// Minimum proportion of Yes votes for proposal to pass. Default value: 0.67.
 	ExpeditedThreshold string `protobuf:"bytes,11,opt,name=expedited_threshold,json=expeditedThreshold,proto3" json:"expedited_threshold,omitempty"`
-	// Minimum expedited deposit for a proposal to enter voting period.
+	//  Minimum expedited deposit for a proposal to enter voting period.
 	ExpeditedMinDeposit []types.Coin `protobuf:"bytes,12,rep,name=expedited_min_deposit,json=expeditedMinDeposit,proto3" json:"expedited_min_deposit"`
 	// burn deposits if a proposal does not meet quorum
 	BurnVoteQuorum bool `protobuf:"varint,13,opt,name=burn_vote_quorum,json=burnVoteQuorum,proto3" json:"burn_vote_quorum,omitempty"`
@@ -1201,6 +1201,9 @@ type MessageBasedParams struct {
 	Threshold string `protobuf:"bytes,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
 	// Minimum value of Veto votes to Total votes ratio for proposal to be vetoed.
 	VetoThreshold string `protobuf:"bytes,4,opt,name=veto_threshold,json=vetoThreshold,proto3" json:"veto_threshold,omitempty"`
+	// tally_strategy defines the name of the tally strategy used to tally the proposals.
+	// The strategy must be registered in the application. If empty, the default tally is used.
+	TallyStrategy string `protobuf:"bytes,21,opt,name=tally_strategy,json=tallyStrategy,proto3" json:"tally_strategy,omitempty"`
 }
 
 func (m *MessageBasedParams) Reset()         { *m = MessageBasedParams{} }
@@ -1271,6 +1274,70 @@ func (m *MessageBasedParams) GetVetoThreshold() string {
 	return ""
 }
 
+func (m *MessageBasedParams) GetTallyStrategy() string {
+	if m != nil {
+		return m.TallyStrategy
+	}
+	return ""
+}
+
+// VoteDelegation defines the delegation of the governance voting power of an account to another account,
+// independently of its staking delegations.
+type VoteDelegation struct {
+	// delegator is the address of the account delegating its voting power.
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	// delegate is the address of the account voting on behalf of the delegator.
+	// It can be any account, including a group policy account.
+	Delegate string `protobuf:"bytes,2,opt,name=delegate,proto3" json:"delegate,omitempty"`
+}
+
+func (m *VoteDelegation) Reset()         { *m = VoteDelegation{} }
+func (m *VoteDelegation) String() string { return proto.CompactTextString(m) }
+func (*VoteDelegation) ProtoMessage()    {}
+func (*VoteDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{11}
+}
+func (m *VoteDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VoteDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VoteDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VoteDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoteDelegation.Merge(m, src)
+}
+func (m *VoteDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *VoteDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoteDelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoteDelegation proto.InternalMessageInfo
+
+func (m *VoteDelegation) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
+}
+
+func (m *VoteDelegation) GetDelegate() string {
+	if m != nil {
+		return m.Delegate
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.gov.v1.ProposalType", ProposalType_name, ProposalType_value)
 	proto.RegisterEnum("cosmos.gov.v1.VoteOption", VoteOption_name, VoteOption_value)
@@ -1286,136 +1353,141 @@ func init() {
 	proto.RegisterType((*TallyParams)(nil), "cosmos.gov.v1.TallyParams")
 	proto.RegisterType((*Params)(nil), "cosmos.gov.v1.Params")
 	proto.RegisterType((*MessageBasedParams)(nil), "cosmos.gov.v1.MessageBasedParams")
+	proto.RegisterType((*VoteDelegation)(nil), "cosmos.gov.v1.VoteDelegation")
 }
 
 func init() { proto.RegisterFile("cosmos/gov/v1/gov.proto", fileDescriptor_e05cb1c0d030febb) }
 
 var fileDescriptor_e05cb1c0d030febb = []byte{
-	// 1971 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcf, 0x6f, 0xdb, 0xc8,
-	0x15, 0x0e, 0x25, 0x59, 0xb6, 0x9e, 0x25, 0x99, 0x1e, 0xdb, 0x6b, 0xc6, 0x5e, 0xcb, 0x8e, 0x50,
-	0x2c, 0xdc, 0xec, 0x5a, 0xb2, 0xb3, 0x75, 0xbb, 0x4d, 0x37, 0x07, 0xc9, 0x62, 0x36, 0x0c, 0x62,
-	0x4b, 0xa5, 0x18, 0x27, 0x69, 0x51, 0x10, 0xb4, 0x39, 0xb1, 0xb9, 0x2b, 0x72, 0x54, 0x72, 0x64,
-	0xc7, 0xfd, 0x2b, 0xf6, 0xd8, 0x53, 0xd1, 0x5b, 0xf7, 0xd8, 0x43, 0xd0, 0x7b, 0x6f, 0x8b, 0x1e,
-	0x8a, 0x45, 0x4e, 0xc5, 0x02, 0x4d, 0x8b, 0xe4, 0x50, 0x60, 0xff, 0x84, 0xa2, 0x40, 0x8b, 0x19,
-	0x0e, 0x45, 0xea, 0x87, 0x63, 0x79, 0xd1, 0x4b, 0x22, 0xcf, 0xfb, 0xbe, 0x6f, 0xde, 0xbc, 0x5f,
-	0x33, 0x12, 0x2c, 0x1f, 0x93, 0xc0, 0x25, 0x41, 0xf5, 0x84, 0x9c, 0x55, 0xcf, 0x76, 0xd8, 0x7f,
-	0x95, 0xae, 0x4f, 0x28, 0x41, 0x85, 0xd0, 0x50, 0x61, 0x2b, 0x67, 0x3b, 0x2b, 0x25, 0x81, 0x3b,
-	0xb2, 0x02, 0x5c, 0x3d, 0xdb, 0x39, 0xc2, 0xd4, 0xda, 0xa9, 0x1e, 0x13, 0xc7, 0x0b, 0xe1, 0x2b,
-	0x8b, 0x27, 0xe4, 0x84, 0xf0, 0x8f, 0x55, 0xf6, 0x49, 0xac, 0xae, 0x9f, 0x10, 0x72, 0xd2, 0xc1,
-	0x55, 0xfe, 0xd7, 0x51, 0xef, 0x79, 0x95, 0x3a, 0x2e, 0x0e, 0xa8, 0xe5, 0x76, 0x05, 0xe0, 0xe6,
-	0x30, 0xc0, 0xf2, 0x2e, 0x84, 0xa9, 0x34, 0x6c, 0xb2, 0x7b, 0xbe, 0x45, 0x1d, 0x12, 0xed, 0x78,
-	0x33, 0xf4, 0xc8, 0x0c, 0x37, 0x15, 0xde, 0x86, 0xa6, 0x79, 0xcb, 0x75, 0x3c, 0x52, 0xe5, 0xff,
-	0x86, 0x4b, 0x65, 0x02, 0xe8, 0x09, 0x76, 0x4e, 0x4e, 0x29, 0xb6, 0x0f, 0x09, 0xc5, 0xcd, 0x2e,
-	0x53, 0x42, 0x3b, 0x90, 0x25, 0xfc, 0x93, 0x22, 0x6d, 0x48, 0x9b, 0xc5, 0x3b, 0x37, 0x2b, 0x03,
-	0xa7, 0xae, 0xc4, 0x50, 0x5d, 0x00, 0xd1, 0x07, 0x90, 0x3d, 0xe7, 0x42, 0x4a, 0x6a, 0x43, 0xda,
-	0xcc, 0xd5, 0x8b, 0xaf, 0x5e, 0x6e, 0x81, 0x60, 0x35, 0xf0, 0xb1, 0x2e, 0xac, 0xe5, 0xdf, 0x4b,
-	0x30, 0xdd, 0xc0, 0x5d, 0x12, 0x38, 0x14, 0xad, 0xc3, 0x6c, 0xd7, 0x27, 0x5d, 0x12, 0x58, 0x1d,
-	0xd3, 0xb1, 0xf9, 0x5e, 0x19, 0x1d, 0xa2, 0x25, 0xcd, 0x46, 0x3f, 0x86, 0x9c, 0x1d, 0x62, 0x89,
-	0x2f, 0x74, 0x95, 0x57, 0x2f, 0xb7, 0x16, 0x85, 0x6e, 0xcd, 0xb6, 0x7d, 0x1c, 0x04, 0x6d, 0xea,
-	0x3b, 0xde, 0x89, 0x1e, 0x43, 0xd1, 0xa7, 0x90, 0xb5, 0x5c, 0xd2, 0xf3, 0xa8, 0x92, 0xde, 0x48,
-	0x6f, 0xce, 0xc6, 0xfe, 0xb3, 0x34, 0x55, 0x44, 0x9a, 0x2a, 0x7b, 0xc4, 0xf1, 0xea, 0xb9, 0xaf,
-	0x5f, 0xaf, 0xdf, 0xf8, 0xea, 0x5f, 0x7f, 0xbc, 0x2d, 0xe9, 0x82, 0x53, 0xfe, 0xf3, 0x34, 0xcc,
-	0xb4, 0x84, 0x13, 0xa8, 0x08, 0xa9, 0xbe, 0x6b, 0x29, 0xc7, 0x46, 0xdb, 0x30, 0xe3, 0xe2, 0x20,
-	0xb0, 0x4e, 0x70, 0xa0, 0xa4, 0xb8, 0xf8, 0x62, 0x25, 0xcc, 0x48, 0x25, 0xca, 0x48, 0xa5, 0xe6,
-	0x5d, 0xe8, 0x7d, 0x14, 0xda, 0x85, 0x6c, 0x40, 0x2d, 0xda, 0x0b, 0x94, 0x34, 0x0f, 0xe6, 0xda,
-	0x50, 0x30, 0xa3, 0xad, 0xda, 0x1c, 0xa4, 0x0b, 0x30, 0x7a, 0x00, 0xe8, 0xb9, 0xe3, 0x59, 0x1d,
-	0x93, 0x5a, 0x9d, 0xce, 0x85, 0xe9, 0xe3, 0xa0, 0xd7, 0xa1, 0x4a, 0x66, 0x43, 0xda, 0x9c, 0xbd,
-	0xb3, 0x32, 0x24, 0x61, 0x30, 0x88, 0xce, 0x11, 0xba, 0xcc, 0x59, 0x89, 0x15, 0x54, 0x83, 0xd9,
-	0xa0, 0x77, 0xe4, 0x3a, 0xd4, 0x64, 0x65, 0xa6, 0x4c, 0x09, 0x89, 0x61, 0xaf, 0x8d, 0xa8, 0x06,
-	0xeb, 0x99, 0x2f, 0xff, 0xb1, 0x2e, 0xe9, 0x10, 0x92, 0xd8, 0x32, 0x7a, 0x08, 0xb2, 0x88, 0xae,
-	0x89, 0x3d, 0x3b, 0xd4, 0xc9, 0x4e, 0xa8, 0x53, 0x14, 0x4c, 0xd5, 0xb3, 0xb9, 0x96, 0x06, 0x05,
-	0x4a, 0xa8, 0xd5, 0x31, 0xc5, 0xba, 0x32, 0x7d, 0x8d, 0x1c, 0xe5, 0x39, 0x35, 0x2a, 0xa0, 0x47,
-	0x30, 0x7f, 0x46, 0xa8, 0xe3, 0x9d, 0x98, 0x01, 0xb5, 0x7c, 0x71, 0xbe, 0x99, 0x09, 0xfd, 0x9a,
-	0x0b, 0xa9, 0x6d, 0xc6, 0xe4, 0x8e, 0x3d, 0x00, 0xb1, 0x14, 0x9f, 0x31, 0x37, 0xa1, 0x56, 0x21,
-	0x24, 0x46, 0x47, 0x5c, 0x61, 0x45, 0x42, 0x2d, 0xdb, 0xa2, 0x96, 0x02, 0xac, 0x6c, 0xf5, 0xfe,
-	0xdf, 0xe8, 0x87, 0x30, 0x45, 0x1d, 0xda, 0xc1, 0xca, 0x2c, 0xaf, 0xe7, 0x85, 0x6f, 0x5f, 0x6e,
-	0xcd, 0x85, 0x27, 0xdf, 0x0a, 0xec, 0x2f, 0x36, 0xb6, 0x2b, 0x3f, 0xfa, 0x89, 0x1e, 0x22, 0xd0,
-	0x16, 0x4c, 0x07, 0x3d, 0xd7, 0xb5, 0xfc, 0x0b, 0x25, 0x7f, 0x39, 0x38, 0xc2, 0xa0, 0xcf, 0x60,
-	0x26, 0xec, 0x1d, 0xec, 0x2b, 0x05, 0x8e, 0xff, 0xf0, 0xb2, 0x66, 0x19, 0xa7, 0xd3, 0x27, 0xa3,
-	0x8f, 0x21, 0x87, 0x5f, 0x74, 0xb1, 0xed, 0x50, 0x6c, 0x2b, 0xc5, 0x0d, 0x69, 0x73, 0xa6, 0xbe,
-	0x34, 0xc2, 0xd8, 0xdd, 0x56, 0x24, 0x3d, 0xc6, 0xa1, 0x4f, 0xa0, 0xf0, 0xdc, 0x72, 0x3a, 0xd8,
-	0x36, 0x7d, 0x6c, 0x05, 0xc4, 0x53, 0xe6, 0x2e, 0x71, 0x79, 0x77, 0x5b, 0xcf, 0x87, 0x48, 0x9d,
-	0x03, 0x91, 0x0e, 0x85, 0xfe, 0x18, 0xa0, 0x17, 0x5d, 0xac, 0xc8, 0xbc, 0x4f, 0x56, 0x2f, 0xe9,
-	0x13, 0xe3, 0xa2, 0x8b, 0xeb, 0xf2, 0xb7, 0x2f, 0xb7, 0xf2, 0x2f, 0xd8, 0x5c, 0xde, 0x38, 0xdb,
-	0xae, 0xdc, 0xa9, 0x6c, 0xeb, 0xf9, 0x6e, 0xc2, 0x5e, 0xfe, 0x8b, 0x04, 0x0b, 0x11, 0x21, 0x9e,
-	0x56, 0x01, 0x5a, 0x03, 0x08, 0x07, 0x96, 0x49, 0x3c, 0xcc, 0xdb, 0x3a, 0xa7, 0xe7, 0xc2, 0x95,
-	0xa6, 0x87, 0x13, 0x66, 0x7a, 0x4e, 0xc2, 0x89, 0x13, 0x99, 0x8d, 0x73, 0x82, 0x6e, 0x41, 0x3e,
-	0x32, 0x9f, 0xfa, 0x18, 0xf3, 0x86, 0xce, 0xe9, 0xb3, 0x02, 0xc0, 0x96, 0xd8, 0x4c, 0x13, 0x90,
-	0xe7, 0xa4, 0xe7, 0xf3, 0x7e, 0xcd, 0xe9, 0x42, 0xf4, 0x3e, 0xe9, 0xf9, 0x09, 0x40, 0xd0, 0xb5,
-	0x5c, 0xde, 0x8d, 0x7d, 0x40, 0xbb, 0x6b, 0xb9, 0x77, 0xe5, 0x57, 0x43, 0x47, 0x2b, 0xff, 0x27,
-	0x0d, 0xb3, 0xc9, 0x86, 0xde, 0x82, 0xdc, 0x05, 0x0e, 0xcc, 0x63, 0x3e, 0xe1, 0xf8, 0x19, 0xea,
-	0x72, 0x62, 0xdc, 0x6a, 0x6c, 0x55, 0x9f, 0xb9, 0xc0, 0xc1, 0x1e, 0x43, 0xa0, 0x5d, 0x28, 0x58,
-	0x47, 0x01, 0xb5, 0x1c, 0x4f, 0x50, 0x52, 0x97, 0x50, 0xf2, 0x02, 0x16, 0xd2, 0x3e, 0x84, 0x19,
-	0x8f, 0x08, 0x46, 0xfa, 0x12, 0xc6, 0xb4, 0x47, 0x42, 0xf0, 0x3d, 0x40, 0x1e, 0x31, 0xcf, 0x1d,
-	0x7a, 0x6a, 0x9e, 0x61, 0x1a, 0xd1, 0x32, 0x97, 0xd0, 0xe6, 0x3c, 0xf2, 0xc4, 0xa1, 0xa7, 0x87,
-	0x98, 0x0a, 0xfa, 0x27, 0x20, 0xc7, 0x69, 0x11, 0xe4, 0xa9, 0x91, 0x7b, 0x44, 0xf3, 0xa8, 0x5e,
-	0xec, 0x27, 0x6b, 0x98, 0x49, 0xcf, 0xa3, 0x6d, 0xb3, 0xef, 0x62, 0x1a, 0xe7, 0x62, 0xcf, 0x4f,
-	0x01, 0x25, 0x93, 0x29, 0xb8, 0xd3, 0x63, 0xb9, 0x72, 0x22, 0xc5, 0x21, 0xfb, 0x2e, 0xcc, 0x27,
-	0xf2, 0x2c, 0xc8, 0x33, 0x63, 0xc9, 0x73, 0x71, 0xf6, 0x43, 0xee, 0x16, 0x00, 0xcb, 0xbd, 0x20,
-	0xe5, 0xc6, 0x92, 0x72, 0x0c, 0xc1, 0xe1, 0xe5, 0x3f, 0x49, 0x90, 0x61, 0x35, 0x7c, 0xf5, 0x7d,
-	0x59, 0x81, 0xa9, 0x33, 0x42, 0xf1, 0xd5, 0x77, 0x65, 0x08, 0x43, 0x3f, 0x83, 0xe9, 0xd0, 0xb7,
-	0x40, 0xc9, 0xf0, 0x21, 0x7c, 0x6b, 0xa8, 0xe7, 0x46, 0xdf, 0x06, 0x7a, 0xc4, 0x18, 0x18, 0x72,
-	0x53, 0x83, 0x43, 0xee, 0x61, 0x66, 0x26, 0x2d, 0x67, 0xca, 0x7f, 0x97, 0xa0, 0x20, 0x46, 0x75,
-	0xcb, 0xf2, 0x2d, 0x37, 0x40, 0xcf, 0x60, 0xd6, 0x75, 0xbc, 0xfe, 0xe4, 0x97, 0xae, 0x9a, 0xfc,
-	0x6b, 0x6c, 0xf2, 0x7f, 0xf7, 0x7a, 0x7d, 0x29, 0xc1, 0xfa, 0x88, 0xb8, 0x0e, 0xc5, 0x6e, 0x97,
-	0x5e, 0xe8, 0xe0, 0x3a, 0x5e, 0x74, 0x17, 0xb8, 0x80, 0x5c, 0xeb, 0x45, 0x04, 0x32, 0xbb, 0xd8,
-	0x77, 0x88, 0xcd, 0x03, 0xc1, 0x76, 0x18, 0x1e, 0xe0, 0x0d, 0xf1, 0x68, 0xaa, 0xff, 0xe0, 0xbb,
-	0xd7, 0xeb, 0xef, 0x8f, 0x12, 0xe3, 0x4d, 0x7e, 0xcb, 0xe6, 0xbb, 0xec, 0x5a, 0x2f, 0xa2, 0x93,
-	0x70, 0xfb, 0xdd, 0x94, 0x22, 0x95, 0x9f, 0x42, 0xfe, 0x90, 0xcf, 0x7d, 0x71, 0xba, 0x06, 0x88,
-	0x7b, 0x20, 0xda, 0x5d, 0xba, 0x6a, 0xf7, 0x0c, 0x57, 0xcf, 0x87, 0xac, 0x84, 0xf2, 0xef, 0x24,
-	0xd1, 0xf1, 0x42, 0xf9, 0x03, 0xc8, 0xfe, 0xba, 0x47, 0xfc, 0x9e, 0x2b, 0xda, 0x7d, 0xe4, 0x75,
-	0x15, 0x5a, 0xd1, 0x47, 0x90, 0x63, 0xc5, 0x1c, 0x9c, 0x92, 0x8e, 0x7d, 0xc9, 0x43, 0x2c, 0x06,
-	0xa0, 0x5d, 0x28, 0xf2, 0x66, 0x8d, 0x29, 0xe9, 0xb1, 0x94, 0x02, 0x43, 0x19, 0x11, 0x88, 0x3b,
-	0xf8, 0xdf, 0x3c, 0x64, 0x85, 0x6f, 0xea, 0x35, 0x73, 0x9a, 0xb8, 0xcd, 0x93, 0xf9, 0xdb, 0xff,
-	0x7e, 0xf9, 0xcb, 0x8c, 0xcf, 0xcf, 0x68, 0x2e, 0xd2, 0xdf, 0x23, 0x17, 0x89, 0xb8, 0x67, 0x26,
-	0x8f, 0xfb, 0xd4, 0xf5, 0xe3, 0x9e, 0x9d, 0x20, 0xee, 0x48, 0x83, 0x9b, 0x2c, 0xd0, 0x8e, 0xe7,
-	0x50, 0x27, 0x7e, 0x3e, 0x99, 0xdc, 0xfd, 0x31, 0x73, 0x8b, 0x29, 0xbc, 0xe7, 0x3a, 0x9e, 0x16,
-	0xe2, 0x45, 0x78, 0x74, 0x86, 0x46, 0x8f, 0x61, 0xa9, 0x3f, 0x49, 0x8e, 0x2d, 0xef, 0x18, 0x77,
-	0x84, 0x4c, 0x38, 0xc1, 0x6e, 0x0d, 0xca, 0x8c, 0xbb, 0xc2, 0x17, 0x22, 0xfe, 0x1e, 0xa7, 0x87,
-	0xb2, 0xbf, 0x82, 0xc5, 0x61, 0x59, 0x1b, 0x07, 0xd1, 0x88, 0x9b, 0xfc, 0x35, 0xb2, 0xbb, 0xad,
-	0xa3, 0x41, 0xfd, 0x06, 0x0e, 0x28, 0xfa, 0x1c, 0x96, 0xfb, 0xef, 0x0d, 0x73, 0x30, 0xbb, 0x70,
-	0x55, 0x76, 0x97, 0x59, 0x76, 0xc7, 0x6d, 0xb4, 0xd4, 0x97, 0x3c, 0x4c, 0x66, 0x5e, 0x87, 0x85,
-	0x78, 0xaf, 0x38, 0x51, 0xb3, 0x93, 0xc6, 0x07, 0xf5, 0xd9, 0x71, 0x02, 0x9f, 0x42, 0xbc, 0x99,
-	0x99, 0xec, 0x99, 0xfc, 0x35, 0x7a, 0x26, 0x76, 0x6b, 0x3f, 0x6e, 0x9e, 0x7b, 0x20, 0x1f, 0xf5,
-	0x7c, 0x8f, 0x05, 0x05, 0x9b, 0xa2, 0x62, 0x0b, 0xfc, 0xe1, 0x36, 0xf6, 0xc9, 0x58, 0x64, 0x60,
-	0x36, 0xd3, 0x7f, 0x1e, 0x96, 0xef, 0x21, 0xac, 0x71, 0x7a, 0x3f, 0x79, 0xfd, 0x2e, 0xf4, 0x31,
-	0x93, 0x14, 0x8f, 0xc0, 0xb1, 0x5a, 0x2b, 0x8c, 0x19, 0x3d, 0xb5, 0xa2, 0x1e, 0x0c, 0x69, 0xe8,
-	0xa7, 0x50, 0x8c, 0xdd, 0x62, 0xc5, 0xcc, 0x1f, 0x85, 0x97, 0x08, 0xe5, 0x23, 0xa7, 0xd8, 0xb3,
-	0x00, 0xed, 0xc3, 0x7c, 0x22, 0x42, 0xa2, 0x3a, 0xe5, 0x49, 0xa3, 0x3f, 0x17, 0x0f, 0x96, 0xb0,
-	0x32, 0x7f, 0x09, 0x2b, 0xc3, 0x95, 0xc9, 0xa6, 0x8d, 0xa8, 0x9e, 0x79, 0xae, 0x5b, 0x1a, 0xd1,
-	0x1d, 0x7c, 0x61, 0x2e, 0x0f, 0x96, 0xe4, 0xbe, 0xf5, 0x42, 0xd4, 0x4a, 0x17, 0xd6, 0xd9, 0xa5,
-	0xe8, 0x3a, 0x01, 0x75, 0x8e, 0x4d, 0xab, 0x47, 0x4f, 0x89, 0xef, 0xfc, 0x06, 0xdb, 0xa6, 0x15,
-	0x56, 0x39, 0x0e, 0x14, 0xb4, 0x91, 0xde, 0xcc, 0xd5, 0x37, 0xdf, 0xd1, 0x01, 0x83, 0x7b, 0xad,
-	0xc5, 0x82, 0xb5, 0xbe, 0x5e, 0x2d, 0x92, 0x43, 0x47, 0x90, 0x00, 0x98, 0x3e, 0xfe, 0x1c, 0x1f,
-	0x0f, 0xd6, 0xe9, 0xc2, 0x44, 0x27, 0x5a, 0x8d, 0x45, 0x74, 0xa1, 0x11, 0x57, 0xeb, 0x3d, 0x00,
-	0xf6, 0xca, 0x14, 0xd5, 0xb4, 0x38, 0x91, 0x20, 0x7b, 0x97, 0x8a, 0x9a, 0xd2, 0x40, 0x8e, 0x8b,
-	0x5d, 0x88, 0x2c, 0x4d, 0x24, 0x32, 0xd7, 0xe7, 0x85, 0x52, 0x77, 0x17, 0x5e, 0x8d, 0x96, 0x4b,
-	0xf9, 0xab, 0x14, 0xa0, 0xfd, 0xf0, 0x3b, 0x76, 0xdd, 0x0a, 0xb0, 0xfd, 0xff, 0xbc, 0x83, 0x13,
-	0x73, 0x3f, 0xf5, 0xce, 0xb9, 0xbf, 0x35, 0x26, 0x46, 0x23, 0x83, 0x3f, 0x8e, 0xc9, 0xc0, 0x35,
-	0x91, 0xbe, 0xfe, 0x35, 0x91, 0x99, 0xe4, 0x7a, 0x1e, 0xf9, 0xfe, 0x70, 0xfb, 0x0f, 0x12, 0xe4,
-	0x93, 0xdf, 0x9e, 0xd0, 0x1a, 0xdc, 0x6c, 0xe9, 0xcd, 0x56, 0xb3, 0x5d, 0x7b, 0x64, 0x1a, 0xcf,
-	0x5a, 0xaa, 0xf9, 0xf8, 0xa0, 0xdd, 0x52, 0xf7, 0xb4, 0xfb, 0x9a, 0xda, 0x90, 0x6f, 0xa0, 0x15,
-	0x78, 0x6f, 0xd0, 0xdc, 0x36, 0x6a, 0x07, 0x8d, 0x9a, 0xde, 0x90, 0x25, 0x74, 0x0b, 0xd6, 0x06,
-	0x6d, 0xfb, 0x8f, 0x1f, 0x19, 0x5a, 0xeb, 0x91, 0x6a, 0xee, 0x3d, 0x68, 0x6a, 0x7b, 0xaa, 0x9c,
-	0x42, 0xef, 0x83, 0x32, 0x08, 0x69, 0xb6, 0x0c, 0x6d, 0x5f, 0x6b, 0x1b, 0xda, 0x9e, 0x9c, 0x46,
-	0xab, 0xb0, 0x3c, 0x68, 0x55, 0x9f, 0xb6, 0xd4, 0x86, 0x66, 0xa8, 0x0d, 0x39, 0x73, 0xfb, 0xdf,
-	0x12, 0x40, 0xe2, 0x77, 0xa8, 0x55, 0x58, 0x3e, 0x6c, 0x1a, 0xa1, 0x40, 0xf3, 0x60, 0xc8, 0xcb,
-	0x05, 0x98, 0x4b, 0x1a, 0x9f, 0xa9, 0x6d, 0x59, 0x1a, 0x5e, 0x6c, 0x1e, 0xa8, 0xb2, 0x84, 0x96,
-	0x61, 0x21, 0xb9, 0x58, 0xab, 0xb7, 0x8d, 0x9a, 0x76, 0x20, 0xa7, 0x86, 0xd1, 0xc6, 0x93, 0xa6,
-	0x9c, 0x42, 0x08, 0x8a, 0xc9, 0xc5, 0x83, 0xa6, 0x9c, 0x46, 0x4b, 0x30, 0x3f, 0x00, 0x7c, 0xa0,
-	0xab, 0xaa, 0x9c, 0x66, 0x27, 0x1d, 0x84, 0x9a, 0x4f, 0x34, 0xe3, 0x81, 0x79, 0xa8, 0x1a, 0x4d,
-	0x39, 0x83, 0x16, 0x41, 0x4e, 0x5a, 0xef, 0x37, 0x1f, 0xeb, 0xa3, 0xab, 0xed, 0x56, 0x6d, 0x5f,
-	0x9e, 0x5a, 0x49, 0xc9, 0xd2, 0xed, 0xbf, 0x4a, 0x50, 0x1c, 0xfc, 0x31, 0x08, 0xad, 0xc3, 0x6a,
-	0x3f, 0x58, 0x6d, 0xa3, 0x66, 0x3c, 0x6e, 0x0f, 0x05, 0xa1, 0x0c, 0xa5, 0x61, 0x40, 0x43, 0x6d,
-	0x35, 0xdb, 0x9a, 0x61, 0xb6, 0x54, 0x5d, 0x6b, 0x0e, 0xa7, 0x4c, 0x60, 0x0e, 0x9b, 0x86, 0x76,
-	0xf0, 0x59, 0x04, 0x49, 0x0d, 0x64, 0x5c, 0x40, 0x5a, 0xb5, 0x76, 0x5b, 0x6d, 0x84, 0x87, 0x1c,
-	0xb6, 0xe9, 0xea, 0x43, 0x75, 0x8f, 0x67, 0x6c, 0x1c, 0xf3, 0x7e, 0x4d, 0x7b, 0xa4, 0x36, 0xe4,
-	0xa9, 0xfa, 0xee, 0xd7, 0x6f, 0x4a, 0xd2, 0x37, 0x6f, 0x4a, 0xd2, 0x3f, 0xdf, 0x94, 0xa4, 0x2f,
-	0xdf, 0x96, 0x6e, 0x7c, 0xf3, 0xb6, 0x74, 0xe3, 0x6f, 0x6f, 0x4b, 0x37, 0x7e, 0xb1, 0x1a, 0x96,
-	0x6f, 0x60, 0x7f, 0x51, 0x71, 0x48, 0x95, 0x17, 0x6b, 0x95, 0x7d, 0xf5, 0x0f, 0xaa, 0x67, 0x3b,
-	0x47, 0x59, 0xde, 0xa3, 0x1f, 0xff, 0x2f, 0x00, 0x00, 0xff, 0xff, 0xa8, 0xeb, 0xae, 0x28, 0x84,
-	0x15, 0x00, 0x00,
+	// 2034 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x4d, 0x6f, 0xdb, 0xc8,
+	0x19, 0x0e, 0x25, 0xf9, 0x43, 0xaf, 0x25, 0x99, 0x1e, 0xdb, 0x6b, 0xc6, 0x5e, 0x7f, 0xc4, 0x68,
+	0x17, 0x6e, 0x76, 0x2d, 0xdb, 0xd9, 0x75, 0xbb, 0x4d, 0x37, 0x07, 0xc9, 0x62, 0x36, 0x0c, 0x62,
+	0x4b, 0xa5, 0x18, 0x27, 0x69, 0x51, 0x10, 0xb4, 0x39, 0x91, 0xb9, 0x2b, 0x72, 0x54, 0x72, 0x64,
+	0xc7, 0xfd, 0x13, 0xdd, 0x63, 0x4f, 0x45, 0x6f, 0xed, 0xb1, 0x87, 0xa0, 0xf7, 0xde, 0x16, 0x3d,
+	0xb4, 0x8b, 0x9c, 0x8a, 0x05, 0x9a, 0x16, 0xc9, 0xa1, 0xc0, 0xfe, 0x84, 0xa2, 0x40, 0x8b, 0xf9,
+	0xa0, 0x48, 0xc9, 0x72, 0xac, 0x2c, 0xf6, 0x62, 0x8b, 0x33, 0xcf, 0xf3, 0xcc, 0x3b, 0xef, 0xd7,
+	0x0c, 0x09, 0x0b, 0xc7, 0x24, 0xf2, 0x49, 0xb4, 0xd5, 0x22, 0xa7, 0x5b, 0xa7, 0x3b, 0xec, 0x5f,
+	0xb9, 0x13, 0x12, 0x4a, 0x50, 0x51, 0x4c, 0x94, 0xd9, 0xc8, 0xe9, 0xce, 0xe2, 0x8a, 0xc4, 0x1d,
+	0x39, 0x11, 0xde, 0x3a, 0xdd, 0x39, 0xc2, 0xd4, 0xd9, 0xd9, 0x3a, 0x26, 0x5e, 0x20, 0xe0, 0x8b,
+	0x73, 0x2d, 0xd2, 0x22, 0xfc, 0xe7, 0x16, 0xfb, 0x25, 0x47, 0x57, 0x5b, 0x84, 0xb4, 0xda, 0x78,
+	0x8b, 0x3f, 0x1d, 0x75, 0x9f, 0x6e, 0x51, 0xcf, 0xc7, 0x11, 0x75, 0xfc, 0x8e, 0x04, 0x5c, 0x1f,
+	0x04, 0x38, 0xc1, 0xb9, 0x9c, 0x5a, 0x19, 0x9c, 0x72, 0xbb, 0xa1, 0x43, 0x3d, 0x12, 0xaf, 0x78,
+	0x5d, 0x58, 0x64, 0x8b, 0x45, 0xa5, 0xb5, 0x62, 0x6a, 0xc6, 0xf1, 0xbd, 0x80, 0x6c, 0xf1, 0xbf,
+	0x62, 0x68, 0x9d, 0x00, 0x7a, 0x84, 0xbd, 0xd6, 0x09, 0xc5, 0xee, 0x21, 0xa1, 0xb8, 0xde, 0x61,
+	0x4a, 0x68, 0x07, 0xc6, 0x09, 0xff, 0xa5, 0x29, 0x6b, 0xca, 0x46, 0xe9, 0xd6, 0xf5, 0x72, 0xdf,
+	0xae, 0xcb, 0x09, 0xd4, 0x94, 0x40, 0xf4, 0x1e, 0x8c, 0x9f, 0x71, 0x21, 0x2d, 0xb3, 0xa6, 0x6c,
+	0xe4, 0xab, 0xa5, 0x17, 0xcf, 0x37, 0x41, 0xb2, 0x6a, 0xf8, 0xd8, 0x94, 0xb3, 0xeb, 0xbf, 0x53,
+	0x60, 0xa2, 0x86, 0x3b, 0x24, 0xf2, 0x28, 0x5a, 0x85, 0xa9, 0x4e, 0x48, 0x3a, 0x24, 0x72, 0xda,
+	0xb6, 0xe7, 0xf2, 0xb5, 0x72, 0x26, 0xc4, 0x43, 0x86, 0x8b, 0x7e, 0x08, 0x79, 0x57, 0x60, 0x49,
+	0x28, 0x75, 0xb5, 0x17, 0xcf, 0x37, 0xe7, 0xa4, 0x6e, 0xc5, 0x75, 0x43, 0x1c, 0x45, 0x4d, 0x1a,
+	0x7a, 0x41, 0xcb, 0x4c, 0xa0, 0xe8, 0x13, 0x18, 0x77, 0x7c, 0xd2, 0x0d, 0xa8, 0x96, 0x5d, 0xcb,
+	0x6e, 0x4c, 0x25, 0xf6, 0xb3, 0x30, 0x95, 0x65, 0x98, 0xca, 0x7b, 0xc4, 0x0b, 0xaa, 0xf9, 0x2f,
+	0x5f, 0xae, 0x5e, 0xfb, 0xc3, 0xbf, 0xff, 0x78, 0x53, 0x31, 0x25, 0x67, 0xfd, 0xcf, 0x13, 0x30,
+	0xd9, 0x90, 0x46, 0xa0, 0x12, 0x64, 0x7a, 0xa6, 0x65, 0x3c, 0x17, 0x6d, 0xc3, 0xa4, 0x8f, 0xa3,
+	0xc8, 0x69, 0xe1, 0x48, 0xcb, 0x70, 0xf1, 0xb9, 0xb2, 0x88, 0x48, 0x39, 0x8e, 0x48, 0xb9, 0x12,
+	0x9c, 0x9b, 0x3d, 0x14, 0xda, 0x85, 0xf1, 0x88, 0x3a, 0xb4, 0x1b, 0x69, 0x59, 0xee, 0xcc, 0xe5,
+	0x01, 0x67, 0xc6, 0x4b, 0x35, 0x39, 0xc8, 0x94, 0x60, 0x74, 0x0f, 0xd0, 0x53, 0x2f, 0x70, 0xda,
+	0x36, 0x75, 0xda, 0xed, 0x73, 0x3b, 0xc4, 0x51, 0xb7, 0x4d, 0xb5, 0xdc, 0x9a, 0xb2, 0x31, 0x75,
+	0x6b, 0x71, 0x40, 0xc2, 0x62, 0x10, 0x93, 0x23, 0x4c, 0x95, 0xb3, 0x52, 0x23, 0xa8, 0x02, 0x53,
+	0x51, 0xf7, 0xc8, 0xf7, 0xa8, 0xcd, 0xd2, 0x4c, 0x1b, 0x93, 0x12, 0x83, 0x56, 0x5b, 0x71, 0x0e,
+	0x56, 0x73, 0x5f, 0xfc, 0x73, 0x55, 0x31, 0x41, 0x90, 0xd8, 0x30, 0xba, 0x0f, 0xaa, 0xf4, 0xae,
+	0x8d, 0x03, 0x57, 0xe8, 0x8c, 0x8f, 0xa8, 0x53, 0x92, 0x4c, 0x3d, 0x70, 0xb9, 0x96, 0x01, 0x45,
+	0x4a, 0xa8, 0xd3, 0xb6, 0xe5, 0xb8, 0x36, 0xf1, 0x16, 0x31, 0x2a, 0x70, 0x6a, 0x9c, 0x40, 0x0f,
+	0x60, 0xe6, 0x94, 0x50, 0x2f, 0x68, 0xd9, 0x11, 0x75, 0x42, 0xb9, 0xbf, 0xc9, 0x11, 0xed, 0x9a,
+	0x16, 0xd4, 0x26, 0x63, 0x72, 0xc3, 0xee, 0x81, 0x1c, 0x4a, 0xf6, 0x98, 0x1f, 0x51, 0xab, 0x28,
+	0x88, 0xf1, 0x16, 0x17, 0x59, 0x92, 0x50, 0xc7, 0x75, 0xa8, 0xa3, 0x01, 0x4b, 0x5b, 0xb3, 0xf7,
+	0x8c, 0x7e, 0x00, 0x63, 0xd4, 0xa3, 0x6d, 0xac, 0x4d, 0xf1, 0x7c, 0x9e, 0xfd, 0xfa, 0xf9, 0xe6,
+	0xb4, 0xd8, 0xf9, 0x66, 0xe4, 0x7e, 0xbe, 0xb6, 0x5d, 0xfe, 0xe8, 0x47, 0xa6, 0x40, 0xa0, 0x4d,
+	0x98, 0x88, 0xba, 0xbe, 0xef, 0x84, 0xe7, 0x5a, 0xe1, 0x72, 0x70, 0x8c, 0x41, 0x9f, 0xc2, 0xa4,
+	0xa8, 0x1d, 0x1c, 0x6a, 0x45, 0x8e, 0x7f, 0xff, 0xb2, 0x62, 0x19, 0xa6, 0xd3, 0x23, 0xa3, 0x0f,
+	0x21, 0x8f, 0x9f, 0x75, 0xb0, 0xeb, 0x51, 0xec, 0x6a, 0xa5, 0x35, 0x65, 0x63, 0xb2, 0x3a, 0x7f,
+	0x81, 0xb1, 0xbb, 0xad, 0x29, 0x66, 0x82, 0x43, 0x1f, 0x43, 0xf1, 0xa9, 0xe3, 0xb5, 0xb1, 0x6b,
+	0x87, 0xd8, 0x89, 0x48, 0xa0, 0x4d, 0x5f, 0x62, 0xf2, 0xee, 0xb6, 0x59, 0x10, 0x48, 0x93, 0x03,
+	0x91, 0x09, 0xc5, 0x5e, 0x1b, 0xa0, 0xe7, 0x1d, 0xac, 0xa9, 0xbc, 0x4e, 0x96, 0x2e, 0xa9, 0x13,
+	0xeb, 0xbc, 0x83, 0xab, 0xea, 0xd7, 0xcf, 0x37, 0x0b, 0xcf, 0x58, 0x5f, 0x5e, 0x3b, 0xdd, 0x2e,
+	0xdf, 0x2a, 0x6f, 0x9b, 0x85, 0x4e, 0x6a, 0x7e, 0xfd, 0x2f, 0x0a, 0xcc, 0xc6, 0x84, 0xa4, 0x5b,
+	0x45, 0x68, 0x19, 0x40, 0x34, 0x2c, 0x9b, 0x04, 0x98, 0x97, 0x75, 0xde, 0xcc, 0x8b, 0x91, 0x7a,
+	0x80, 0x53, 0xd3, 0xf4, 0x8c, 0x68, 0x99, 0xf4, 0xb4, 0x75, 0x46, 0xd0, 0x0d, 0x28, 0xc4, 0xd3,
+	0x27, 0x21, 0xc6, 0xbc, 0xa0, 0xf3, 0xe6, 0x94, 0x04, 0xb0, 0x21, 0xd6, 0xd3, 0x24, 0xe4, 0x29,
+	0xe9, 0x86, 0xbc, 0x5e, 0xf3, 0xa6, 0x14, 0xbd, 0x4b, 0xba, 0x61, 0x0a, 0x10, 0x75, 0x1c, 0x5f,
+	0x1b, 0x4b, 0x03, 0x9a, 0x1d, 0xc7, 0xbf, 0xad, 0xbe, 0x18, 0xd8, 0xda, 0xfa, 0x7f, 0xb3, 0x30,
+	0x95, 0x2e, 0xe8, 0x4d, 0xc8, 0x9f, 0xe3, 0xc8, 0x3e, 0xe6, 0x1d, 0x8e, 0xef, 0xa1, 0xaa, 0xa6,
+	0xda, 0xad, 0xc1, 0x46, 0xcd, 0xc9, 0x73, 0x1c, 0xed, 0x31, 0x04, 0xda, 0x85, 0xa2, 0x73, 0x14,
+	0x51, 0xc7, 0x0b, 0x24, 0x25, 0x73, 0x09, 0xa5, 0x20, 0x61, 0x82, 0xf6, 0x3e, 0x4c, 0x06, 0x44,
+	0x32, 0xb2, 0x97, 0x30, 0x26, 0x02, 0x22, 0xc0, 0x77, 0x00, 0x05, 0xc4, 0x3e, 0xf3, 0xe8, 0x89,
+	0x7d, 0x8a, 0x69, 0x4c, 0xcb, 0x5d, 0x42, 0x9b, 0x0e, 0xc8, 0x23, 0x8f, 0x9e, 0x1c, 0x62, 0x2a,
+	0xe9, 0x1f, 0x83, 0x9a, 0x84, 0x45, 0x92, 0xc7, 0x2e, 0x9c, 0x23, 0x46, 0x40, 0xcd, 0x52, 0x2f,
+	0x58, 0x83, 0x4c, 0x7a, 0x16, 0x2f, 0x3b, 0xfe, 0x26, 0xa6, 0x75, 0x26, 0xd7, 0xfc, 0x04, 0x50,
+	0x3a, 0x98, 0x92, 0x3b, 0x31, 0x94, 0xab, 0xa6, 0x42, 0x2c, 0xd8, 0xb7, 0x61, 0x26, 0x15, 0x67,
+	0x49, 0x9e, 0x1c, 0x4a, 0x9e, 0x4e, 0xa2, 0x2f, 0xb8, 0x9b, 0x00, 0x2c, 0xf6, 0x92, 0x94, 0x1f,
+	0x4a, 0xca, 0x33, 0x04, 0x87, 0xaf, 0xff, 0x49, 0x81, 0x1c, 0xcb, 0xe1, 0xab, 0xcf, 0xcb, 0x32,
+	0x8c, 0x9d, 0x12, 0x8a, 0xaf, 0x3e, 0x2b, 0x05, 0x0c, 0xfd, 0x04, 0x26, 0x84, 0x6d, 0x91, 0x96,
+	0xe3, 0x4d, 0xf8, 0xc6, 0x40, 0xcd, 0x5d, 0xbc, 0x1b, 0x98, 0x31, 0xa3, 0xaf, 0xc9, 0x8d, 0xf5,
+	0x37, 0xb9, 0xfb, 0xb9, 0xc9, 0xac, 0x9a, 0x5b, 0xff, 0x87, 0x02, 0x45, 0xd9, 0xaa, 0x1b, 0x4e,
+	0xe8, 0xf8, 0x11, 0x7a, 0x02, 0x53, 0xbe, 0x17, 0xf4, 0x3a, 0xbf, 0x72, 0x55, 0xe7, 0x5f, 0x66,
+	0x9d, 0xff, 0x9b, 0x97, 0xab, 0xf3, 0x29, 0xd6, 0x07, 0xc4, 0xf7, 0x28, 0xf6, 0x3b, 0xf4, 0xdc,
+	0x04, 0xdf, 0x0b, 0xe2, 0xb3, 0xc0, 0x07, 0xe4, 0x3b, 0xcf, 0x62, 0x90, 0xdd, 0xc1, 0xa1, 0x47,
+	0x5c, 0xee, 0x08, 0xb6, 0xc2, 0x60, 0x03, 0xaf, 0xc9, 0x4b, 0x53, 0xf5, 0x7b, 0xdf, 0xbc, 0x5c,
+	0x7d, 0xf7, 0x22, 0x31, 0x59, 0xe4, 0x37, 0xac, 0xbf, 0xab, 0xbe, 0xf3, 0x2c, 0xde, 0x09, 0x9f,
+	0xbf, 0x9d, 0xd1, 0x94, 0xf5, 0xc7, 0x50, 0x38, 0xe4, 0x7d, 0x5f, 0xee, 0xae, 0x06, 0xf2, 0x1c,
+	0x88, 0x57, 0x57, 0xae, 0x5a, 0x3d, 0xc7, 0xd5, 0x0b, 0x82, 0x95, 0x52, 0xfe, 0xad, 0x22, 0x2b,
+	0x5e, 0x2a, 0xbf, 0x07, 0xe3, 0xbf, 0xec, 0x92, 0xb0, 0xeb, 0x6b, 0xca, 0x85, 0x6c, 0xe1, 0xb7,
+	0x2b, 0x31, 0x8b, 0x3e, 0x80, 0x3c, 0x4b, 0xe6, 0xe8, 0x84, 0xb4, 0xdd, 0x4b, 0x2e, 0x62, 0x09,
+	0x00, 0xed, 0x42, 0x89, 0x17, 0x6b, 0x42, 0xc9, 0x0e, 0xa5, 0x14, 0x19, 0xca, 0x8a, 0x41, 0xdc,
+	0xc0, 0xff, 0x15, 0x60, 0x5c, 0xda, 0xa6, 0xbf, 0x65, 0x4c, 0x53, 0xa7, 0x79, 0x3a, 0x7e, 0xfb,
+	0xdf, 0x2e, 0x7e, 0xb9, 0xe1, 0xf1, 0xb9, 0x18, 0x8b, 0xec, 0xb7, 0x88, 0x45, 0xca, 0xef, 0xb9,
+	0xd1, 0xfd, 0x3e, 0xf6, 0xf6, 0x7e, 0x1f, 0x1f, 0xc1, 0xef, 0xc8, 0x80, 0xeb, 0xcc, 0xd1, 0x5e,
+	0xe0, 0x51, 0x2f, 0xb9, 0x3e, 0xd9, 0xdc, 0x7c, 0x6d, 0x62, 0xa8, 0xc2, 0x3b, 0xbe, 0x17, 0x18,
+	0x02, 0x2f, 0xdd, 0x63, 0x32, 0x34, 0x7a, 0x08, 0xf3, 0xbd, 0x4e, 0x72, 0xec, 0x04, 0xc7, 0xb8,
+	0x2d, 0x65, 0x44, 0x07, 0xbb, 0xd1, 0x2f, 0x33, 0xec, 0x08, 0x9f, 0x8d, 0xf9, 0x7b, 0x9c, 0x2e,
+	0x64, 0x7f, 0x01, 0x73, 0x83, 0xb2, 0x2e, 0x8e, 0xe2, 0x16, 0x37, 0xfa, 0x6d, 0x64, 0x77, 0xdb,
+	0x44, 0xfd, 0xfa, 0x35, 0x1c, 0x51, 0xf4, 0x19, 0x2c, 0xf4, 0xee, 0x1b, 0x76, 0x7f, 0x74, 0xe1,
+	0xaa, 0xe8, 0x2e, 0xb0, 0xe8, 0x0e, 0x5b, 0x68, 0xbe, 0x27, 0x79, 0x98, 0x8e, 0xbc, 0x09, 0xb3,
+	0xc9, 0x5a, 0x49, 0xa0, 0xa6, 0x46, 0xf5, 0x0f, 0xea, 0xb1, 0x93, 0x00, 0x3e, 0x86, 0x64, 0x31,
+	0x3b, 0x5d, 0x33, 0x85, 0xb7, 0xa8, 0x99, 0xc4, 0xac, 0xfd, 0xa4, 0x78, 0xee, 0x80, 0x7a, 0xd4,
+	0x0d, 0x03, 0xe6, 0x14, 0x6c, 0xcb, 0x8c, 0x2d, 0xf2, 0x8b, 0xdb, 0xd0, 0x2b, 0x63, 0x89, 0x81,
+	0x59, 0x4f, 0xff, 0xa9, 0x48, 0xdf, 0x43, 0x58, 0xe6, 0xf4, 0x5e, 0xf0, 0x7a, 0x55, 0x18, 0x62,
+	0x26, 0xa9, 0x95, 0x2e, 0xd7, 0x5a, 0x64, 0xcc, 0xf8, 0xaa, 0x15, 0xd7, 0xa0, 0xa0, 0xa1, 0x1f,
+	0x43, 0x29, 0x31, 0x8b, 0x25, 0xb3, 0x36, 0x7d, 0xb9, 0x50, 0x21, 0x36, 0x8a, 0x5d, 0x0b, 0xd0,
+	0x3e, 0xcc, 0xa4, 0x3c, 0x24, 0xb3, 0x53, 0x1d, 0xd5, 0xfb, 0xd3, 0x49, 0x63, 0x11, 0x99, 0xf9,
+	0x73, 0x58, 0x1c, 0xcc, 0x4c, 0xd6, 0x6d, 0x64, 0xf6, 0xcc, 0x70, 0xdd, 0x95, 0x0b, 0xba, 0xfd,
+	0x37, 0xcc, 0x85, 0xfe, 0x94, 0xdc, 0x77, 0x9e, 0xc9, 0x5c, 0xe9, 0xc0, 0x2a, 0x3b, 0x14, 0x7d,
+	0x2f, 0xa2, 0xde, 0xb1, 0xed, 0x74, 0xe9, 0x09, 0x09, 0xbd, 0x5f, 0x61, 0xd7, 0x76, 0x44, 0x96,
+	0xe3, 0x48, 0x43, 0x6b, 0xd9, 0x8d, 0x7c, 0x75, 0xe3, 0x0d, 0x15, 0xd0, 0xbf, 0xd6, 0x72, 0x22,
+	0x58, 0xe9, 0xe9, 0x55, 0x62, 0x39, 0x74, 0x04, 0x29, 0x80, 0x1d, 0xe2, 0xcf, 0xf0, 0x71, 0x7f,
+	0x9e, 0xce, 0x8e, 0xb4, 0xa3, 0xa5, 0x44, 0xc4, 0x94, 0x1a, 0x49, 0xb6, 0xde, 0x01, 0x60, 0xb7,
+	0x4c, 0x99, 0x4d, 0x73, 0x23, 0x09, 0xb2, 0x7b, 0xa9, 0xcc, 0x29, 0x03, 0xd4, 0x24, 0xd9, 0xa5,
+	0xc8, 0xfc, 0x48, 0x22, 0xd3, 0x3d, 0x9e, 0x90, 0xba, 0x3d, 0xfb, 0xe2, 0x62, 0xba, 0xac, 0xff,
+	0x2d, 0x03, 0x68, 0x5f, 0xbc, 0x63, 0x57, 0x9d, 0x08, 0xbb, 0xdf, 0xe5, 0x19, 0x9c, 0xea, 0xfb,
+	0x99, 0x37, 0xf6, 0xfd, 0xcd, 0x21, 0x3e, 0xba, 0xd0, 0xf8, 0x13, 0x9f, 0xf4, 0x1d, 0x13, 0xd9,
+	0xb7, 0x3f, 0x26, 0x72, 0xa3, 0x1c, 0x13, 0xdf, 0x87, 0x92, 0xf8, 0x64, 0x10, 0xd1, 0xd0, 0xa1,
+	0xb8, 0x75, 0x2e, 0xdc, 0x6e, 0x16, 0xf9, 0x68, 0x53, 0x0e, 0x0e, 0x79, 0xcd, 0xf8, 0xb5, 0x02,
+	0x25, 0x56, 0x7f, 0x35, 0xdc, 0xc6, 0x2d, 0xee, 0x1b, 0xf1, 0x01, 0x86, 0x3f, 0x91, 0x50, 0x53,
+	0xae, 0xb8, 0x54, 0x26, 0x50, 0xf4, 0x11, 0x4c, 0xca, 0x07, 0x7c, 0xe5, 0x5d, 0xb4, 0x87, 0xbc,
+	0x3d, 0xfd, 0xe2, 0xf9, 0xe6, 0x94, 0x30, 0x69, 0xa7, 0xbc, 0x5d, 0xde, 0xbe, 0xf9, 0x7b, 0x05,
+	0x0a, 0xe9, 0xd7, 0x3e, 0xb4, 0x0c, 0xd7, 0x1b, 0x66, 0xbd, 0x51, 0x6f, 0x56, 0x1e, 0xd8, 0xd6,
+	0x93, 0x86, 0x6e, 0x3f, 0x3c, 0x68, 0x36, 0xf4, 0x3d, 0xe3, 0xae, 0xa1, 0xd7, 0xd4, 0x6b, 0x68,
+	0x11, 0xde, 0xe9, 0x9f, 0x6e, 0x5a, 0x95, 0x83, 0x5a, 0xc5, 0xac, 0xa9, 0x0a, 0xba, 0x01, 0xcb,
+	0xfd, 0x73, 0xfb, 0x0f, 0x1f, 0x58, 0x46, 0xe3, 0x81, 0x6e, 0xef, 0xdd, 0xab, 0x1b, 0x7b, 0xba,
+	0x9a, 0x41, 0xef, 0x82, 0xd6, 0x0f, 0xa9, 0x37, 0x2c, 0x63, 0xdf, 0x68, 0x5a, 0xc6, 0x9e, 0x9a,
+	0x45, 0x4b, 0xb0, 0xd0, 0x3f, 0xab, 0x3f, 0x6e, 0xe8, 0x35, 0xc3, 0xd2, 0x6b, 0x6a, 0xee, 0xe6,
+	0x7f, 0x14, 0x80, 0xd4, 0x07, 0xb4, 0x25, 0x58, 0x38, 0xac, 0x5b, 0x42, 0xa0, 0x7e, 0x30, 0x60,
+	0xe5, 0x2c, 0x4c, 0xa7, 0x27, 0x9f, 0xe8, 0x4d, 0x55, 0x19, 0x1c, 0xac, 0x1f, 0xe8, 0xaa, 0x82,
+	0x16, 0x60, 0x36, 0x3d, 0x58, 0xa9, 0x36, 0xad, 0x8a, 0x71, 0xa0, 0x66, 0x06, 0xd1, 0xd6, 0xa3,
+	0xba, 0x9a, 0x41, 0x08, 0x4a, 0xe9, 0xc1, 0x83, 0xba, 0x9a, 0x45, 0xf3, 0x30, 0xd3, 0x07, 0xbc,
+	0x67, 0xea, 0xba, 0x9a, 0x65, 0x3b, 0xed, 0x87, 0xda, 0x8f, 0x0c, 0xeb, 0x9e, 0x7d, 0xa8, 0x5b,
+	0x75, 0x35, 0x87, 0xe6, 0x40, 0x4d, 0xcf, 0xde, 0xad, 0x3f, 0x34, 0x2f, 0x8e, 0x36, 0x1b, 0x95,
+	0x7d, 0x75, 0x6c, 0x31, 0xa3, 0x2a, 0x37, 0xff, 0xaa, 0x40, 0xa9, 0xff, 0x2b, 0x16, 0x5a, 0x85,
+	0xa5, 0x9e, 0xb3, 0x9a, 0x56, 0xc5, 0x7a, 0xd8, 0x1c, 0x70, 0xc2, 0x3a, 0xac, 0x0c, 0x02, 0x6a,
+	0x7a, 0xa3, 0xde, 0x34, 0x2c, 0xbb, 0xa1, 0x9b, 0x46, 0x7d, 0x30, 0x64, 0x12, 0x73, 0x58, 0xb7,
+	0x8c, 0x83, 0x4f, 0x63, 0x48, 0xa6, 0x2f, 0xe2, 0x12, 0xd2, 0xa8, 0x34, 0x9b, 0x7a, 0x4d, 0x6c,
+	0x72, 0x70, 0xce, 0xd4, 0xef, 0xeb, 0x7b, 0x3c, 0x62, 0xc3, 0x98, 0x77, 0x2b, 0xc6, 0x03, 0xbd,
+	0xa6, 0x8e, 0x55, 0x77, 0xbf, 0x7c, 0xb5, 0xa2, 0x7c, 0xf5, 0x6a, 0x45, 0xf9, 0xd7, 0xab, 0x15,
+	0xe5, 0x8b, 0xd7, 0x2b, 0xd7, 0xbe, 0x7a, 0xbd, 0x72, 0xed, 0xef, 0xaf, 0x57, 0xae, 0xfd, 0x6c,
+	0x49, 0xa4, 0x70, 0xe4, 0x7e, 0x5e, 0xf6, 0xc8, 0x16, 0xcf, 0xd5, 0x2d, 0xf6, 0xcd, 0x22, 0x62,
+	0x1f, 0x7f, 0xc7, 0x79, 0x73, 0xf9, 0xf0, 0xff, 0x03, 0x00, 0x50, 0x95, 0xcc, 0xb1, 0x3d, 0x16,
+	0x00, 0x00,
 }
 
 func (m *WeightedVoteOption) Marshal() (dAtA []byte, err error) {
@@ -2227,6 +2299,15 @@ func (m *MessageBasedParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.TallyStrategy) > 0 {
+		i -= len(m.TallyStrategy)
+		copy(dAtA[i:], m.TallyStrategy)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.TallyStrategy)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
 	if len(m.YesQuorum) > 0 {
 		i -= len(m.YesQuorum)
 		copy(dAtA[i:], m.YesQuorum)
@@ -2270,6 +2351,43 @@ func (m *MessageBasedParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *VoteDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VoteDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VoteDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Delegate) > 0 {
+		i -= len(m.Delegate)
+		copy(dAtA[i:], m.Delegate)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Delegate)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGov(v)
 	base := offset
@@ -2667,6 +2785,27 @@ func (m *MessageBasedParams) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovGov(uint64(l))
 	}
+	l = len(m.TallyStrategy)
+	if l > 0 {
+		n += 2 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *VoteDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Delegate)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
 	return n
 }
 
@@ -5409,6 +5548,152 @@ func (m *MessageBasedParams) Unmarshal(dAtA []byte) error {
 			}
 			m.YesQuorum = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TallyStrategy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TallyStrategy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VoteDelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VoteDelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VoteDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
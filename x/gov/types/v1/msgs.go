@@ -133,6 +133,16 @@ func NewMsgCancelProposal(proposalID uint64, proposer string) *MsgCancelProposal
 	}
 }
 
+// NewMsgDelegateVote creates a new MsgDelegateVote instance.
+func NewMsgDelegateVote(delegator, delegate string) *MsgDelegateVote {
+	return &MsgDelegateVote{Delegator: delegator, Delegate: delegate}
+}
+
+// NewMsgUndelegateVote creates a new MsgUndelegateVote instance.
+func NewMsgUndelegateVote(delegator string) *MsgUndelegateVote {
+	return &MsgUndelegateVote{Delegator: delegator}
+}
+
 // GetSudoedMsg returns the cache values from the MsgSudoExec.Msg if present.
 func (msg *MsgSudoExec) GetSudoedMsg() (sdk.Msg, error) {
 	if msg.Msg == nil {
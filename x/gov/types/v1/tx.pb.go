@@ -1169,6 +1169,181 @@ func (m *MsgSudoExecResponse) GetResult() []byte {
 	return nil
 }
 
+// MsgDelegateVote defines a message to delegate the governance voting power of an account to another account.
+type MsgDelegateVote struct {
+	// delegator is the address of the account delegating its voting power.
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	// delegate is the address of the account voting on behalf of the delegator.
+	Delegate string `protobuf:"bytes,2,opt,name=delegate,proto3" json:"delegate,omitempty"`
+}
+
+func (m *MsgDelegateVote) Reset()         { *m = MsgDelegateVote{} }
+func (m *MsgDelegateVote) String() string { return proto.CompactTextString(m) }
+func (*MsgDelegateVote) ProtoMessage()    {}
+func (*MsgDelegateVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ff8f4a63b6fc9a9, []int{20}
+}
+func (m *MsgDelegateVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDelegateVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDelegateVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDelegateVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelegateVote.Merge(m, src)
+}
+func (m *MsgDelegateVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDelegateVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelegateVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDelegateVote proto.InternalMessageInfo
+
+func (m *MsgDelegateVote) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
+}
+
+func (m *MsgDelegateVote) GetDelegate() string {
+	if m != nil {
+		return m.Delegate
+	}
+	return ""
+}
+
+// MsgDelegateVoteResponse defines the Msg/DelegateVote response type.
+type MsgDelegateVoteResponse struct {
+}
+
+func (m *MsgDelegateVoteResponse) Reset()         { *m = MsgDelegateVoteResponse{} }
+func (m *MsgDelegateVoteResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDelegateVoteResponse) ProtoMessage()    {}
+func (*MsgDelegateVoteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ff8f4a63b6fc9a9, []int{21}
+}
+func (m *MsgDelegateVoteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDelegateVoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDelegateVoteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDelegateVoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelegateVoteResponse.Merge(m, src)
+}
+func (m *MsgDelegateVoteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDelegateVoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelegateVoteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDelegateVoteResponse proto.InternalMessageInfo
+
+// MsgUndelegateVote defines a message to revoke the delegation of the governance voting power of an account.
+type MsgUndelegateVote struct {
+	// delegator is the address of the account revoking its vote delegation.
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+}
+
+func (m *MsgUndelegateVote) Reset()         { *m = MsgUndelegateVote{} }
+func (m *MsgUndelegateVote) String() string { return proto.CompactTextString(m) }
+func (*MsgUndelegateVote) ProtoMessage()    {}
+func (*MsgUndelegateVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ff8f4a63b6fc9a9, []int{22}
+}
+func (m *MsgUndelegateVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUndelegateVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUndelegateVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUndelegateVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUndelegateVote.Merge(m, src)
+}
+func (m *MsgUndelegateVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUndelegateVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUndelegateVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUndelegateVote proto.InternalMessageInfo
+
+func (m *MsgUndelegateVote) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
+}
+
+// MsgUndelegateVoteResponse defines the Msg/UndelegateVote response type.
+type MsgUndelegateVoteResponse struct {
+}
+
+func (m *MsgUndelegateVoteResponse) Reset()         { *m = MsgUndelegateVoteResponse{} }
+func (m *MsgUndelegateVoteResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUndelegateVoteResponse) ProtoMessage()    {}
+func (*MsgUndelegateVoteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ff8f4a63b6fc9a9, []int{23}
+}
+func (m *MsgUndelegateVoteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUndelegateVoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUndelegateVoteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUndelegateVoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUndelegateVoteResponse.Merge(m, src)
+}
+func (m *MsgUndelegateVoteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUndelegateVoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUndelegateVoteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUndelegateVoteResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSubmitProposal)(nil), "cosmos.gov.v1.MsgSubmitProposal")
 	proto.RegisterType((*MsgSubmitProposalResponse)(nil), "cosmos.gov.v1.MsgSubmitProposalResponse")
@@ -1190,105 +1365,116 @@ func init() {
 	proto.RegisterType((*MsgUpdateMessageParamsResponse)(nil), "cosmos.gov.v1.MsgUpdateMessageParamsResponse")
 	proto.RegisterType((*MsgSudoExec)(nil), "cosmos.gov.v1.MsgSudoExec")
 	proto.RegisterType((*MsgSudoExecResponse)(nil), "cosmos.gov.v1.MsgSudoExecResponse")
+	proto.RegisterType((*MsgDelegateVote)(nil), "cosmos.gov.v1.MsgDelegateVote")
+	proto.RegisterType((*MsgDelegateVoteResponse)(nil), "cosmos.gov.v1.MsgDelegateVoteResponse")
+	proto.RegisterType((*MsgUndelegateVote)(nil), "cosmos.gov.v1.MsgUndelegateVote")
+	proto.RegisterType((*MsgUndelegateVoteResponse)(nil), "cosmos.gov.v1.MsgUndelegateVoteResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/gov/v1/tx.proto", fileDescriptor_9ff8f4a63b6fc9a9) }
 
 var fileDescriptor_9ff8f4a63b6fc9a9 = []byte{
-	// 1481 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xcd, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0xc6, 0x89, 0xed, 0x4c, 0xdc, 0xa4, 0xd9, 0xb8, 0xc9, 0x66, 0x9b, 0xda, 0xee, 0x16,
-	0xa8, 0x49, 0xf1, 0xda, 0x4e, 0x9b, 0x02, 0xa6, 0xaa, 0x54, 0x87, 0x02, 0x95, 0x30, 0x54, 0xdb,
-	0x0f, 0x24, 0xa8, 0x64, 0x6d, 0xbc, 0xc3, 0x66, 0x55, 0xaf, 0x67, 0xf1, 0xac, 0xad, 0xf8, 0x80,
-	0x84, 0x38, 0xf6, 0xd4, 0x0b, 0x12, 0x42, 0xe2, 0x0e, 0x9c, 0x72, 0xf0, 0x09, 0x8e, 0x5c, 0xaa,
-	0x1c, 0x50, 0x95, 0x03, 0x42, 0x3d, 0xb4, 0xa8, 0x15, 0x44, 0xe2, 0x9f, 0x00, 0xcd, 0xec, 0xec,
-	0xae, 0xbd, 0xbb, 0x76, 0xd2, 0x22, 0x21, 0x2e, 0xad, 0xf7, 0x7d, 0xcd, 0x7b, 0xbf, 0x79, 0xf3,
-	0x7b, 0x2f, 0x60, 0xa9, 0x81, 0xb0, 0x89, 0x70, 0x51, 0x47, 0xdd, 0x62, 0xb7, 0x5c, 0xb4, 0x77,
-	0x64, 0xab, 0x8d, 0x6c, 0xc4, 0x1f, 0x73, 0xe4, 0xb2, 0x8e, 0xba, 0x72, 0xb7, 0x2c, 0x66, 0x98,
-	0xd9, 0x96, 0x8a, 0x61, 0xb1, 0x5b, 0xde, 0x82, 0xb6, 0x5a, 0x2e, 0x36, 0x90, 0xd1, 0x72, 0xcc,
-	0xc5, 0xe5, 0xe1, 0x30, 0xc4, 0xcb, 0x51, 0xa4, 0x75, 0xa4, 0x23, 0xfa, 0xb3, 0x48, 0x7e, 0x31,
-	0xe9, 0x8a, 0x63, 0x5e, 0x77, 0x14, 0xec, 0x28, 0xa6, 0xd2, 0x11, 0xd2, 0x9b, 0xb0, 0x48, 0xbf,
-	0xb6, 0x3a, 0x9f, 0x16, 0xd5, 0x56, 0x2f, 0x70, 0x88, 0x89, 0x75, 0x72, 0x88, 0x89, 0x75, 0xa6,
-	0x58, 0x50, 0x4d, 0xa3, 0x85, 0x8a, 0xf4, 0x5f, 0x26, 0xca, 0x06, 0xc3, 0xd8, 0x86, 0x09, 0xb1,
-	0xad, 0x9a, 0x96, 0x63, 0x20, 0xfd, 0x38, 0x05, 0x16, 0x6a, 0x58, 0xbf, 0xd1, 0xd9, 0x32, 0x0d,
-	0xfb, 0x7a, 0x1b, 0x59, 0x08, 0xab, 0x4d, 0xbe, 0x04, 0x92, 0x26, 0xc4, 0x58, 0xd5, 0x21, 0x16,
-	0xb8, 0x5c, 0x2c, 0x3f, 0xbb, 0x9e, 0x96, 0x9d, 0x48, 0xb2, 0x1b, 0x49, 0xbe, 0xd2, 0xea, 0x29,
-	0x9e, 0x15, 0x7f, 0x8f, 0x03, 0xf3, 0x46, 0xcb, 0xb0, 0x0d, 0xb5, 0x59, 0xd7, 0xa0, 0x85, 0xb0,
-	0x61, 0x0b, 0x93, 0xd4, 0x73, 0x45, 0x66, 0x85, 0x11, 0xd0, 0x64, 0x06, 0x9a, 0xbc, 0x89, 0x8c,
-	0x56, 0xf5, 0x9d, 0x07, 0x8f, 0xb3, 0x13, 0x3f, 0x3c, 0xc9, 0xe6, 0x75, 0xc3, 0xde, 0xee, 0x6c,
-	0xc9, 0x0d, 0x64, 0x32, 0x14, 0xd8, 0x7f, 0x05, 0xac, 0xdd, 0x2d, 0xda, 0x3d, 0x0b, 0x62, 0xea,
-	0x80, 0xbf, 0x39, 0xd8, 0x5d, 0x4b, 0x35, 0xa1, 0xae, 0x36, 0x7a, 0x75, 0x02, 0x3b, 0xfe, 0xee,
-	0x60, 0x77, 0x8d, 0x53, 0xe6, 0xd8, 0xc9, 0x6f, 0x3b, 0x07, 0xf3, 0x17, 0x40, 0xd2, 0xa2, 0xa5,
-	0xc0, 0xb6, 0x10, 0xcb, 0x71, 0xf9, 0x99, 0xaa, 0xb0, 0xdf, 0x2f, 0xa4, 0x59, 0x1e, 0x57, 0x34,
-	0xad, 0x0d, 0x31, 0xbe, 0x61, 0xb7, 0x8d, 0x96, 0xae, 0x78, 0x96, 0xbc, 0x48, 0x8a, 0xb6, 0x55,
-	0x4d, 0xb5, 0x55, 0x61, 0x8a, 0x78, 0x29, 0xde, 0x37, 0xff, 0x2a, 0x98, 0xb6, 0x0d, 0xbb, 0x09,
-	0x85, 0x69, 0x1a, 0x6e, 0xf1, 0x51, 0xbf, 0x30, 0xef, 0xa7, 0x98, 0x2b, 0xc9, 0x17, 0x5e, 0x57,
-	0x1c, 0x0b, 0xbe, 0x00, 0x12, 0xb8, 0x63, 0x9a, 0x6a, 0xbb, 0x27, 0xc4, 0x47, 0x1b, 0xbb, 0x36,
-	0xfc, 0x79, 0x30, 0x03, 0x77, 0x2c, 0xa8, 0x19, 0x36, 0xd4, 0x84, 0x44, 0x8e, 0xcb, 0x27, 0xab,
-	0x27, 0x42, 0x0e, 0x1b, 0x25, 0x81, 0x53, 0x7c, 0x3b, 0x5e, 0x01, 0xc7, 0x2c, 0x76, 0x57, 0x75,
-	0x02, 0x8f, 0x90, 0xcc, 0x71, 0xf9, 0xb9, 0xf5, 0x93, 0xf2, 0x50, 0xbb, 0xca, 0xee, 0x7d, 0xde,
-	0xec, 0x59, 0xb0, 0x7a, 0xfc, 0x51, 0xbf, 0x90, 0xda, 0x21, 0x3d, 0x99, 0xeb, 0x96, 0xe4, 0x75,
-	0xb9, 0xa4, 0xa4, 0xac, 0x01, 0x7d, 0xa5, 0xfc, 0xe5, 0xc1, 0xee, 0x9a, 0x87, 0xc6, 0xbd, 0x83,
-	0xdd, 0xb5, 0xec, 0xc0, 0x25, 0x74, 0xcb, 0xc5, 0x50, 0x9b, 0x48, 0x97, 0xc0, 0x4a, 0x48, 0xa8,
-	0x40, 0x6c, 0xa1, 0x16, 0x86, 0x7c, 0x16, 0xcc, 0x7a, 0x39, 0x1a, 0x9a, 0xc0, 0xe5, 0xb8, 0xfc,
-	0x94, 0x02, 0x5c, 0xd1, 0x35, 0x4d, 0xfa, 0x89, 0x03, 0xe9, 0x1a, 0xd6, 0xaf, 0xee, 0xc0, 0xc6,
-	0xfb, 0xf4, 0x4a, 0x37, 0x51, 0xcb, 0x86, 0x2d, 0x9b, 0xff, 0x00, 0x24, 0x1a, 0xce, 0x4f, 0xea,
-	0x35, 0xa2, 0xf9, 0xaa, 0x99, 0xbd, 0x7e, 0x41, 0x1c, 0x2a, 0xd8, 0x6d, 0x2d, 0xea, 0xab, 0xb8,
-	0x41, 0xf8, 0x55, 0x30, 0xa3, 0x76, 0xec, 0x6d, 0xd4, 0x36, 0xec, 0x9e, 0x30, 0x49, 0x6f, 0xd6,
-	0x17, 0x54, 0x36, 0x48, 0xdd, 0xfe, 0x37, 0x29, 0x5c, 0x0a, 0x15, 0x1e, 0x4a, 0x52, 0xca, 0x80,
-	0xd5, 0x28, 0xb9, 0x5b, 0xbe, 0xf4, 0x07, 0x07, 0x12, 0x35, 0xac, 0xdf, 0x46, 0x36, 0xe4, 0x37,
-	0x22, 0xa0, 0xa8, 0xa6, 0xff, 0x7a, 0x9c, 0x1d, 0x14, 0x3b, 0xad, 0x3c, 0x00, 0x10, 0x2f, 0x83,
-	0xe9, 0x2e, 0xb2, 0x61, 0xdb, 0xc9, 0x79, 0x4c, 0x0f, 0x3b, 0x66, 0x7c, 0x19, 0xc4, 0x91, 0x65,
-	0x1b, 0xa8, 0x45, 0x9b, 0x7e, 0xce, 0x7f, 0x79, 0xac, 0x1d, 0x48, 0x2e, 0x1f, 0x52, 0x03, 0x85,
-	0x19, 0x8e, 0xeb, 0xf9, 0xca, 0x4b, 0x04, 0x18, 0x27, 0x34, 0x01, 0xe5, 0x44, 0x08, 0x14, 0x12,
-	0x4f, 0x5a, 0x00, 0xf3, 0xec, 0xa7, 0x57, 0xfa, 0xdf, 0x9c, 0x27, 0xfb, 0x08, 0x1a, 0xfa, 0x36,
-	0xe9, 0xd8, 0xff, 0x08, 0x82, 0xb7, 0x40, 0xc2, 0xa9, 0x0c, 0x0b, 0x31, 0xca, 0x3e, 0xa7, 0x03,
-	0x18, 0xb8, 0x09, 0x0d, 0x60, 0xe1, 0x7a, 0x8c, 0x05, 0xe3, 0xb5, 0x61, 0x30, 0x4e, 0x45, 0x82,
-	0xe1, 0x06, 0x97, 0x56, 0xc0, 0x72, 0x40, 0xe4, 0x81, 0xf3, 0x27, 0x07, 0x40, 0x0d, 0xeb, 0x2e,
-	0x55, 0xbd, 0x20, 0x2e, 0x17, 0xc1, 0x0c, 0x63, 0x59, 0x74, 0x38, 0x36, 0xbe, 0x29, 0x7f, 0x09,
-	0xc4, 0x55, 0x13, 0x75, 0x5a, 0x36, 0x83, 0x67, 0x0c, 0x39, 0xcf, 0x10, 0x72, 0x76, 0x4e, 0x66,
-	0x3e, 0x95, 0x73, 0xf4, 0xa9, 0x78, 0xd1, 0x08, 0x10, 0x42, 0x08, 0x08, 0x56, 0x99, 0x94, 0x06,
-	0xbc, 0xff, 0xe5, 0x95, 0xff, 0x8b, 0xd3, 0x1b, 0xb7, 0x2c, 0x4d, 0xb5, 0xe1, 0x75, 0xb5, 0xad,
-	0x9a, 0x98, 0x14, 0xe3, 0xbf, 0x4f, 0xee, 0xb0, 0x62, 0x3c, 0x53, 0xfe, 0x0d, 0x10, 0xb7, 0x68,
-	0x04, 0x8a, 0xc0, 0xec, 0xfa, 0x89, 0x20, 0xfd, 0x51, 0xe5, 0x50, 0x21, 0x8e, 0x7d, 0xe5, 0xda,
-	0x7e, 0x98, 0x92, 0xc3, 0x34, 0x70, 0x66, 0xa0, 0xb6, 0x1d, 0x77, 0xa6, 0x07, 0x92, 0x97, 0x64,
-	0x7a, 0xd5, 0x83, 0x22, 0xb7, 0xd6, 0xca, 0x62, 0xc4, 0x29, 0xd2, 0xb7, 0x1c, 0x1d, 0xb8, 0x9b,
-	0x6a, 0xab, 0x01, 0x9b, 0x03, 0x03, 0x37, 0xa2, 0x0d, 0xe6, 0x03, 0x6d, 0x30, 0xd4, 0x01, 0x83,
-	0x33, 0x6e, 0xf2, 0xa8, 0x33, 0xae, 0x92, 0xdb, 0x0f, 0x8f, 0x96, 0x21, 0xde, 0x97, 0x7e, 0xe5,
-	0x28, 0xa9, 0x0f, 0xe7, 0xe7, 0x91, 0xfa, 0xf3, 0xe7, 0x79, 0x0d, 0x1c, 0x6b, 0xd0, 0x58, 0x50,
-	0xab, 0x93, 0xe5, 0x83, 0xdd, 0x95, 0x18, 0xa2, 0xf4, 0x9b, 0xee, 0x66, 0x52, 0x4d, 0x92, 0x0b,
-	0xbb, 0xff, 0x24, 0xcb, 0x29, 0x29, 0xd7, 0x95, 0x28, 0xf9, 0xb3, 0x60, 0xde, 0x0b, 0xb5, 0x4d,
-	0xdf, 0x15, 0x25, 0xba, 0x29, 0x65, 0xce, 0x15, 0xbf, 0x47, 0xa5, 0x11, 0xc0, 0x6f, 0x94, 0xa4,
-	0xaf, 0x62, 0x20, 0xeb, 0x4d, 0xab, 0x5a, 0xa7, 0x69, 0x1b, 0x56, 0x13, 0x6e, 0x6e, 0x23, 0xa3,
-	0x01, 0xbd, 0x6b, 0x88, 0xda, 0x62, 0xb8, 0xff, 0xc3, 0x16, 0x33, 0xf9, 0x42, 0x5b, 0x4c, 0x2c,
-	0xb0, 0xc5, 0xa4, 0xdd, 0x2d, 0xc6, 0x61, 0x37, 0xb6, 0xb0, 0x08, 0xfe, 0xc2, 0x42, 0xb7, 0x1b,
-	0x7f, 0x37, 0xb9, 0x0a, 0x52, 0x84, 0xf1, 0xea, 0x2e, 0xa5, 0xc6, 0xe9, 0xd5, 0x49, 0x23, 0xb6,
-	0x0c, 0x9f, 0x52, 0xb1, 0x32, 0xdb, 0xf5, 0x3f, 0x2a, 0xab, 0xfb, 0xfd, 0xc2, 0xac, 0xb3, 0x79,
-	0x94, 0xe5, 0x92, 0x1c, 0x68, 0xb8, 0x4f, 0xc0, 0xd9, 0x43, 0xae, 0xe5, 0xc8, 0x2b, 0x45, 0x65,
-	0x3e, 0x70, 0x92, 0xf4, 0x33, 0x07, 0x96, 0xbc, 0xe7, 0x59, 0x73, 0x96, 0xd5, 0x7f, 0xc9, 0x3a,
-	0xcb, 0x20, 0x61, 0x62, 0xbd, 0xde, 0x69, 0x37, 0xd9, 0x2e, 0x11, 0x37, 0xb1, 0x7e, 0xab, 0xdd,
-	0xe4, 0xdf, 0xf4, 0xe8, 0x28, 0x46, 0x71, 0x0a, 0x8e, 0x1e, 0x76, 0x7c, 0x55, 0xc5, 0x50, 0x63,
-	0x4c, 0xe1, 0xf2, 0xd1, 0xa9, 0x08, 0x84, 0xfc, 0x23, 0xa5, 0x32, 0xc8, 0x44, 0x17, 0xe1, 0x51,
-	0x4d, 0xa8, 0xf0, 0xef, 0x39, 0x30, 0x4b, 0x61, 0xd5, 0x10, 0xd9, 0x51, 0x5e, 0xb8, 0xda, 0x4d,
-	0x10, 0x33, 0xb1, 0xce, 0x1e, 0x6d, 0xf4, 0x1e, 0x76, 0x72, 0xaf, 0x5f, 0x58, 0x8e, 0x7a, 0x1d,
-	0x35, 0xac, 0x2b, 0xc4, 0xfb, 0xb0, 0xf2, 0x2e, 0x83, 0xc5, 0x81, 0x54, 0xbd, 0xdb, 0x5e, 0x02,
-	0xf1, 0x36, 0xc4, 0x9d, 0xa6, 0xb3, 0x05, 0xa6, 0x14, 0xf6, 0x15, 0xaa, 0x75, 0xfd, 0x5e, 0x12,
-	0xc4, 0x6a, 0x58, 0xe7, 0xef, 0x80, 0xb9, 0xc0, 0xdf, 0x31, 0xb9, 0xe0, 0x15, 0x04, 0xb7, 0x55,
-	0x31, 0x7f, 0x98, 0x85, 0x97, 0x0e, 0x04, 0x0b, 0xe1, 0x55, 0xf5, 0x4c, 0xd8, 0x3d, 0x64, 0x24,
-	0x9e, 0x3b, 0x82, 0x91, 0x77, 0xcc, 0x65, 0x30, 0x45, 0x77, 0xc6, 0xa5, 0xb0, 0x13, 0x91, 0x8b,
-	0x99, 0x68, 0xb9, 0xe7, 0x7f, 0x1b, 0xa4, 0x86, 0x16, 0xaf, 0x11, 0xf6, 0xae, 0x5e, 0x7c, 0x65,
-	0xbc, 0xde, 0x8b, 0xfb, 0x2e, 0x48, 0xb8, 0xc4, 0xb4, 0x12, 0x76, 0x61, 0x2a, 0xf1, 0xf4, 0x48,
-	0x95, 0x17, 0xe8, 0x2e, 0x48, 0x0d, 0x4d, 0xff, 0x88, 0x04, 0x07, 0xf5, 0x51, 0x09, 0x46, 0x4d,
-	0x5b, 0x69, 0x71, 0x2f, 0x3c, 0x6d, 0xf9, 0xcf, 0xc0, 0x5c, 0x60, 0xd2, 0x46, 0xb4, 0xc4, 0xb0,
-	0x45, 0x54, 0x4b, 0x44, 0x4f, 0xc3, 0x88, 0x23, 0x37, 0x4a, 0xfc, 0xd7, 0x1c, 0x58, 0x1d, 0x3b,
-	0x64, 0xe4, 0x51, 0x2d, 0x17, 0x6d, 0x2f, 0x5e, 0x7c, 0x3e, 0x7b, 0x2f, 0xbb, 0xe3, 0x7b, 0xfd,
-	0x42, 0x2a, 0x37, 0xf0, 0x50, 0xf8, 0xcf, 0xc1, 0x62, 0x14, 0x13, 0xbe, 0x3c, 0x0a, 0xe1, 0x21,
-	0x33, 0xb1, 0x70, 0x24, 0xb3, 0x31, 0xc7, 0xdf, 0x01, 0x49, 0x8f, 0x8f, 0xc4, 0xa8, 0xa2, 0x1c,
-	0x9d, 0x28, 0x8d, 0xd6, 0x8d, 0x8e, 0x2e, 0x4e, 0x7f, 0x41, 0xa6, 0x68, 0x75, 0xe3, 0xc1, 0xd3,
-	0x0c, 0xf7, 0xf0, 0x69, 0x86, 0xfb, 0xfd, 0x69, 0x86, 0xbb, 0xff, 0x2c, 0x33, 0xf1, 0xf0, 0x59,
-	0x66, 0xe2, 0xb7, 0x67, 0x99, 0x89, 0x8f, 0x4f, 0x3a, 0x51, 0xb1, 0x76, 0x57, 0x36, 0x10, 0x5b,
-	0xe8, 0xe8, 0x54, 0x2e, 0x76, 0xcb, 0x5b, 0x71, 0x4a, 0x69, 0xe7, 0xff, 0x09, 0x00, 0x00, 0xff,
-	0xff, 0x7b, 0xac, 0x54, 0xba, 0x09, 0x12, 0x00, 0x00,
+	// 1592 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xcf, 0xc6, 0x89, 0x93, 0x4c, 0xdc, 0xa4, 0xd9, 0xa4, 0xc9, 0x66, 0x9b, 0xda, 0xee, 0xf6,
+	0xfb, 0x6d, 0x4d, 0x5a, 0xaf, 0xed, 0xb4, 0x29, 0x60, 0xaa, 0xa2, 0x3a, 0x2d, 0x50, 0x09, 0x43,
+	0xb5, 0xfd, 0x81, 0x04, 0x95, 0xa2, 0x8d, 0x77, 0xd8, 0xac, 0xea, 0xf5, 0x2c, 0x9e, 0xb5, 0x95,
+	0x1c, 0x90, 0x10, 0xc7, 0x1e, 0x50, 0x2f, 0x48, 0x08, 0x89, 0x3b, 0x70, 0xca, 0xc1, 0x27, 0xb8,
+	0x20, 0x71, 0xa9, 0x72, 0x40, 0x55, 0x0e, 0x08, 0xf5, 0xd0, 0xa2, 0x54, 0x10, 0x89, 0x7f, 0x02,
+	0x34, 0xb3, 0xbb, 0xb3, 0xde, 0x1f, 0xb6, 0xd3, 0x82, 0x10, 0x97, 0xd6, 0xf3, 0xde, 0xe7, 0xfd,
+	0x9c, 0x37, 0xef, 0xbd, 0x0d, 0x98, 0xaf, 0x21, 0x6c, 0x22, 0x5c, 0xd0, 0x51, 0xbb, 0xd0, 0x2e,
+	0x15, 0xec, 0x2d, 0xd9, 0x6a, 0x22, 0x1b, 0xf1, 0x47, 0x1c, 0xba, 0xac, 0xa3, 0xb6, 0xdc, 0x2e,
+	0x89, 0x69, 0x17, 0xb6, 0xa1, 0x62, 0x58, 0x68, 0x97, 0x36, 0xa0, 0xad, 0x96, 0x0a, 0x35, 0x64,
+	0x34, 0x1c, 0xb8, 0xb8, 0x10, 0x54, 0x43, 0xa4, 0x1c, 0xc6, 0x9c, 0x8e, 0x74, 0x44, 0x7f, 0x16,
+	0xc8, 0x2f, 0x97, 0xba, 0xe8, 0xc0, 0xd7, 0x1d, 0x86, 0x6b, 0xca, 0x65, 0xe9, 0x08, 0xe9, 0x75,
+	0x58, 0xa0, 0xa7, 0x8d, 0xd6, 0x87, 0x05, 0xb5, 0xb1, 0x1d, 0x32, 0x62, 0x62, 0x9d, 0x18, 0x31,
+	0xb1, 0xee, 0x32, 0x66, 0x54, 0xd3, 0x68, 0xa0, 0x02, 0xfd, 0xd7, 0x25, 0x65, 0xc2, 0x6a, 0x6c,
+	0xc3, 0x84, 0xd8, 0x56, 0x4d, 0xcb, 0x01, 0x48, 0xdf, 0x8d, 0x80, 0x99, 0x2a, 0xd6, 0x6f, 0xb6,
+	0x36, 0x4c, 0xc3, 0xbe, 0xd1, 0x44, 0x16, 0xc2, 0x6a, 0x9d, 0x2f, 0x82, 0x71, 0x13, 0x62, 0xac,
+	0xea, 0x10, 0x0b, 0x5c, 0x36, 0x91, 0x9b, 0x5c, 0x99, 0x93, 0x1d, 0x4d, 0xb2, 0xa7, 0x49, 0xbe,
+	0xd2, 0xd8, 0x56, 0x18, 0x8a, 0xbf, 0xcf, 0x81, 0x69, 0xa3, 0x61, 0xd8, 0x86, 0x5a, 0x5f, 0xd7,
+	0xa0, 0x85, 0xb0, 0x61, 0x0b, 0xc3, 0x54, 0x72, 0x51, 0x76, 0x03, 0x23, 0x49, 0x93, 0xdd, 0xa4,
+	0xc9, 0x6b, 0xc8, 0x68, 0x54, 0xde, 0x78, 0xf8, 0x24, 0x33, 0xf4, 0xed, 0xd3, 0x4c, 0x4e, 0x37,
+	0xec, 0xcd, 0xd6, 0x86, 0x5c, 0x43, 0xa6, 0x9b, 0x05, 0xf7, 0xbf, 0x3c, 0xd6, 0xee, 0x15, 0xec,
+	0x6d, 0x0b, 0x62, 0x2a, 0x80, 0xbf, 0x3c, 0xd8, 0x59, 0x4e, 0xd5, 0xa1, 0xae, 0xd6, 0xb6, 0xd7,
+	0x49, 0xda, 0xf1, 0xd7, 0x07, 0x3b, 0xcb, 0x9c, 0x32, 0xe5, 0x5a, 0xbe, 0xea, 0x18, 0xe6, 0x2f,
+	0x80, 0x71, 0x8b, 0x86, 0x02, 0x9b, 0x42, 0x22, 0xcb, 0xe5, 0x26, 0x2a, 0xc2, 0x5e, 0x27, 0x3f,
+	0xe7, 0xfa, 0x71, 0x45, 0xd3, 0x9a, 0x10, 0xe3, 0x9b, 0x76, 0xd3, 0x68, 0xe8, 0x0a, 0x43, 0xf2,
+	0x22, 0x09, 0xda, 0x56, 0x35, 0xd5, 0x56, 0x85, 0x11, 0x22, 0xa5, 0xb0, 0x33, 0xff, 0x12, 0x18,
+	0xb5, 0x0d, 0xbb, 0x0e, 0x85, 0x51, 0xaa, 0x6e, 0xf6, 0x71, 0x27, 0x3f, 0xed, 0xbb, 0x98, 0x2d,
+	0xca, 0x17, 0x5e, 0x56, 0x1c, 0x04, 0x9f, 0x07, 0x63, 0xb8, 0x65, 0x9a, 0x6a, 0x73, 0x5b, 0x48,
+	0xf6, 0x06, 0x7b, 0x18, 0xfe, 0x3c, 0x98, 0x80, 0x5b, 0x16, 0xd4, 0x0c, 0x1b, 0x6a, 0xc2, 0x58,
+	0x96, 0xcb, 0x8d, 0x57, 0x8e, 0x45, 0x04, 0x56, 0x8b, 0x02, 0xa7, 0xf8, 0x38, 0x5e, 0x01, 0x47,
+	0x2c, 0xf7, 0xae, 0xd6, 0x49, 0x7a, 0x84, 0xf1, 0x2c, 0x97, 0x9b, 0x5a, 0x39, 0x2e, 0x07, 0xca,
+	0x55, 0xf6, 0xee, 0xf3, 0xd6, 0xb6, 0x05, 0x2b, 0x47, 0x1f, 0x77, 0xf2, 0xa9, 0x2d, 0x52, 0x93,
+	0xd9, 0x76, 0x51, 0x5e, 0x91, 0x8b, 0x4a, 0xca, 0xea, 0xe2, 0x97, 0x4b, 0x9f, 0x1e, 0xec, 0x2c,
+	0xb3, 0x6c, 0xdc, 0x3f, 0xd8, 0x59, 0xce, 0x74, 0x5d, 0x42, 0xbb, 0x54, 0x88, 0x94, 0x89, 0x74,
+	0x09, 0x2c, 0x46, 0x88, 0x0a, 0xc4, 0x16, 0x6a, 0x60, 0xc8, 0x67, 0xc0, 0x24, 0xf3, 0xd1, 0xd0,
+	0x04, 0x2e, 0xcb, 0xe5, 0x46, 0x14, 0xe0, 0x91, 0xae, 0x6b, 0xd2, 0xf7, 0x1c, 0x98, 0xab, 0x62,
+	0xfd, 0xda, 0x16, 0xac, 0xbd, 0x4d, 0xaf, 0x74, 0x0d, 0x35, 0x6c, 0xd8, 0xb0, 0xf9, 0x77, 0xc0,
+	0x58, 0xcd, 0xf9, 0x49, 0xa5, 0x7a, 0x14, 0x5f, 0x25, 0xbd, 0xdb, 0xc9, 0x8b, 0x81, 0x80, 0xbd,
+	0xd2, 0xa2, 0xb2, 0x8a, 0xa7, 0x84, 0x5f, 0x02, 0x13, 0x6a, 0xcb, 0xde, 0x44, 0x4d, 0xc3, 0xde,
+	0x16, 0x86, 0xe9, 0xcd, 0xfa, 0x84, 0xf2, 0x2a, 0x89, 0xdb, 0x3f, 0x93, 0xc0, 0xa5, 0x48, 0xe0,
+	0x11, 0x27, 0xa5, 0x34, 0x58, 0x8a, 0xa3, 0x7b, 0xe1, 0x4b, 0xbf, 0x71, 0x60, 0xac, 0x8a, 0xf5,
+	0x3b, 0xc8, 0x86, 0xfc, 0x6a, 0x4c, 0x2a, 0x2a, 0x73, 0x7f, 0x3c, 0xc9, 0x74, 0x93, 0x9d, 0x52,
+	0xee, 0x4a, 0x10, 0x2f, 0x83, 0xd1, 0x36, 0xb2, 0x61, 0x53, 0x18, 0x1e, 0x50, 0xc3, 0x0e, 0x8c,
+	0x2f, 0x81, 0x24, 0xb2, 0x6c, 0x03, 0x35, 0x68, 0xd1, 0x4f, 0xf9, 0x2f, 0xcf, 0x2d, 0x07, 0xe2,
+	0xcb, 0xbb, 0x14, 0xa0, 0xb8, 0xc0, 0x7e, 0x35, 0x5f, 0xfe, 0x1f, 0x49, 0x8c, 0xa3, 0x9a, 0x24,
+	0xe5, 0x58, 0x24, 0x29, 0x44, 0x9f, 0x34, 0x03, 0xa6, 0xdd, 0x9f, 0x2c, 0xf4, 0x3f, 0x39, 0x46,
+	0x7b, 0x0f, 0x1a, 0xfa, 0x26, 0xa9, 0xd8, 0x7f, 0x29, 0x05, 0xaf, 0x81, 0x31, 0x27, 0x32, 0x2c,
+	0x24, 0x68, 0xf7, 0x39, 0x19, 0xca, 0x81, 0xe7, 0x50, 0x57, 0x2e, 0x3c, 0x89, 0xbe, 0xc9, 0x38,
+	0x17, 0x4c, 0xc6, 0x89, 0xd8, 0x64, 0x78, 0xca, 0xa5, 0x45, 0xb0, 0x10, 0x22, 0xb1, 0xe4, 0xfc,
+	0xce, 0x01, 0x50, 0xc5, 0xba, 0xd7, 0xaa, 0x5e, 0x30, 0x2f, 0x17, 0xc1, 0x84, 0xdb, 0x65, 0xd1,
+	0xe0, 0xdc, 0xf8, 0x50, 0xfe, 0x12, 0x48, 0xaa, 0x26, 0x6a, 0x35, 0x6c, 0x37, 0x3d, 0x7d, 0x9a,
+	0xf3, 0x04, 0x69, 0xce, 0x8e, 0x65, 0x57, 0xa6, 0x7c, 0x96, 0x3e, 0x15, 0xa6, 0x8d, 0x24, 0x42,
+	0x88, 0x24, 0xc2, 0x8d, 0x4c, 0x9a, 0x03, 0xbc, 0x7f, 0x62, 0xe1, 0xff, 0xe4, 0xd4, 0xc6, 0x6d,
+	0x4b, 0x53, 0x6d, 0x78, 0x43, 0x6d, 0xaa, 0x26, 0x26, 0xc1, 0xf8, 0xef, 0x93, 0x1b, 0x14, 0x0c,
+	0x83, 0xf2, 0xaf, 0x80, 0xa4, 0x45, 0x35, 0xd0, 0x0c, 0x4c, 0xae, 0x1c, 0x0b, 0xb7, 0x3f, 0xca,
+	0x0c, 0x04, 0xe2, 0xe0, 0xcb, 0xd7, 0xf7, 0xa2, 0x2d, 0x39, 0xda, 0x06, 0x4e, 0x75, 0xc5, 0xb6,
+	0xe5, 0xcd, 0xf4, 0x90, 0xf3, 0x92, 0x0c, 0x16, 0x42, 0x24, 0x2f, 0xd6, 0xf2, 0x6c, 0x8c, 0x15,
+	0xe9, 0x2b, 0x8e, 0x0e, 0xdc, 0x35, 0xb5, 0x51, 0x83, 0xf5, 0xae, 0x81, 0x1b, 0x53, 0x06, 0xd3,
+	0xa1, 0x32, 0x08, 0x54, 0x40, 0xf7, 0x8c, 0x1b, 0x3e, 0xec, 0x8c, 0x2b, 0x67, 0xf7, 0xa2, 0xa3,
+	0x25, 0xd0, 0xf7, 0xa5, 0x9f, 0x39, 0xb0, 0x18, 0xf1, 0x8f, 0x35, 0xf5, 0xe7, 0xf7, 0xf3, 0x3a,
+	0x38, 0x52, 0xa3, 0xba, 0xa0, 0xb6, 0x4e, 0x96, 0x0f, 0xf7, 0xae, 0xc4, 0x48, 0x4b, 0xbf, 0xe5,
+	0x6d, 0x26, 0x95, 0x71, 0x72, 0x61, 0x0f, 0x9e, 0x66, 0x38, 0x25, 0xe5, 0x89, 0x12, 0x26, 0x7f,
+	0x06, 0x4c, 0x33, 0x55, 0x9b, 0xf4, 0x5d, 0xd1, 0x46, 0x37, 0xa2, 0x4c, 0x79, 0xe4, 0xb7, 0x28,
+	0x35, 0x26, 0xf1, 0xab, 0x45, 0xe9, 0xf3, 0x04, 0xc8, 0xb0, 0x69, 0x55, 0x6d, 0xd5, 0x6d, 0xc3,
+	0xaa, 0xc3, 0xb5, 0x4d, 0x64, 0xd4, 0x20, 0xbb, 0x86, 0xb8, 0x2d, 0x86, 0xfb, 0x2f, 0x6c, 0x31,
+	0xc3, 0x2f, 0xb4, 0xc5, 0x24, 0x42, 0x5b, 0xcc, 0x9c, 0xb7, 0xc5, 0x38, 0xdd, 0xcd, 0x39, 0xf0,
+	0x82, 0xbf, 0xb0, 0xd0, 0xed, 0xc6, 0xdf, 0x4d, 0xae, 0x81, 0x14, 0xe9, 0x78, 0xeb, 0x5e, 0x4b,
+	0x4d, 0xd2, 0xab, 0x93, 0x7a, 0x6c, 0x19, 0x7e, 0x4b, 0xc5, 0xca, 0x64, 0xdb, 0x3f, 0x94, 0x97,
+	0xf6, 0x3a, 0xf9, 0x49, 0x67, 0xf3, 0x28, 0xc9, 0x45, 0x39, 0x54, 0x70, 0x1f, 0x80, 0x33, 0x03,
+	0xae, 0xe5, 0xd0, 0x2b, 0x45, 0x79, 0x3a, 0x64, 0x49, 0xfa, 0x91, 0x03, 0xf3, 0xec, 0x79, 0x56,
+	0x9d, 0x65, 0xf5, 0x6f, 0x76, 0x9d, 0x05, 0x30, 0x66, 0x62, 0x7d, 0xbd, 0xd5, 0xac, 0xbb, 0xbb,
+	0x44, 0xd2, 0xc4, 0xfa, 0xed, 0x66, 0x9d, 0x7f, 0x95, 0xb5, 0xa3, 0x44, 0x96, 0x8b, 0x19, 0x3d,
+	0xae, 0xf9, 0x8a, 0x8a, 0xa1, 0xe6, 0x76, 0x0a, 0xaf, 0x1f, 0x9d, 0x88, 0xc9, 0x90, 0x6f, 0x52,
+	0x2a, 0x81, 0x74, 0x7c, 0x10, 0xac, 0xd5, 0x44, 0x02, 0xff, 0x86, 0x03, 0x93, 0x34, 0xad, 0x1a,
+	0x22, 0x3b, 0xca, 0x0b, 0x47, 0xbb, 0x06, 0x12, 0x26, 0xd6, 0x85, 0xe1, 0x3e, 0x7b, 0xd8, 0xf1,
+	0xdd, 0x4e, 0x7e, 0x21, 0xee, 0x75, 0x54, 0xb1, 0xae, 0x10, 0xe9, 0x41, 0xe1, 0x5d, 0x06, 0xb3,
+	0x5d, 0xae, 0xb2, 0xdb, 0x9e, 0x07, 0xc9, 0x26, 0xc4, 0xad, 0xba, 0xb3, 0x05, 0xa6, 0x14, 0xf7,
+	0x14, 0x8d, 0xf5, 0x07, 0x67, 0xa6, 0x5c, 0x85, 0xe4, 0x4d, 0xd9, 0x90, 0xae, 0x5c, 0x74, 0x40,
+	0xd2, 0x33, 0x6a, 0x0e, 0x8e, 0x97, 0x41, 0xc9, 0xa3, 0x73, 0x0f, 0x70, 0xf0, 0xa3, 0xf3, 0x90,
+	0xe5, 0xd7, 0xe3, 0x02, 0x64, 0x4a, 0xe3, 0x17, 0x86, 0x6e, 0x77, 0xa5, 0x65, 0xb0, 0x10, 0x22,
+	0xf5, 0xbe, 0xda, 0xcf, 0x9c, 0x09, 0x72, 0xbb, 0xa1, 0xfd, 0x03, 0x01, 0x97, 0xaf, 0x0c, 0x74,
+	0x3d, 0xfa, 0x19, 0x10, 0x34, 0x2d, 0x9d, 0x03, 0x8b, 0x11, 0x62, 0x4f, 0xf7, 0x57, 0xf6, 0x27,
+	0x40, 0xa2, 0x8a, 0x75, 0xfe, 0x2e, 0x98, 0x0a, 0x7d, 0x75, 0x66, 0xc3, 0x0f, 0x26, 0xfc, 0x6d,
+	0x21, 0xe6, 0x06, 0x21, 0x58, 0xf1, 0x40, 0x30, 0x13, 0xfd, 0xb0, 0x38, 0x15, 0x15, 0x8f, 0x80,
+	0xc4, 0xb3, 0x87, 0x00, 0x31, 0x33, 0x97, 0xc1, 0x08, 0xcd, 0xfe, 0x7c, 0x54, 0x88, 0xd0, 0xc5,
+	0x74, 0x3c, 0x9d, 0xc9, 0xdf, 0x01, 0xa9, 0xc0, 0x9a, 0xdc, 0x03, 0xef, 0xf1, 0xc5, 0xd3, 0xfd,
+	0xf9, 0x4c, 0xef, 0x9b, 0x60, 0xcc, 0x1b, 0x23, 0x8b, 0x51, 0x11, 0x97, 0x25, 0x9e, 0xec, 0xc9,
+	0x62, 0x8a, 0xee, 0x81, 0x54, 0x60, 0x57, 0x8b, 0x71, 0xb0, 0x9b, 0x2f, 0x9e, 0xee, 0xcf, 0x67,
+	0x7b, 0xe0, 0xec, 0x6e, 0x74, 0x37, 0xe2, 0x3f, 0x02, 0x53, 0xa1, 0xbd, 0x28, 0xa6, 0x24, 0x82,
+	0x08, 0x31, 0x37, 0x08, 0xd1, 0xc7, 0xe4, 0x6a, 0x91, 0xff, 0x82, 0x03, 0x4b, 0x7d, 0x57, 0x02,
+	0xb9, 0x57, 0xc9, 0xc5, 0xe3, 0xc5, 0x8b, 0xcf, 0x87, 0x67, 0xde, 0x1d, 0xdd, 0xed, 0xe4, 0x53,
+	0xd9, 0xae, 0x87, 0xc2, 0x7f, 0x0c, 0x66, 0xe3, 0xe6, 0xd6, 0xff, 0x7b, 0x65, 0x38, 0x00, 0x13,
+	0xf3, 0x87, 0x82, 0xf5, 0x31, 0x7f, 0x17, 0x8c, 0xb3, 0xe9, 0x21, 0xc6, 0x05, 0xe5, 0xf0, 0x44,
+	0xa9, 0x37, 0xaf, 0x8f, 0x76, 0x1d, 0xa4, 0x02, 0xfd, 0x3a, 0x1d, 0x57, 0x8a, 0x3e, 0x5f, 0x3c,
+	0xdd, 0x9f, 0xcf, 0x2c, 0x4d, 0xef, 0x06, 0xdb, 0x0d, 0x6f, 0x82, 0xa9, 0x50, 0xa7, 0x8c, 0xa9,
+	0xa9, 0x20, 0x42, 0xcc, 0x0d, 0x42, 0xf4, 0x34, 0x27, 0x8e, 0x7e, 0x42, 0x76, 0xb9, 0xca, 0xea,
+	0xc3, 0xfd, 0x34, 0xf7, 0x68, 0x3f, 0xcd, 0xfd, 0xba, 0x9f, 0xe6, 0x1e, 0x3c, 0x4b, 0x0f, 0x3d,
+	0x7a, 0x96, 0x1e, 0xfa, 0xe5, 0x59, 0x7a, 0xe8, 0xfd, 0xe3, 0x8e, 0x6a, 0xac, 0xdd, 0x93, 0x0d,
+	0xe4, 0x7e, 0x56, 0xd0, 0xdd, 0x90, 0xfc, 0x3d, 0x31, 0x49, 0x07, 0xeb, 0xf9, 0xbf, 0x06, 0x00,
+	0xa1, 0x4f, 0xbf, 0xae, 0x8f, 0x14, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1326,6 +1512,11 @@ type MsgClient interface {
 	// SudoExec defines a method to execute an inner message as the governance module.
 	// It permits to execute any message from a proposal, even if they weren't meant to be governance proposals.
 	SudoExec(ctx context.Context, in *MsgSudoExec, opts ...grpc.CallOption) (*MsgSudoExecResponse, error)
+	// DelegateVote defines a method to delegate the governance voting power of an account to another account.
+	// A direct vote of the delegator overrides the delegation.
+	DelegateVote(ctx context.Context, in *MsgDelegateVote, opts ...grpc.CallOption) (*MsgDelegateVoteResponse, error)
+	// UndelegateVote defines a method to revoke the delegation of the governance voting power of an account.
+	UndelegateVote(ctx context.Context, in *MsgUndelegateVote, opts ...grpc.CallOption) (*MsgUndelegateVoteResponse, error)
 }
 
 type msgClient struct {
@@ -1426,6 +1617,24 @@ func (c *msgClient) SudoExec(ctx context.Context, in *MsgSudoExec, opts ...grpc.
 	return out, nil
 }
 
+func (c *msgClient) DelegateVote(ctx context.Context, in *MsgDelegateVote, opts ...grpc.CallOption) (*MsgDelegateVoteResponse, error) {
+	out := new(MsgDelegateVoteResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gov.v1.Msg/DelegateVote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UndelegateVote(ctx context.Context, in *MsgUndelegateVote, opts ...grpc.CallOption) (*MsgUndelegateVoteResponse, error) {
+	out := new(MsgUndelegateVoteResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gov.v1.Msg/UndelegateVote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// SubmitProposal defines a method to create new proposal given the messages.
@@ -1451,6 +1660,11 @@ type MsgServer interface {
 	// SudoExec defines a method to execute an inner message as the governance module.
 	// It permits to execute any message from a proposal, even if they weren't meant to be governance proposals.
 	SudoExec(context.Context, *MsgSudoExec) (*MsgSudoExecResponse, error)
+	// DelegateVote defines a method to delegate the governance voting power of an account to another account.
+	// A direct vote of the delegator overrides the delegation.
+	DelegateVote(context.Context, *MsgDelegateVote) (*MsgDelegateVoteResponse, error)
+	// UndelegateVote defines a method to revoke the delegation of the governance voting power of an account.
+	UndelegateVote(context.Context, *MsgUndelegateVote) (*MsgUndelegateVoteResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1487,6 +1701,12 @@ func (*UnimplementedMsgServer) UpdateMessageParams(ctx context.Context, req *Msg
 func (*UnimplementedMsgServer) SudoExec(ctx context.Context, req *MsgSudoExec) (*MsgSudoExecResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SudoExec not implemented")
 }
+func (*UnimplementedMsgServer) DelegateVote(ctx context.Context, req *MsgDelegateVote) (*MsgDelegateVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegateVote not implemented")
+}
+func (*UnimplementedMsgServer) UndelegateVote(ctx context.Context, req *MsgUndelegateVote) (*MsgUndelegateVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndelegateVote not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1672,6 +1892,42 @@ func _Msg_SudoExec_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_DelegateVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelegateVote)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DelegateVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gov.v1.Msg/DelegateVote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DelegateVote(ctx, req.(*MsgDelegateVote))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UndelegateVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUndelegateVote)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UndelegateVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gov.v1.Msg/UndelegateVote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UndelegateVote(ctx, req.(*MsgUndelegateVote))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.gov.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1716,6 +1972,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SudoExec",
 			Handler:    _Msg_SudoExec_Handler,
 		},
+		{
+			MethodName: "DelegateVote",
+			Handler:    _Msg_DelegateVote_Handler,
+		},
+		{
+			MethodName: "UndelegateVote",
+			Handler:    _Msg_UndelegateVote_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1/tx.proto",
@@ -2517,6 +2781,119 @@ func (m *MsgSudoExecResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgDelegateVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelegateVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelegateVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Delegate) > 0 {
+		i -= len(m.Delegate)
+		copy(dAtA[i:], m.Delegate)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegate)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDelegateVoteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelegateVoteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelegateVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUndelegateVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUndelegateVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUndelegateVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUndelegateVoteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUndelegateVoteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUndelegateVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -2871,13 +3248,61 @@ func (m *MsgSudoExecResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MsgDelegateVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Delegate)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *MsgDelegateVoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
-func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
+
+func (m *MsgUndelegateVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUndelegateVoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5109,6 +5534,302 @@ func (m *MsgSudoExecResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgDelegateVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDelegateVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDelegateVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDelegateVoteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDelegateVoteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDelegateVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUndelegateVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUndelegateVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUndelegateVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUndelegateVoteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUndelegateVoteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUndelegateVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -58,6 +58,28 @@ func (k Keeper) Tombstone(ctx context.Context, consAddr sdk.ConsAddress) error {
 	return k.ValidatorSigningInfo.Set(ctx, consAddr, signInfo)
 }
 
+// Untombstone lifts the tombstone of a validator, e.g. after a governance
+// decision. The validator remains jailed, but its jail time is reset to the
+// current block time so that the operator can unjail it with MsgUnjail.
+func (k Keeper) Untombstone(ctx context.Context, consAddr sdk.ConsAddress) error {
+	signInfo, err := k.ValidatorSigningInfo.Get(ctx, consAddr)
+	if err != nil {
+		addr, err := k.sk.ConsensusAddressCodec().BytesToString(consAddr)
+		if err != nil {
+			return types.ErrNoSigningInfoFound.Wrapf("could not convert consensus address to string. Error: %s", err.Error())
+		}
+		return types.ErrNoSigningInfoFound.Wrap(fmt.Sprintf("cannot untombstone validator with consensus address %s that does not have any signing information", addr))
+	}
+
+	if !signInfo.Tombstoned {
+		return types.ErrValidatorNotTombstoned.Wrap("cannot untombstone validator that is not tombstoned")
+	}
+
+	signInfo.Tombstoned = false
+	signInfo.JailedUntil = k.HeaderService.HeaderInfo(ctx).Time
+	return k.ValidatorSigningInfo.Set(ctx, consAddr, signInfo)
+}
+
 // IsTombstoned returns if a given validator by consensus address is tombstoned.
 func (k Keeper) IsTombstoned(ctx context.Context, consAddr sdk.ConsAddress) bool {
 	signInfo, err := k.ValidatorSigningInfo.Get(ctx, consAddr)
@@ -50,10 +50,18 @@ func (s *KeeperTestSuite) TestValidatorSigningInfo() {
 	require.NoError(err)
 	require.True(keeper.IsTombstoned(ctx, consAddr))
 
+	// test Untombstone
+	require.NoError(keeper.Untombstone(ctx, consAddr))
+	require.False(keeper.IsTombstoned(ctx, consAddr))
+	sInfo, err := keeper.ValidatorSigningInfo.Get(ctx, consAddr)
+	require.NoError(err)
+	require.Equal(ctx.HeaderInfo().Time, sInfo.JailedUntil)
+	require.ErrorIs(keeper.Untombstone(ctx, consAddr), slashingtypes.ErrValidatorNotTombstoned)
+
 	// test JailUntil
 	jailTime := time.Now().Add(time.Hour).UTC()
 	require.NoError(keeper.JailUntil(ctx, consAddr, jailTime))
-	sInfo, _ := keeper.ValidatorSigningInfo.Get(ctx, consAddr)
+	sInfo, _ = keeper.ValidatorSigningInfo.Get(ctx, consAddr)
 	require.Equal(sInfo.JailedUntil, jailTime)
 }
 
@@ -40,6 +40,12 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "authority",
 					Short:     "Get the upgrade authority address",
 				},
+				{
+					RpcMethod: "ReadinessTally",
+					Use:       "readiness-tally",
+					Short:     "Query the readiness of the bonded validators for the current upgrade plan",
+					Long:      "Gets the voting power of the bonded validators that signalled their readiness for the current upgrade plan, and lists the validators that have not signalled it yet.",
+				},
 				{
 					RpcMethod: "UpgradedConsensusState",
 					Skip:      true, // Skipping this command as the query is deprecated.
@@ -55,6 +61,15 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:       "Submit a proposal to cancel a planned chain upgrade.",
 					GovProposal: true,
 				},
+				{
+					RpcMethod: "SignalUpgradeReadiness",
+					Use:       "signal-readiness [name]",
+					Short:     "Signal that a validator is ready for the scheduled upgrade plan",
+					Long:      "Signal that the validator is ready for the scheduled upgrade plan. The signal must be sent from the account of the validator operator. Conditional upgrade plans are only executed once enough voting power signalled its readiness.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "name"},
+					},
+				},
 				{
 					RpcMethod: "SoftwareUpgrade",
 					Skip:      true, // skipped because authority gated
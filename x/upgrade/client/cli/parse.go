@@ -1,6 +1,8 @@
 package cli
 
 import (
+	"fmt"
+
 	"github.com/spf13/pflag"
 
 	"cosmossdk.io/x/upgrade/types"
@@ -17,5 +19,49 @@ func parsePlan(fs *pflag.FlagSet, name string) (types.Plan, error) {
 		return types.Plan{}, err
 	}
 
-	return types.Plan{Name: name, Height: height, Info: info}, nil
+	condition, err := parseReadinessCondition(fs)
+	if err != nil {
+		return types.Plan{}, err
+	}
+
+	return types.Plan{Name: name, Height: height, Info: info, ReadinessCondition: condition}, nil
+}
+
+// parseReadinessCondition returns the readiness condition of the plan, or nil if no readiness threshold is set.
+func parseReadinessCondition(fs *pflag.FlagSet) (*types.ReadinessCondition, error) {
+	threshold, err := fs.GetString(FlagReadinessThreshold)
+	if err != nil || threshold == "" {
+		return nil, err
+	}
+
+	onFailure, err := fs.GetString(FlagReadinessOnFailure)
+	if err != nil {
+		return nil, err
+	}
+
+	delayBlocks, err := fs.GetInt64(FlagReadinessDelayBlocks)
+	if err != nil {
+		return nil, err
+	}
+
+	maxDelays, err := fs.GetUint32(FlagReadinessMaxDelays)
+	if err != nil {
+		return nil, err
+	}
+
+	condition := &types.ReadinessCondition{
+		Threshold:   threshold,
+		DelayBlocks: delayBlocks,
+		MaxDelays:   maxDelays,
+	}
+	switch onFailure {
+	case "delay":
+		condition.OnFailure = types.ReadinessFailureDelay
+	case "cancel":
+		condition.OnFailure = types.ReadinessFailureCancel
+	default:
+		return nil, fmt.Errorf("invalid readiness failure action %q, expected delay or cancel", onFailure)
+	}
+
+	return condition, nil
 }
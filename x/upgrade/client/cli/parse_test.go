@@ -32,3 +32,25 @@ func TestParsePlan(t *testing.T) {
 	require.Equal(t, p.Height, proposal.Plan.Height)
 	require.Equal(t, p.Info, proposal.Plan.Info)
 }
+
+func TestParsePlanWithReadinessCondition(t *testing.T) {
+	fs := NewCmdSubmitUpgradeProposal().Flags()
+
+	require.NoError(t, fs.Set(FlagUpgradeHeight, "123456"))
+	require.NoError(t, fs.Set(FlagReadinessThreshold, "0.67"))
+	require.NoError(t, fs.Set(FlagReadinessDelayBlocks, "100"))
+	require.NoError(t, fs.Set(FlagReadinessMaxDelays, "3"))
+
+	p, err := parsePlan(fs, "plan name")
+	require.NoError(t, err)
+	require.Equal(t, &types.ReadinessCondition{
+		Threshold:   "0.67",
+		OnFailure:   types.ReadinessFailureDelay,
+		DelayBlocks: 100,
+		MaxDelays:   3,
+	}, p.ReadinessCondition)
+
+	require.NoError(t, fs.Set(FlagReadinessOnFailure, "ignore"))
+	_, err = parsePlan(fs, "plan name")
+	require.Error(t, err)
+}
@@ -0,0 +1,392 @@
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"text/tabwriter"
+	"time"
+
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/store/rootmulti"
+	"cosmossdk.io/x/upgrade/keeper"
+	"cosmossdk.io/x/upgrade/types"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/version"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+)
+
+const (
+	FlagTmpDir         = "tmp-dir"
+	FlagSkipInvariants = "skip-invariants"
+)
+
+// RehearsalReport is the outcome of the rehearsal of an upgrade.
+type RehearsalReport struct {
+	Name             string            `json:"name"`
+	Height           int64             `json:"height"`
+	Migrations       []MigrationReport `json:"migrations"`
+	GasUsed          uint64            `json:"gas_used"`
+	Duration         string            `json:"duration"`
+	Error            string            `json:"error,omitempty"`
+	BrokenInvariants []string          `json:"broken_invariants,omitempty"`
+}
+
+// MigrationReport is the outcome of the migration of a module during the rehearsal of an upgrade.
+type MigrationReport struct {
+	Module      string `json:"module"`
+	FromVersion uint64 `json:"from_version"`
+	ToVersion   uint64 `json:"to_version"`
+	InitGenesis bool   `json:"init_genesis,omitempty"`
+	GasUsed     uint64 `json:"gas_used"`
+	Duration    string `json:"duration"`
+	Error       string `json:"error,omitempty"`
+}
+
+// Success returns true if the upgrade was applied and no invariant was broken.
+func (r RehearsalReport) Success() bool {
+	return r.Error == "" && len(r.BrokenInvariants) == 0
+}
+
+// NewRehearseUpgradeCmd returns a command that rehearses an upgrade on a copy of the latest committed state of
+// the node. The modules function returns the upgrade keeper and the module manager of an application created
+// by appCreator.
+func NewRehearseUpgradeCmd[T servertypes.Application](appCreator servertypes.AppCreator[T], modules func(app T) (*keeper.Keeper, *module.Manager)) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rehearse-upgrade [name]",
+		Short: "Rehearse an upgrade on a copy of the latest committed state",
+		Long: `Rehearse an upgrade on a copy of the latest committed state of the node, as if the upgrade plan was
+scheduled at the next height.
+
+The application database is copied to a temporary directory, which is removed once the rehearsal is over.
+The real data directory of the node is never written to, but the node must be stopped during the rehearsal.
+
+The store upgrades of the upgrade are applied when the copied state is loaded, then the upgrade handler
+is executed and the invariants of the modules are checked. The time, gas and error of the migration of
+each module are reported.
+`,
+		Example: fmt.Sprintf("%s rehearse-upgrade v2 --tmp-dir /mnt/scratch", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+
+			tmpDir, err := cmd.Flags().GetString(FlagTmpDir)
+			if err != nil {
+				return err
+			}
+			skipInvariants, err := cmd.Flags().GetBool(FlagSkipInvariants)
+			if err != nil {
+				return err
+			}
+			output, err := cmd.Flags().GetString(flags.FlagOutput)
+			if err != nil {
+				return err
+			}
+
+			report, err := rehearseUpgrade(serverCtx, appCreator, modules, args[0], tmpDir, !skipInvariants)
+			if err != nil {
+				return err
+			}
+
+			if err := printReport(cmd.OutOrStdout(), report, output); err != nil {
+				return err
+			}
+
+			if !report.Success() {
+				// the report already describes the failure
+				cmd.SilenceUsage = true
+				return fmt.Errorf("rehearsal of upgrade %s failed", report.Name)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().String(FlagTmpDir, os.TempDir(), "Directory in which the application database is copied")
+	cmd.Flags().Bool(FlagSkipInvariants, false, "Skip the invariant checks after the upgrade")
+	cmd.Flags().StringP(flags.FlagOutput, "o", flags.OutputFormatText, "Output format (text|json)")
+
+	return cmd
+}
+
+// rehearseUpgrade applies the named upgrade on a copy of the latest committed state of the node.
+// An error is only returned if the rehearsal could not be run, failures of the upgrade itself are reported.
+func rehearseUpgrade[T servertypes.Application](
+	serverCtx *server.Context,
+	appCreator servertypes.AppCreator[T],
+	modules func(app T) (*keeper.Keeper, *module.Manager),
+	name, tmpDir string,
+	checkInvariants bool,
+) (*RehearsalReport, error) {
+	home, err := os.MkdirTemp(tmpDir, "rehearse-upgrade-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(home)
+
+	dataDir := filepath.Join(home, "data")
+	if err := copyDir(filepath.Join(serverCtx.Config.RootDir, "data", "application.db"), filepath.Join(dataDir, "application.db")); err != nil {
+		return nil, fmt.Errorf("failed to copy the application database: %w", err)
+	}
+
+	db, err := server.OpenDB(home, server.GetAppDBBackend(serverCtx.Viper))
+	if err != nil {
+		return nil, err
+	}
+
+	latestHeight := rootmulti.GetLatestVersion(db)
+	if latestHeight == 0 {
+		db.Close()
+		return nil, errors.New("no committed state found")
+	}
+	plan := types.Plan{Name: name, Height: latestHeight + 1}
+
+	// The upgrade info makes the application set its store loader, which applies the store upgrades
+	// of the plan when loading the state.
+	info, err := json.Marshal(plan)
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	if err := os.WriteFile(filepath.Join(dataDir, types.UpgradeInfoFilename), info, 0o600); err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	// the genesis of the node is not copied, so the chain ID is read from it beforehand
+	if serverCtx.Viper.GetString(flags.FlagChainID) == "" {
+		chainID, err := readChainID(serverCtx.Config.GenesisFile())
+		if err != nil {
+			db.Close()
+			return nil, err
+		}
+		serverCtx.Viper.Set(flags.FlagChainID, chainID)
+	}
+
+	serverCtx.Viper.Set(flags.FlagHome, home)
+	app, err := createApp(serverCtx, appCreator, db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the state with the store upgrades of %s: %w", name, err)
+	}
+	defer app.Close()
+
+	upgradeKeeper, moduleManager := modules(app)
+	if !upgradeKeeper.HasHandler(name) {
+		return nil, fmt.Errorf("no upgrade handler registered for %s", name)
+	}
+
+	report := &RehearsalReport{Name: name, Height: plan.Height}
+
+	// the state changes are never written to the application database
+	now := time.Now()
+	ctx := sdk.NewContext(app.CommitMultiStore().CacheMultiStore(), false, serverCtx.Logger).
+		WithBlockHeader(cmtproto.Header{Height: plan.Height, Time: now}).
+		WithHeaderInfo(header.Info{Height: plan.Height, Time: now})
+	ctx = module.WithMigrationListener(ctx, func(result module.MigrationResult) {
+		migration := MigrationReport{
+			Module:      result.Module,
+			FromVersion: result.FromVersion,
+			ToVersion:   result.ToVersion,
+			InitGenesis: result.InitGenesis,
+			GasUsed:     result.GasUsed,
+			Duration:    result.Duration.String(),
+		}
+		if result.Err != nil {
+			migration.Error = result.Err.Error()
+		}
+		report.Migrations = append(report.Migrations, migration)
+	})
+
+	start := time.Now()
+	err = applyUpgrade(ctx, upgradeKeeper, plan)
+	report.Duration = time.Since(start).String()
+	report.GasUsed = ctx.GasMeter().GasConsumed()
+	if err != nil {
+		report.Error = err.Error()
+		return report, nil
+	}
+
+	if checkInvariants {
+		report.BrokenInvariants = brokenInvariants(ctx, moduleManager)
+	}
+
+	return report, nil
+}
+
+// readChainID reads the chain ID from the genesis file.
+func readChainID(genesisFile string) (string, error) {
+	reader, err := os.Open(genesisFile)
+	if err != nil {
+		return "", err
+	}
+	defer reader.Close()
+
+	chainID, err := genutiltypes.ParseChainIDFromGenesis(reader)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse chain-id from genesis file: %w", err)
+	}
+	return chainID, nil
+}
+
+// createApp creates the application, which loads the latest state and applies the store upgrades.
+// Applications usually panic if the state cannot be loaded.
+func createApp[T servertypes.Application](serverCtx *server.Context, appCreator servertypes.AppCreator[T], db dbm.DB) (app T, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			db.Close()
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+
+	return appCreator(serverCtx.Logger, db, nil, serverCtx.Viper), nil
+}
+
+// applyUpgrade applies the upgrade plan and recovers from panics, e.g. in the upgrade handler.
+func applyUpgrade(ctx sdk.Context, upgradeKeeper *keeper.Keeper, plan types.Plan) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("upgrade %s panicked: %v", plan.Name, r)
+		}
+	}()
+
+	return upgradeKeeper.ApplyUpgrade(ctx, plan)
+}
+
+// invariantRegistry collects the invariants registered by the modules.
+type invariantRegistry struct {
+	routes []invariantRoute
+}
+
+type invariantRoute struct {
+	module, route string
+	invariant     sdk.Invariant
+}
+
+func (r *invariantRegistry) RegisterRoute(moduleName, route string, invariant sdk.Invariant) {
+	r.routes = append(r.routes, invariantRoute{module: moduleName, route: route, invariant: invariant})
+}
+
+// brokenInvariants checks the invariants of the modules and returns the messages of the broken ones.
+func brokenInvariants(ctx sdk.Context, moduleManager *module.Manager) []string {
+	registry := &invariantRegistry{}
+	moduleManager.RegisterInvariants(registry)
+
+	var broken []string
+	for _, route := range registry.routes {
+		msg, isBroken, err := checkInvariant(ctx, route.invariant)
+		switch {
+		case err != nil:
+			broken = append(broken, fmt.Sprintf("%s/%s: %v", route.module, route.route, err))
+		case isBroken:
+			broken = append(broken, msg)
+		}
+	}
+
+	return broken
+}
+
+func checkInvariant(ctx sdk.Context, invariant sdk.Invariant) (msg string, broken bool, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("invariant panicked: %v", r)
+		}
+	}()
+
+	// invariants are checked on a branch, so they cannot alter the upgraded state
+	cacheCtx, _ := ctx.CacheContext()
+	msg, broken = invariant(cacheCtx)
+	return msg, broken, nil
+}
+
+// printReport prints the report of the rehearsal in the given format.
+func printReport(w io.Writer, report *RehearsalReport, output string) error {
+	if output == flags.OutputFormatJSON {
+		bz, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(w, string(bz))
+		return err
+	}
+
+	fmt.Fprintf(w, "Upgrade %s rehearsed at height %d in %s, %d gas used\n\n", report.Name, report.Height, report.Duration, report.GasUsed)
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "MODULE\tFROM\tTO\tGAS\tDURATION\tERROR")
+	for _, migration := range report.Migrations {
+		to := fmt.Sprintf("%d", migration.ToVersion)
+		if migration.InitGenesis {
+			to += " (new)"
+		}
+		fmt.Fprintf(tw, "%s\t%d\t%s\t%d\t%s\t%s\n", migration.Module, migration.FromVersion, to, migration.GasUsed, migration.Duration, migration.Error)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	if report.Error != "" {
+		fmt.Fprintf(w, "\nUpgrade failed: %s\n", report.Error)
+	}
+	for _, msg := range report.BrokenInvariants {
+		fmt.Fprintf(w, "\nBroken invariant: %s", msg)
+	}
+	if report.Success() {
+		fmt.Fprintln(w, "\nUpgrade succeeded")
+	}
+
+	return nil
+}
+
+// copyDir recursively copies the src directory to dst.
+func copyDir(src, dst string) error {
+	return filepath.Walk(src, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+
+		if info.IsDir() {
+			return os.MkdirAll(target, 0o700)
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		return copyFile(path, target)
+	})
+}
+
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o600)
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
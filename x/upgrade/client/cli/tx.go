@@ -25,6 +25,11 @@ const (
 	FlagNoChecksumRequired = "no-checksum-required"
 	FlagDaemonName         = "daemon-name"
 	FlagAuthority          = "authority"
+
+	FlagReadinessThreshold   = "readiness-threshold"
+	FlagReadinessOnFailure   = "readiness-on-failure"
+	FlagReadinessDelayBlocks = "readiness-delay-blocks"
+	FlagReadinessMaxDelays   = "readiness-max-delays"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -50,7 +55,9 @@ func NewCmdSubmitUpgradeProposal() *cobra.Command {
 		Short: "Submit a software upgrade proposal",
 		Long: "Submit a software upgrade along with an initial deposit.\n" +
 			"Please specify a unique name and height for the upgrade to take effect.\n" +
-			"You may include info to reference a binary download link, in a format compatible with: https://docs.cosmos.network/main/tooling/cosmovisor",
+			"You may include info to reference a binary download link, in a format compatible with: https://docs.cosmos.network/main/tooling/cosmovisor\n" +
+			"With --readiness-threshold, the upgrade is only executed if enough voting power signalled its readiness at the upgrade height, " +
+			"otherwise it is delayed or cancelled as defined by --readiness-on-failure.",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -124,6 +131,10 @@ func NewCmdSubmitUpgradeProposal() *cobra.Command {
 	cmd.Flags().Bool(FlagNoChecksumRequired, false, "Skip requirement of checksums for binaries in the upgrade info")
 	cmd.Flags().String(FlagDaemonName, getDefaultDaemonName(), "The name of the executable being upgraded (for upgrade-info validation). Default is the DAEMON_NAME env var if set, or else this executable")
 	cmd.Flags().String(FlagAuthority, "", "The address of the upgrade module authority (defaults to gov)")
+	cmd.Flags().String(FlagReadinessThreshold, "", "The minimum fraction of the bonded voting power that must signal its readiness for the upgrade to happen (e.g. 0.67)")
+	cmd.Flags().String(FlagReadinessOnFailure, "delay", "What happens to the upgrade if the readiness threshold is not met: delay or cancel")
+	cmd.Flags().Int64(FlagReadinessDelayBlocks, 0, "The number of blocks the upgrade is delayed by if the readiness threshold is not met")
+	cmd.Flags().Uint32(FlagReadinessMaxDelays, 0, "The maximum number of times the upgrade can be delayed before it is cancelled (0 for no limit)")
 
 	// add common proposal flags
 	flags.AddTxFlagsToCmd(cmd)
@@ -41,7 +41,8 @@ type ModuleInputs struct {
 	AddressCodec       address.Codec
 	AppVersionModifier app.VersionModifier
 
-	AppOpts servertypes.AppOptions `optional:"true"`
+	AppOpts       servertypes.AppOptions `optional:"true"`
+	StakingKeeper types.StakingKeeper    `optional:"true"`
 }
 
 type ModuleOutputs struct {
@@ -78,6 +79,9 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 
 	// set the governance module account as the authority for conducting upgrades
 	k := keeper.NewKeeper(in.Environment, skipUpgradeHeights, in.Cdc, homePath, in.AppVersionModifier, authorityStr)
+	if in.StakingKeeper != nil {
+		k.SetStakingKeeper(in.StakingKeeper)
+	}
 	m := NewAppModule(k)
 
 	return ModuleOutputs{UpgradeKeeper: k, Module: m}
@@ -14,6 +14,8 @@ import (
 // PreBlocker will check if there is a scheduled plan and if it is ready to be executed.
 // If the current height is in the provided set of heights to skip, it will skip and clear the upgrade plan.
 // If it is ready, it will execute it if the handler is installed, and panic/abort otherwise.
+// If the plan is gated on the readiness of the validators and the readiness threshold is not met, it will
+// delay or cancel the plan instead.
 // If the plan is not ready, it will ensure the handler is not registered too early (and abort otherwise).
 //
 // The purpose is to ensure the binary is switched EXACTLY at the desired block, and to allow
@@ -76,6 +78,19 @@ func (k Keeper) PreBlocker(ctx context.Context) error {
 			return nil
 		}
 
+		// If the plan is gated on the readiness of the validators and not enough voting power is ready,
+		// delay or cancel the plan instead of halting
+		if plan.IsConditional() {
+			ready, err := k.isReady(ctx, plan)
+			if err != nil {
+				return err
+			}
+
+			if !ready {
+				return k.handleReadinessFailure(ctx, plan)
+			}
+		}
+
 		// Prepare shutdown if we don't have an upgrade handler for this upgrade name (meaning this software is out of date)
 		if !k.HasHandler(plan.Name) {
 			// Write the upgrade info to disk. The UpgradeStoreLoader uses this info to perform or skip
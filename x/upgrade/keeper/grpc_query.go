@@ -77,3 +77,13 @@ func (k Keeper) ModuleVersions(ctx context.Context, req *types.QueryModuleVersio
 func (k Keeper) Authority(c context.Context, req *types.QueryAuthorityRequest) (*types.QueryAuthorityResponse, error) {
 	return &types.QueryAuthorityResponse{Address: k.authority}, nil
 }
+
+// ReadinessTally implements the Query/ReadinessTally gRPC method
+func (k Keeper) ReadinessTally(ctx context.Context, req *types.QueryReadinessTallyRequest) (*types.QueryReadinessTallyResponse, error) {
+	plan, err := k.GetUpgradePlan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return k.GetReadinessTally(ctx, plan.Name)
+}
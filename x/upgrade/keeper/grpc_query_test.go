@@ -239,6 +239,29 @@ func (suite *UpgradeTestSuite) TestAuthority() {
 	suite.Require().Equal(suite.encodedAuthority, res.Address)
 }
 
+func (suite *UpgradeTestSuite) TestReadinessTally() {
+	sk := newFakeStakingKeeper(suite.T(), 300, 100)
+	suite.upgradeKeeper.SetStakingKeeper(sk)
+
+	_, err := suite.queryClient.ReadinessTally(context.Background(), &types.QueryReadinessTallyRequest{})
+	suite.Require().ErrorContains(err, types.ErrNoUpgradePlanFound.Error())
+
+	err = suite.upgradeKeeper.ScheduleUpgrade(suite.ctx, types.Plan{Name: "test-plan", Height: 5})
+	suite.Require().NoError(err)
+	err = suite.upgradeKeeper.SignalUpgradeReadiness(suite.ctx, sk.valAddr(1), "test-plan")
+	suite.Require().NoError(err)
+
+	res, err := suite.queryClient.ReadinessTally(context.Background(), &types.QueryReadinessTallyRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal("test-plan", res.Name)
+	suite.Require().Equal("100", res.ReadyPower.String())
+	suite.Require().Equal("400", res.TotalPower.String())
+	suite.Require().Equal("0.250000000000000000", res.ReadyFraction)
+	suite.Require().Len(res.Validators, 2)
+	suite.Require().False(res.Validators[0].Ready)
+	suite.Require().True(res.Validators[1].Ready)
+}
+
 func TestUpgradeTestSuite(t *testing.T) {
 	suite.Run(t, new(UpgradeTestSuite))
 }
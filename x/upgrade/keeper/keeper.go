@@ -39,6 +39,7 @@ type Keeper struct {
 	downgradeVerified  bool                            // tells if we've already sanity checked that this binary version isn't being used against an old state.
 	authority          string                          // the address capable of executing and canceling an upgrade. Usually the gov module account
 	initVersionMap     appmodule.VersionMap            // the module version map at init genesis
+	stakingKeeper      types.StakingKeeper             // tracks the readiness of the validators for conditional upgrade plans
 }
 
 // NewKeeper constructs an upgrade Keeper which requires the following arguments:
@@ -84,6 +85,13 @@ func (k *Keeper) GetInitVersionMap() appmodule.VersionMap {
 	return k.initVersionMap
 }
 
+// SetStakingKeeper sets the staking keeper used to track the readiness of the validators for conditional
+// upgrade plans. Conditional upgrade plans cannot be scheduled without a staking keeper.
+// This is only used in app wiring and should not be used in any other context.
+func (k *Keeper) SetStakingKeeper(sk types.StakingKeeper) {
+	k.stakingKeeper = sk
+}
+
 // SetUpgradeHandler sets an UpgradeHandler for the upgrade specified by name. This handler will be called when the upgrade
 // with this name is applied. In order for an upgrade with the given name to proceed, a handler for this upgrade
 // must be set even if it is a no-op function.
@@ -202,6 +210,15 @@ func (k Keeper) ScheduleUpgrade(ctx context.Context, plan types.Plan) error {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "upgrade cannot be scheduled in the past")
 	}
 
+	if plan.IsConditional() {
+		if k.stakingKeeper == nil {
+			return errorsmod.Wrap(types.ErrReadinessUnsupported, "cannot schedule a conditional upgrade without a staking keeper")
+		}
+		if plan.ReadinessCondition.Delays != 0 {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "delays must be 0 when scheduling an upgrade")
+		}
+	}
+
 	doneHeight, err := k.GetDoneHeight(ctx, plan.Name)
 	if err != nil {
 		return err
@@ -211,8 +228,6 @@ func (k Keeper) ScheduleUpgrade(ctx context.Context, plan types.Plan) error {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "upgrade with name %s has already been completed", plan.Name)
 	}
 
-	store := k.KVStoreService.OpenKVStore(ctx)
-
 	// clear any old IBC state stored by previous plan
 	oldPlan, err := k.GetUpgradePlan(ctx)
 	// if there's an error but it's not ErrNoUpgradePlanFound, return error
@@ -225,14 +240,17 @@ func (k Keeper) ScheduleUpgrade(ctx context.Context, plan types.Plan) error {
 		if err != nil {
 			return err
 		}
-	}
 
-	bz, err := k.cdc.Marshal(&plan)
-	if err != nil {
-		return err
+		// the readiness signalled for the old plan does not apply to a different plan
+		if oldPlan.Name != plan.Name {
+			err = k.clearReadiness(ctx, oldPlan.Name)
+			if err != nil {
+				return err
+			}
+		}
 	}
 
-	err = store.Set(types.PlanKey(), bz)
+	err = k.setUpgradePlan(ctx, plan)
 	if err != nil {
 		return err
 	}
@@ -371,10 +389,26 @@ func (k Keeper) ClearUpgradePlan(ctx context.Context) error {
 		return err
 	}
 
+	err = k.clearReadiness(ctx, oldPlan.Name)
+	if err != nil {
+		return err
+	}
+
 	store := k.KVStoreService.OpenKVStore(ctx)
 	return store.Delete(types.PlanKey())
 }
 
+// setUpgradePlan saves the upgrade plan, without any validation.
+func (k Keeper) setUpgradePlan(ctx context.Context, plan types.Plan) error {
+	bz, err := k.cdc.Marshal(&plan)
+	if err != nil {
+		return err
+	}
+
+	store := k.KVStoreService.OpenKVStore(ctx)
+	return store.Set(types.PlanKey(), bz)
+}
+
 // GetUpgradePlan returns the currently scheduled Plan if any. If not found it returns
 // ErrNoUpgradePlanFound, but other errors may be returned if there is an error reading from the store.
 func (k Keeper) GetUpgradePlan(ctx context.Context) (plan types.Plan, err error) {
@@ -130,3 +130,65 @@ func (s *KeeperTestSuite) TestCancelUpgrade() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestSignalUpgradeReadiness() {
+	sk := newFakeStakingKeeper(s.T(), 100)
+	s.upgradeKeeper.SetStakingKeeper(sk)
+	valAddr, err := sk.codec.BytesToString(sk.valAddr(0))
+	s.Require().NoError(err)
+
+	err = s.upgradeKeeper.ScheduleUpgrade(s.ctx, types.Plan{
+		Name:   "some name",
+		Height: 123450000,
+	})
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name      string
+		req       *types.MsgSignalUpgradeReadiness
+		expectErr bool
+		errMsg    string
+	}{
+		{
+			"invalid validator address",
+			&types.MsgSignalUpgradeReadiness{
+				ValidatorAddress: "validator",
+				Name:             "some name",
+			},
+			true,
+			"invalid validator address",
+		},
+		{
+			"upgrade plan not scheduled",
+			&types.MsgSignalUpgradeReadiness{
+				ValidatorAddress: valAddr,
+				Name:             "other name",
+			},
+			true,
+			"upgrade plan other name is not scheduled",
+		},
+		{
+			"readiness signalled successfully",
+			&types.MsgSignalUpgradeReadiness{
+				ValidatorAddress: valAddr,
+				Name:             "some name",
+			},
+			false,
+			"",
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.msgSrvr.SignalUpgradeReadiness(s.ctx, tc.req)
+			if tc.expectErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errMsg)
+			} else {
+				s.Require().NoError(err)
+				ready, err := s.upgradeKeeper.HasSignalledReadiness(s.ctx, sk.valAddr(0), tc.req.Name)
+				s.Require().NoError(err)
+				s.Require().True(ready)
+			}
+		})
+	}
+}
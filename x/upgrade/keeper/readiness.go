@@ -0,0 +1,188 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/upgrade/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// SignalUpgradeReadiness records that the validator is ready for the currently scheduled upgrade plan.
+// The signal is cleared when the plan is applied, cancelled or replaced by a plan with a different name.
+func (k Keeper) SignalUpgradeReadiness(ctx context.Context, valAddr sdk.ValAddress, name string) error {
+	if k.stakingKeeper == nil {
+		return errorsmod.Wrap(types.ErrReadinessUnsupported, "no staking keeper set")
+	}
+
+	plan, err := k.GetUpgradePlan(ctx)
+	if err != nil {
+		return err
+	}
+
+	if plan.Name != name {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "upgrade plan %s is not scheduled, the scheduled plan is %s", name, plan.Name)
+	}
+
+	if _, err := k.stakingKeeper.Validator(ctx, valAddr); err != nil {
+		return err
+	}
+
+	store := k.KVStoreService.OpenKVStore(ctx)
+	return store.Set(types.ReadinessKey(name, valAddr), []byte{1})
+}
+
+// HasSignalledReadiness returns true if the validator signalled its readiness for the upgrade plan.
+func (k Keeper) HasSignalledReadiness(ctx context.Context, valAddr sdk.ValAddress, name string) (bool, error) {
+	store := k.KVStoreService.OpenKVStore(ctx)
+	return store.Has(types.ReadinessKey(name, valAddr))
+}
+
+// GetReadinessTally computes the readiness signalled by the bonded validators for the upgrade plan, weighted by
+// their bonded tokens.
+func (k Keeper) GetReadinessTally(ctx context.Context, name string) (*types.QueryReadinessTallyResponse, error) {
+	if k.stakingKeeper == nil {
+		return nil, errorsmod.Wrap(types.ErrReadinessUnsupported, "no staking keeper set")
+	}
+
+	tally := &types.QueryReadinessTallyResponse{
+		Name:       name,
+		ReadyPower: math.ZeroInt(),
+		TotalPower: math.ZeroInt(),
+	}
+
+	var innerErr error
+	err := k.stakingKeeper.IterateBondedValidatorsByPower(ctx, func(_ int64, validator sdk.ValidatorI) (stop bool) {
+		valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(validator.GetOperator())
+		if err != nil {
+			innerErr = err
+			return true
+		}
+
+		ready, err := k.HasSignalledReadiness(ctx, valAddr, name)
+		if err != nil {
+			innerErr = err
+			return true
+		}
+
+		power := validator.GetBondedTokens()
+		tally.TotalPower = tally.TotalPower.Add(power)
+		if ready {
+			tally.ReadyPower = tally.ReadyPower.Add(power)
+		}
+		tally.Validators = append(tally.Validators, &types.ValidatorReadiness{
+			ValidatorAddress: validator.GetOperator(),
+			Power:            power,
+			Ready:            ready,
+		})
+
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+	if innerErr != nil {
+		return nil, innerErr
+	}
+
+	fraction := math.LegacyZeroDec()
+	if tally.TotalPower.IsPositive() {
+		fraction = math.LegacyNewDecFromInt(tally.ReadyPower).QuoInt(tally.TotalPower)
+	}
+	tally.ReadyFraction = fraction.String()
+
+	return tally, nil
+}
+
+// isReady returns true if the readiness condition of the plan is met.
+func (k Keeper) isReady(ctx context.Context, plan types.Plan) (bool, error) {
+	threshold, err := math.LegacyNewDecFromStr(plan.ReadinessCondition.Threshold)
+	if err != nil {
+		return false, err
+	}
+
+	tally, err := k.GetReadinessTally(ctx, plan.Name)
+	if err != nil {
+		return false, err
+	}
+
+	fraction, err := math.LegacyNewDecFromStr(tally.ReadyFraction)
+	if err != nil {
+		return false, err
+	}
+
+	return fraction.GTE(threshold), nil
+}
+
+// handleReadinessFailure delays or cancels an upgrade plan whose readiness condition is not met, as defined by
+// the condition. A delayed plan is cancelled once it has been delayed max_delays times.
+func (k Keeper) handleReadinessFailure(ctx context.Context, plan types.Plan) error {
+	condition := plan.ReadinessCondition
+	if condition.OnFailure == types.ReadinessFailureDelay &&
+		(condition.MaxDelays == 0 || condition.Delays < condition.MaxDelays) {
+		// the IBC state is stored under the plan height, it is moved to the new height
+		upgradedClient, err := k.GetUpgradedClient(ctx, plan.Height)
+		if err != nil && !errors.Is(err, types.ErrNoUpgradedClientFound) {
+			return err
+		}
+		upgradedConsState, err := k.GetUpgradedConsensusState(ctx, plan.Height)
+		if err != nil && !errors.Is(err, types.ErrNoUpgradedConsensusStateFound) {
+			return err
+		}
+		if err := k.ClearIBCState(ctx, plan.Height); err != nil {
+			return err
+		}
+
+		plan.Height += condition.DelayBlocks
+		condition.Delays++
+
+		if upgradedClient != nil {
+			if err := k.SetUpgradedClient(ctx, plan.Height, upgradedClient); err != nil {
+				return err
+			}
+		}
+		if upgradedConsState != nil {
+			if err := k.SetUpgradedConsensusState(ctx, plan.Height, upgradedConsState); err != nil {
+				return err
+			}
+		}
+
+		k.Logger.Info(fmt.Sprintf("UPGRADE \"%s\" DELAYED to %d: readiness threshold %s not met", plan.Name, plan.Height, condition.Threshold))
+		return k.setUpgradePlan(ctx, plan)
+	}
+
+	k.Logger.Info(fmt.Sprintf("UPGRADE \"%s\" CANCELLED at %d: readiness threshold %s not met", plan.Name, plan.Height, condition.Threshold))
+	return k.ClearUpgradePlan(ctx)
+}
+
+// clearReadiness removes the readiness signalled by the validators for the upgrade plan.
+func (k Keeper) clearReadiness(ctx context.Context, name string) error {
+	store := k.KVStoreService.OpenKVStore(ctx)
+	prefix := types.ReadinessPrefix(name)
+	it, err := store.Iterator(prefix, storetypes.PrefixEndBytes(prefix))
+	if err != nil {
+		return err
+	}
+
+	var keys [][]byte
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, it.Key())
+	}
+	if err := it.Close(); err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if err := store.Delete(key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,254 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/math"
+	stakingtypes "cosmossdk.io/x/staking/types"
+	"cosmossdk.io/x/upgrade/types"
+
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// fakeStakingKeeper is a staking keeper with a fixed set of bonded validators.
+type fakeStakingKeeper struct {
+	codec      address.Codec
+	validators []stakingtypes.Validator
+}
+
+func newFakeStakingKeeper(t *testing.T, tokens ...int64) *fakeStakingKeeper {
+	t.Helper()
+	sk := &fakeStakingKeeper{codec: addresscodec.NewBech32Codec("cosmosvaloper")}
+	for i, amount := range tokens {
+		valAddr, err := sk.codec.BytesToString(sdk.ValAddress([]byte{byte(i + 1)}))
+		require.NoError(t, err)
+		sk.validators = append(sk.validators, stakingtypes.Validator{
+			OperatorAddress: valAddr,
+			Status:          stakingtypes.Bonded,
+			Tokens:          math.NewInt(amount),
+		})
+	}
+	return sk
+}
+
+func (sk *fakeStakingKeeper) valAddr(i int) sdk.ValAddress {
+	return sdk.ValAddress([]byte{byte(i + 1)})
+}
+
+func (sk *fakeStakingKeeper) ValidatorAddressCodec() address.Codec {
+	return sk.codec
+}
+
+func (sk *fakeStakingKeeper) Validator(_ context.Context, addr sdk.ValAddress) (sdk.ValidatorI, error) {
+	for i, validator := range sk.validators {
+		if sk.valAddr(i).Equals(addr) {
+			return validator, nil
+		}
+	}
+	return nil, stakingtypes.ErrNoValidatorFound
+}
+
+func (sk *fakeStakingKeeper) IterateBondedValidatorsByPower(_ context.Context, fn func(index int64, validator sdk.ValidatorI) (stop bool)) error {
+	for i, validator := range sk.validators {
+		if fn(int64(i), validator) {
+			break
+		}
+	}
+	return nil
+}
+
+func TestSignalUpgradeReadiness(t *testing.T) {
+	s := setupTest(t, 10, map[int64]bool{})
+	sk := newFakeStakingKeeper(t, 600, 300, 100)
+
+	err := s.keeper.SignalUpgradeReadiness(s.ctx, sk.valAddr(0), "test")
+	require.ErrorIs(t, err, types.ErrReadinessUnsupported)
+
+	s.keeper.SetStakingKeeper(sk)
+
+	err = s.keeper.SignalUpgradeReadiness(s.ctx, sk.valAddr(0), "test")
+	require.ErrorIs(t, err, types.ErrNoUpgradePlanFound)
+
+	require.NoError(t, s.keeper.ScheduleUpgrade(s.ctx, types.Plan{Name: "test", Height: 20}))
+
+	err = s.keeper.SignalUpgradeReadiness(s.ctx, sk.valAddr(0), "other")
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	err = s.keeper.SignalUpgradeReadiness(s.ctx, sk.valAddr(5), "test")
+	require.ErrorIs(t, err, stakingtypes.ErrNoValidatorFound)
+
+	require.NoError(t, s.keeper.SignalUpgradeReadiness(s.ctx, sk.valAddr(0), "test"))
+	require.NoError(t, s.keeper.SignalUpgradeReadiness(s.ctx, sk.valAddr(2), "test"))
+
+	tally, err := s.keeper.GetReadinessTally(s.ctx, "test")
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(700), tally.ReadyPower)
+	require.Equal(t, math.NewInt(1000), tally.TotalPower)
+	require.Equal(t, "0.700000000000000000", tally.ReadyFraction)
+	require.Len(t, tally.Validators, 3)
+	require.False(t, tally.Validators[1].Ready)
+
+	// rescheduling the same plan keeps the signals
+	require.NoError(t, s.keeper.ScheduleUpgrade(s.ctx, types.Plan{Name: "test", Height: 30}))
+	ready, err := s.keeper.HasSignalledReadiness(s.ctx, sk.valAddr(0), "test")
+	require.NoError(t, err)
+	require.True(t, ready)
+
+	// clearing the plan clears the signals
+	require.NoError(t, s.keeper.ClearUpgradePlan(s.ctx))
+	ready, err = s.keeper.HasSignalledReadiness(s.ctx, sk.valAddr(0), "test")
+	require.NoError(t, err)
+	require.False(t, ready)
+}
+
+func TestConditionalUpgrade(t *testing.T) {
+	cases := map[string]struct {
+		condition      types.ReadinessCondition
+		ready          []int
+		expectHalt     bool
+		expectCleared  bool
+		expectedHeight int64
+		expectedDelays uint32
+	}{
+		"threshold met": {
+			condition:  types.ReadinessCondition{Threshold: "0.6", OnFailure: types.ReadinessFailureCancel},
+			ready:      []int{0},
+			expectHalt: true,
+		},
+		"threshold not met, plan cancelled": {
+			condition:     types.ReadinessCondition{Threshold: "0.67", OnFailure: types.ReadinessFailureCancel},
+			ready:         []int{0},
+			expectCleared: true,
+		},
+		"threshold not met, plan delayed": {
+			condition:      types.ReadinessCondition{Threshold: "0.67", OnFailure: types.ReadinessFailureDelay, DelayBlocks: 5, MaxDelays: 2},
+			ready:          []int{0},
+			expectedHeight: 16,
+			expectedDelays: 1,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := setupTest(t, 10, map[int64]bool{})
+			sk := newFakeStakingKeeper(t, 600, 300, 100)
+			s.keeper.SetStakingKeeper(sk)
+
+			condition := tc.condition
+			require.NoError(t, s.keeper.ScheduleUpgrade(s.ctx, types.Plan{Name: "test", Height: 11, ReadinessCondition: &condition}))
+			for _, i := range tc.ready {
+				require.NoError(t, s.keeper.SignalUpgradeReadiness(s.ctx, sk.valAddr(i), "test"))
+			}
+
+			newCtx := s.ctx.WithHeaderInfo(header.Info{Height: 11})
+			err := s.preModule.PreBlock(newCtx)
+			if tc.expectHalt {
+				require.ErrorContains(t, err, "UPGRADE \"test\" NEEDED at height: 11: ")
+
+				s.keeper.SetUpgradeHandler("test", func(ctx context.Context, plan types.Plan, vm appmodule.VersionMap) (appmodule.VersionMap, error) {
+					return vm, nil
+				})
+				require.NoError(t, s.preModule.PreBlock(newCtx))
+				s.VerifyDone(t, newCtx, "test")
+				s.VerifyCleared(t, newCtx)
+				return
+			}
+			require.NoError(t, err)
+
+			if tc.expectCleared {
+				s.VerifyCleared(t, newCtx)
+				s.VerifyNotDone(t, newCtx, "test")
+				return
+			}
+
+			plan, err := s.keeper.GetUpgradePlan(newCtx)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedHeight, plan.Height)
+			require.Equal(t, tc.expectedDelays, plan.ReadinessCondition.Delays)
+
+			// the signals are kept while the plan is delayed
+			ready, err := s.keeper.HasSignalledReadiness(newCtx, sk.valAddr(0), "test")
+			require.NoError(t, err)
+			require.True(t, ready)
+		})
+	}
+}
+
+func TestConditionalUpgradeMaxDelays(t *testing.T) {
+	s := setupTest(t, 10, map[int64]bool{})
+	sk := newFakeStakingKeeper(t, 600, 300, 100)
+	s.keeper.SetStakingKeeper(sk)
+
+	condition := types.ReadinessCondition{Threshold: "0.67", OnFailure: types.ReadinessFailureDelay, DelayBlocks: 5, MaxDelays: 1}
+	require.NoError(t, s.keeper.ScheduleUpgrade(s.ctx, types.Plan{Name: "test", Height: 11, ReadinessCondition: &condition}))
+	require.NoError(t, s.keeper.SignalUpgradeReadiness(s.ctx, sk.valAddr(0), "test"))
+
+	t.Log("Verify that the plan is delayed once")
+	newCtx := s.ctx.WithHeaderInfo(header.Info{Height: 11})
+	require.NoError(t, s.preModule.PreBlock(newCtx))
+	plan, err := s.keeper.GetUpgradePlan(newCtx)
+	require.NoError(t, err)
+	require.Equal(t, int64(16), plan.Height)
+
+	t.Log("Verify that a delayed plan cannot be scheduled")
+	err = s.keeper.ScheduleUpgrade(newCtx, plan)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	t.Log("Verify that the plan is cancelled once the maximum number of delays is reached")
+	newCtx = s.ctx.WithHeaderInfo(header.Info{Height: 16})
+	require.NoError(t, s.preModule.PreBlock(newCtx))
+	s.VerifyCleared(t, newCtx)
+	s.VerifyNotDone(t, newCtx, "test")
+
+	ready, err := s.keeper.HasSignalledReadiness(newCtx, sk.valAddr(0), "test")
+	require.NoError(t, err)
+	require.False(t, ready)
+}
+
+func TestConditionalUpgradeDelayKeepsIBCState(t *testing.T) {
+	s := setupTest(t, 10, map[int64]bool{})
+	sk := newFakeStakingKeeper(t, 600, 300, 100)
+	s.keeper.SetStakingKeeper(sk)
+
+	condition := types.ReadinessCondition{Threshold: "0.67", OnFailure: types.ReadinessFailureDelay, DelayBlocks: 5}
+	require.NoError(t, s.keeper.ScheduleUpgrade(s.ctx, types.Plan{Name: "test", Height: 11, ReadinessCondition: &condition}))
+	require.NoError(t, s.keeper.SetUpgradedClient(s.ctx, 11, []byte("client")))
+	require.NoError(t, s.keeper.SetUpgradedConsensusState(s.ctx, 11, []byte("consensus state")))
+
+	newCtx := s.ctx.WithHeaderInfo(header.Info{Height: 11})
+	require.NoError(t, s.preModule.PreBlock(newCtx))
+	plan, err := s.keeper.GetUpgradePlan(newCtx)
+	require.NoError(t, err)
+	require.Equal(t, int64(16), plan.Height)
+
+	t.Log("Verify that the IBC state is moved to the new height")
+	client, err := s.keeper.GetUpgradedClient(newCtx, 16)
+	require.NoError(t, err)
+	require.Equal(t, []byte("client"), client)
+	consState, err := s.keeper.GetUpgradedConsensusState(newCtx, 16)
+	require.NoError(t, err)
+	require.Equal(t, []byte("consensus state"), consState)
+
+	_, err = s.keeper.GetUpgradedClient(newCtx, 11)
+	require.ErrorIs(t, err, types.ErrNoUpgradedClientFound)
+	_, err = s.keeper.GetUpgradedConsensusState(newCtx, 11)
+	require.ErrorIs(t, err, types.ErrNoUpgradedConsensusStateFound)
+}
+
+func TestScheduleConditionalUpgradeWithoutStaking(t *testing.T) {
+	s := setupTest(t, 10, map[int64]bool{})
+	err := s.keeper.ScheduleUpgrade(s.ctx, types.Plan{
+		Name:               "test",
+		Height:             11,
+		ReadinessCondition: &types.ReadinessCondition{Threshold: "0.67", OnFailure: types.ReadinessFailureCancel},
+	})
+	require.ErrorIs(t, err, types.ErrReadinessUnsupported)
+}
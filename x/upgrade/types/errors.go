@@ -16,4 +16,6 @@ var (
 	ErrNoUpgradedConsensusStateFound = errors.Register(ModuleName, 5, "upgraded consensus state not found")
 	// ErrInvalidSigner error if the authority is not the signer for a proposal message
 	ErrInvalidSigner = errors.Register(ModuleName, 6, "expected authority account as only signer for proposal message")
+	// ErrReadinessUnsupported error if the readiness of the validators cannot be tracked
+	ErrReadinessUnsupported = errors.Register(ModuleName, 7, "upgrade readiness not supported")
 )
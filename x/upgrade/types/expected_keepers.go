@@ -0,0 +1,17 @@
+package types
+
+import (
+	"context"
+
+	"cosmossdk.io/core/address"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// StakingKeeper defines the expected staking keeper, used to track the readiness of the validators
+// for conditional upgrade plans.
+type StakingKeeper interface {
+	ValidatorAddressCodec() address.Codec
+	Validator(ctx context.Context, addr sdk.ValAddress) (sdk.ValidatorI, error)
+	IterateBondedValidatorsByPower(ctx context.Context, fn func(index int64, validator sdk.ValidatorI) (stop bool)) error
+}
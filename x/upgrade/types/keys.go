@@ -1,6 +1,10 @@
 package types
 
-import "fmt"
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
 
 const (
 	// ModuleName is the name of this module
@@ -28,6 +32,9 @@ const (
 	// VersionMapByte is a prefix to look up module names (key) and versions (value)
 	VersionMapByte = 0x2
 
+	// ReadinessByte is a prefix to look up the validators that signalled their readiness for an upgrade plan
+	ReadinessByte = 0x4
+
 	// KeyUpgradedIBCState is the key under which upgraded ibc state is stored in the upgrade store
 	KeyUpgradedIBCState = "upgradedIBCState"
 
@@ -44,6 +51,16 @@ func PlanKey() []byte {
 	return []byte{PlanByte}
 }
 
+// ReadinessPrefix is the prefix under which the readiness signals of the validators for an upgrade plan are saved
+func ReadinessPrefix(name string) []byte {
+	return append([]byte{ReadinessByte}, address.MustLengthPrefix([]byte(name))...)
+}
+
+// ReadinessKey is the key under which the readiness signal of a validator for an upgrade plan is saved
+func ReadinessKey(name string, valAddr []byte) []byte {
+	return append(ReadinessPrefix(name), valAddr...)
+}
+
 // UpgradedClientKey is the key under which the upgraded client state is saved
 // Connecting IBC chains can verify against the upgraded client in this path before
 // upgrading their clients
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -25,6 +26,39 @@ func (p Plan) ValidateBasic() error {
 	if p.Height <= 0 {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "height must be greater than 0")
 	}
+	if p.ReadinessCondition != nil {
+		if err := p.ReadinessCondition.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// IsConditional returns true if the Plan is gated on the readiness of the validators
+func (p Plan) IsConditional() bool {
+	return p.ReadinessCondition != nil
+}
+
+// ValidateBasic does basic validation of a ReadinessCondition
+func (c ReadinessCondition) ValidateBasic() error {
+	threshold, err := math.LegacyNewDecFromStr(c.Threshold)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid readiness threshold: %s", err)
+	}
+	if !threshold.IsPositive() || threshold.GT(math.LegacyOneDec()) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "readiness threshold must be positive and at most 1, got %s", c.Threshold)
+	}
+
+	switch c.OnFailure {
+	case ReadinessFailureDelay:
+		if c.DelayBlocks <= 0 {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "delay blocks must be greater than 0")
+		}
+	case ReadinessFailureCancel:
+	default:
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid readiness failure action %s", c.OnFailure)
+	}
 
 	return nil
 }
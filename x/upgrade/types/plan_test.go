@@ -90,6 +90,47 @@ func TestPlanValid(t *testing.T) {
 				Height: -12345,
 			},
 		},
+		"conditional upgrade": {
+			p: types.Plan{
+				Name:   "conditional",
+				Height: 123450000,
+				ReadinessCondition: &types.ReadinessCondition{
+					Threshold:   "0.67",
+					OnFailure:   types.ReadinessFailureDelay,
+					DelayBlocks: 100,
+				},
+			},
+			valid: true,
+		},
+		"conditional upgrade with invalid threshold": {
+			p: types.Plan{
+				Name:   "conditional",
+				Height: 123450000,
+				ReadinessCondition: &types.ReadinessCondition{
+					Threshold: "1.5",
+					OnFailure: types.ReadinessFailureCancel,
+				},
+			},
+		},
+		"conditional upgrade without failure action": {
+			p: types.Plan{
+				Name:   "conditional",
+				Height: 123450000,
+				ReadinessCondition: &types.ReadinessCondition{
+					Threshold: "0.67",
+				},
+			},
+		},
+		"conditional upgrade without delay": {
+			p: types.Plan{
+				Name:   "conditional",
+				Height: 123450000,
+				ReadinessCondition: &types.ReadinessCondition{
+					Threshold: "0.67",
+					OnFailure: types.ReadinessFailureDelay,
+				},
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -5,8 +5,10 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -484,6 +486,111 @@ func (m *QueryAuthorityResponse) GetAddress() string {
 	return ""
 }
 
+// QueryReadinessTallyRequest is the request type for the Query/ReadinessTally RPC method.
+type QueryReadinessTallyRequest struct {
+}
+
+func (m *QueryReadinessTallyRequest) Reset()         { *m = QueryReadinessTallyRequest{} }
+func (m *QueryReadinessTallyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryReadinessTallyRequest) ProtoMessage()    {}
+func (*QueryReadinessTallyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a334d07ad8374f0, []int{10}
+}
+func (m *QueryReadinessTallyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReadinessTallyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReadinessTallyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryReadinessTallyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReadinessTallyRequest.Merge(m, src)
+}
+func (m *QueryReadinessTallyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReadinessTallyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReadinessTallyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryReadinessTallyRequest proto.InternalMessageInfo
+
+// QueryReadinessTallyResponse is the response type for the Query/ReadinessTally RPC method.
+type QueryReadinessTallyResponse struct {
+	// name is the name of the current upgrade plan.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// ready_power is the bonded voting power of the validators that signalled their readiness.
+	ReadyPower cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=ready_power,json=readyPower,proto3,customtype=cosmossdk.io/math.Int" json:"ready_power"`
+	// total_power is the total bonded voting power.
+	TotalPower cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=total_power,json=totalPower,proto3,customtype=cosmossdk.io/math.Int" json:"total_power"`
+	// ready_fraction is the fraction of the bonded voting power that signalled its readiness.
+	ReadyFraction string `protobuf:"bytes,4,opt,name=ready_fraction,json=readyFraction,proto3" json:"ready_fraction,omitempty"`
+	// validators is the readiness of each bonded validator.
+	Validators []*ValidatorReadiness `protobuf:"bytes,5,rep,name=validators,proto3" json:"validators,omitempty"`
+}
+
+func (m *QueryReadinessTallyResponse) Reset()         { *m = QueryReadinessTallyResponse{} }
+func (m *QueryReadinessTallyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryReadinessTallyResponse) ProtoMessage()    {}
+func (*QueryReadinessTallyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a334d07ad8374f0, []int{11}
+}
+func (m *QueryReadinessTallyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReadinessTallyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReadinessTallyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryReadinessTallyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReadinessTallyResponse.Merge(m, src)
+}
+func (m *QueryReadinessTallyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReadinessTallyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReadinessTallyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryReadinessTallyResponse proto.InternalMessageInfo
+
+func (m *QueryReadinessTallyResponse) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *QueryReadinessTallyResponse) GetReadyFraction() string {
+	if m != nil {
+		return m.ReadyFraction
+	}
+	return ""
+}
+
+func (m *QueryReadinessTallyResponse) GetValidators() []*ValidatorReadiness {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryCurrentPlanRequest)(nil), "cosmos.upgrade.v1beta1.QueryCurrentPlanRequest")
 	proto.RegisterType((*QueryCurrentPlanResponse)(nil), "cosmos.upgrade.v1beta1.QueryCurrentPlanResponse")
@@ -495,6 +602,8 @@ func init() {
 	proto.RegisterType((*QueryModuleVersionsResponse)(nil), "cosmos.upgrade.v1beta1.QueryModuleVersionsResponse")
 	proto.RegisterType((*QueryAuthorityRequest)(nil), "cosmos.upgrade.v1beta1.QueryAuthorityRequest")
 	proto.RegisterType((*QueryAuthorityResponse)(nil), "cosmos.upgrade.v1beta1.QueryAuthorityResponse")
+	proto.RegisterType((*QueryReadinessTallyRequest)(nil), "cosmos.upgrade.v1beta1.QueryReadinessTallyRequest")
+	proto.RegisterType((*QueryReadinessTallyResponse)(nil), "cosmos.upgrade.v1beta1.QueryReadinessTallyResponse")
 }
 
 func init() {
@@ -502,50 +611,63 @@ func init() {
 }
 
 var fileDescriptor_4a334d07ad8374f0 = []byte{
-	// 685 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xcf, 0x4f, 0x13, 0x4f,
-	0x1c, 0x65, 0x0a, 0x5f, 0xf8, 0xf2, 0xa9, 0x01, 0x33, 0xc4, 0xba, 0xac, 0xa4, 0xd6, 0x01, 0x15,
-	0x23, 0xdd, 0x85, 0xd6, 0x70, 0x40, 0x63, 0x14, 0x0e, 0x8a, 0x11, 0xa2, 0x35, 0x7a, 0xf0, 0xd2,
-	0x0c, 0xec, 0xa4, 0x34, 0xb4, 0xbb, 0xcb, 0xce, 0x2c, 0x91, 0x10, 0x3c, 0x70, 0xf2, 0x68, 0xe2,
-	0xcd, 0x83, 0x37, 0x13, 0xff, 0x00, 0xaf, 0xde, 0x8d, 0x27, 0xa2, 0x17, 0x63, 0x3c, 0x18, 0xf0,
-	0x0f, 0x31, 0x3b, 0x33, 0x25, 0xfd, 0xb1, 0xbb, 0xa0, 0xb7, 0xce, 0xce, 0x7b, 0xef, 0xf3, 0xde,
-	0xce, 0xbc, 0x2d, 0x90, 0x75, 0x8f, 0x37, 0x3d, 0x6e, 0x87, 0x7e, 0x2d, 0xa0, 0x0e, 0xb3, 0xb7,
-	0xe7, 0xd6, 0x98, 0xa0, 0x73, 0xf6, 0x56, 0xc8, 0x82, 0x1d, 0xcb, 0x0f, 0x3c, 0xe1, 0xe1, 0x9c,
-	0xc2, 0x58, 0x1a, 0x63, 0x69, 0x8c, 0x39, 0x51, 0xf3, 0xbc, 0x5a, 0x83, 0xd9, 0xd4, 0xaf, 0xdb,
-	0xd4, 0x75, 0x3d, 0x41, 0x45, 0xdd, 0x73, 0xb9, 0x62, 0x99, 0x53, 0x09, 0xca, 0x2d, 0x15, 0x85,
-	0x1a, 0x57, 0xa8, 0xaa, 0x5c, 0xd9, 0x7a, 0x90, 0x5c, 0x90, 0x71, 0x38, 0xff, 0x38, 0x72, 0xb1,
-	0x14, 0x06, 0x01, 0x73, 0xc5, 0xa3, 0x06, 0x75, 0x2b, 0x6c, 0x2b, 0x64, 0x5c, 0x90, 0x87, 0x60,
-	0xf4, 0x6e, 0x71, 0xdf, 0x73, 0x39, 0xc3, 0xb3, 0x30, 0xe0, 0x37, 0xa8, 0x6b, 0xa0, 0x02, 0x9a,
-	0xce, 0x96, 0x26, 0xac, 0x78, 0xf3, 0x96, 0xe4, 0x48, 0x24, 0x29, 0xea, 0x41, 0x77, 0x7d, 0xbf,
-	0x51, 0x67, 0x4e, 0xdb, 0x20, 0x8c, 0x61, 0xc0, 0xa5, 0x4d, 0x26, 0xc5, 0x86, 0x2b, 0xf2, 0x37,
-	0x29, 0xe9, 0xe1, 0x1d, 0x70, 0x3d, 0x3c, 0x07, 0x83, 0x1b, 0xac, 0x5e, 0xdb, 0x10, 0x92, 0xd1,
-	0x5f, 0xd1, 0x2b, 0xb2, 0x0c, 0x44, 0x72, 0x9e, 0x2a, 0x17, 0xce, 0x52, 0x84, 0x76, 0x79, 0xc8,
-	0x9f, 0x08, 0x2a, 0x58, 0x6b, 0xda, 0x45, 0xc8, 0x36, 0x28, 0x17, 0xd5, 0x0e, 0x09, 0x88, 0x1e,
-	0xdd, 0x97, 0x4f, 0x16, 0x32, 0x06, 0x22, 0x2f, 0x61, 0x32, 0x55, 0x4a, 0x3b, 0x59, 0x01, 0x43,
-	0x47, 0x76, 0xaa, 0xeb, 0x2d, 0x48, 0x95, 0x47, 0x18, 0x23, 0x53, 0x40, 0xd3, 0x67, 0x16, 0xc7,
-	0x7e, 0x7c, 0x2c, 0x8e, 0xaa, 0xb7, 0x53, 0xe4, 0xce, 0x66, 0x61, 0xd6, 0xba, 0x51, 0xae, 0xe4,
-	0xc2, 0x58, 0xd9, 0x68, 0xf2, 0x83, 0x81, 0xff, 0xd1, 0xd9, 0x0c, 0xa9, 0x80, 0x29, 0xe7, 0xaf,
-	0x78, 0x4e, 0xd8, 0x60, 0xcf, 0x58, 0xc0, 0xa3, 0x43, 0x6f, 0x8b, 0xd0, 0x94, 0x1b, 0xd5, 0xb6,
-	0xf7, 0x06, 0xea, 0xd1, 0x2a, 0x6d, 0xb2, 0x85, 0xb1, 0xaf, 0xbd, 0x53, 0xc9, 0x3e, 0x82, 0x0b,
-	0xb1, 0xa2, 0x3a, 0xcc, 0x2a, 0x8c, 0x6a, 0xd5, 0x6d, 0xbd, 0x65, 0xa0, 0x42, 0xff, 0x74, 0xb6,
-	0x74, 0x39, 0xe9, 0x78, 0x3b, 0x84, 0x2a, 0x23, 0xcd, 0x0e, 0xdd, 0x78, 0x13, 0x33, 0x70, 0x4e,
-	0x9d, 0x6b, 0x28, 0x36, 0xbc, 0xa0, 0x2e, 0x76, 0x74, 0xa6, 0x38, 0xf4, 0x3c, 0xb9, 0x07, 0xb9,
-	0x6e, 0xb4, 0x36, 0x6b, 0xc0, 0x10, 0x75, 0x9c, 0x80, 0x71, 0xae, 0xe3, 0xb7, 0x96, 0xb1, 0x42,
-	0xa5, 0xb7, 0x43, 0xf0, 0x9f, 0x54, 0xc2, 0xef, 0x10, 0x64, 0xdb, 0x6e, 0x34, 0xb6, 0x93, 0xc2,
-	0x25, 0xd4, 0xc2, 0x9c, 0x3d, 0x3d, 0x41, 0x79, 0x25, 0x33, 0xfb, 0xdf, 0x7e, 0xbf, 0xc9, 0x5c,
-	0xc1, 0x53, 0x76, 0x42, 0x5b, 0xd7, 0x15, 0xa9, 0x1a, 0x15, 0x05, 0xbf, 0x47, 0x90, 0x6d, 0xbb,
-	0xf5, 0x27, 0x18, 0xec, 0xad, 0xd3, 0x09, 0x06, 0x63, 0x0a, 0x45, 0xca, 0xd2, 0x60, 0x11, 0x5f,
-	0x4f, 0x32, 0x48, 0x15, 0x49, 0x1a, 0xb4, 0x77, 0xa3, 0x4b, 0xb7, 0x87, 0x7f, 0x22, 0xc8, 0xc5,
-	0xd7, 0x03, 0x2f, 0xa4, 0x3a, 0x48, 0xad, 0xa7, 0x79, 0xf3, 0x9f, 0xb8, 0x3a, 0xc8, 0xb2, 0x0c,
-	0x72, 0x07, 0xdf, 0xb6, 0xd3, 0xbf, 0x8b, 0x3d, 0x6d, 0xb5, 0x77, 0xdb, 0xbe, 0x09, 0x7b, 0xaf,
-	0x32, 0x08, 0x7f, 0x42, 0x30, 0xd2, 0x59, 0x14, 0x5c, 0x4a, 0xb5, 0x16, 0x5b, 0x55, 0xb3, 0xfc,
-	0x57, 0x1c, 0x1d, 0x63, 0xf1, 0x4b, 0x6f, 0x73, 0x64, 0xb2, 0x6b, 0xf8, 0x6a, 0x52, 0xb2, 0xae,
-	0xea, 0xe2, 0x0f, 0x08, 0x86, 0x8f, 0x6b, 0x83, 0x8b, 0xe9, 0x77, 0xa2, 0xab, 0x8c, 0xa6, 0x75,
-	0x5a, 0xb8, 0x36, 0x7c, 0xab, 0xd7, 0xf0, 0xbc, 0x34, 0x3c, 0x89, 0x2f, 0x25, 0xde, 0xa9, 0x96,
-	0xca, 0xe2, 0xfc, 0xe7, 0xc3, 0x3c, 0x3a, 0x38, 0xcc, 0xa3, 0x5f, 0x87, 0x79, 0xf4, 0xfa, 0x28,
-	0xdf, 0x77, 0x70, 0x94, 0xef, 0xfb, 0x7e, 0x94, 0xef, 0x7b, 0x3e, 0xa1, 0xb8, 0xdc, 0xd9, 0xb4,
-	0xea, 0x9e, 0xfd, 0xe2, 0x58, 0x43, 0xec, 0xf8, 0x8c, 0xaf, 0x0d, 0xca, 0xbf, 0xb0, 0xf2, 0x9f,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x04, 0x0d, 0x4f, 0xe3, 0x5f, 0x07, 0x00, 0x00,
+	// 886 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x41, 0x6f, 0xdc, 0x44,
+	0x14, 0xce, 0x6c, 0xd2, 0xd2, 0xbc, 0x85, 0x6d, 0x35, 0x6d, 0x17, 0xd7, 0x8d, 0x36, 0x8b, 0x5b,
+	0x20, 0xd0, 0xae, 0xbd, 0xdd, 0x85, 0x1c, 0x02, 0x42, 0x90, 0x20, 0x20, 0x55, 0x5b, 0x15, 0x03,
+	0x3d, 0x70, 0xb1, 0xa6, 0xeb, 0x61, 0x63, 0xd5, 0xeb, 0x71, 0x3d, 0xe3, 0xc0, 0xaa, 0x2a, 0x87,
+	0x9e, 0x38, 0x22, 0x71, 0xe7, 0x86, 0xc4, 0x0f, 0xc8, 0x15, 0xce, 0x55, 0x4f, 0x55, 0xb8, 0xa0,
+	0x82, 0x2a, 0x94, 0x20, 0x7e, 0x07, 0xf2, 0xcc, 0xec, 0xca, 0x9b, 0xb5, 0x37, 0x69, 0x6e, 0xf6,
+	0xcc, 0xf7, 0x7d, 0xef, 0x7b, 0xcf, 0x2f, 0x5f, 0x16, 0xac, 0x1e, 0xe3, 0x03, 0xc6, 0x9d, 0x34,
+	0xee, 0x27, 0xc4, 0xa7, 0xce, 0xf6, 0xb5, 0xbb, 0x54, 0x90, 0x6b, 0xce, 0xfd, 0x94, 0x26, 0x43,
+	0x3b, 0x4e, 0x98, 0x60, 0xb8, 0xae, 0x30, 0xb6, 0xc6, 0xd8, 0x1a, 0x63, 0x2e, 0xf5, 0x19, 0xeb,
+	0x87, 0xd4, 0x21, 0x71, 0xe0, 0x90, 0x28, 0x62, 0x82, 0x88, 0x80, 0x45, 0x5c, 0xb1, 0xcc, 0xcb,
+	0x25, 0xca, 0x23, 0x15, 0x85, 0xba, 0xa0, 0x50, 0x9e, 0x7c, 0x73, 0x74, 0x21, 0x75, 0x75, 0xae,
+	0xcf, 0xfa, 0x4c, 0x9d, 0x67, 0x4f, 0xea, 0xd4, 0xba, 0x00, 0xaf, 0x7e, 0x9e, 0x79, 0xdb, 0x48,
+	0x93, 0x84, 0x46, 0xe2, 0x76, 0x48, 0x22, 0x97, 0xde, 0x4f, 0x29, 0x17, 0xd6, 0x0d, 0x30, 0xa6,
+	0xaf, 0x78, 0xcc, 0x22, 0x4e, 0x71, 0x1b, 0x16, 0xe2, 0x90, 0x44, 0x06, 0x6a, 0xa2, 0x95, 0x6a,
+	0x67, 0xc9, 0x2e, 0x6e, 0xc9, 0x96, 0x1c, 0x89, 0xb4, 0x5a, 0xba, 0xd0, 0x47, 0x71, 0x1c, 0x06,
+	0xd4, 0xcf, 0x15, 0xc2, 0x18, 0x16, 0x22, 0x32, 0xa0, 0x52, 0x6c, 0xd1, 0x95, 0xcf, 0x56, 0x07,
+	0x8c, 0x69, 0xb8, 0x2e, 0x5e, 0x87, 0x93, 0x5b, 0x34, 0xe8, 0x6f, 0x09, 0xc9, 0x98, 0x77, 0xf5,
+	0x9b, 0xb5, 0x09, 0x96, 0xe4, 0x7c, 0xa5, 0x5c, 0xf8, 0x1b, 0x19, 0x3a, 0xe2, 0x29, 0xff, 0x42,
+	0x10, 0x41, 0x47, 0xd5, 0x96, 0xa1, 0x1a, 0x12, 0x2e, 0xbc, 0x09, 0x09, 0xc8, 0x8e, 0x3e, 0x93,
+	0x27, 0x6b, 0x15, 0x03, 0x59, 0xdf, 0xc3, 0xa5, 0x99, 0x52, 0xda, 0xc9, 0x4d, 0x30, 0x74, 0xcb,
+	0xbe, 0xd7, 0x1b, 0x41, 0x3c, 0x9e, 0x61, 0x8c, 0x4a, 0x13, 0xad, 0xbc, 0xbc, 0x7e, 0xf6, 0xd9,
+	0x4e, 0xeb, 0xb4, 0x9a, 0x4e, 0x8b, 0xfb, 0xf7, 0x9a, 0x6d, 0xfb, 0x9d, 0xae, 0x5b, 0x4f, 0x0b,
+	0x65, 0xb3, 0xca, 0xd7, 0x17, 0x4e, 0xa1, 0x33, 0x15, 0xcb, 0x05, 0x53, 0xd6, 0xbf, 0xc9, 0xfc,
+	0x34, 0xa4, 0x77, 0x68, 0xc2, 0xb3, 0x55, 0xc8, 0xb5, 0x30, 0x90, 0x17, 0x5e, 0x6e, 0x6e, 0xa0,
+	0x8e, 0x6e, 0x91, 0x01, 0x5d, 0x3b, 0xbb, 0x3b, 0x5d, 0xd5, 0x7a, 0x84, 0xe0, 0x62, 0xa1, 0xa8,
+	0x6e, 0xe6, 0x16, 0x9c, 0xd6, 0xaa, 0xdb, 0xfa, 0xca, 0x40, 0xcd, 0xf9, 0x95, 0x6a, 0xe7, 0xf5,
+	0xb2, 0xcf, 0x3b, 0x21, 0xe4, 0xd6, 0x06, 0x13, 0xba, 0xc5, 0x26, 0xae, 0xc2, 0x79, 0xf5, 0x5d,
+	0x53, 0xb1, 0xc5, 0x92, 0x40, 0x0c, 0x75, 0x4f, 0x45, 0xe8, 0x55, 0xeb, 0x53, 0xa8, 0x1f, 0x44,
+	0x6b, 0xb3, 0x06, 0xbc, 0x44, 0x7c, 0x3f, 0xa1, 0x9c, 0xeb, 0xf6, 0x47, 0xaf, 0xc5, 0x42, 0x1d,
+	0x3d, 0x4f, 0x97, 0x12, 0x3f, 0x88, 0x28, 0xe7, 0x5f, 0x92, 0x30, 0x1c, 0xd7, 0x3e, 0xb7, 0xbb,
+	0xd3, 0x3a, 0xf3, 0xdd, 0xe8, 0x4f, 0xa9, 0xb9, 0xdd, 0xb6, 0x3b, 0x76, 0xdb, 0xfa, 0xaf, 0x02,
+	0x17, 0x0b, 0x49, 0xda, 0x42, 0xc1, 0xda, 0xe2, 0x1b, 0x50, 0x4d, 0x28, 0xf1, 0x87, 0x5e, 0xcc,
+	0xbe, 0xa5, 0x89, 0xdc, 0x81, 0xc5, 0xf5, 0x2b, 0x8f, 0x9f, 0x2f, 0xcf, 0x3d, 0x7b, 0xbe, 0x7c,
+	0x5e, 0xb9, 0xe2, 0xfe, 0x3d, 0x3b, 0x60, 0xce, 0x80, 0x88, 0x2d, 0x7b, 0x33, 0x12, 0xbb, 0x3b,
+	0x2d, 0xd0, 0xf3, 0xdd, 0x8c, 0x84, 0x0b, 0x92, 0x7f, 0x3b, 0xa3, 0x67, 0x6a, 0x82, 0x09, 0x12,
+	0x6a, 0xb5, 0xf9, 0x63, 0xa8, 0x49, 0xbe, 0x52, 0x7b, 0x17, 0x6a, 0xca, 0xdb, 0x37, 0x09, 0xe9,
+	0x65, 0xd1, 0x62, 0x2c, 0x48, 0xc1, 0x5a, 0x8e, 0xf3, 0x31, 0xed, 0xb9, 0xaf, 0x48, 0xd4, 0x27,
+	0x1a, 0x84, 0xaf, 0x03, 0x6c, 0x93, 0x30, 0xf0, 0x89, 0x60, 0x09, 0x37, 0x4e, 0xc8, 0x8d, 0x78,
+	0xbb, 0x6c, 0x23, 0xee, 0x8c, 0x90, 0xe3, 0x99, 0xb9, 0x39, 0x76, 0xf1, 0xa0, 0x3b, 0x7f, 0x9d,
+	0x82, 0x13, 0x72, 0xd0, 0xf8, 0x67, 0x04, 0xd5, 0x5c, 0xdc, 0x60, 0xa7, 0xac, 0x4e, 0x49, 0x66,
+	0x99, 0xed, 0xa3, 0x13, 0xd4, 0x57, 0xb4, 0xae, 0x3e, 0xfa, 0xe3, 0xdf, 0x9f, 0x2a, 0x6f, 0xe0,
+	0xcb, 0x4e, 0x49, 0xc0, 0xf6, 0x14, 0xc9, 0xcb, 0x52, 0x0c, 0xff, 0x82, 0xa0, 0x9a, 0x8b, 0xa4,
+	0x43, 0x0c, 0x4e, 0x67, 0x9d, 0xd9, 0x3e, 0x3a, 0x41, 0x1b, 0xec, 0x4a, 0x83, 0x2d, 0x7c, 0xa5,
+	0xcc, 0x20, 0x51, 0x24, 0x69, 0xd0, 0x79, 0x90, 0xad, 0xe1, 0x43, 0xfc, 0x37, 0x82, 0x7a, 0x71,
+	0x76, 0xe1, 0xb5, 0x99, 0x0e, 0x66, 0x66, 0xa7, 0xf9, 0xde, 0xb1, 0xb8, 0xba, 0x91, 0x4d, 0xd9,
+	0xc8, 0x87, 0xf8, 0x03, 0x67, 0xf6, 0xbf, 0xb2, 0xa9, 0x28, 0x75, 0x1e, 0xe4, 0x02, 0xfb, 0xe1,
+	0x0f, 0x15, 0x84, 0x7f, 0x43, 0x50, 0x9b, 0x4c, 0x31, 0xdc, 0x99, 0x69, 0xad, 0x30, 0x47, 0xcd,
+	0xee, 0x0b, 0x71, 0x74, 0x1b, 0xeb, 0x4f, 0xa6, 0x63, 0x4d, 0x76, 0xf6, 0x16, 0x7e, 0xb3, 0xac,
+	0xb3, 0x03, 0xb9, 0x8a, 0x7f, 0x45, 0xb0, 0x38, 0xce, 0x34, 0xdc, 0x9a, 0xbd, 0x13, 0x07, 0x92,
+	0xd2, 0xb4, 0x8f, 0x0a, 0xd7, 0x86, 0xdf, 0x9f, 0x36, 0xbc, 0x2a, 0x0d, 0x5f, 0xc2, 0xaf, 0x95,
+	0xee, 0xd4, 0xd8, 0xdc, 0xef, 0x08, 0x6a, 0x93, 0x01, 0x78, 0xc8, 0xa8, 0x0b, 0x23, 0xd6, 0xec,
+	0xbe, 0x10, 0x47, 0x3b, 0xdf, 0x78, 0x52, 0x10, 0x17, 0x87, 0xcd, 0x3a, 0x19, 0x69, 0x79, 0x22,
+	0x13, 0x5b, 0x5f, 0x7d, 0xbc, 0xd7, 0x40, 0x4f, 0xf7, 0x1a, 0xe8, 0x9f, 0xbd, 0x06, 0xfa, 0x71,
+	0xbf, 0x31, 0xf7, 0x74, 0xbf, 0x31, 0xf7, 0xe7, 0x7e, 0x63, 0xee, 0xeb, 0xa5, 0x89, 0x04, 0x1d,
+	0xd7, 0x71, 0xc4, 0x30, 0xa6, 0xfc, 0xee, 0x49, 0xf9, 0x03, 0xa9, 0xfb, 0xff, 0x00, 0xab, 0xec,
+	0xf6, 0x39, 0xd3, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -575,6 +697,8 @@ type QueryClient interface {
 	ModuleVersions(ctx context.Context, in *QueryModuleVersionsRequest, opts ...grpc.CallOption) (*QueryModuleVersionsResponse, error)
 	// Returns the account with authority to conduct upgrades
 	Authority(ctx context.Context, in *QueryAuthorityRequest, opts ...grpc.CallOption) (*QueryAuthorityResponse, error)
+	// ReadinessTally queries the readiness signalled by the bonded validators for the current upgrade plan.
+	ReadinessTally(ctx context.Context, in *QueryReadinessTallyRequest, opts ...grpc.CallOption) (*QueryReadinessTallyResponse, error)
 }
 
 type queryClient struct {
@@ -631,6 +755,15 @@ func (c *queryClient) Authority(ctx context.Context, in *QueryAuthorityRequest,
 	return out, nil
 }
 
+func (c *queryClient) ReadinessTally(ctx context.Context, in *QueryReadinessTallyRequest, opts ...grpc.CallOption) (*QueryReadinessTallyResponse, error) {
+	out := new(QueryReadinessTallyResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.upgrade.v1beta1.Query/ReadinessTally", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// CurrentPlan queries the current upgrade plan.
@@ -648,6 +781,8 @@ type QueryServer interface {
 	ModuleVersions(context.Context, *QueryModuleVersionsRequest) (*QueryModuleVersionsResponse, error)
 	// Returns the account with authority to conduct upgrades
 	Authority(context.Context, *QueryAuthorityRequest) (*QueryAuthorityResponse, error)
+	// ReadinessTally queries the readiness signalled by the bonded validators for the current upgrade plan.
+	ReadinessTally(context.Context, *QueryReadinessTallyRequest) (*QueryReadinessTallyResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -669,6 +804,9 @@ func (*UnimplementedQueryServer) ModuleVersions(ctx context.Context, req *QueryM
 func (*UnimplementedQueryServer) Authority(ctx context.Context, req *QueryAuthorityRequest) (*QueryAuthorityResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Authority not implemented")
 }
+func (*UnimplementedQueryServer) ReadinessTally(ctx context.Context, req *QueryReadinessTallyRequest) (*QueryReadinessTallyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadinessTally not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -764,6 +902,24 @@ func _Query_Authority_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ReadinessTally_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReadinessTallyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ReadinessTally(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.upgrade.v1beta1.Query/ReadinessTally",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ReadinessTally(ctx, req.(*QueryReadinessTallyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.upgrade.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -788,6 +944,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Authority",
 			Handler:    _Query_Authority_Handler,
 		},
+		{
+			MethodName: "ReadinessTally",
+			Handler:    _Query_ReadinessTally_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/upgrade/v1beta1/query.proto",
@@ -1087,6 +1247,100 @@ func (m *QueryAuthorityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryReadinessTallyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReadinessTallyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReadinessTallyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReadinessTallyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReadinessTallyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReadinessTallyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ReadyFraction) > 0 {
+		i -= len(m.ReadyFraction)
+		copy(dAtA[i:], m.ReadyFraction)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ReadyFraction)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.TotalPower.Size()
+		i -= size
+		if _, err := m.TotalPower.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.ReadyPower.Size()
+		i -= size
+		if _, err := m.ReadyPower.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1220,6 +1474,42 @@ func (m *QueryAuthorityResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryReadinessTallyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryReadinessTallyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.ReadyPower.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TotalPower.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.ReadyFraction)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1964,6 +2254,272 @@ func (m *QueryAuthorityResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryReadinessTallyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReadinessTallyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReadinessTallyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReadinessTallyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReadinessTallyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReadinessTallyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadyPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReadyPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadyFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReadyFraction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, &ValidatorReadiness{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -206,44 +206,146 @@ func (m *MsgCancelUpgradeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCancelUpgradeResponse proto.InternalMessageInfo
 
+// MsgSignalUpgradeReadiness is the Msg/SignalUpgradeReadiness request type.
+type MsgSignalUpgradeReadiness struct {
+	// validator_address is the operator address of the validator signalling its readiness.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// name is the name of the scheduled upgrade plan.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *MsgSignalUpgradeReadiness) Reset()         { *m = MsgSignalUpgradeReadiness{} }
+func (m *MsgSignalUpgradeReadiness) String() string { return proto.CompactTextString(m) }
+func (*MsgSignalUpgradeReadiness) ProtoMessage()    {}
+func (*MsgSignalUpgradeReadiness) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2852c16e3ab79fef, []int{4}
+}
+func (m *MsgSignalUpgradeReadiness) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSignalUpgradeReadiness) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSignalUpgradeReadiness.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSignalUpgradeReadiness) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSignalUpgradeReadiness.Merge(m, src)
+}
+func (m *MsgSignalUpgradeReadiness) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSignalUpgradeReadiness) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSignalUpgradeReadiness.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSignalUpgradeReadiness proto.InternalMessageInfo
+
+func (m *MsgSignalUpgradeReadiness) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *MsgSignalUpgradeReadiness) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+// MsgSignalUpgradeReadinessResponse is the Msg/SignalUpgradeReadiness response type.
+type MsgSignalUpgradeReadinessResponse struct {
+}
+
+func (m *MsgSignalUpgradeReadinessResponse) Reset()         { *m = MsgSignalUpgradeReadinessResponse{} }
+func (m *MsgSignalUpgradeReadinessResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSignalUpgradeReadinessResponse) ProtoMessage()    {}
+func (*MsgSignalUpgradeReadinessResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2852c16e3ab79fef, []int{5}
+}
+func (m *MsgSignalUpgradeReadinessResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSignalUpgradeReadinessResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSignalUpgradeReadinessResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSignalUpgradeReadinessResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSignalUpgradeReadinessResponse.Merge(m, src)
+}
+func (m *MsgSignalUpgradeReadinessResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSignalUpgradeReadinessResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSignalUpgradeReadinessResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSignalUpgradeReadinessResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSoftwareUpgrade)(nil), "cosmos.upgrade.v1beta1.MsgSoftwareUpgrade")
 	proto.RegisterType((*MsgSoftwareUpgradeResponse)(nil), "cosmos.upgrade.v1beta1.MsgSoftwareUpgradeResponse")
 	proto.RegisterType((*MsgCancelUpgrade)(nil), "cosmos.upgrade.v1beta1.MsgCancelUpgrade")
 	proto.RegisterType((*MsgCancelUpgradeResponse)(nil), "cosmos.upgrade.v1beta1.MsgCancelUpgradeResponse")
+	proto.RegisterType((*MsgSignalUpgradeReadiness)(nil), "cosmos.upgrade.v1beta1.MsgSignalUpgradeReadiness")
+	proto.RegisterType((*MsgSignalUpgradeReadinessResponse)(nil), "cosmos.upgrade.v1beta1.MsgSignalUpgradeReadinessResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/upgrade/v1beta1/tx.proto", fileDescriptor_2852c16e3ab79fef) }
 
 var fileDescriptor_2852c16e3ab79fef = []byte{
-	// 423 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x2f, 0x2d, 0x48, 0x2f, 0x4a, 0x4c, 0x49, 0xd5, 0x2f, 0x33, 0x4c, 0x4a, 0x2d,
-	0x49, 0x34, 0xd4, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x83, 0x28, 0xd0,
-	0x83, 0x2a, 0xd0, 0x83, 0x2a, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x2b, 0xd1, 0x07, 0xb1,
-	0x20, 0xaa, 0xa5, 0x24, 0x21, 0xaa, 0xe3, 0x21, 0x12, 0x50, 0xad, 0x10, 0x29, 0x15, 0x1c, 0x36,
-	0xc1, 0x0c, 0x86, 0xa8, 0x12, 0x87, 0xaa, 0xca, 0x2d, 0x4e, 0xd7, 0x2f, 0x33, 0x04, 0x51, 0x50,
-	0x09, 0xc1, 0xc4, 0xdc, 0xcc, 0xbc, 0x7c, 0x7d, 0x30, 0x09, 0x11, 0x52, 0x3a, 0xc7, 0xc8, 0x25,
-	0xe4, 0x5b, 0x9c, 0x1e, 0x9c, 0x9f, 0x56, 0x52, 0x9e, 0x58, 0x94, 0x1a, 0x0a, 0x31, 0x48, 0xc8,
-	0x8c, 0x8b, 0x33, 0xb1, 0xb4, 0x24, 0x23, 0xbf, 0x28, 0xb3, 0xa4, 0x52, 0x82, 0x51, 0x81, 0x51,
-	0x83, 0xd3, 0x49, 0xe2, 0xd2, 0x16, 0x5d, 0x11, 0xa8, 0x6b, 0x1c, 0x53, 0x52, 0x8a, 0x52, 0x8b,
-	0x8b, 0x83, 0x4b, 0x8a, 0x32, 0xf3, 0xd2, 0x83, 0x10, 0x4a, 0x85, 0xac, 0xb9, 0x58, 0x0a, 0x72,
-	0x12, 0xf3, 0x24, 0x98, 0x14, 0x18, 0x35, 0xb8, 0x8d, 0x64, 0xf4, 0xb0, 0x7b, 0x5c, 0x2f, 0x20,
-	0x27, 0x31, 0xcf, 0x89, 0xf3, 0xc4, 0x3d, 0x79, 0x86, 0x15, 0xcf, 0x37, 0x68, 0x31, 0x06, 0x81,
-	0x35, 0x59, 0x39, 0x5f, 0xda, 0xa2, 0xcb, 0x0f, 0xd1, 0xa1, 0x5b, 0x9c, 0x92, 0xad, 0x60, 0xa0,
-	0x67, 0x62, 0xd6, 0xf4, 0x7c, 0x83, 0x16, 0xc2, 0xfc, 0xae, 0xe7, 0x1b, 0xb4, 0x64, 0x11, 0x2a,
-	0xf4, 0x31, 0x5d, 0xae, 0x64, 0xc8, 0x25, 0x85, 0x29, 0x1a, 0x94, 0x5a, 0x5c, 0x90, 0x9f, 0x57,
-	0x9c, 0x6a, 0x25, 0x8c, 0xc5, 0x0a, 0xa5, 0x5e, 0x46, 0x2e, 0x01, 0xdf, 0xe2, 0x74, 0xe7, 0xc4,
-	0xbc, 0xe4, 0xd4, 0x1c, 0x0a, 0x43, 0xc0, 0xca, 0x91, 0x28, 0x4f, 0x48, 0xa3, 0x7a, 0x02, 0xc5,
-	0x6a, 0x25, 0x7d, 0x2e, 0x09, 0x74, 0x31, 0xbc, 0x1e, 0x30, 0x9a, 0xc1, 0xc4, 0xc5, 0xec, 0x5b,
-	0x9c, 0x2e, 0xd4, 0xc6, 0xc8, 0xc5, 0x8f, 0x1e, 0x93, 0x5a, 0xb8, 0xe2, 0x00, 0x33, 0x94, 0xa4,
-	0x8c, 0x88, 0x57, 0x0b, 0x73, 0x90, 0x92, 0xf0, 0x29, 0x4c, 0x07, 0x09, 0x35, 0x30, 0x72, 0xf1,
-	0xa2, 0x06, 0xa7, 0x06, 0x1e, 0xa3, 0x51, 0x54, 0x4a, 0x19, 0x10, 0xab, 0x12, 0xaf, 0x13, 0xa4,
-	0x58, 0x1b, 0x40, 0x29, 0xcb, 0xc9, 0xec, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f,
-	0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18,
-	0xa2, 0x64, 0x20, 0x3a, 0x8a, 0x53, 0xb2, 0xf5, 0x32, 0xf3, 0xf5, 0x2b, 0xe0, 0x79, 0xaa, 0xa4,
-	0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0x9c, 0x3d, 0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x63,
-	0xb7, 0xaa, 0xa0, 0xdc, 0x03, 0x00, 0x00,
+	// 555 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0x3f, 0x6b, 0xdb, 0x4e,
+	0x18, 0xc7, 0x7d, 0xbf, 0xe4, 0x57, 0xf0, 0x95, 0x12, 0x47, 0x31, 0xa9, 0xa3, 0xa6, 0x8a, 0x23,
+	0x32, 0x18, 0x83, 0xee, 0x2c, 0xb7, 0x18, 0xe2, 0x4e, 0x71, 0x66, 0x97, 0x62, 0xd3, 0x0e, 0x5d,
+	0xc2, 0x25, 0xba, 0xaa, 0x22, 0xb6, 0x4e, 0xe8, 0x14, 0x35, 0xd9, 0x42, 0x87, 0x0e, 0x85, 0x42,
+	0x5f, 0x40, 0x5f, 0x40, 0x47, 0x0f, 0x86, 0xbe, 0x85, 0x90, 0xa1, 0x04, 0xd3, 0xa1, 0x53, 0x29,
+	0xf6, 0xe0, 0xb7, 0x51, 0xa4, 0x93, 0x6d, 0x64, 0xc9, 0xc6, 0xa5, 0x8b, 0x74, 0x3c, 0xcf, 0xe7,
+	0xf9, 0xf3, 0xbd, 0xe7, 0xe1, 0xe0, 0xde, 0x19, 0xe3, 0x5d, 0xc6, 0xf1, 0x85, 0x63, 0xba, 0xc4,
+	0xa0, 0xd8, 0xd7, 0x4f, 0xa9, 0x47, 0x74, 0xec, 0x5d, 0x22, 0xc7, 0x65, 0x1e, 0x93, 0xb6, 0x05,
+	0x80, 0x22, 0x00, 0x45, 0x80, 0x9c, 0x37, 0x99, 0xc9, 0x42, 0x04, 0x07, 0x27, 0x41, 0xcb, 0x3b,
+	0x82, 0x3e, 0x11, 0x8e, 0x28, 0x54, 0xb8, 0x0e, 0x16, 0x54, 0x9a, 0x24, 0x16, 0xd4, 0xc3, 0x88,
+	0xea, 0x72, 0x13, 0xfb, 0x7a, 0xf0, 0x8b, 0x1c, 0x9b, 0xa4, 0x6b, 0xd9, 0x0c, 0x87, 0x5f, 0x61,
+	0x52, 0xbf, 0x03, 0x28, 0x35, 0xb9, 0xd9, 0x66, 0x6f, 0xbc, 0x77, 0xc4, 0xa5, 0x2f, 0x45, 0x22,
+	0xa9, 0x06, 0xb3, 0xe4, 0xc2, 0x7b, 0xcb, 0x5c, 0xcb, 0xbb, 0x2a, 0x80, 0x22, 0x28, 0x65, 0x1b,
+	0x85, 0x41, 0x5f, 0xcb, 0x47, 0xdd, 0x1c, 0x19, 0x86, 0x4b, 0x39, 0x6f, 0x7b, 0xae, 0x65, 0x9b,
+	0xad, 0x19, 0x2a, 0x3d, 0x83, 0xeb, 0x4e, 0x87, 0xd8, 0x85, 0xff, 0x8a, 0xa0, 0x74, 0xbf, 0xba,
+	0x8b, 0xd2, 0x85, 0xa3, 0x17, 0x1d, 0x62, 0x37, 0xb2, 0x37, 0xbf, 0xf6, 0x32, 0x5f, 0xc7, 0xbd,
+	0x32, 0x68, 0x85, 0x41, 0xf5, 0xe3, 0x41, 0x5f, 0xdb, 0x10, 0x11, 0x1a, 0x37, 0xce, 0x8b, 0x15,
+	0xf4, 0xb4, 0xf6, 0x7e, 0xdc, 0x2b, 0xcf, 0xf2, 0x7f, 0x1c, 0xf7, 0xca, 0x8f, 0x67, 0x04, 0x4e,
+	0x76, 0xae, 0xea, 0x50, 0x4e, 0x5a, 0x5b, 0x94, 0x3b, 0xcc, 0xe6, 0xb4, 0xbe, 0x95, 0x52, 0x42,
+	0xfd, 0x04, 0x60, 0xae, 0xc9, 0xcd, 0x63, 0x62, 0x9f, 0xd1, 0xce, 0x3f, 0xde, 0x40, 0xfd, 0x68,
+	0x25, 0x11, 0x8f, 0xe2, 0x22, 0x62, 0xa5, 0x55, 0x0c, 0x0b, 0xf3, 0xb6, 0xe5, 0x02, 0x7e, 0x00,
+	0xb8, 0x13, 0x88, 0xb6, 0x4c, 0x9b, 0xcc, 0x22, 0x88, 0x61, 0xd9, 0x94, 0x73, 0xe9, 0x39, 0xdc,
+	0xf4, 0x49, 0xc7, 0x32, 0x88, 0xc7, 0xdc, 0x13, 0x22, 0xfa, 0x8e, 0x14, 0xed, 0x0f, 0xfa, 0x5a,
+	0x74, 0x9f, 0xe8, 0xd5, 0x84, 0x89, 0x4b, 0xcb, 0xf9, 0x73, 0x76, 0x49, 0x82, 0xeb, 0x36, 0xe9,
+	0xd2, 0x70, 0xc6, 0xd9, 0x56, 0x78, 0xae, 0xb7, 0x07, 0x7d, 0x2d, 0x77, 0x39, 0xd9, 0xc3, 0xa2,
+	0x5f, 0x41, 0x55, 0x54, 0x09, 0x64, 0x27, 0x4b, 0x07, 0xf2, 0x0f, 0xe6, 0x66, 0x98, 0xda, 0xb8,
+	0x7a, 0x08, 0xf7, 0x17, 0x3a, 0xa7, 0x17, 0x92, 0x4f, 0xab, 0x5c, 0xfd, 0xb6, 0x06, 0xd7, 0x9a,
+	0xdc, 0x94, 0x3e, 0x00, 0xb8, 0x31, 0xbf, 0xdb, 0xe5, 0x45, 0x5b, 0x99, 0xdc, 0x1b, 0xb9, 0xba,
+	0x3a, 0x3b, 0xe9, 0x48, 0xdd, 0xba, 0x4d, 0x8e, 0x48, 0xba, 0x06, 0xf0, 0x41, 0x7c, 0xc1, 0x4a,
+	0x4b, 0x52, 0xc7, 0x48, 0xb9, 0xb2, 0x2a, 0xb9, 0xbc, 0x85, 0x2f, 0x00, 0x6e, 0x2f, 0x58, 0x11,
+	0x7d, 0x99, 0xcc, 0xd4, 0x10, 0xf9, 0xf0, 0xaf, 0x43, 0xa6, 0xdd, 0xe5, 0x6f, 0x53, 0x46, 0x26,
+	0xff, 0x7f, 0x1d, 0x3c, 0x05, 0x8d, 0xda, 0xcd, 0x50, 0x01, 0x77, 0x43, 0x05, 0xfc, 0x1e, 0x2a,
+	0xe0, 0xf3, 0x48, 0xc9, 0xdc, 0x8d, 0x94, 0xcc, 0xcf, 0x91, 0x92, 0x79, 0xbd, 0x2b, 0x0a, 0x72,
+	0xe3, 0x1c, 0x59, 0x0c, 0x4f, 0xe3, 0xb1, 0x77, 0xe5, 0x50, 0x7e, 0x7a, 0x2f, 0x7c, 0xcf, 0x9e,
+	0xfc, 0x19, 0x00, 0x6f, 0x22, 0x88, 0xda, 0x8d, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -263,6 +365,9 @@ type MsgClient interface {
 	// CancelUpgrade is a governance operation for cancelling a previously
 	// approved software upgrade.
 	CancelUpgrade(ctx context.Context, in *MsgCancelUpgrade, opts ...grpc.CallOption) (*MsgCancelUpgradeResponse, error)
+	// SignalUpgradeReadiness defines a method for a validator to signal that it is ready for the
+	// scheduled upgrade plan.
+	SignalUpgradeReadiness(ctx context.Context, in *MsgSignalUpgradeReadiness, opts ...grpc.CallOption) (*MsgSignalUpgradeReadinessResponse, error)
 }
 
 type msgClient struct {
@@ -291,6 +396,15 @@ func (c *msgClient) CancelUpgrade(ctx context.Context, in *MsgCancelUpgrade, opt
 	return out, nil
 }
 
+func (c *msgClient) SignalUpgradeReadiness(ctx context.Context, in *MsgSignalUpgradeReadiness, opts ...grpc.CallOption) (*MsgSignalUpgradeReadinessResponse, error) {
+	out := new(MsgSignalUpgradeReadinessResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.upgrade.v1beta1.Msg/SignalUpgradeReadiness", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// SoftwareUpgrade is a governance operation for initiating a software upgrade.
@@ -298,6 +412,9 @@ type MsgServer interface {
 	// CancelUpgrade is a governance operation for cancelling a previously
 	// approved software upgrade.
 	CancelUpgrade(context.Context, *MsgCancelUpgrade) (*MsgCancelUpgradeResponse, error)
+	// SignalUpgradeReadiness defines a method for a validator to signal that it is ready for the
+	// scheduled upgrade plan.
+	SignalUpgradeReadiness(context.Context, *MsgSignalUpgradeReadiness) (*MsgSignalUpgradeReadinessResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -310,6 +427,9 @@ func (*UnimplementedMsgServer) SoftwareUpgrade(ctx context.Context, req *MsgSoft
 func (*UnimplementedMsgServer) CancelUpgrade(ctx context.Context, req *MsgCancelUpgrade) (*MsgCancelUpgradeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelUpgrade not implemented")
 }
+func (*UnimplementedMsgServer) SignalUpgradeReadiness(ctx context.Context, req *MsgSignalUpgradeReadiness) (*MsgSignalUpgradeReadinessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignalUpgradeReadiness not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -351,6 +471,24 @@ func _Msg_CancelUpgrade_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SignalUpgradeReadiness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSignalUpgradeReadiness)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SignalUpgradeReadiness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.upgrade.v1beta1.Msg/SignalUpgradeReadiness",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SignalUpgradeReadiness(ctx, req.(*MsgSignalUpgradeReadiness))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.upgrade.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -363,6 +501,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CancelUpgrade",
 			Handler:    _Msg_CancelUpgrade_Handler,
 		},
+		{
+			MethodName: "SignalUpgradeReadiness",
+			Handler:    _Msg_SignalUpgradeReadiness_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/upgrade/v1beta1/tx.proto",
@@ -484,6 +626,66 @@ func (m *MsgCancelUpgradeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSignalUpgradeReadiness) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSignalUpgradeReadiness) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSignalUpgradeReadiness) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSignalUpgradeReadinessResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSignalUpgradeReadinessResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSignalUpgradeReadinessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -541,6 +743,32 @@ func (m *MsgCancelUpgradeResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSignalUpgradeReadiness) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSignalUpgradeReadinessResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -844,6 +1072,170 @@ func (m *MsgCancelUpgradeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSignalUpgradeReadiness) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSignalUpgradeReadiness: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSignalUpgradeReadiness: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSignalUpgradeReadinessResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSignalUpgradeReadinessResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSignalUpgradeReadinessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
@@ -30,6 +31,38 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ReadinessFailureAction defines what happens to an upgrade plan whose readiness condition is not met.
+type ReadinessFailureAction int32
+
+const (
+	// READINESS_FAILURE_ACTION_UNSPECIFIED defines an unspecified action.
+	ReadinessFailureUnspecified ReadinessFailureAction = 0
+	// READINESS_FAILURE_ACTION_DELAY pushes the plan height back by delay_blocks.
+	ReadinessFailureDelay ReadinessFailureAction = 1
+	// READINESS_FAILURE_ACTION_CANCEL cancels the plan.
+	ReadinessFailureCancel ReadinessFailureAction = 2
+)
+
+var ReadinessFailureAction_name = map[int32]string{
+	0: "READINESS_FAILURE_ACTION_UNSPECIFIED",
+	1: "READINESS_FAILURE_ACTION_DELAY",
+	2: "READINESS_FAILURE_ACTION_CANCEL",
+}
+
+var ReadinessFailureAction_value = map[string]int32{
+	"READINESS_FAILURE_ACTION_UNSPECIFIED": 0,
+	"READINESS_FAILURE_ACTION_DELAY":       1,
+	"READINESS_FAILURE_ACTION_CANCEL":      2,
+}
+
+func (x ReadinessFailureAction) String() string {
+	return proto.EnumName(ReadinessFailureAction_name, int32(x))
+}
+
+func (ReadinessFailureAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ccf2a7d4d7b48dca, []int{0}
+}
+
 // Plan specifies information about a planned upgrade and when it should occur.
 type Plan struct {
 	// Sets the name for the upgrade. This name will be used by the upgraded
@@ -53,6 +86,10 @@ type Plan struct {
 	// moved to the IBC module in the sub module 02-client.
 	// If this field is not empty, an error will be thrown.
 	UpgradedClientState *any.Any `protobuf:"bytes,5,opt,name=upgraded_client_state,json=upgradedClientState,proto3" json:"upgraded_client_state,omitempty"` // Deprecated: Do not use.
+	// readiness_condition optionally gates the upgrade on the readiness signalled by the validators.
+	// When set, the upgrade is only executed at the plan height if enough voting power has signalled
+	// its readiness with MsgSignalUpgradeReadiness.
+	ReadinessCondition *ReadinessCondition `protobuf:"bytes,6,opt,name=readiness_condition,json=readinessCondition,proto3" json:"readiness_condition,omitempty"`
 }
 
 func (m *Plan) Reset()         { *m = Plan{} }
@@ -88,6 +125,99 @@ func (m *Plan) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Plan proto.InternalMessageInfo
 
+// ReadinessCondition defines the readiness required from the validators to execute an upgrade plan.
+type ReadinessCondition struct {
+	// threshold is the minimum fraction of the bonded voting power that must have signalled its
+	// readiness when the plan height is reached.
+	Threshold string `protobuf:"bytes,1,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// on_failure defines what happens to the plan when the threshold is not reached at the plan height.
+	OnFailure ReadinessFailureAction `protobuf:"varint,2,opt,name=on_failure,json=onFailure,proto3,enum=cosmos.upgrade.v1beta1.ReadinessFailureAction" json:"on_failure,omitempty"`
+	// delay_blocks is the number of blocks the plan height is pushed back by when the plan is delayed.
+	DelayBlocks int64 `protobuf:"varint,3,opt,name=delay_blocks,json=delayBlocks,proto3" json:"delay_blocks,omitempty"`
+	// max_delays is the maximum number of times the plan can be delayed before it is cancelled.
+	// Zero means no limit.
+	MaxDelays uint32 `protobuf:"varint,4,opt,name=max_delays,json=maxDelays,proto3" json:"max_delays,omitempty"`
+	// delays is the number of times the plan has been delayed. It is set by the module and must be
+	// zero when the plan is scheduled.
+	Delays uint32 `protobuf:"varint,5,opt,name=delays,proto3" json:"delays,omitempty"`
+}
+
+func (m *ReadinessCondition) Reset()         { *m = ReadinessCondition{} }
+func (m *ReadinessCondition) String() string { return proto.CompactTextString(m) }
+func (*ReadinessCondition) ProtoMessage()    {}
+func (*ReadinessCondition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ccf2a7d4d7b48dca, []int{1}
+}
+func (m *ReadinessCondition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReadinessCondition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReadinessCondition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReadinessCondition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadinessCondition.Merge(m, src)
+}
+func (m *ReadinessCondition) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReadinessCondition) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadinessCondition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadinessCondition proto.InternalMessageInfo
+
+// ValidatorReadiness defines the readiness of a bonded validator for an upgrade plan.
+type ValidatorReadiness struct {
+	// validator_address is the operator address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// power is the bonded tokens of the validator.
+	Power cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=power,proto3,customtype=cosmossdk.io/math.Int" json:"power"`
+	// ready is true when the validator has signalled its readiness.
+	Ready bool `protobuf:"varint,3,opt,name=ready,proto3" json:"ready,omitempty"`
+}
+
+func (m *ValidatorReadiness) Reset()         { *m = ValidatorReadiness{} }
+func (m *ValidatorReadiness) String() string { return proto.CompactTextString(m) }
+func (*ValidatorReadiness) ProtoMessage()    {}
+func (*ValidatorReadiness) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ccf2a7d4d7b48dca, []int{2}
+}
+func (m *ValidatorReadiness) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorReadiness) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorReadiness.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorReadiness) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorReadiness.Merge(m, src)
+}
+func (m *ValidatorReadiness) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorReadiness) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorReadiness.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorReadiness proto.InternalMessageInfo
+
 // SoftwareUpgradeProposal is a gov Content type for initiating a software
 // upgrade.
 // Deprecated: This legacy proposal is deprecated in favor of Msg-based gov
@@ -107,7 +237,7 @@ func (m *SoftwareUpgradeProposal) Reset()         { *m = SoftwareUpgradeProposal
 func (m *SoftwareUpgradeProposal) String() string { return proto.CompactTextString(m) }
 func (*SoftwareUpgradeProposal) ProtoMessage()    {}
 func (*SoftwareUpgradeProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ccf2a7d4d7b48dca, []int{1}
+	return fileDescriptor_ccf2a7d4d7b48dca, []int{3}
 }
 func (m *SoftwareUpgradeProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -153,7 +283,7 @@ func (m *CancelSoftwareUpgradeProposal) Reset()         { *m = CancelSoftwareUpg
 func (m *CancelSoftwareUpgradeProposal) String() string { return proto.CompactTextString(m) }
 func (*CancelSoftwareUpgradeProposal) ProtoMessage()    {}
 func (*CancelSoftwareUpgradeProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ccf2a7d4d7b48dca, []int{2}
+	return fileDescriptor_ccf2a7d4d7b48dca, []int{4}
 }
 func (m *CancelSoftwareUpgradeProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -194,7 +324,7 @@ func (m *ModuleVersion) Reset()         { *m = ModuleVersion{} }
 func (m *ModuleVersion) String() string { return proto.CompactTextString(m) }
 func (*ModuleVersion) ProtoMessage()    {}
 func (*ModuleVersion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ccf2a7d4d7b48dca, []int{3}
+	return fileDescriptor_ccf2a7d4d7b48dca, []int{5}
 }
 func (m *ModuleVersion) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -224,7 +354,10 @@ func (m *ModuleVersion) XXX_DiscardUnknown() {
 var xxx_messageInfo_ModuleVersion proto.InternalMessageInfo
 
 func init() {
+	proto.RegisterEnum("cosmos.upgrade.v1beta1.ReadinessFailureAction", ReadinessFailureAction_name, ReadinessFailureAction_value)
 	proto.RegisterType((*Plan)(nil), "cosmos.upgrade.v1beta1.Plan")
+	proto.RegisterType((*ReadinessCondition)(nil), "cosmos.upgrade.v1beta1.ReadinessCondition")
+	proto.RegisterType((*ValidatorReadiness)(nil), "cosmos.upgrade.v1beta1.ValidatorReadiness")
 	proto.RegisterType((*SoftwareUpgradeProposal)(nil), "cosmos.upgrade.v1beta1.SoftwareUpgradeProposal")
 	proto.RegisterType((*CancelSoftwareUpgradeProposal)(nil), "cosmos.upgrade.v1beta1.CancelSoftwareUpgradeProposal")
 	proto.RegisterType((*ModuleVersion)(nil), "cosmos.upgrade.v1beta1.ModuleVersion")
@@ -235,41 +368,64 @@ func init() {
 }
 
 var fileDescriptor_ccf2a7d4d7b48dca = []byte{
-	// 532 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0xbf, 0x6e, 0xd3, 0x40,
-	0x18, 0xcf, 0xb5, 0x6e, 0x51, 0x2e, 0x42, 0x15, 0x26, 0xb4, 0xd7, 0xa8, 0x38, 0x96, 0xc5, 0x10,
-	0x55, 0x8a, 0x4d, 0x5b, 0x26, 0x33, 0x20, 0x92, 0x11, 0x90, 0x8a, 0x0b, 0x1d, 0x58, 0xa2, 0x4b,
-	0x7c, 0x71, 0xad, 0xda, 0x77, 0x96, 0xef, 0x12, 0xc8, 0x2b, 0x30, 0xf5, 0x11, 0x18, 0x11, 0x53,
-	0x87, 0x3e, 0x44, 0xc4, 0x54, 0x31, 0x21, 0x21, 0xf1, 0x27, 0x19, 0xca, 0xc6, 0x2b, 0xa0, 0xbb,
-	0xb3, 0xab, 0x08, 0x0a, 0x62, 0x60, 0x89, 0xbe, 0xef, 0xbb, 0xef, 0x97, 0xdf, 0x9f, 0x3b, 0xc3,
-	0x3b, 0x03, 0xc6, 0x53, 0xc6, 0xbd, 0x51, 0x16, 0xe5, 0x38, 0x24, 0xde, 0x78, 0xa7, 0x4f, 0x04,
-	0xde, 0x29, 0x7b, 0x37, 0xcb, 0x99, 0x60, 0xe6, 0xba, 0xde, 0x72, 0xcb, 0x69, 0xb1, 0xd5, 0xd8,
-	0x8c, 0x18, 0x8b, 0x12, 0xe2, 0xa9, 0xad, 0xfe, 0x68, 0xe8, 0x61, 0x3a, 0xd1, 0x90, 0x46, 0x3d,
-	0x62, 0x11, 0x53, 0xa5, 0x27, 0xab, 0x62, 0xda, 0xfc, 0x15, 0x20, 0xe2, 0x94, 0x70, 0x81, 0xd3,
-	0xac, 0x58, 0xd8, 0xd4, 0x4c, 0x3d, 0x8d, 0x2c, 0x68, 0xf5, 0xd1, 0x0d, 0x9c, 0xc6, 0x94, 0x79,
-	0xea, 0x57, 0x8f, 0x9c, 0x1f, 0x00, 0x1a, 0xfb, 0x09, 0xa6, 0xa6, 0x09, 0x0d, 0x8a, 0x53, 0x82,
-	0x80, 0x0d, 0x5a, 0xd5, 0x40, 0xd5, 0xe6, 0x03, 0x68, 0xc8, 0x7f, 0x47, 0x4b, 0x36, 0x68, 0xd5,
-	0x76, 0x1b, 0xae, 0xa6, 0x76, 0x4b, 0x6a, 0xf7, 0x59, 0x49, 0xdd, 0x59, 0x9b, 0x7e, 0x6e, 0x56,
-	0x4e, 0xbe, 0x34, 0xc1, 0xdb, 0x8b, 0xd3, 0x6d, 0x80, 0x40, 0xa0, 0x80, 0xe6, 0x3a, 0x5c, 0x3d,
-	0x22, 0x71, 0x74, 0x24, 0xd0, 0xb2, 0x0d, 0x5a, 0xcb, 0x41, 0xd1, 0x49, 0xb2, 0x98, 0x0e, 0x19,
-	0x32, 0x34, 0x99, 0xac, 0xcd, 0xc7, 0xf0, 0x56, 0x11, 0x4e, 0xd8, 0x1b, 0x24, 0x31, 0xa1, 0xa2,
-	0xc7, 0x05, 0x16, 0x04, 0xad, 0x28, 0xf6, 0xfa, 0x6f, 0xec, 0x0f, 0xe9, 0xa4, 0xb3, 0x84, 0x40,
-	0x70, 0xb3, 0x84, 0x75, 0x15, 0xea, 0x40, 0x82, 0x7c, 0xf4, 0xfd, 0x4d, 0x13, 0xbc, 0xbe, 0x38,
-	0xdd, 0x5e, 0xd3, 0x09, 0xb4, 0x79, 0x78, 0xec, 0x49, 0xa3, 0xce, 0x27, 0x00, 0x37, 0x0e, 0xd8,
-	0x50, 0xbc, 0xc4, 0x39, 0x79, 0xae, 0x91, 0xfb, 0x39, 0xcb, 0x18, 0xc7, 0x89, 0x59, 0x87, 0x2b,
-	0x22, 0x16, 0x49, 0x99, 0x82, 0x6e, 0x4c, 0x1b, 0xd6, 0x42, 0xc2, 0x07, 0x79, 0x9c, 0x89, 0x98,
-	0x51, 0x95, 0x46, 0x35, 0x58, 0x1c, 0x99, 0xf7, 0xa1, 0x91, 0x25, 0x98, 0x2a, 0x97, 0xb5, 0xdd,
-	0x2d, 0xf7, 0xea, 0xcb, 0x76, 0x25, 0x7f, 0xa7, 0x2a, 0xa3, 0x52, 0x31, 0x05, 0x0a, 0xe4, 0x3f,
-	0x92, 0x52, 0xdf, 0x9f, 0xb5, 0x1b, 0x05, 0x2a, 0x62, 0xe3, 0x4b, 0x44, 0x97, 0x51, 0x41, 0xa8,
-	0x90, 0x46, 0x9c, 0x05, 0x23, 0x7f, 0xd0, 0x8f, 0x80, 0xf3, 0x0e, 0xc0, 0xdb, 0x5d, 0x4c, 0x07,
-	0x24, 0xf9, 0xcf, 0x1e, 0xfd, 0xa7, 0xff, 0x26, 0xb3, 0xb5, 0x20, 0xf3, 0xaf, 0x42, 0x10, 0x70,
-	0x0e, 0xe1, 0xf5, 0x27, 0x2c, 0x1c, 0x25, 0xe4, 0x90, 0xe4, 0x5c, 0xe6, 0x78, 0xd5, 0x23, 0x44,
-	0xf0, 0xda, 0x58, 0x1f, 0x2b, 0x55, 0x46, 0x50, 0xb6, 0xfe, 0x86, 0x54, 0xf4, 0xe1, 0xac, 0xbd,
-	0x70, 0xc5, 0xf6, 0x5d, 0xf7, 0xde, 0x5e, 0xc7, 0x9f, 0x7e, 0xb3, 0x2a, 0xd3, 0x99, 0x05, 0xce,
-	0x67, 0x16, 0xf8, 0x3a, 0xb3, 0xc0, 0xc9, 0xdc, 0xaa, 0x9c, 0xcf, 0xad, 0xca, 0xc7, 0xb9, 0x55,
-	0x79, 0xb1, 0xa5, 0xd7, 0x79, 0x78, 0xec, 0xc6, 0xcc, 0x7b, 0x75, 0xf9, 0xe1, 0x8a, 0x49, 0x46,
-	0x78, 0x7f, 0x55, 0xbd, 0xaf, 0xbd, 0x9f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x9b, 0x6a, 0x37, 0x42,
-	0xd7, 0x03, 0x00, 0x00,
+	// 908 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x4d, 0x6f, 0x1b, 0x45,
+	0x18, 0xf6, 0xb8, 0x4e, 0xc0, 0x13, 0xda, 0xba, 0x53, 0x27, 0xdd, 0x98, 0xc6, 0x76, 0xad, 0x1e,
+	0xa2, 0x80, 0x77, 0xd3, 0x94, 0x93, 0x11, 0xaa, 0xd6, 0x1f, 0x91, 0x2c, 0x52, 0x13, 0xd6, 0x4d,
+	0x24, 0xb8, 0xac, 0x26, 0xbb, 0x63, 0x7b, 0x95, 0xf5, 0xcc, 0xb2, 0x33, 0x71, 0xe3, 0x1f, 0x80,
+	0x84, 0x72, 0xea, 0x1f, 0x88, 0x84, 0xc4, 0x05, 0x71, 0xea, 0x21, 0x3f, 0x22, 0xe2, 0x54, 0x85,
+	0x0b, 0x2a, 0x52, 0x81, 0xe4, 0x10, 0xf8, 0x17, 0x68, 0x67, 0x76, 0x83, 0x49, 0x1c, 0xe0, 0xd0,
+	0xcb, 0x6a, 0xe6, 0x99, 0xf7, 0x79, 0x9f, 0xf7, 0x73, 0xe1, 0x43, 0x87, 0xf1, 0x21, 0xe3, 0xc6,
+	0x5e, 0xd0, 0x0f, 0xb1, 0x4b, 0x8c, 0xd1, 0xa3, 0x1d, 0x22, 0xf0, 0xa3, 0xe4, 0xae, 0x07, 0x21,
+	0x13, 0x0c, 0x2d, 0x28, 0x2b, 0x3d, 0x41, 0x63, 0xab, 0xc2, 0x62, 0x9f, 0xb1, 0xbe, 0x4f, 0x0c,
+	0x69, 0xb5, 0xb3, 0xd7, 0x33, 0x30, 0x1d, 0x2b, 0x4a, 0x21, 0xdf, 0x67, 0x7d, 0x26, 0x8f, 0x46,
+	0x74, 0x8a, 0xd1, 0xd2, 0x65, 0x82, 0xf0, 0x86, 0x84, 0x0b, 0x3c, 0x0c, 0x62, 0x83, 0x45, 0xa5,
+	0x64, 0x2b, 0x66, 0x2c, 0xab, 0x9e, 0xee, 0xe0, 0xa1, 0x47, 0x99, 0x21, 0xbf, 0x0a, 0xaa, 0x9c,
+	0xa6, 0x61, 0x66, 0xd3, 0xc7, 0x14, 0x21, 0x98, 0xa1, 0x78, 0x48, 0x34, 0x50, 0x06, 0xcb, 0x59,
+	0x4b, 0x9e, 0xd1, 0x13, 0x98, 0x89, 0xbc, 0x6b, 0xe9, 0x32, 0x58, 0x9e, 0x5b, 0x2b, 0xe8, 0x4a,
+	0x5a, 0x4f, 0xa4, 0xf5, 0x67, 0x89, 0x74, 0xfd, 0xf6, 0xf1, 0x9b, 0x52, 0xea, 0xc5, 0xaf, 0x25,
+	0xf0, 0xfd, 0xf9, 0xcb, 0x15, 0xa0, 0x01, 0x4b, 0x12, 0xd1, 0x02, 0x9c, 0x1d, 0x10, 0xaf, 0x3f,
+	0x10, 0xda, 0x8d, 0x32, 0x58, 0xbe, 0x61, 0xc5, 0xb7, 0x48, 0xcc, 0xa3, 0x3d, 0xa6, 0x65, 0x94,
+	0x58, 0x74, 0x46, 0x1b, 0x70, 0x3e, 0x2e, 0x8e, 0x6b, 0x3b, 0xbe, 0x47, 0xa8, 0xb0, 0xb9, 0xc0,
+	0x82, 0x68, 0x33, 0x52, 0x3d, 0x7f, 0x45, 0xdd, 0xa4, 0xe3, 0x7a, 0x5a, 0x03, 0xd6, 0xdd, 0x84,
+	0xd6, 0x90, 0xac, 0x6e, 0x44, 0x42, 0x5f, 0xc1, 0xbb, 0x21, 0xc1, 0xae, 0x47, 0x09, 0xe7, 0xb6,
+	0xc3, 0xa8, 0xeb, 0x09, 0x8f, 0x51, 0x6d, 0x56, 0xfa, 0x5a, 0xd1, 0xa7, 0x77, 0x43, 0xb7, 0x12,
+	0x4a, 0x23, 0x61, 0xd4, 0xf3, 0xaf, 0x8f, 0xaa, 0xb9, 0xfd, 0xa4, 0x9b, 0xe5, 0xd1, 0xaa, 0xbe,
+	0xa6, 0xaf, 0x5a, 0x28, 0xbc, 0x62, 0x59, 0xd3, 0xfe, 0xf8, 0xb6, 0x04, 0x0e, 0xce, 0x5f, 0xae,
+	0xdc, 0x56, 0xde, 0xab, 0xdc, 0xdd, 0x35, 0xa2, 0xda, 0x56, 0xbe, 0x4e, 0x43, 0x74, 0xd5, 0x35,
+	0xfa, 0x10, 0x66, 0xc5, 0x20, 0x24, 0x7c, 0xc0, 0x7c, 0x57, 0xd5, 0xbd, 0x7e, 0xeb, 0xe4, 0xa8,
+	0x0a, 0xe3, 0xe0, 0x9a, 0xc4, 0xb1, 0xfe, 0x36, 0x40, 0x4f, 0x21, 0x64, 0xd4, 0xee, 0x61, 0xcf,
+	0xdf, 0x0b, 0x55, 0x4b, 0x6e, 0xad, 0xe9, 0xff, 0x99, 0xc8, 0xba, 0xb2, 0x37, 0x9d, 0x48, 0xd1,
+	0xca, 0x32, 0x1a, 0x03, 0xe8, 0x01, 0x7c, 0xcf, 0x25, 0x3e, 0x1e, 0xdb, 0x3b, 0x3e, 0x73, 0x76,
+	0x79, 0xdc, 0xa0, 0x39, 0x89, 0xd5, 0x25, 0x84, 0x96, 0x20, 0x1c, 0xe2, 0x7d, 0x5b, 0x42, 0x5c,
+	0xf6, 0xea, 0xa6, 0x95, 0x1d, 0xe2, 0xfd, 0xa6, 0x04, 0xa2, 0xe6, 0xc6, 0x4f, 0x33, 0xf2, 0x29,
+	0xbe, 0xa9, 0x3a, 0x9c, 0x4c, 0xa9, 0x5a, 0xe5, 0x27, 0x00, 0xd1, 0x36, 0xf6, 0x3d, 0x17, 0x0b,
+	0x16, 0x5e, 0x84, 0x88, 0x3a, 0xf0, 0xce, 0x28, 0x41, 0x6d, 0xec, 0xba, 0x21, 0xe1, 0x3c, 0xae,
+	0xc7, 0x83, 0x93, 0xa3, 0xea, 0x52, 0x9c, 0xe3, 0x05, 0xd3, 0x54, 0x26, 0x5d, 0x11, 0x7a, 0xb4,
+	0x6f, 0xe5, 0x46, 0x97, 0x70, 0x64, 0xc2, 0x99, 0x80, 0x3d, 0x27, 0xa1, 0x2c, 0x52, 0xb6, 0xfe,
+	0x41, 0x34, 0x9b, 0xaf, 0xdf, 0x94, 0xe6, 0x95, 0x1f, 0xee, 0xee, 0xea, 0x1e, 0x33, 0x86, 0x58,
+	0x0c, 0xf4, 0x36, 0x15, 0x13, 0x05, 0x6f, 0x53, 0x61, 0x29, 0x26, 0xca, 0xc3, 0x99, 0xa8, 0xc3,
+	0x63, 0x59, 0x96, 0x77, 0x2d, 0x75, 0xa9, 0xe5, 0xa7, 0x66, 0xf5, 0x0b, 0x80, 0xf7, 0xba, 0xac,
+	0x27, 0x9e, 0xe3, 0x90, 0x6c, 0xa9, 0xa7, 0xcd, 0x90, 0x05, 0x8c, 0x63, 0x3f, 0xf2, 0x23, 0x3c,
+	0xe1, 0x27, 0x6b, 0xa5, 0x2e, 0xa8, 0x0c, 0xe7, 0x5c, 0xc2, 0x9d, 0xd0, 0x0b, 0xe4, 0x50, 0xca,
+	0x30, 0xad, 0x49, 0x08, 0x7d, 0x0c, 0x33, 0x81, 0x8f, 0xa9, 0x94, 0x9f, 0x5b, 0xbb, 0x7f, 0x5d,
+	0x9b, 0xa3, 0xe9, 0xaa, 0x67, 0xa3, 0xfc, 0xe4, 0xde, 0x59, 0x92, 0x54, 0xfb, 0x34, 0x6a, 0xc0,
+	0x8f, 0x47, 0xd5, 0x42, 0xcc, 0xea, 0xb3, 0xd1, 0x05, 0xa3, 0xc1, 0xa8, 0x20, 0x54, 0x44, 0x63,
+	0x5a, 0x99, 0x18, 0xd3, 0x6b, 0xe2, 0xd7, 0x40, 0xe5, 0x07, 0x00, 0x97, 0x1a, 0x98, 0x3a, 0xc4,
+	0x7f, 0xcb, 0x39, 0xd6, 0x3e, 0xff, 0x7f, 0x61, 0x2e, 0x4f, 0x84, 0xf9, 0xaf, 0x81, 0x68, 0xa0,
+	0xb2, 0x0d, 0x6f, 0x3e, 0x65, 0xee, 0x9e, 0x4f, 0xb6, 0x49, 0xc8, 0x3d, 0x36, 0xfd, 0xaf, 0xa6,
+	0xc1, 0x77, 0x46, 0xea, 0x59, 0x46, 0x95, 0xb1, 0x92, 0x6b, 0xed, 0x5e, 0x3c, 0xb9, 0x13, 0x0b,
+	0x5c, 0x5e, 0xd5, 0x3f, 0x7a, 0xbc, 0xf2, 0x27, 0x80, 0x0b, 0xd3, 0x57, 0x0a, 0xb5, 0xe1, 0x43,
+	0xab, 0x65, 0x36, 0xdb, 0x9d, 0x56, 0xb7, 0x6b, 0xaf, 0x9b, 0xed, 0x8d, 0x2d, 0xab, 0x65, 0x9b,
+	0x8d, 0x67, 0xed, 0xcf, 0x3a, 0xf6, 0x56, 0xa7, 0xbb, 0xd9, 0x6a, 0xb4, 0xd7, 0xdb, 0xad, 0x66,
+	0x2e, 0x55, 0x28, 0x1d, 0x1c, 0x96, 0xdf, 0xbf, 0xec, 0x65, 0x8b, 0xf2, 0x80, 0x38, 0x5e, 0xcf,
+	0x23, 0x2e, 0xfa, 0x04, 0x16, 0xaf, 0x75, 0xd5, 0x6c, 0x6d, 0x98, 0x5f, 0xe4, 0x40, 0x61, 0xf1,
+	0xe0, 0xb0, 0x3c, 0x7f, 0xd9, 0x89, 0x5c, 0x48, 0xf4, 0x04, 0x96, 0xae, 0xa5, 0x37, 0xcc, 0x4e,
+	0xa3, 0xb5, 0x91, 0x4b, 0x17, 0x0a, 0x07, 0x87, 0xe5, 0x2b, 0xa9, 0xa8, 0xb2, 0x16, 0x32, 0xdf,
+	0x7c, 0x57, 0x4c, 0xd5, 0x6b, 0xc7, 0xbf, 0x17, 0x53, 0xc7, 0xa7, 0x45, 0xf0, 0xea, 0xb4, 0x08,
+	0x7e, 0x3b, 0x2d, 0x82, 0x17, 0x67, 0xc5, 0xd4, 0xab, 0xb3, 0x62, 0xea, 0xe7, 0xb3, 0x62, 0xea,
+	0xcb, 0xfb, 0xff, 0x58, 0xa2, 0x8b, 0x5d, 0x30, 0xc4, 0x38, 0x20, 0x7c, 0x67, 0x56, 0xfe, 0x9c,
+	0x1f, 0xff, 0x35, 0x00, 0x44, 0x69, 0x31, 0x7b, 0x14, 0x07, 0x00, 0x00,
 }
 
 func (this *Plan) Equal(that interface{}) bool {
@@ -306,6 +462,45 @@ func (this *Plan) Equal(that interface{}) bool {
 	if !this.UpgradedClientState.Equal(that1.UpgradedClientState) {
 		return false
 	}
+	if !this.ReadinessCondition.Equal(that1.ReadinessCondition) {
+		return false
+	}
+	return true
+}
+func (this *ReadinessCondition) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ReadinessCondition)
+	if !ok {
+		that2, ok := that.(ReadinessCondition)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Threshold != that1.Threshold {
+		return false
+	}
+	if this.OnFailure != that1.OnFailure {
+		return false
+	}
+	if this.DelayBlocks != that1.DelayBlocks {
+		return false
+	}
+	if this.MaxDelays != that1.MaxDelays {
+		return false
+	}
+	if this.Delays != that1.Delays {
+		return false
+	}
 	return true
 }
 func (this *SoftwareUpgradeProposal) Equal(that interface{}) bool {
@@ -412,6 +607,18 @@ func (m *Plan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ReadinessCondition != nil {
+		{
+			size, err := m.ReadinessCondition.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintUpgrade(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.UpgradedClientState != nil {
 		{
 			size, err := m.UpgradedClientState.MarshalToSizedBuffer(dAtA[:i])
@@ -436,12 +643,12 @@ func (m *Plan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x18
 	}
-	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
-	if err2 != nil {
-		return 0, err2
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err3 != nil {
+		return 0, err3
 	}
-	i -= n2
-	i = encodeVarintUpgrade(dAtA, i, uint64(n2))
+	i -= n3
+	i = encodeVarintUpgrade(dAtA, i, uint64(n3))
 	i--
 	dAtA[i] = 0x12
 	if len(m.Name) > 0 {
@@ -454,6 +661,106 @@ func (m *Plan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ReadinessCondition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReadinessCondition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReadinessCondition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Delays != 0 {
+		i = encodeVarintUpgrade(dAtA, i, uint64(m.Delays))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxDelays != 0 {
+		i = encodeVarintUpgrade(dAtA, i, uint64(m.MaxDelays))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.DelayBlocks != 0 {
+		i = encodeVarintUpgrade(dAtA, i, uint64(m.DelayBlocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.OnFailure != 0 {
+		i = encodeVarintUpgrade(dAtA, i, uint64(m.OnFailure))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Threshold) > 0 {
+		i -= len(m.Threshold)
+		copy(dAtA[i:], m.Threshold)
+		i = encodeVarintUpgrade(dAtA, i, uint64(len(m.Threshold)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorReadiness) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorReadiness) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorReadiness) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Ready {
+		i--
+		if m.Ready {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Power.Size()
+		i -= size
+		if _, err := m.Power.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintUpgrade(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintUpgrade(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *SoftwareUpgradeProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -607,6 +914,53 @@ func (m *Plan) Size() (n int) {
 		l = m.UpgradedClientState.Size()
 		n += 1 + l + sovUpgrade(uint64(l))
 	}
+	if m.ReadinessCondition != nil {
+		l = m.ReadinessCondition.Size()
+		n += 1 + l + sovUpgrade(uint64(l))
+	}
+	return n
+}
+
+func (m *ReadinessCondition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Threshold)
+	if l > 0 {
+		n += 1 + l + sovUpgrade(uint64(l))
+	}
+	if m.OnFailure != 0 {
+		n += 1 + sovUpgrade(uint64(m.OnFailure))
+	}
+	if m.DelayBlocks != 0 {
+		n += 1 + sovUpgrade(uint64(m.DelayBlocks))
+	}
+	if m.MaxDelays != 0 {
+		n += 1 + sovUpgrade(uint64(m.MaxDelays))
+	}
+	if m.Delays != 0 {
+		n += 1 + sovUpgrade(uint64(m.Delays))
+	}
+	return n
+}
+
+func (m *ValidatorReadiness) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovUpgrade(uint64(l))
+	}
+	l = m.Power.Size()
+	n += 1 + l + sovUpgrade(uint64(l))
+	if m.Ready {
+		n += 2
+	}
 	return n
 }
 
@@ -849,6 +1203,336 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadinessCondition", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ReadinessCondition == nil {
+				m.ReadinessCondition = &ReadinessCondition{}
+			}
+			if err := m.ReadinessCondition.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUpgrade(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReadinessCondition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUpgrade
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReadinessCondition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReadinessCondition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Threshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnFailure", wireType)
+			}
+			m.OnFailure = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OnFailure |= ReadinessFailureAction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelayBlocks", wireType)
+			}
+			m.DelayBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelayBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDelays", wireType)
+			}
+			m.MaxDelays = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDelays |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delays", wireType)
+			}
+			m.Delays = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Delays |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUpgrade(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorReadiness) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUpgrade
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorReadiness: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorReadiness: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Power.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ready", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Ready = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipUpgrade(dAtA[iNdEx:])